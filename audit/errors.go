@@ -0,0 +1,10 @@
+package audit
+
+import "errors"
+
+var (
+	ErrInvalidAction     = errors.New("action is not valid")
+	ErrInvalidTargetType = errors.New("target type is not valid, must be one of 'repository', 'token', 'team', 'user', 'registry', 'namespace'")
+	ErrInvalidLimit      = errors.New("limit is not valid, must be between 1 and 1000")
+	ErrInvalidTimeRange  = errors.New("time range is not valid, start cannot be after end")
+)
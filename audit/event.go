@@ -0,0 +1,132 @@
+package audit
+
+import (
+	"github.com/evanebb/regauth/store"
+	"github.com/google/uuid"
+	"net"
+	"time"
+)
+
+// Event is a single entry in the audit log, recording an action that was performed by an actor on a target.
+type Event struct {
+	ID        uuid.UUID
+	Timestamp time.Time
+	Action    Action
+	// ActorID is the ID of the user that performed the action. It is uuid.Nil for anonymous actors, for example when an
+	// unauthenticated client requests a registry token to pull a public image.
+	ActorID       uuid.UUID
+	ActorUsername string
+	// SourceIP is the IP address that the action was performed from, if known.
+	SourceIP   net.IP
+	TargetType TargetType
+	// Target identifies the target of the action, for example 'namespace/name' for a repository or the username of a user.
+	Target string
+	// Details contains additional information about the action, which differs per action.
+	Details map[string]string
+}
+
+func (e Event) IsValid() error {
+	if err := e.Action.IsValid(); err != nil {
+		return err
+	}
+
+	return e.TargetType.IsValid()
+}
+
+// Position returns the position of the event in the audit log, which is sorted on the timestamp of the events.
+func (e Event) Position() store.Position {
+	return store.Position{CreatedAt: e.Timestamp, ID: e.ID}
+}
+
+type Action string
+
+const (
+	ActionRepositoryCreate                = Action("repository.create")
+	ActionRepositoryUpdate                = Action("repository.update")
+	ActionRepositoryDelete                = Action("repository.delete")
+	ActionRepositoryCollaboratorAdd       = Action("repository.collaborator.add")
+	ActionRepositoryCollaboratorRemove    = Action("repository.collaborator.remove")
+	ActionRepositoryProtectedTagAdd       = Action("repository.protectedtag.add")
+	ActionRepositoryProtectedTagRemove    = Action("repository.protectedtag.remove")
+	ActionRepositoryProtectedTagOverwrite = Action("repository.protectedtag.overwrite")
+	ActionRepositoryProtectedTagDelete    = Action("repository.protectedtag.delete")
+	ActionTokenCreate                     = Action("token.create")
+	ActionTokenDelete                     = Action("token.delete")
+	ActionTokenRevoke                     = Action("token.revoke")
+	ActionTokenRotate                     = Action("token.rotate")
+	ActionTeamCreate                      = Action("team.create")
+	ActionTeamUpdate                      = Action("team.update")
+	ActionTeamDelete                      = Action("team.delete")
+	ActionTeamMemberAdd                   = Action("team.member.add")
+	ActionTeamMemberRemove                = Action("team.member.remove")
+	ActionTeamRobotCreate                 = Action("team.robot.create")
+	ActionTeamRobotDelete                 = Action("team.robot.delete")
+	ActionUserCreate                      = Action("user.create")
+	ActionUserDelete                      = Action("user.delete")
+	ActionUserDisable                     = Action("user.disable")
+	ActionUserEnable                      = Action("user.enable")
+	ActionUserPasswordChange              = Action("user.password.change")
+	ActionRegistryTokenIssue              = Action("registry.token.issue")
+	ActionNamespaceSettingsUpdate         = Action("namespace.settings.update")
+	ActionNamespaceQuotaUpdate            = Action("namespace.quota.update")
+	ActionNamespaceQuotaDelete            = Action("namespace.quota.delete")
+)
+
+var validActions = map[Action]struct{}{
+	ActionRepositoryCreate:                {},
+	ActionRepositoryUpdate:                {},
+	ActionRepositoryDelete:                {},
+	ActionRepositoryCollaboratorAdd:       {},
+	ActionRepositoryCollaboratorRemove:    {},
+	ActionRepositoryProtectedTagAdd:       {},
+	ActionRepositoryProtectedTagRemove:    {},
+	ActionRepositoryProtectedTagOverwrite: {},
+	ActionRepositoryProtectedTagDelete:    {},
+	ActionTokenCreate:                     {},
+	ActionTokenDelete:                     {},
+	ActionTokenRevoke:                     {},
+	ActionTokenRotate:                     {},
+	ActionTeamCreate:                      {},
+	ActionTeamDelete:                      {},
+	ActionTeamMemberAdd:                   {},
+	ActionTeamMemberRemove:                {},
+	ActionTeamRobotCreate:                 {},
+	ActionTeamRobotDelete:                 {},
+	ActionUserCreate:                      {},
+	ActionUserDelete:                      {},
+	ActionUserDisable:                     {},
+	ActionUserEnable:                      {},
+	ActionUserPasswordChange:              {},
+	ActionRegistryTokenIssue:              {},
+	ActionNamespaceSettingsUpdate:         {},
+	ActionNamespaceQuotaUpdate:            {},
+	ActionNamespaceQuotaDelete:            {},
+}
+
+func (a Action) IsValid() error {
+	if _, ok := validActions[a]; !ok {
+		return ErrInvalidAction
+	}
+
+	return nil
+}
+
+type TargetType string
+
+const (
+	TargetTypeRepository = TargetType("repository")
+	TargetTypeToken      = TargetType("token")
+	TargetTypeTeam       = TargetType("team")
+	TargetTypeUser       = TargetType("user")
+	TargetTypeRegistry   = TargetType("registry")
+	TargetTypeNamespace  = TargetType("namespace")
+)
+
+func (t TargetType) IsValid() error {
+	if t != TargetTypeRepository && t != TargetTypeToken && t != TargetTypeTeam && t != TargetTypeUser && t != TargetTypeRegistry &&
+		t != TargetTypeNamespace {
+		return ErrInvalidTargetType
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// NewJSONLinesExporter wraps the given Store, so that every recorded event is also written to w as a single line of
+// JSON. This allows the audit log to be shipped to external systems, such as a SIEM.
+func NewJSONLinesExporter(s Store, w io.Writer) Store {
+	return &jsonLinesExporter{Store: s, w: w}
+}
+
+type jsonLinesExporter struct {
+	Store
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (e *jsonLinesExporter) Record(ctx context.Context, ev Event) error {
+	if err := e.Store.Record(ctx, ev); err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(convertToJSONEvent(ev))
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	_, err = e.w.Write(append(line, '\n'))
+	return err
+}
+
+type jsonEvent struct {
+	ID            string            `json:"id"`
+	Timestamp     time.Time         `json:"timestamp"`
+	Action        Action            `json:"action"`
+	ActorID       string            `json:"actorId,omitempty"`
+	ActorUsername string            `json:"actorUsername,omitempty"`
+	SourceIP      net.IP            `json:"sourceIp,omitempty"`
+	TargetType    TargetType        `json:"targetType"`
+	Target        string            `json:"target"`
+	Details       map[string]string `json:"details,omitempty"`
+}
+
+func convertToJSONEvent(e Event) jsonEvent {
+	j := jsonEvent{
+		ID:            e.ID.String(),
+		Timestamp:     e.Timestamp,
+		Action:        e.Action,
+		ActorUsername: e.ActorUsername,
+		SourceIP:      e.SourceIP,
+		TargetType:    e.TargetType,
+		Target:        e.Target,
+		Details:       e.Details,
+	}
+
+	if e.ActorID != uuid.Nil {
+		j.ActorID = e.ActorID.String()
+	}
+
+	return j
+}
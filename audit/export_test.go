@@ -0,0 +1,77 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/evanebb/regauth/store"
+	"github.com/google/uuid"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+type recordingStore struct {
+	events []Event
+}
+
+func (s *recordingStore) Tx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (s *recordingStore) Record(ctx context.Context, e Event) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *recordingStore) List(ctx context.Context, f Filter) (store.Page[Event], error) {
+	return store.Page[Event]{Items: s.events}, nil
+}
+
+func TestJSONLinesExporter_Record(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	underlying := &recordingStore{}
+	s := NewJSONLinesExporter(underlying, &buf)
+
+	id, _ := uuid.NewV7()
+	e := Event{
+		ID:            id,
+		Timestamp:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Action:        ActionTeamCreate,
+		ActorUsername: "anonymous-actor",
+		SourceIP:      net.ParseIP("10.0.0.1"),
+		TargetType:    TargetTypeTeam,
+		Target:        "team",
+	}
+
+	for range 2 {
+		if err := s.Record(t.Context(), e); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+	}
+
+	if len(underlying.events) != 2 {
+		t.Errorf("expected events to be recorded in the underlying store, got %d events", len(underlying.events))
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected two lines, got %d", len(lines))
+	}
+
+	var actual map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &actual); err != nil {
+		t.Fatalf("expected line to be valid JSON, got %q", err)
+	}
+
+	if actual["id"] != id.String() || actual["action"] != "team.create" || actual["sourceIp"] != "10.0.0.1" {
+		t.Errorf("unexpected exported event %v", actual)
+	}
+
+	if _, ok := actual["actorId"]; ok {
+		t.Errorf("expected anonymous actor ID to be omitted, got %v", actual["actorId"])
+	}
+}
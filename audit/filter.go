@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"github.com/evanebb/regauth/store"
+	"time"
+)
+
+const (
+	DefaultLimit = 100
+	MaxLimit     = 1000
+)
+
+// Filter selects the events that are returned when querying the audit log. Empty fields are not filtered on.
+// Events are always returned from newest to oldest.
+type Filter struct {
+	ActorUsername string
+	Action        Action
+	TargetType    TargetType
+	Target        string
+	// Since and Until restrict the events to the ones that happened in the given time range. Both are inclusive.
+	Since time.Time
+	Until time.Time
+	Limit int
+	// Cursor continues a previous listing after the last event that it returned. It is empty for the first page.
+	Cursor store.Cursor
+}
+
+func (f Filter) IsValid() error {
+	if f.Action != "" {
+		if err := f.Action.IsValid(); err != nil {
+			return err
+		}
+	}
+
+	if f.TargetType != "" {
+		if err := f.TargetType.IsValid(); err != nil {
+			return err
+		}
+	}
+
+	if !f.Since.IsZero() && !f.Until.IsZero() && f.Since.After(f.Until) {
+		return ErrInvalidTimeRange
+	}
+
+	if f.Limit < 1 || f.Limit > MaxLimit {
+		return ErrInvalidLimit
+	}
+
+	_, _, err := f.ListOptions().After()
+	return err
+}
+
+// ListOptions returns the pagination of the filter as store.ListOptions, sorted from newest to oldest.
+func (f Filter) ListOptions() store.ListOptions {
+	return store.ListOptions{
+		Limit:  f.Limit,
+		Cursor: f.Cursor,
+		Sort:   store.SortByCreatedAt,
+		Order:  store.SortDescending,
+	}
+}
+
+// Matches checks whether the given event matches the filter. Pagination is not taken into account.
+func (f Filter) Matches(e Event) bool {
+	if f.ActorUsername != "" && e.ActorUsername != f.ActorUsername {
+		return false
+	}
+
+	if f.Action != "" && e.Action != f.Action {
+		return false
+	}
+
+	if f.TargetType != "" && e.TargetType != f.TargetType {
+		return false
+	}
+
+	if f.Target != "" && e.Target != f.Target {
+		return false
+	}
+
+	if !f.Since.IsZero() && e.Timestamp.Before(f.Since) {
+		return false
+	}
+
+	if !f.Until.IsZero() && e.Timestamp.After(f.Until) {
+		return false
+	}
+
+	return true
+}
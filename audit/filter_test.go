@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/store"
+	"testing"
+	"time"
+)
+
+func TestFilter_IsValid(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := []struct {
+		desc   string
+		filter Filter
+		err    error
+	}{
+		{"valid filter", Filter{Action: ActionUserCreate, TargetType: TargetTypeUser, Limit: DefaultLimit}, nil},
+		{"invalid action", Filter{Action: "user.frobnicate", Limit: DefaultLimit}, ErrInvalidAction},
+		{"invalid target type", Filter{TargetType: "organization", Limit: DefaultLimit}, ErrInvalidTargetType},
+		{"since after until", Filter{Since: now, Until: now.Add(-time.Hour), Limit: DefaultLimit}, ErrInvalidTimeRange},
+		{"limit too low", Filter{Limit: 0}, ErrInvalidLimit},
+		{"limit too high", Filter{Limit: MaxLimit + 1}, ErrInvalidLimit},
+		{"malformed cursor", Filter{Limit: DefaultLimit, Cursor: "not-a-cursor"}, store.ErrInvalidCursor},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.filter.IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	e := Event{
+		Timestamp:     timestamp,
+		Action:        ActionRepositoryCreate,
+		ActorUsername: "user",
+		TargetType:    TargetTypeRepository,
+		Target:        "user/image",
+	}
+
+	testCases := []struct {
+		desc     string
+		filter   Filter
+		expected bool
+	}{
+		{"empty filter", Filter{}, true},
+		{"matching filter", Filter{ActorUsername: "user", Action: ActionRepositoryCreate, TargetType: TargetTypeRepository, Target: "user/image"}, true},
+		{"other actor", Filter{ActorUsername: "other"}, false},
+		{"other action", Filter{Action: ActionRepositoryDelete}, false},
+		{"other target type", Filter{TargetType: TargetTypeTeam}, false},
+		{"other target", Filter{Target: "user/other"}, false},
+		{"inclusive time range", Filter{Since: timestamp, Until: timestamp}, true},
+		{"before time range", Filter{Since: timestamp.Add(time.Second)}, false},
+		{"after time range", Filter{Until: timestamp.Add(-time.Second)}, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			if actual := c.filter.Matches(e); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
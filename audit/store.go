@@ -0,0 +1,13 @@
+package audit
+
+import (
+	"context"
+	"github.com/evanebb/regauth/store"
+)
+
+type Store interface {
+	store.TransactionStore
+	Record(ctx context.Context, e Event) error
+	// List returns the events matching the given filter, from newest to oldest.
+	List(ctx context.Context, f Filter) (store.Page[Event], error)
+}
@@ -45,8 +45,12 @@ func (a authenticator) Authenticate(ctx context.Context, username, password stri
 		return p, u, errors.Join(ErrAuthenticationFailed, errors.New("token does not belong to user"))
 	}
 
-	if p.ExpirationDate.Before(time.Now()) {
-		return p, u, errors.Join(ErrAuthenticationFailed, errors.New("token has expired"))
+	if err := ValidateToken(p, u, time.Now()); err != nil {
+		return p, u, err
+	}
+
+	if err := ValidateSourceIP(p, sourceIP); err != nil {
+		return p, u, err
 	}
 
 	// Log that the token was used
@@ -59,3 +63,36 @@ func (a authenticator) Authenticate(ctx context.Context, username, password stri
 	err = a.tokenStore.AddUsageLogEntry(ctx, logEntry)
 	return p, u, err
 }
+
+// ValidateToken checks whether the given personal access token can still be used by its owner at the given time. The
+// token cannot have expired or been revoked, and its owner cannot be disabled. This is shared by every way of
+// authenticating using a personal access token, so they all reject the same tokens.
+func ValidateToken(p token.PersonalAccessToken, owner user.User, now time.Time) error {
+	if p.IsExpired(now) {
+		return errors.Join(ErrAuthenticationFailed, errors.New("token has expired"))
+	}
+
+	if p.IsRevoked() {
+		return errors.Join(ErrAuthenticationFailed, errors.New("token has been revoked"))
+	}
+
+	return ValidateUser(owner)
+}
+
+// ValidateSourceIP checks whether the given personal access token may be used from the given IP address.
+func ValidateSourceIP(p token.PersonalAccessToken, sourceIP net.IP) error {
+	if !p.AllowsSourceIP(sourceIP) {
+		return errors.Join(ErrAuthenticationFailed, errors.New("token cannot be used from this source IP address"))
+	}
+
+	return nil
+}
+
+// ValidateUser checks whether the given user is allowed to authenticate.
+func ValidateUser(u user.User) error {
+	if u.Disabled {
+		return errors.Join(ErrAuthenticationFailed, errors.New("user is disabled"))
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/evanebb/regauth/user"
 	"github.com/google/uuid"
 	"net"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -114,6 +115,130 @@ func TestAuthenticator_Authenticate(t *testing.T) {
 		}
 	})
 
+	t.Run("personal access token has been revoked", func(t *testing.T) {
+		t.Parallel()
+		tokenStore := memory.NewPersonalAccessTokenStore()
+		userStore := memory.NewUserStore()
+		a := NewAuthenticator(tokenStore, userStore, "registry_pat_")
+
+		u := user.User{
+			ID:       uuid.New(),
+			Username: "user",
+			Role:     user.RoleAdmin,
+		}
+		if err := userStore.Create(t.Context(), u); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		tok := token.PersonalAccessToken{
+			ID:             uuid.New(),
+			Description:    "token",
+			Permission:     token.PermissionReadOnly,
+			ExpirationDate: time.Now().Add(time.Hour),
+			RevokedAt:      time.Now().Add(-time.Minute),
+			UserID:         u.ID,
+		}
+		if err := tokenStore.Create(t.Context(), tok, "registry_pat_foobarbaz"); err != nil {
+			t.Fatalf("failed to create personal access token: %q", err)
+		}
+
+		_, _, err := a.Authenticate(t.Context(), "user", "registry_pat_foobarbaz", sourceIP)
+		if !errors.Is(err, ErrAuthenticationFailed) || !strings.Contains(err.Error(), "token has been revoked") {
+			t.Fatalf("expected %q, got %q", ErrAuthenticationFailed, err)
+		}
+	})
+
+	t.Run("owner of personal access token is disabled", func(t *testing.T) {
+		t.Parallel()
+		tokenStore := memory.NewPersonalAccessTokenStore()
+		userStore := memory.NewUserStore()
+		a := NewAuthenticator(tokenStore, userStore, "registry_pat_")
+
+		u := user.User{
+			ID:       uuid.New(),
+			Username: "user",
+			Role:     user.RoleUser,
+			Disabled: true,
+		}
+		if err := userStore.Create(t.Context(), u); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		tok := token.PersonalAccessToken{
+			ID:             uuid.New(),
+			Description:    "token",
+			Permission:     token.PermissionReadOnly,
+			ExpirationDate: time.Now().Add(time.Hour),
+			UserID:         u.ID,
+		}
+		if err := tokenStore.Create(t.Context(), tok, "registry_pat_foobarbaz"); err != nil {
+			t.Fatalf("failed to create personal access token: %q", err)
+		}
+
+		_, _, err := a.Authenticate(t.Context(), "user", "registry_pat_foobarbaz", sourceIP)
+		if !errors.Is(err, ErrAuthenticationFailed) || !strings.Contains(err.Error(), "user is disabled") {
+			t.Fatalf("expected %q, got %q", ErrAuthenticationFailed, err)
+		}
+
+		// a rejected token is not recorded as used
+		page, err := tokenStore.GetUsageLog(t.Context(), tok.ID, token.UsageLogFilter{Limit: token.DefaultUsageLogLimit})
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if len(page.Items) != 0 {
+			t.Fatalf("expected no token usage log entries, got %d entries", len(page.Items))
+		}
+	})
+
+	t.Run("source IP address is not allowed", func(t *testing.T) {
+		t.Parallel()
+		tokenStore := memory.NewPersonalAccessTokenStore()
+		userStore := memory.NewUserStore()
+		a := NewAuthenticator(tokenStore, userStore, "registry_pat_")
+
+		u := user.User{
+			ID:       uuid.New(),
+			Username: "user",
+			Role:     user.RoleAdmin,
+		}
+		if err := userStore.Create(t.Context(), u); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		tok := token.PersonalAccessToken{
+			ID:             uuid.New(),
+			Description:    "token",
+			Permission:     token.PermissionReadOnly,
+			AllowedCIDRs:   []token.CIDR{"10.0.0.0/8"},
+			ExpirationDate: time.Now().Add(time.Hour),
+			UserID:         u.ID,
+		}
+		if err := tokenStore.Create(t.Context(), tok, "registry_pat_foobarbaz"); err != nil {
+			t.Fatalf("failed to create personal access token: %q", err)
+		}
+
+		_, _, err := a.Authenticate(t.Context(), "user", "registry_pat_foobarbaz", sourceIP)
+		if !errors.Is(err, ErrAuthenticationFailed) || !strings.Contains(err.Error(), "token cannot be used from this source IP address") {
+			t.Fatalf("expected %q, got %q", ErrAuthenticationFailed, err)
+		}
+
+		// a rejected attempt is not recorded as usage of the token
+		page, err := tokenStore.GetUsageLog(t.Context(), tok.ID, token.UsageLogFilter{Limit: token.DefaultUsageLogLimit})
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if len(page.Items) != 0 {
+			t.Fatalf("expected no token usage log entries, got %d entries", len(page.Items))
+		}
+
+		// the token can be used from an allowed address
+		if _, _, err := a.Authenticate(t.Context(), "user", "registry_pat_foobarbaz", net.ParseIP("10.1.2.3")); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+	})
+
 	t.Run("successful authentication", func(t *testing.T) {
 		t.Parallel()
 		tokenStore := memory.NewPersonalAccessTokenStore()
@@ -145,24 +270,97 @@ func TestAuthenticator_Authenticate(t *testing.T) {
 			t.Fatalf("expected err to be nil, got %q", err)
 		}
 
-		if actualToken != tok || actualUser != u {
+		if !reflect.DeepEqual(actualToken, tok) || actualUser != u {
 			t.Fatalf("expected %+v, %+v, got %+v, %+v", tok, u, actualToken, actualUser)
 		}
 
 		// verify that an entry was added to the usage log
-		usageLog, err := tokenStore.GetUsageLog(t.Context(), tok.ID)
+		page, err := tokenStore.GetUsageLog(t.Context(), tok.ID, token.UsageLogFilter{Limit: token.DefaultUsageLogLimit})
 		if err != nil {
 			t.Fatalf("expected err to be nil, got %q", err)
 		}
 
-		if len(usageLog) != 1 {
-			t.Fatalf("expected one token usage log entry, got %d entries", len(usageLog))
+		if len(page.Items) != 1 {
+			t.Fatalf("expected one token usage log entry, got %d entries", len(page.Items))
 		}
 
-		entry := usageLog[0]
+		entry := page.Items[0]
 		if entry.TokenID != tok.ID || !entry.SourceIP.Equal(sourceIP) {
 			t.Fatalf("expected log entry with token ID %q, source IP %q, got %q, %q",
 				tok.ID, sourceIP.String(), entry.TokenID, entry.SourceIP.String())
 		}
 	})
+	t.Run("robot authenticates using personal access token", func(t *testing.T) {
+		t.Parallel()
+		tokenStore := memory.NewPersonalAccessTokenStore()
+		userStore := memory.NewUserStore()
+		a := NewAuthenticator(tokenStore, userStore, "registry_pat_")
+
+		u := user.User{
+			ID:       uuid.New(),
+			Username: user.NewRobotUsername("team", "ci"),
+			Role:     user.RoleRobot,
+		}
+		if err := userStore.Create(t.Context(), u); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		tok := token.PersonalAccessToken{
+			ID:             uuid.New(),
+			Description:    "token",
+			Permission:     token.PermissionReadWrite,
+			Repositories:   []token.RepositoryPattern{"team/app"},
+			ExpirationDate: time.Now().Add(time.Hour),
+			UserID:         u.ID,
+		}
+		if err := tokenStore.Create(t.Context(), tok, "registry_pat_foobarbaz"); err != nil {
+			t.Fatalf("failed to create personal access token: %q", err)
+		}
+
+		actualToken, actualUser, err := a.Authenticate(t.Context(), "team+ci", "registry_pat_foobarbaz", sourceIP)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if !reflect.DeepEqual(actualToken, tok) || actualUser != u {
+			t.Fatalf("expected %+v, %+v, got %+v, %+v", tok, u, actualToken, actualUser)
+		}
+	})
+}
+
+func TestValidateToken(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	owner := user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}
+	disabledOwner := owner
+	disabledOwner.Disabled = true
+
+	testCases := []struct {
+		desc     string
+		token    token.PersonalAccessToken
+		owner    user.User
+		expected string
+	}{
+		{"valid token", token.PersonalAccessToken{ExpirationDate: now.Add(time.Hour)}, owner, ""},
+		{"expired token", token.PersonalAccessToken{ExpirationDate: now.Add(-time.Hour)}, owner, "token has expired"},
+		{"revoked token", token.PersonalAccessToken{ExpirationDate: now.Add(time.Hour), RevokedAt: now.Add(-time.Minute)}, owner, "token has been revoked"},
+		{"disabled owner", token.PersonalAccessToken{ExpirationDate: now.Add(time.Hour)}, disabledOwner, "user is disabled"},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := ValidateToken(c.token, c.owner, now)
+			if c.expected == "" {
+				if err != nil {
+					t.Fatalf("expected err to be nil, got %q", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, ErrAuthenticationFailed) || !strings.Contains(err.Error(), c.expected) {
+				t.Fatalf("expected %q, got %q", c.expected, err)
+			}
+		})
+	}
 }
@@ -3,30 +3,63 @@ package auth
 import (
 	"context"
 	"errors"
+	"github.com/evanebb/regauth/audit"
 	"github.com/evanebb/regauth/repository"
 	"github.com/evanebb/regauth/token"
 	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
 	"log/slog"
 	"slices"
 	"strings"
+	"time"
 )
 
 type Authorizer interface {
 	AuthorizeAccess(ctx context.Context, u *user.User, p *token.PersonalAccessToken, requestedAccess Access) (Access, error)
 }
 
-func NewAuthorizer(logger *slog.Logger, repoStore repository.Store, teamStore user.TeamStore) Authorizer {
-	return authorizer{logger: logger, repoStore: repoStore, teamStore: teamStore}
+// NewAuthorizer creates an Authorizer. Admins are always allowed to list the catalog of the registry, and catalogRoles
+// are the additional user roles that are allowed to do so. Repositories that are created automatically on their first
+// push are recorded in the auditStore. Push access is not granted to namespaces that are over their quota.
+func NewAuthorizer(
+	logger *slog.Logger,
+	repoStore repository.Store,
+	teamStore user.TeamStore,
+	auditStore audit.Store,
+	catalogRoles []user.Role,
+	quotas repository.Quotas,
+) Authorizer {
+	return authorizer{
+		logger:       logger,
+		repoStore:    repoStore,
+		teamStore:    teamStore,
+		auditStore:   auditStore,
+		catalogRoles: catalogRoles,
+		quotas:       quotas,
+	}
 }
 
 type ResourceActions struct {
-	Type    string   `json:"type"`
+	Type string `json:"type"`
+	// Class is the optional resource class, such as 'plugin' in 'repository(plugin):namespace/name:pull'.
+	Class   string   `json:"class,omitempty"`
 	Name    string   `json:"name"`
 	Actions []string `json:"actions"`
 }
 
 type Access []ResourceActions
 
+// allows checks whether the given action is granted on the given repository.
+func (a Access) allows(repositoryName string, action string) bool {
+	for _, ra := range a {
+		if ra.Type == "repository" && ra.Name == repositoryName && slices.Contains(ra.Actions, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
 type AuthorizedNamespaces map[string]struct{}
 
 func (an AuthorizedNamespaces) Add(namespace string) {
@@ -38,30 +71,34 @@ func (an AuthorizedNamespaces) Contains(namespace string) bool {
 	return ok
 }
 
+// requester contains the information about the requesting user that is needed to authorize access to repositories.
+type requester struct {
+	// namespaces are the namespaces owned by the user, either directly or through their teams.
+	namespaces AuthorizedNamespaces
+	// user is the requesting user, if any.
+	user *user.User
+	// teams are the teams that the requesting user is a member of.
+	teams []user.Team
+}
+
 type authorizer struct {
-	logger    *slog.Logger
-	repoStore repository.Store
-	teamStore user.TeamStore
+	logger       *slog.Logger
+	repoStore    repository.Store
+	teamStore    user.TeamStore
+	auditStore   audit.Store
+	catalogRoles []user.Role
+	quotas       repository.Quotas
 }
 
 func (a authorizer) AuthorizeAccess(ctx context.Context, u *user.User, p *token.PersonalAccessToken, requestedAccess Access) (Access, error) {
-	authorizedNamespaces := make(AuthorizedNamespaces)
-	if u != nil {
-		authorizedNamespaces.Add(string(u.Username))
-
-		teams, err := a.teamStore.GetAllByUser(ctx, u.ID)
-		if err != nil {
-			return Access{}, err
-		}
-
-		for _, team := range teams {
-			authorizedNamespaces.Add(string(team.Name))
-		}
+	req, err := a.newRequester(ctx, u)
+	if err != nil {
+		return Access{}, err
 	}
 
 	grantedAccess := Access{}
 	for _, requestedActions := range requestedAccess {
-		grantedActions, err := a.authorizeResourceActions(ctx, authorizedNamespaces, p, requestedActions)
+		grantedActions, err := a.authorizeResourceActions(ctx, req, p, requestedActions)
 		if err != nil {
 			if errors.Is(err, ErrAccessNotGranted) {
 				continue
@@ -71,30 +108,101 @@ func (a authorizer) AuthorizeAccess(ctx context.Context, u *user.User, p *token.
 		grantedAccess = append(grantedAccess, grantedActions)
 	}
 
+	a.logMountDecisions(requestedAccess, grantedAccess)
 	return grantedAccess, nil
 }
 
+// logMountDecisions logs whether cross-repository blob mounts are allowed. When pushing blobs that already exist in
+// another repository, clients request pull access on that repository next to push access on the target repository, and
+// silently fall back to uploading the blobs if the pull access is not granted.
+func (a authorizer) logMountDecisions(requestedAccess Access, grantedAccess Access) {
+	for _, to := range requestedAccess {
+		if to.Type != "repository" || !slices.Contains(to.Actions, "push") {
+			continue
+		}
+
+		for _, from := range requestedAccess {
+			if from.Type != "repository" || from.Name == to.Name || slices.Contains(from.Actions, "push") || !slices.Contains(from.Actions, "pull") {
+				continue
+			}
+
+			if grantedAccess.allows(to.Name, "push") && grantedAccess.allows(from.Name, "pull") {
+				a.logger.Info("cross-repository blob mount allowed", "from", from.Name, "to", to.Name)
+			} else {
+				a.logger.Info("cross-repository blob mount denied, blobs will be uploaded instead", "from", from.Name, "to", to.Name)
+			}
+		}
+	}
+}
+
+// newRequester determines the namespaces and teams of the given user, which may be nil for anonymous requests.
+func (a authorizer) newRequester(ctx context.Context, u *user.User) (requester, error) {
+	req := requester{namespaces: make(AuthorizedNamespaces), user: u}
+	if u == nil {
+		return req, nil
+	}
+
+	if u.Role == user.RoleRobot {
+		// robots do not have a namespace of their own, and act on behalf of the team that owns them
+		robot, err := a.teamStore.GetRobotByUserID(ctx, u.ID)
+		if err != nil {
+			return req, err
+		}
+
+		team, err := a.teamStore.GetByID(ctx, robot.TeamID)
+		if err != nil {
+			return req, err
+		}
+
+		req.namespaces.Add(string(team.Name))
+		req.teams = []user.Team{team}
+		return req, nil
+	}
+
+	req.namespaces.Add(string(u.Username))
+
+	teams, err := a.teamStore.GetAllByUser(ctx, u.ID)
+	if err != nil {
+		return req, err
+	}
+
+	for _, team := range teams {
+		req.namespaces.Add(string(team.Name))
+	}
+	req.teams = teams
+
+	return req, nil
+}
+
 func (a authorizer) authorizeResourceActions(
 	ctx context.Context,
-	authorizedNamespaces AuthorizedNamespaces,
+	req requester,
 	p *token.PersonalAccessToken,
 	r ResourceActions,
 ) (ResourceActions, error) {
 	var granted ResourceActions
+	if r.Type == "registry" {
+		return a.authorizeRegistryActions(req, p, r)
+	}
+
 	if r.Type != "repository" {
 		// Only authorize access to repositories
 		a.logger.Debug("cannot grant access to non-repository requests", "type", r.Type, "name", r.Name)
 		return granted, ErrAccessNotGranted
 	}
 
-	split := strings.Split(r.Name, "/")
-	if len(split) != 2 {
-		// Only support repositories like 'namespace/name'
+	// the namespace is the first path component, the name of the repository can consist of the remaining components
+	namespace, name, ok := strings.Cut(r.Name, "/")
+	if !ok || namespace == "" || name == "" {
 		a.logger.Debug("malformed repository name given", "repository", r.Name)
 		return granted, ErrAccessNotGranted
 	}
 
-	repo, err := a.repoStore.GetByNamespaceAndName(ctx, split[0], split[1])
+	repo, err := a.repoStore.GetByNamespaceAndName(ctx, namespace, name)
+	if errors.Is(err, repository.ErrNotFound) {
+		// The repository might be created automatically when it is pushed to for the first time
+		repo, err = a.autoCreateRepository(ctx, req, p, r, namespace, name)
+	}
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			// If the repository cannot be found, grant no access
@@ -106,14 +214,45 @@ func (a authorizer) authorizeResourceActions(
 
 	var allowedActions []string
 	// First, determine the actions that are allowed for the user
-	if authorizedNamespaces.Contains(repo.Namespace) {
+	if req.namespaces.Contains(repo.Namespace) {
 		// the repository is in an authorized namespace, allow all actions
 		a.logger.Debug("repository is in authorized namespace, all actions allowed", "repository", r.Name)
 		allowedActions = []string{"pull", "push", "delete"}
-	} else if repo.Visibility == repository.VisibilityPublic {
-		// If the user does not own this repository but it is public, pull access is allowed
-		a.logger.Debug("user does not own public repository, allowing pull access", "repository", r.Name, "repository", r.Name)
-		allowedActions = []string{"pull"}
+	} else {
+		// If the user does not own this repository, they might have been added as a collaborator to it
+		allowedActions, err = a.collaboratorActions(ctx, req, repo)
+		if err != nil {
+			return granted, err
+		}
+
+		if repo.Visibility == repository.VisibilityPublic && !slices.Contains(allowedActions, "pull") {
+			// If the user does not own this repository but it is public, pull access is allowed
+			a.logger.Debug("user does not own public repository, allowing pull access", "repository", r.Name)
+			allowedActions = append(allowedActions, "pull")
+		}
+	}
+
+	if p != nil && !p.AllowsRepository(r.Name) {
+		// The token is restricted to other repositories, so only allow what anonymous users would be allowed to do
+		a.logger.Debug("repository not allowed by personal access token", "repository", r.Name)
+		allowedActions = nil
+		if repo.Visibility == repository.VisibilityPublic {
+			allowedActions = []string{"pull"}
+		}
+	}
+
+	if slices.Contains(allowedActions, "delete") && slices.Contains(r.Actions, "delete") {
+		allowedActions, err = a.restrictProtectedTagDeletion(ctx, req, repo, allowedActions)
+		if err != nil {
+			return granted, err
+		}
+	}
+
+	if slices.Contains(allowedActions, "push") && slices.Contains(r.Actions, "push") {
+		allowedActions, err = a.restrictPushOverQuota(ctx, repo, allowedActions)
+		if err != nil {
+			return granted, err
+		}
 	}
 
 	// Remove actions that are not allowed by the assigned token permissions or not requested by the user
@@ -141,5 +280,252 @@ func (a authorizer) authorizeResourceActions(
 
 	granted.Name = r.Name
 	granted.Type = r.Type
+	granted.Class = r.Class
 	return granted, nil
 }
+
+// restrictProtectedTagDeletion removes the delete action for repositories with protected tags, unless the requester is an
+// admin of the namespace of the repository. The tags that will be deleted are not known when issuing a token, so deleting
+// anything from these repositories is left to the namespace admins.
+func (a authorizer) restrictProtectedTagDeletion(ctx context.Context, req requester, repo repository.Repository, allowedActions []string) ([]string, error) {
+	protectedTags, err := a.repoStore.GetProtectedTags(ctx, repo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(protectedTags) == 0 {
+		return allowedActions, nil
+	}
+
+	isAdmin, err := a.isNamespaceAdmin(ctx, req, repo.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if isAdmin {
+		return allowedActions, nil
+	}
+
+	a.logger.Debug("repository has protected tags, delete is only allowed for namespace admins", "repository", repo.FullName())
+	return slices.DeleteFunc(slices.Clone(allowedActions), func(action string) bool {
+		return action == "delete"
+	}), nil
+}
+
+// restrictPushOverQuota removes the push action for repositories in a namespace that is over its quota.
+func (a authorizer) restrictPushOverQuota(ctx context.Context, repo repository.Repository, allowedActions []string) ([]string, error) {
+	quota, usage, err := repository.NamespaceQuota(ctx, a.repoStore, a.teamStore, a.quotas, repo.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if quota.AllowsPush(usage) {
+		return allowedActions, nil
+	}
+
+	a.logger.Info("namespace is over quota, push access not granted",
+		"repository", repo.FullName(),
+		"repositories", usage.Repositories,
+		"bytes", usage.Bytes)
+	return slices.DeleteFunc(slices.Clone(allowedActions), func(action string) bool {
+		return action == "push"
+	}), nil
+}
+
+// isNamespaceAdmin returns whether the requester is the owner of the given user namespace, or an admin of the team that
+// owns the given namespace. Robots are never namespace admins.
+func (a authorizer) isNamespaceAdmin(ctx context.Context, req requester, namespace string) (bool, error) {
+	if req.user == nil || req.user.Role == user.RoleRobot {
+		return false, nil
+	}
+
+	if string(req.user.Username) == namespace {
+		return true, nil
+	}
+
+	for _, team := range req.teams {
+		if string(team.Name) != namespace {
+			continue
+		}
+
+		member, err := a.teamStore.GetTeamMember(ctx, team.ID, req.user.ID)
+		if err != nil {
+			if errors.Is(err, user.ErrTeamMemberNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		return member.Role == user.TeamMemberRoleAdmin, nil
+	}
+
+	return false, nil
+}
+
+// autoCreateRepository creates the requested repository if push access is requested by a caller that is allowed to
+// push to the namespace, and the namespace has automatic repository creation enabled. It returns repository.ErrNotFound
+// if the repository is not created.
+func (a authorizer) autoCreateRepository(
+	ctx context.Context,
+	req requester,
+	p *token.PersonalAccessToken,
+	r ResourceActions,
+	namespace string,
+	name string,
+) (repository.Repository, error) {
+	if req.user == nil || !slices.Contains(r.Actions, "push") || !req.namespaces.Contains(namespace) {
+		return repository.Repository{}, repository.ErrNotFound
+	}
+
+	if p != nil && (!p.AllowsRepository(r.Name) || !slices.Contains(p.Permission.GetAllowedActions(), "push")) {
+		a.logger.Debug("personal access token is not allowed to push to repository, not creating it", "repository", r.Name)
+		return repository.Repository{}, repository.ErrNotFound
+	}
+
+	settings, err := a.repoStore.GetNamespaceSettings(ctx, namespace)
+	if err != nil {
+		if errors.Is(err, repository.ErrNamespaceNotFound) {
+			return repository.Repository{}, repository.ErrNotFound
+		}
+		return repository.Repository{}, err
+	}
+
+	if !settings.AutoCreateRepositories {
+		return repository.Repository{}, repository.ErrNotFound
+	}
+
+	quota, usage, err := repository.NamespaceQuota(ctx, a.repoStore, a.teamStore, a.quotas, namespace)
+	if err != nil {
+		return repository.Repository{}, err
+	}
+
+	if !quota.AllowsRepository(usage) {
+		a.logger.Info("namespace has reached its repository quota, not creating repository", "repository", r.Name)
+		return repository.Repository{}, repository.ErrNotFound
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return repository.Repository{}, err
+	}
+
+	repo := repository.Repository{
+		ID:         id,
+		Namespace:  namespace,
+		Name:       repository.Name(name),
+		Visibility: settings.AutoCreateVisibility,
+		CreatedBy:  req.user.ID,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := repo.IsValid(); err != nil {
+		a.logger.Debug("invalid repository name given, not creating it", "repository", r.Name, "error", err)
+		return repository.Repository{}, repository.ErrNotFound
+	}
+
+	if err := a.repoStore.Create(ctx, repo); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			// the repository was created concurrently, for example by pushing multiple images at once
+			return a.repoStore.GetByNamespaceAndName(ctx, namespace, name)
+		}
+		return repository.Repository{}, err
+	}
+
+	a.logger.Info("automatically created repository on push", "repository", r.Name, "username", req.user.Username)
+	a.recordRepositoryCreation(ctx, *req.user, repo)
+
+	return repo, nil
+}
+
+// recordRepositoryCreation records the automatic creation of the repository in the audit log. Failing to do so is only
+// logged, since the repository has already been created at this point.
+func (a authorizer) recordRepositoryCreation(ctx context.Context, u user.User, repo repository.Repository) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		a.logger.ErrorContext(ctx, "could not generate UUID", "error", err)
+		return
+	}
+
+	e := audit.Event{
+		ID:            id,
+		Timestamp:     time.Now(),
+		Action:        audit.ActionRepositoryCreate,
+		ActorID:       u.ID,
+		ActorUsername: string(u.Username),
+		TargetType:    audit.TargetTypeRepository,
+		Target:        repo.FullName(),
+		Details: map[string]string{
+			"visibility": string(repo.Visibility),
+			"automatic":  "true",
+		},
+	}
+
+	if err := a.auditStore.Record(ctx, e); err != nil {
+		a.logger.ErrorContext(ctx, "could not record audit event", "action", string(e.Action), "error", err)
+	}
+}
+
+// authorizeRegistryActions authorizes access to registry-wide resources, of which only listing the catalog using the
+// 'registry:catalog:*' scope is supported. Since the catalog contains every repository, it is only granted to admins and
+// the configured catalog roles, and not to tokens that are restricted to specific repositories.
+func (a authorizer) authorizeRegistryActions(req requester, p *token.PersonalAccessToken, r ResourceActions) (ResourceActions, error) {
+	var granted ResourceActions
+	if r.Name != "catalog" || !slices.Contains(r.Actions, "*") {
+		a.logger.Debug("cannot grant access to unknown registry resource", "name", r.Name, "actions", r.Actions)
+		return granted, ErrAccessNotGranted
+	}
+
+	if req.user == nil || (req.user.Role != user.RoleAdmin && !slices.Contains(a.catalogRoles, req.user.Role)) {
+		a.logger.Debug("user is not allowed to list the catalog")
+		return granted, ErrAccessNotGranted
+	}
+
+	if p != nil && len(p.Repositories) > 0 {
+		a.logger.Debug("personal access token is restricted to repositories, not allowed to list the catalog")
+		return granted, ErrAccessNotGranted
+	}
+
+	a.logger.Debug("catalog access granted", "username", req.user.Username)
+	granted.Type = r.Type
+	granted.Name = r.Name
+	granted.Actions = []string{"*"}
+	return granted, nil
+}
+
+// collaboratorActions returns the actions that the requester is allowed to perform on the repository through being a
+// collaborator on it, either directly or through one of their teams.
+func (a authorizer) collaboratorActions(ctx context.Context, req requester, repo repository.Repository) ([]string, error) {
+	var allowedActions []string
+	if req.user == nil {
+		return allowedActions, nil
+	}
+
+	type subject struct {
+		t  repository.CollaboratorType
+		id uuid.UUID
+	}
+
+	subjects := []subject{{repository.CollaboratorTypeUser, req.user.ID}}
+	for _, team := range req.teams {
+		subjects = append(subjects, subject{repository.CollaboratorTypeTeam, team.ID})
+	}
+
+	for _, s := range subjects {
+		c, err := a.repoStore.GetCollaborator(ctx, repo.ID, s.t, s.id)
+		if err != nil {
+			if errors.Is(err, repository.ErrCollaboratorNotFound) {
+				continue
+			}
+			return allowedActions, err
+		}
+
+		a.logger.Debug("requester is a collaborator on repository", "type", c.Type, "name", c.SubjectName, "permission", c.Permission, "repository", repo.FullName())
+		for _, action := range c.Permission.GetAllowedActions() {
+			if !slices.Contains(allowedActions, action) {
+				allowedActions = append(allowedActions, action)
+			}
+		}
+	}
+
+	return allowedActions, nil
+}
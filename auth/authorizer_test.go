@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"errors"
+	"github.com/evanebb/regauth/audit"
 	"github.com/evanebb/regauth/repository"
 	"github.com/evanebb/regauth/store/memory"
 	"github.com/evanebb/regauth/token"
@@ -23,7 +25,7 @@ func TestAuthorizer_AuthorizeAccess(t *testing.T) {
 		t.Parallel()
 		repoStore := memory.NewRepositoryStore()
 		teamStore := memory.NewTeamStore()
-		a := NewAuthorizer(logger, repoStore, teamStore)
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
 
 		requestedAccess := Access{}
 		grantedAccess, err := a.AuthorizeAccess(t.Context(), nil, nil, requestedAccess)
@@ -40,7 +42,7 @@ func TestAuthorizer_AuthorizeAccess(t *testing.T) {
 		t.Parallel()
 		repoStore := memory.NewRepositoryStore()
 		teamStore := memory.NewTeamStore()
-		a := NewAuthorizer(logger, repoStore, teamStore)
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
 
 		requestedAccess := Access{
 			{
@@ -62,7 +64,7 @@ func TestAuthorizer_AuthorizeAccess(t *testing.T) {
 		t.Parallel()
 		repoStore := memory.NewRepositoryStore()
 		teamStore := memory.NewTeamStore()
-		a := NewAuthorizer(logger, repoStore, teamStore)
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
 
 		requestedAccess := Access{
 			{
@@ -81,11 +83,52 @@ func TestAuthorizer_AuthorizeAccess(t *testing.T) {
 		}
 	})
 
+	t.Run("access granted for nested repository names", func(t *testing.T) {
+		t.Parallel()
+		repoStore := memory.NewRepositoryStore()
+		teamStore := memory.NewTeamStore()
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
+
+		repo := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "platform",
+			Name:       "payments/api/worker",
+			Visibility: repository.VisibilityPrivate,
+		}
+		if err := repoStore.Create(t.Context(), repo); err != nil {
+			t.Fatalf("could not create repository: %q", err)
+		}
+
+		u := &user.User{ID: uuid.New(), Username: "platform"}
+
+		requestedAccess := Access{
+			{
+				Type:    "repository",
+				Name:    "platform/payments/api/worker",
+				Actions: []string{"pull", "push"},
+			},
+			{
+				Type:    "repository",
+				Name:    "platform/payments",
+				Actions: []string{"pull"},
+			},
+		}
+		grantedAccess, err := a.AuthorizeAccess(t.Context(), u, nil, requestedAccess)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		expectedAccess := Access{requestedAccess[0]}
+		if !compareAccess(grantedAccess, expectedAccess) {
+			t.Fatalf("expected %+v, got %+v", expectedAccess, grantedAccess)
+		}
+	})
+
 	t.Run("no access granted for unknown repositories", func(t *testing.T) {
 		t.Parallel()
 		repoStore := memory.NewRepositoryStore()
 		teamStore := memory.NewTeamStore()
-		a := NewAuthorizer(logger, repoStore, teamStore)
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
 
 		requestedAccess := Access{
 			{
@@ -108,7 +151,7 @@ func TestAuthorizer_AuthorizeAccess(t *testing.T) {
 		t.Parallel()
 		repoStore := memory.NewRepositoryStore()
 		teamStore := memory.NewTeamStore()
-		a := NewAuthorizer(logger, repoStore, teamStore)
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
 
 		repo := repository.Repository{
 			ID:         uuid.New(),
@@ -148,7 +191,7 @@ func TestAuthorizer_AuthorizeAccess(t *testing.T) {
 		t.Parallel()
 		repoStore := memory.NewRepositoryStore()
 		teamStore := memory.NewTeamStore()
-		a := NewAuthorizer(logger, repoStore, teamStore)
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
 
 		repo := repository.Repository{
 			ID:         uuid.New(),
@@ -181,7 +224,7 @@ func TestAuthorizer_AuthorizeAccess(t *testing.T) {
 		t.Parallel()
 		repoStore := memory.NewRepositoryStore()
 		teamStore := memory.NewTeamStore()
-		a := NewAuthorizer(logger, repoStore, teamStore)
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
 
 		repo1 := repository.Repository{
 			ID:         uuid.New(),
@@ -248,6 +291,849 @@ func TestAuthorizer_AuthorizeAccess(t *testing.T) {
 		}
 	})
 
+	t.Run("grant collaborator permissions for repositories in other namespaces", func(t *testing.T) {
+		t.Parallel()
+		repoStore := memory.NewRepositoryStore()
+		teamStore := memory.NewTeamStore()
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
+
+		privateRepo := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "alice",
+			Name:       "app",
+			Visibility: repository.VisibilityPrivate,
+		}
+		publicRepo := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "alice",
+			Name:       "website",
+			Visibility: repository.VisibilityPublic,
+		}
+		otherRepo := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "alice",
+			Name:       "secret",
+			Visibility: repository.VisibilityPrivate,
+		}
+		for _, repo := range []repository.Repository{privateRepo, publicRepo, otherRepo} {
+			if err := repoStore.Create(t.Context(), repo); err != nil {
+				t.Fatalf("could not create repository: %q", err)
+			}
+		}
+
+		teamID := uuid.New()
+		if err := teamStore.Create(t.Context(), user.Team{ID: teamID, Name: "ci"}); err != nil {
+			t.Fatalf("could not create team: %q", err)
+		}
+
+		userID := uuid.New()
+		if err := teamStore.AddTeamMember(t.Context(), user.TeamMember{UserID: userID, TeamID: teamID}); err != nil {
+			t.Fatalf("could not add team member: %q", err)
+		}
+
+		collaborators := []repository.Collaborator{
+			{
+				RepositoryID: privateRepo.ID,
+				Type:         repository.CollaboratorTypeTeam,
+				SubjectID:    teamID,
+				Permission:   repository.CollaboratorPermissionPush,
+			},
+			{
+				RepositoryID: publicRepo.ID,
+				Type:         repository.CollaboratorTypeUser,
+				SubjectID:    userID,
+				Permission:   repository.CollaboratorPermissionDelete,
+			},
+		}
+		for _, c := range collaborators {
+			if err := repoStore.SaveCollaborator(t.Context(), c); err != nil {
+				t.Fatalf("could not save collaborator: %q", err)
+			}
+		}
+
+		u := &user.User{
+			ID:       userID,
+			Username: "bob",
+		}
+		tok := &token.PersonalAccessToken{Permission: token.PermissionReadWriteDelete}
+
+		requestedAccess := Access{
+			{
+				Type:    "repository",
+				Name:    "alice/app",
+				Actions: []string{"pull", "push", "delete"},
+			},
+			{
+				Type:    "repository",
+				Name:    "alice/website",
+				Actions: []string{"pull", "push", "delete"},
+			},
+			{
+				Type:    "repository",
+				Name:    "alice/secret",
+				Actions: []string{"pull"},
+			},
+		}
+		grantedAccess, err := a.AuthorizeAccess(t.Context(), u, tok, requestedAccess)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		expectedAccess := Access{
+			{
+				Type:    "repository",
+				Name:    "alice/app",
+				Actions: []string{"pull", "push"},
+			},
+			{
+				Type:    "repository",
+				Name:    "alice/website",
+				Actions: []string{"pull", "push", "delete"},
+			},
+		}
+		if !compareAccess(grantedAccess, expectedAccess) {
+			t.Fatalf("expected %+v, got %+v", expectedAccess, grantedAccess)
+		}
+	})
+
+	t.Run("restrict collaborator permissions by token permission", func(t *testing.T) {
+		t.Parallel()
+		repoStore := memory.NewRepositoryStore()
+		teamStore := memory.NewTeamStore()
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
+
+		repo := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "alice",
+			Name:       "app",
+			Visibility: repository.VisibilityPrivate,
+		}
+		if err := repoStore.Create(t.Context(), repo); err != nil {
+			t.Fatalf("could not create repository: %q", err)
+		}
+
+		u := &user.User{
+			ID:       uuid.New(),
+			Username: "bob",
+		}
+		c := repository.Collaborator{
+			RepositoryID: repo.ID,
+			Type:         repository.CollaboratorTypeUser,
+			SubjectID:    u.ID,
+			Permission:   repository.CollaboratorPermissionDelete,
+		}
+		if err := repoStore.SaveCollaborator(t.Context(), c); err != nil {
+			t.Fatalf("could not save collaborator: %q", err)
+		}
+
+		tok := &token.PersonalAccessToken{Permission: token.PermissionReadOnly}
+
+		requestedAccess := Access{
+			{
+				Type:    "repository",
+				Name:    "alice/app",
+				Actions: []string{"pull", "push", "delete"},
+			},
+		}
+		grantedAccess, err := a.AuthorizeAccess(t.Context(), u, tok, requestedAccess)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		expectedAccess := Access{
+			{
+				Type:    "repository",
+				Name:    "alice/app",
+				Actions: []string{"pull"},
+			},
+		}
+		if !compareAccess(grantedAccess, expectedAccess) {
+			t.Fatalf("expected %+v, got %+v", expectedAccess, grantedAccess)
+		}
+	})
+
+	t.Run("restrict access to repositories allowed by token", func(t *testing.T) {
+		t.Parallel()
+		repoStore := memory.NewRepositoryStore()
+		teamStore := memory.NewTeamStore()
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
+
+		repos := []repository.Repository{
+			{ID: uuid.New(), Namespace: "user", Name: "app", Visibility: repository.VisibilityPrivate},
+			{ID: uuid.New(), Namespace: "user", Name: "other", Visibility: repository.VisibilityPrivate},
+			{ID: uuid.New(), Namespace: "user", Name: "website", Visibility: repository.VisibilityPublic},
+			{ID: uuid.New(), Namespace: "myteam", Name: "build", Visibility: repository.VisibilityPrivate},
+		}
+		for _, repo := range repos {
+			if err := repoStore.Create(t.Context(), repo); err != nil {
+				t.Fatalf("could not create repository: %q", err)
+			}
+		}
+
+		teamID := uuid.New()
+		if err := teamStore.Create(t.Context(), user.Team{ID: teamID, Name: "myteam"}); err != nil {
+			t.Fatalf("could not create team: %q", err)
+		}
+
+		userID := uuid.New()
+		if err := teamStore.AddTeamMember(t.Context(), user.TeamMember{UserID: userID, TeamID: teamID}); err != nil {
+			t.Fatalf("could not add team member: %q", err)
+		}
+
+		u := &user.User{
+			ID:       userID,
+			Username: "user",
+		}
+		tok := &token.PersonalAccessToken{
+			Permission:   token.PermissionReadWrite,
+			Repositories: []token.RepositoryPattern{"user/app", "myteam/*"},
+		}
+
+		requestedAccess := Access{
+			{
+				Type:    "repository",
+				Name:    "user/app",
+				Actions: []string{"pull", "push"},
+			},
+			{
+				Type:    "repository",
+				Name:    "user/other",
+				Actions: []string{"pull", "push"},
+			},
+			{
+				Type:    "repository",
+				Name:    "user/website",
+				Actions: []string{"pull", "push"},
+			},
+			{
+				Type:    "repository",
+				Name:    "myteam/build",
+				Actions: []string{"pull", "push"},
+			},
+		}
+		grantedAccess, err := a.AuthorizeAccess(t.Context(), u, tok, requestedAccess)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		expectedAccess := Access{
+			{
+				Type:    "repository",
+				Name:    "user/app",
+				Actions: []string{"pull", "push"},
+			},
+			{
+				Type:    "repository",
+				Name:    "user/website",
+				Actions: []string{"pull"},
+			},
+			{
+				Type:    "repository",
+				Name:    "myteam/build",
+				Actions: []string{"pull", "push"},
+			},
+		}
+		if !compareAccess(grantedAccess, expectedAccess) {
+			t.Fatalf("expected %+v, got %+v", expectedAccess, grantedAccess)
+		}
+	})
+
+	t.Run("robots are granted access to the namespace of their team", func(t *testing.T) {
+		t.Parallel()
+		repoStore := memory.NewRepositoryStore()
+		teamStore := memory.NewTeamStore()
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
+
+		teamRepo := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "myteam",
+			Name:       "app",
+			Visibility: repository.VisibilityPrivate,
+		}
+		otherRepo := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "myteam+ci",
+			Name:       "app",
+			Visibility: repository.VisibilityPrivate,
+		}
+		for _, repo := range []repository.Repository{teamRepo, otherRepo} {
+			if err := repoStore.Create(t.Context(), repo); err != nil {
+				t.Fatalf("could not create repository: %q", err)
+			}
+		}
+
+		teamID := uuid.New()
+		if err := teamStore.Create(t.Context(), user.Team{ID: teamID, Name: "myteam"}); err != nil {
+			t.Fatalf("could not create team: %q", err)
+		}
+
+		u := &user.User{
+			ID:       uuid.New(),
+			Username: user.NewRobotUsername("myteam", "ci"),
+			Role:     user.RoleRobot,
+		}
+		robot := user.Robot{
+			UserID:   u.ID,
+			TeamID:   teamID,
+			Name:     "ci",
+			Username: u.Username,
+		}
+		if err := teamStore.AddRobot(t.Context(), robot); err != nil {
+			t.Fatalf("could not add robot: %q", err)
+		}
+
+		tok := &token.PersonalAccessToken{Permission: token.PermissionReadWrite}
+
+		requestedAccess := Access{
+			{
+				Type:    "repository",
+				Name:    "myteam/app",
+				Actions: []string{"pull", "push"},
+			},
+			{
+				Type:    "repository",
+				Name:    "myteam+ci/app",
+				Actions: []string{"pull", "push"},
+			},
+		}
+		grantedAccess, err := a.AuthorizeAccess(t.Context(), u, tok, requestedAccess)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		expectedAccess := Access{
+			{
+				Type:    "repository",
+				Name:    "myteam/app",
+				Actions: []string{"pull", "push"},
+			},
+		}
+		if !compareAccess(grantedAccess, expectedAccess) {
+			t.Fatalf("expected %+v, got %+v", expectedAccess, grantedAccess)
+		}
+	})
+
+	t.Run("resource class is kept when granting access", func(t *testing.T) {
+		t.Parallel()
+		repoStore := memory.NewRepositoryStore()
+		teamStore := memory.NewTeamStore()
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
+
+		repo := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "user",
+			Name:       "myplugin",
+			Visibility: repository.VisibilityPrivate,
+		}
+		if err := repoStore.Create(t.Context(), repo); err != nil {
+			t.Fatalf("could not create repository: %q", err)
+		}
+
+		u := &user.User{ID: uuid.New(), Username: "user"}
+
+		requestedAccess := Access{
+			{
+				Type:    "repository",
+				Class:   "plugin",
+				Name:    "user/myplugin",
+				Actions: []string{"pull"},
+			},
+		}
+		grantedAccess, err := a.AuthorizeAccess(t.Context(), u, nil, requestedAccess)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if len(grantedAccess) != 1 || grantedAccess[0].Class != "plugin" {
+			t.Fatalf("expected access with class 'plugin', got %+v", grantedAccess)
+		}
+	})
+
+	mountTestCases := []struct {
+		desc             string
+		fromNamespace    string
+		fromVisibility   repository.Visibility
+		token            *token.PersonalAccessToken
+		expectedFromPull bool
+	}{
+		{"owned repository", "user", repository.VisibilityPrivate, nil, true},
+		{"public repository of another user", "other", repository.VisibilityPublic, nil, true},
+		{"private repository of another user", "other", repository.VisibilityPrivate, nil, false},
+		{
+			"owned repository with token restricted to target",
+			"user",
+			repository.VisibilityPrivate,
+			&token.PersonalAccessToken{Permission: token.PermissionReadWrite, Repositories: []token.RepositoryPattern{"user/target"}},
+			false,
+		},
+		{
+			"public repository with token restricted to target",
+			"other",
+			repository.VisibilityPublic,
+			&token.PersonalAccessToken{Permission: token.PermissionReadWrite, Repositories: []token.RepositoryPattern{"user/target"}},
+			true,
+		},
+	}
+
+	for _, c := range mountTestCases {
+		t.Run("blob mount from "+c.desc, func(t *testing.T) {
+			t.Parallel()
+			repoStore := memory.NewRepositoryStore()
+			teamStore := memory.NewTeamStore()
+			a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
+
+			repos := []repository.Repository{
+				{ID: uuid.New(), Namespace: "user", Name: "target", Visibility: repository.VisibilityPrivate},
+				{ID: uuid.New(), Namespace: c.fromNamespace, Name: "source", Visibility: c.fromVisibility},
+			}
+			for _, repo := range repos {
+				if err := repoStore.Create(t.Context(), repo); err != nil {
+					t.Fatalf("could not create repository: %q", err)
+				}
+			}
+
+			u := &user.User{ID: uuid.New(), Username: "user"}
+			from := c.fromNamespace + "/source"
+
+			requestedAccess := Access{
+				{Type: "repository", Name: "user/target", Actions: []string{"pull", "push"}},
+				{Type: "repository", Name: from, Actions: []string{"pull"}},
+			}
+			grantedAccess, err := a.AuthorizeAccess(t.Context(), u, c.token, requestedAccess)
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			expectedAccess := Access{
+				{Type: "repository", Name: "user/target", Actions: []string{"pull", "push"}},
+			}
+			if c.expectedFromPull {
+				expectedAccess = append(expectedAccess, ResourceActions{Type: "repository", Name: from, Actions: []string{"pull"}})
+			}
+			if !compareAccess(grantedAccess, expectedAccess) {
+				t.Fatalf("expected %+v, got %+v", expectedAccess, grantedAccess)
+			}
+		})
+	}
+
+	catalogTestCases := []struct {
+		desc         string
+		user         *user.User
+		token        *token.PersonalAccessToken
+		catalogRoles []user.Role
+		granted      bool
+	}{
+		{"anonymous user", nil, nil, []user.Role{user.RoleUser}, false},
+		{"admin", &user.User{ID: uuid.New(), Username: "admin", Role: user.RoleAdmin}, nil, nil, true},
+		{"user", &user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}, nil, nil, false},
+		{"user with catalog role", &user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}, nil, []user.Role{user.RoleUser}, true},
+		{
+			"admin with repository-scoped token",
+			&user.User{ID: uuid.New(), Username: "admin", Role: user.RoleAdmin},
+			&token.PersonalAccessToken{Permission: token.PermissionReadOnly, Repositories: []token.RepositoryPattern{"admin/app"}},
+			nil,
+			false,
+		},
+	}
+
+	for _, c := range catalogTestCases {
+		t.Run("catalog access for "+c.desc, func(t *testing.T) {
+			t.Parallel()
+			repoStore := memory.NewRepositoryStore()
+			teamStore := memory.NewTeamStore()
+			a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), c.catalogRoles, repository.Quotas{})
+
+			requestedAccess := Access{
+				{
+					Type:    "registry",
+					Name:    "catalog",
+					Actions: []string{"*"},
+				},
+			}
+			grantedAccess, err := a.AuthorizeAccess(t.Context(), c.user, c.token, requestedAccess)
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			expectedAccess := Access{}
+			if c.granted {
+				expectedAccess = requestedAccess
+			}
+			if !compareAccess(grantedAccess, expectedAccess) {
+				t.Fatalf("expected %+v, got %+v", expectedAccess, grantedAccess)
+			}
+		})
+	}
+
+	protectedTagTestCases := []struct {
+		desc            string
+		repository      string
+		teamRole        user.TeamMemberRole
+		robot           bool
+		protected       bool
+		expectedActions []string
+	}{
+		{"owner of user namespace", "user/app", user.TeamMemberRoleUser, false, true, []string{"pull", "push", "delete"}},
+		{"team admin", "myteam/app", user.TeamMemberRoleAdmin, false, true, []string{"pull", "push", "delete"}},
+		{"team member", "myteam/app", user.TeamMemberRoleUser, false, true, []string{"pull", "push"}},
+		{"team member without protected tags", "myteam/app", user.TeamMemberRoleUser, false, false, []string{"pull", "push", "delete"}},
+		{"team robot", "myteam/app", user.TeamMemberRoleUser, true, true, []string{"pull", "push"}},
+		{"collaborator", "other/app", user.TeamMemberRoleAdmin, false, true, []string{"pull", "push"}},
+	}
+
+	for _, c := range protectedTagTestCases {
+		t.Run("delete access to repository with protected tags for "+c.desc, func(t *testing.T) {
+			t.Parallel()
+			repoStore := memory.NewRepositoryStore()
+			teamStore := memory.NewTeamStore()
+			a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
+
+			teamID := uuid.New()
+			if err := teamStore.Create(t.Context(), user.Team{ID: teamID, Name: "myteam"}); err != nil {
+				t.Fatalf("could not create team: %q", err)
+			}
+
+			u := &user.User{ID: uuid.New(), Username: "user"}
+			if c.robot {
+				u = &user.User{ID: uuid.New(), Username: user.NewRobotUsername("myteam", "ci"), Role: user.RoleRobot}
+				robot := user.Robot{UserID: u.ID, TeamID: teamID, Name: "ci", Username: u.Username}
+				if err := teamStore.AddRobot(t.Context(), robot); err != nil {
+					t.Fatalf("could not add robot: %q", err)
+				}
+			} else {
+				member := user.TeamMember{UserID: u.ID, TeamID: teamID, Username: u.Username, Role: c.teamRole}
+				if err := teamStore.AddTeamMember(t.Context(), member); err != nil {
+					t.Fatalf("could not add team member: %q", err)
+				}
+			}
+
+			namespace, name, _ := strings.Cut(c.repository, "/")
+			repo := repository.Repository{
+				ID:         uuid.New(),
+				Namespace:  namespace,
+				Name:       repository.Name(name),
+				Visibility: repository.VisibilityPrivate,
+			}
+			if err := repoStore.Create(t.Context(), repo); err != nil {
+				t.Fatalf("could not create repository: %q", err)
+			}
+
+			collaborator := repository.Collaborator{
+				RepositoryID: repo.ID,
+				Type:         repository.CollaboratorTypeUser,
+				SubjectID:    u.ID,
+				Permission:   repository.CollaboratorPermissionDelete,
+			}
+			if err := repoStore.SaveCollaborator(t.Context(), collaborator); err != nil {
+				t.Fatalf("could not save collaborator: %q", err)
+			}
+
+			if c.protected {
+				if err := repoStore.SaveProtectedTag(t.Context(), repository.ProtectedTag{RepositoryID: repo.ID, Pattern: "v*"}); err != nil {
+					t.Fatalf("could not save protected tag: %q", err)
+				}
+			}
+
+			requestedAccess := Access{
+				{
+					Type:    "repository",
+					Name:    c.repository,
+					Actions: []string{"pull", "push", "delete"},
+				},
+			}
+			grantedAccess, err := a.AuthorizeAccess(t.Context(), u, nil, requestedAccess)
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			expectedAccess := Access{
+				{
+					Type:    "repository",
+					Name:    c.repository,
+					Actions: c.expectedActions,
+				},
+			}
+			if !compareAccess(grantedAccess, expectedAccess) {
+				t.Fatalf("expected %+v, got %+v", expectedAccess, grantedAccess)
+			}
+		})
+	}
+
+	quotaTestCases := []struct {
+		desc            string
+		repository      string
+		quotas          repository.Quotas
+		overrides       []repository.QuotaOverride
+		expectedActions []string
+	}{
+		{"no quota", "user/app", repository.Quotas{}, nil, []string{"pull", "push"}},
+		{"user namespace below storage quota", "user/app", repository.Quotas{User: repository.Quota{Bytes: 2000}}, nil, []string{"pull", "push"}},
+		{"user namespace at storage quota", "user/app", repository.Quotas{User: repository.Quota{Bytes: 1000}}, nil, []string{"pull"}},
+		{"user namespace over repository quota", "user/app", repository.Quotas{User: repository.Quota{Repositories: 1}}, nil, []string{"pull"}},
+		{"team namespace below storage quota", "myteam/app", repository.Quotas{User: repository.Quota{Bytes: 1000}, Team: repository.Quota{Bytes: 2000}}, nil, []string{"pull", "push"}},
+		{"team namespace at storage quota", "myteam/app", repository.Quotas{User: repository.Quota{Bytes: 2000}, Team: repository.Quota{Bytes: 1000}}, nil, []string{"pull"}},
+		{"user namespace with quota override above default", "user/app", repository.Quotas{User: repository.Quota{Bytes: 1000}}, []repository.QuotaOverride{{Namespace: "user", Quota: repository.Quota{Bytes: 2000}}}, []string{"pull", "push"}},
+		{"user namespace with unlimited quota override", "user/app", repository.Quotas{User: repository.Quota{Repositories: 1}}, []repository.QuotaOverride{{Namespace: "user"}}, []string{"pull", "push"}},
+		{"user namespace at quota override without default", "user/app", repository.Quotas{}, []repository.QuotaOverride{{Namespace: "user", Quota: repository.Quota{Bytes: 1000}}}, []string{"pull"}},
+		{"team namespace at quota override below default", "myteam/app", repository.Quotas{Team: repository.Quota{Bytes: 2000}}, []repository.QuotaOverride{{Namespace: "myteam", Quota: repository.Quota{Bytes: 1000}}}, []string{"pull"}},
+		{"team namespace with quota override of other namespace", "myteam/app", repository.Quotas{Team: repository.Quota{Bytes: 2000}}, []repository.QuotaOverride{{Namespace: "user", Quota: repository.Quota{Bytes: 1000}}}, []string{"pull", "push"}},
+	}
+
+	for _, c := range quotaTestCases {
+		t.Run("push access for "+c.desc, func(t *testing.T) {
+			t.Parallel()
+			repoStore := memory.NewRepositoryStore()
+			teamStore := memory.NewTeamStore()
+			a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, c.quotas)
+
+			teamID := uuid.New()
+			if err := teamStore.Create(t.Context(), user.Team{ID: teamID, Name: "myteam"}); err != nil {
+				t.Fatalf("could not create team: %q", err)
+			}
+
+			u := &user.User{ID: uuid.New(), Username: "user"}
+			if err := teamStore.AddTeamMember(t.Context(), user.TeamMember{UserID: u.ID, TeamID: teamID}); err != nil {
+				t.Fatalf("could not add team member: %q", err)
+			}
+
+			for _, o := range c.overrides {
+				if err := repoStore.SaveQuotaOverride(t.Context(), o); err != nil {
+					t.Fatalf("could not save quota override: %q", err)
+				}
+			}
+
+			for _, namespace := range []string{"user", "myteam"} {
+				for _, name := range []string{"app", "worker"} {
+					repo := repository.Repository{ID: uuid.New(), Namespace: namespace, Name: repository.Name(name), Visibility: repository.VisibilityPrivate}
+					if err := repoStore.Create(t.Context(), repo); err != nil {
+						t.Fatalf("could not create repository: %q", err)
+					}
+
+					b := repository.Blob{RepositoryID: repo.ID, Digest: repository.Digest("sha256:" + name), Size: 500}
+					if err := repoStore.SaveBlob(t.Context(), b); err != nil {
+						t.Fatalf("could not save blob: %q", err)
+					}
+				}
+			}
+
+			requestedAccess := Access{
+				{
+					Type:    "repository",
+					Name:    c.repository,
+					Actions: []string{"pull", "push"},
+				},
+			}
+			grantedAccess, err := a.AuthorizeAccess(t.Context(), u, nil, requestedAccess)
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			expectedAccess := Access{
+				{
+					Type:    "repository",
+					Name:    c.repository,
+					Actions: c.expectedActions,
+				},
+			}
+			if !compareAccess(grantedAccess, expectedAccess) {
+				t.Fatalf("expected %+v, got %+v", expectedAccess, grantedAccess)
+			}
+		})
+	}
+
+	t.Run("no repository created automatically in namespace at repository quota", func(t *testing.T) {
+		t.Parallel()
+		repoStore := memory.NewRepositoryStore()
+		teamStore := memory.NewTeamStore()
+		a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{User: repository.Quota{Repositories: 1}})
+
+		repo := repository.Repository{ID: uuid.New(), Namespace: "user", Name: "app", Visibility: repository.VisibilityPrivate}
+		if err := repoStore.Create(t.Context(), repo); err != nil {
+			t.Fatalf("could not create repository: %q", err)
+		}
+
+		s := repository.NamespaceSettings{Namespace: "user", AutoCreateRepositories: true, AutoCreateVisibility: repository.VisibilityPrivate}
+		if err := repoStore.SaveNamespaceSettings(t.Context(), s); err != nil {
+			t.Fatalf("could not save namespace settings: %q", err)
+		}
+
+		u := &user.User{ID: uuid.New(), Username: "user"}
+		requestedAccess := Access{
+			{
+				Type:    "repository",
+				Name:    "user/worker",
+				Actions: []string{"pull", "push"},
+			},
+		}
+		grantedAccess, err := a.AuthorizeAccess(t.Context(), u, nil, requestedAccess)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if len(grantedAccess) != 0 {
+			t.Fatalf("expected no access to be granted, got %+v", grantedAccess)
+		}
+
+		if _, err := repoStore.GetByNamespaceAndName(t.Context(), "user", "worker"); !errors.Is(err, repository.ErrNotFound) {
+			t.Fatalf("expected err to be %q, got %q", repository.ErrNotFound, err)
+		}
+	})
+
+	autoCreateTestCases := []struct {
+		desc    string
+		enabled bool
+		name    string
+		actions []string
+		token   *token.PersonalAccessToken
+		created bool
+	}{
+		{
+			"owned namespace",
+			true,
+			"user/app",
+			[]string{"pull", "push"},
+			nil,
+			true,
+		},
+		{
+			"nested name in team namespace",
+			true,
+			"myteam/services/api",
+			[]string{"pull", "push"},
+			&token.PersonalAccessToken{Permission: token.PermissionReadWrite},
+			true,
+		},
+		{
+			"namespace without automatic creation",
+			false,
+			"user/app",
+			[]string{"pull", "push"},
+			nil,
+			false,
+		},
+		{
+			"namespace of another user",
+			true,
+			"other/app",
+			[]string{"pull", "push"},
+			nil,
+			false,
+		},
+		{
+			"pull only",
+			true,
+			"user/app",
+			[]string{"pull"},
+			nil,
+			false,
+		},
+		{
+			"read-only token",
+			true,
+			"user/app",
+			[]string{"pull", "push"},
+			&token.PersonalAccessToken{Permission: token.PermissionReadOnly},
+			false,
+		},
+		{
+			"token restricted to other repositories",
+			true,
+			"user/app",
+			[]string{"pull", "push"},
+			&token.PersonalAccessToken{Permission: token.PermissionReadWrite, Repositories: []token.RepositoryPattern{"user/website"}},
+			false,
+		},
+		{
+			"invalid repository name",
+			true,
+			"user/App",
+			[]string{"pull", "push"},
+			nil,
+			false,
+		},
+	}
+
+	for _, c := range autoCreateTestCases {
+		t.Run("automatic repository creation for "+c.desc, func(t *testing.T) {
+			t.Parallel()
+			repoStore := memory.NewRepositoryStore()
+			teamStore := memory.NewTeamStore()
+			auditStore := memory.NewAuditStore()
+			a := NewAuthorizer(logger, repoStore, teamStore, auditStore, nil, repository.Quotas{})
+
+			teamID := uuid.New()
+			if err := teamStore.Create(t.Context(), user.Team{ID: teamID, Name: "myteam"}); err != nil {
+				t.Fatalf("could not create team: %q", err)
+			}
+
+			u := &user.User{ID: uuid.New(), Username: "user"}
+			if err := teamStore.AddTeamMember(t.Context(), user.TeamMember{UserID: u.ID, TeamID: teamID}); err != nil {
+				t.Fatalf("could not add team member: %q", err)
+			}
+
+			for _, namespace := range []string{"user", "myteam", "other"} {
+				s := repository.NamespaceSettings{
+					Namespace:              namespace,
+					AutoCreateRepositories: c.enabled,
+					AutoCreateVisibility:   repository.VisibilityPublic,
+				}
+				if err := repoStore.SaveNamespaceSettings(t.Context(), s); err != nil {
+					t.Fatalf("could not save namespace settings: %q", err)
+				}
+			}
+
+			requestedAccess := Access{
+				{
+					Type:    "repository",
+					Name:    c.name,
+					Actions: c.actions,
+				},
+			}
+			grantedAccess, err := a.AuthorizeAccess(t.Context(), u, c.token, requestedAccess)
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			namespace, name, _ := strings.Cut(c.name, "/")
+			repo, err := repoStore.GetByNamespaceAndName(t.Context(), namespace, name)
+			if !c.created {
+				if !errors.Is(err, repository.ErrNotFound) {
+					t.Fatalf("expected err to be %q, got %q", repository.ErrNotFound, err)
+				}
+
+				if len(grantedAccess) != 0 {
+					t.Fatalf("expected no access to be granted, got %+v", grantedAccess)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			if repo.CreatedBy != u.ID {
+				t.Errorf("expected repository to be created by %q, got %q", u.ID, repo.CreatedBy)
+			}
+
+			if repo.Visibility != repository.VisibilityPublic {
+				t.Errorf("expected visibility %q, got %q", repository.VisibilityPublic, repo.Visibility)
+			}
+
+			if !compareAccess(grantedAccess, requestedAccess) {
+				t.Errorf("expected %+v, got %+v", requestedAccess, grantedAccess)
+			}
+
+			page, err := auditStore.List(t.Context(), audit.Filter{Action: audit.ActionRepositoryCreate, Limit: audit.DefaultLimit})
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			if len(page.Items) != 1 || page.Items[0].Target != c.name || page.Items[0].ActorID != u.ID {
+				t.Errorf("expected repository creation of %q by %q to be recorded, got %+v", c.name, u.ID, page.Items)
+			}
+		})
+	}
+
 	tokenTestCases := []struct {
 		desc            string
 		permission      token.Permission
@@ -275,7 +1161,7 @@ func TestAuthorizer_AuthorizeAccess(t *testing.T) {
 			t.Parallel()
 			repoStore := memory.NewRepositoryStore()
 			teamStore := memory.NewTeamStore()
-			a := NewAuthorizer(logger, repoStore, teamStore)
+			a := NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{})
 
 			repo := repository.Repository{
 				ID:         uuid.New(),
@@ -359,5 +1245,5 @@ func actionsToString(ra ResourceActions) string {
 	tmp := make([]string, len(ra.Actions))
 	copy(tmp, ra.Actions)
 	slices.Sort(tmp)
-	return ra.Type + ra.Name + strings.Join(tmp, "")
+	return ra.Type + ra.Class + ra.Name + strings.Join(tmp, "")
 }
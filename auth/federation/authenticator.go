@@ -0,0 +1,129 @@
+package federation
+
+import (
+	"context"
+	"errors"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Authenticator authenticates workloads, such as CI jobs, using a token issued by an identity provider that is trusted
+// through one of the configured policies.
+type Authenticator struct {
+	policies  []Policy
+	keys      *keySets
+	userStore user.Store
+}
+
+// NewAuthenticator creates a new Authenticator for the given policies, compiling their claim patterns. The key sets of
+// the issuers are cached for as long as the given context is alive.
+func NewAuthenticator(ctx context.Context, policies []Policy, userStore user.Store) (Authenticator, error) {
+	compiled := make([]Policy, 0, len(policies))
+	for _, p := range policies {
+		if err := p.compile(); err != nil {
+			return Authenticator{}, err
+		}
+
+		compiled = append(compiled, p)
+	}
+
+	return Authenticator{
+		policies:  compiled,
+		keys:      newKeySets(ctx, http.DefaultClient),
+		userStore: userStore,
+	}, nil
+}
+
+// LooksLikeToken checks whether the given credential looks like a JWT, so it can be told apart from personal access
+// tokens, which never contain dots.
+func LooksLikeToken(credential string) bool {
+	return strings.Count(credential, ".") == 2
+}
+
+// Authenticate verifies the given token against the policies of its issuer, and returns the user.User that the first
+// matching policy maps it to. The access of the user is restricted by the returned token.PersonalAccessToken, which is
+// not stored and only exists for the duration of the request.
+func (a Authenticator) Authenticate(ctx context.Context, rawToken string) (token.PersonalAccessToken, user.User, error) {
+	// the issuer is needed to find the key set that the token is verified with, so it has to be read before verifying
+	unverified, err := jwt.ParseString(rawToken, jwt.WithVerify(false), jwt.WithValidate(false))
+	if err != nil {
+		return token.PersonalAccessToken{}, user.User{}, errors.Join(auth.ErrAuthenticationFailed, err)
+	}
+
+	// policies of the same issuer can use different key sets, so a token that cannot be verified with the key set of
+	// one policy may still be verified with that of another
+	var verifyErr error
+
+	issuer := unverified.Issuer()
+	for _, p := range a.policies {
+		if p.Issuer != issuer {
+			continue
+		}
+
+		keys, err := a.keys.get(ctx, p.Issuer, p.JWKSURL)
+		if err != nil {
+			return token.PersonalAccessToken{}, user.User{}, err
+		}
+
+		t, err := jwt.ParseString(
+			rawToken,
+			jwt.WithKeySet(keys, jws.WithInferAlgorithmFromKey(true)),
+			jwt.WithValidate(true),
+			jwt.WithIssuer(p.Issuer),
+			jwt.WithRequiredClaim(jwt.ExpirationKey),
+		)
+		if err != nil {
+			verifyErr = err
+			continue
+		}
+
+		claims, err := t.AsMap(ctx)
+		if err != nil {
+			return token.PersonalAccessToken{}, user.User{}, err
+		}
+
+		if !slices.Contains(t.Audience(), p.Audience) || !p.matchesClaims(claims) {
+			continue
+		}
+
+		return a.authenticateAs(ctx, p, t)
+	}
+
+	if verifyErr != nil {
+		return token.PersonalAccessToken{}, user.User{}, errors.Join(auth.ErrAuthenticationFailed, verifyErr)
+	}
+
+	return token.PersonalAccessToken{}, user.User{}, errors.Join(auth.ErrAuthenticationFailed, ErrNoMatchingPolicy)
+}
+
+func (a Authenticator) authenticateAs(ctx context.Context, p Policy, t jwt.Token) (token.PersonalAccessToken, user.User, error) {
+	u, err := a.userStore.GetByUsername(ctx, p.Username)
+	if err != nil {
+		if errors.Is(err, user.ErrNotFound) {
+			err = errors.Join(auth.ErrAuthenticationFailed, err)
+		}
+
+		return token.PersonalAccessToken{}, u, err
+	}
+
+	pat := token.PersonalAccessToken{
+		Description:    token.Description(p.Name),
+		Permission:     p.Permission,
+		Repositories:   p.Repositories,
+		ExpirationDate: t.Expiration(),
+		UserID:         u.ID,
+	}
+
+	if err := auth.ValidateToken(pat, u, time.Now()); err != nil {
+		return token.PersonalAccessToken{}, u, err
+	}
+
+	return pat, u, nil
+}
@@ -0,0 +1,291 @@
+package federation
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/auth/oidc"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testIssuer serves the OpenID provider metadata and key set of an issuer, and signs tokens with its key.
+type testIssuer struct {
+	*httptest.Server
+	key jwk.Key
+	// keyRequests counts the number of times the key set was fetched
+	keyRequests atomic.Int32
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+
+	raw, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %q", err)
+	}
+
+	key, err := jwk.FromRaw(raw)
+	if err != nil {
+		t.Fatalf("failed to create key: %q", err)
+	}
+	_ = key.Set(jwk.KeyIDKey, "test")
+
+	i := &testIssuer{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(oidc.Metadata{Issuer: i.URL, JWKSURI: i.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		i.keyRequests.Add(1)
+		pub, _ := key.PublicKey()
+		set := jwk.NewSet()
+		_ = set.AddKey(pub)
+		_ = json.NewEncoder(w).Encode(set)
+	})
+
+	i.Server = httptest.NewServer(mux)
+	t.Cleanup(i.Close)
+
+	return i
+}
+
+// sign creates a token with the given claims, which are added on top of valid default claims.
+func (i *testIssuer) sign(t *testing.T, claims map[string]any) string {
+	t.Helper()
+
+	tok := jwt.New()
+	_ = tok.Set(jwt.IssuerKey, i.URL)
+	_ = tok.Set(jwt.AudienceKey, "regauth")
+	_ = tok.Set(jwt.SubjectKey, "repo:org/app:ref:refs/heads/main")
+	_ = tok.Set(jwt.IssuedAtKey, time.Now())
+	_ = tok.Set(jwt.ExpirationKey, time.Now().Add(5*time.Minute))
+	for k, v := range claims {
+		if err := tok.Set(k, v); err != nil {
+			t.Fatalf("failed to set claim %q: %q", k, err)
+		}
+	}
+
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.RS256, i.key))
+	if err != nil {
+		t.Fatalf("failed to sign token: %q", err)
+	}
+
+	return string(signed)
+}
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	issuer := newTestIssuer(t)
+	other := newTestIssuer(t)
+
+	userStore := memory.NewUserStore()
+	robot := user.User{ID: uuid.New(), Username: user.NewRobotUsername("team", "ci"), Role: user.RoleRobot}
+	if err := userStore.Create(t.Context(), robot); err != nil {
+		t.Fatalf("failed to create user: %q", err)
+	}
+
+	policies := []Policy{
+		{
+			Name:         "main",
+			Issuer:       issuer.URL,
+			Audience:     "regauth",
+			Claims:       map[string]string{"sub": "repo:org/app:ref:refs/heads/main"},
+			Username:     "team+ci",
+			Permission:   token.PermissionReadWrite,
+			Repositories: []token.RepositoryPattern{"team/app"},
+		},
+		{
+			Name:       "pull-requests",
+			Issuer:     issuer.URL,
+			JWKSURL:    issuer.URL + "/jwks",
+			Audience:   "regauth",
+			Claims:     map[string]string{"sub": "repo:org/app:pull_request", "event_name": "pull_request"},
+			Username:   "team+ci",
+			Permission: token.PermissionReadOnly,
+		},
+		{
+			Name:       "deleted-user",
+			Issuer:     issuer.URL,
+			Audience:   "regauth",
+			Claims:     map[string]string{"sub": "repo:org/deleted:*"},
+			Username:   "deleted",
+			Permission: token.PermissionReadOnly,
+		},
+	}
+
+	a, err := NewAuthenticator(t.Context(), policies, userStore)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	t.Run("token matches first policy", func(t *testing.T) {
+		t.Parallel()
+
+		p, u, err := a.Authenticate(t.Context(), issuer.sign(t, nil))
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if u != robot {
+			t.Errorf("expected %+v, got %+v", robot, u)
+		}
+
+		expected := []token.RepositoryPattern{"team/app"}
+		if p.Permission != token.PermissionReadWrite || !reflect.DeepEqual(p.Repositories, expected) || p.UserID != robot.ID {
+			t.Errorf("unexpected token %+v", p)
+		}
+	})
+
+	t.Run("token matches policy on multiple claims", func(t *testing.T) {
+		t.Parallel()
+
+		tok := issuer.sign(t, map[string]any{jwt.SubjectKey: "repo:org/app:pull_request", "event_name": "pull_request"})
+		p, _, err := a.Authenticate(t.Context(), tok)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if p.Permission != token.PermissionReadOnly || len(p.Repositories) != 0 {
+			t.Errorf("unexpected token %+v", p)
+		}
+	})
+
+	cases := []struct {
+		name  string
+		token string
+	}{
+		{name: "subject does not match", token: issuer.sign(t, map[string]any{jwt.SubjectKey: "repo:org/other:ref:refs/heads/main"})},
+		{name: "missing claim", token: issuer.sign(t, map[string]any{jwt.SubjectKey: "repo:org/app:pull_request"})},
+		{name: "wrong audience", token: issuer.sign(t, map[string]any{jwt.AudienceKey: "other"})},
+		{name: "expired", token: issuer.sign(t, map[string]any{jwt.ExpirationKey: time.Now().Add(-time.Minute)})},
+		{name: "untrusted issuer", token: other.sign(t, nil)},
+		{name: "signed by other key", token: other.sign(t, map[string]any{jwt.IssuerKey: issuer.URL})},
+		{name: "user does not exist", token: issuer.sign(t, map[string]any{jwt.SubjectKey: "repo:org/deleted:ref:refs/heads/main"})},
+		{name: "malformed token", token: "foo.bar.baz"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, _, err := a.Authenticate(t.Context(), c.token); !errors.Is(err, auth.ErrAuthenticationFailed) {
+				t.Errorf("expected %q, got %q", auth.ErrAuthenticationFailed, err)
+			}
+		})
+	}
+}
+
+func TestAuthenticator_Authenticate_CachesKeySet(t *testing.T) {
+	t.Parallel()
+
+	issuer := newTestIssuer(t)
+
+	userStore := memory.NewUserStore()
+	if err := userStore.Create(t.Context(), user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}); err != nil {
+		t.Fatalf("failed to create user: %q", err)
+	}
+
+	a, err := NewAuthenticator(t.Context(), []Policy{{
+		Name:       "main",
+		Issuer:     issuer.URL,
+		Audience:   "regauth",
+		Claims:     map[string]string{"sub": "*"},
+		Username:   "user",
+		Permission: token.PermissionReadOnly,
+	}}, userStore)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	for range 3 {
+		if _, _, err := a.Authenticate(t.Context(), issuer.sign(t, nil)); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+	}
+
+	if n := issuer.keyRequests.Load(); n != 1 {
+		t.Errorf("expected key set to be fetched once, got %d", n)
+	}
+}
+
+func TestAuthenticator_Authenticate_KeySetPerPolicy(t *testing.T) {
+	t.Parallel()
+
+	issuer := newTestIssuer(t)
+	// mirror serves another key set for the same issuer, for example one that is published separately
+	mirror := newTestIssuer(t)
+
+	userStore := memory.NewUserStore()
+	for _, username := range []user.Username{"user", "mirror"} {
+		if err := userStore.Create(t.Context(), user.User{ID: uuid.New(), Username: username, Role: user.RoleUser}); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+	}
+
+	a, err := NewAuthenticator(t.Context(), []Policy{
+		{
+			Name:       "mirror",
+			Issuer:     issuer.URL,
+			JWKSURL:    mirror.URL + "/jwks",
+			Audience:   "regauth",
+			Claims:     map[string]string{"sub": "*"},
+			Username:   "mirror",
+			Permission: token.PermissionReadOnly,
+		},
+		{
+			Name:       "main",
+			Issuer:     issuer.URL,
+			Audience:   "regauth",
+			Claims:     map[string]string{"sub": "*"},
+			Username:   "user",
+			Permission: token.PermissionReadOnly,
+		},
+	}, userStore)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	cases := []struct {
+		desc     string
+		rawToken string
+		username user.Username
+	}{
+		{"signed with key set of issuer", issuer.sign(t, nil), "user"},
+		{"signed with key set of mirror", mirror.sign(t, map[string]any{jwt.IssuerKey: issuer.URL}), "mirror"},
+	}
+
+	for _, c := range cases {
+		_, u, err := a.Authenticate(t.Context(), c.rawToken)
+		if err != nil {
+			t.Fatalf("%s: expected err to be nil, got %q", c.desc, err)
+		}
+
+		if u.Username != c.username {
+			t.Errorf("%s: expected user %q, got %q", c.desc, c.username, u.Username)
+		}
+	}
+
+	if n := issuer.keyRequests.Load(); n != 1 {
+		t.Errorf("expected key set of issuer to be fetched once, got %d", n)
+	}
+
+	if n := mirror.keyRequests.Load(); n != 1 {
+		t.Errorf("expected key set of mirror to be fetched once, got %d", n)
+	}
+}
@@ -0,0 +1,11 @@
+package federation
+
+import "errors"
+
+var ErrNoMatchingPolicy = errors.New("no trust policy matches the token")
+
+type InvalidPolicyError string
+
+func (e InvalidPolicyError) Error() string {
+	return string(e)
+}
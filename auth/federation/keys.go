@@ -0,0 +1,81 @@
+package federation
+
+import (
+	"context"
+	"fmt"
+	"github.com/evanebb/regauth/auth/oidc"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"net/http"
+	"sync"
+)
+
+// keySets fetches the key sets of the trusted issuers, and caches them so that they are not fetched on every request.
+// Cached key sets are refreshed in the background.
+type keySets struct {
+	cache  *jwk.Cache
+	client *http.Client
+
+	mu sync.Mutex
+	// discovered maps each issuer to the URL of its key set, once it has been discovered
+	discovered map[string]string
+	// registered contains the URLs of the key sets that are cached, which are shared between issuers and policies
+	registered map[string]bool
+}
+
+func newKeySets(ctx context.Context, client *http.Client) *keySets {
+	return &keySets{
+		cache:      jwk.NewCache(ctx),
+		client:     client,
+		discovered: make(map[string]string),
+		registered: make(map[string]bool),
+	}
+}
+
+// get returns the key set for the given issuer. If jwksURL is empty, the URL is discovered through the OpenID provider
+// metadata of the issuer.
+func (k *keySets) get(ctx context.Context, issuer, jwksURL string) (jwk.Set, error) {
+	u, err := k.register(ctx, issuer, jwksURL)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := k.cache.Get(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch key set for issuer %q: %w", issuer, err)
+	}
+
+	return set, nil
+}
+
+// register registers the key set of the given issuer with the cache, if it has not been registered yet, and returns its
+// URL. Key sets are cached by their URL, since policies of the same issuer can use different key sets.
+func (k *keySets) register(ctx context.Context, issuer, jwksURL string) (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if jwksURL == "" {
+		u, ok := k.discovered[issuer]
+		if !ok {
+			m, err := oidc.Discover(ctx, k.client, issuer)
+			if err != nil {
+				return "", fmt.Errorf("failed to discover key set for issuer %q: %w", issuer, err)
+			}
+
+			u = m.JWKSURI
+			k.discovered[issuer] = u
+		}
+
+		jwksURL = u
+	}
+
+	if k.registered[jwksURL] {
+		return jwksURL, nil
+	}
+
+	if err := k.cache.Register(jwksURL, jwk.WithHTTPClient(k.client)); err != nil {
+		return "", err
+	}
+
+	k.registered[jwksURL] = true
+	return jwksURL, nil
+}
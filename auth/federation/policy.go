@@ -0,0 +1,132 @@
+package federation
+
+import (
+	"fmt"
+	"github.com/evanebb/regauth/token"
+	"regexp"
+	"strings"
+)
+
+// Policy trusts tokens issued by an external identity provider, such as the OIDC tokens of CI jobs, and maps the tokens
+// that match it to a user or robot.
+type Policy struct {
+	Name string
+	// Issuer is the issuer that the token must be issued by.
+	Issuer string
+	// JWKSURL is the URL of the key set of the issuer. If it is empty, it is discovered through the OpenID provider
+	// metadata of the issuer.
+	JWKSURL string
+	// Audience is the audience that the token must be issued for.
+	Audience string
+	// Claims maps claim names to the patterns that their values must match. Patterns may contain '*', which matches any
+	// sequence of characters, including '/'.
+	Claims map[string]string
+	// Username is the user or robot that matching tokens act as.
+	Username string
+	// Permission and Repositories restrict the access that is granted, just like for a personal access token.
+	Permission   token.Permission
+	Repositories []token.RepositoryPattern
+
+	// claimPatterns are the compiled patterns of Claims, see compile
+	claimPatterns map[string]*regexp.Regexp
+}
+
+func (p Policy) IsValid() error {
+	if p.Name == "" {
+		return InvalidPolicyError("policy name cannot be empty")
+	}
+
+	if p.Issuer == "" {
+		return InvalidPolicyError(fmt.Sprintf("policy %q has no issuer", p.Name))
+	}
+
+	if p.Audience == "" {
+		return InvalidPolicyError(fmt.Sprintf("policy %q has no audience", p.Name))
+	}
+
+	if len(p.Claims) == 0 {
+		// without any claim matchers, every token of the issuer would match, which is never what you want for shared
+		// issuers like GitHub Actions
+		return InvalidPolicyError(fmt.Sprintf("policy %q has no claims to match", p.Name))
+	}
+
+	if p.Username == "" {
+		return InvalidPolicyError(fmt.Sprintf("policy %q has no username", p.Name))
+	}
+
+	// this only compiles the claim patterns of the copy of the policy, to check them
+	if err := p.compile(); err != nil {
+		return err
+	}
+
+	if err := p.Permission.IsValid(); err != nil {
+		return err
+	}
+
+	for _, r := range p.Repositories {
+		if err := r.IsValid(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compile compiles the claim patterns of the policy once, so that they are not compiled again for every token.
+func (p *Policy) compile() error {
+	p.claimPatterns = make(map[string]*regexp.Regexp, len(p.Claims))
+	for name, pattern := range p.Claims {
+		re, err := compilePattern(pattern)
+		if err != nil {
+			return InvalidPolicyError(fmt.Sprintf("policy %q has an invalid pattern for claim %q: %s", p.Name, name, err))
+		}
+
+		p.claimPatterns[name] = re
+	}
+
+	return nil
+}
+
+// matchesClaims checks whether the given claims match all the claim patterns of the policy. A policy whose patterns
+// have not been compiled matches nothing.
+func (p Policy) matchesClaims(claims map[string]any) bool {
+	if len(p.claimPatterns) != len(p.Claims) {
+		return false
+	}
+
+	for name, re := range p.claimPatterns {
+		value, ok := claims[name]
+		if !ok {
+			return false
+		}
+
+		if !re.MatchString(claimString(value)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// claimString converts a claim value to a string to match it against a pattern.
+func claimString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// compilePattern compiles the pattern to a regular expression matching the whole value, in which '*' matches any
+// sequence of characters.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return regexp.Compile("^" + strings.Join(parts, ".*") + "$")
+}
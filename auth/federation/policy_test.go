@@ -0,0 +1,122 @@
+package federation
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/token"
+	"testing"
+)
+
+func TestPolicy_IsValid(t *testing.T) {
+	t.Parallel()
+
+	valid := Policy{
+		Name:         "deploy",
+		Issuer:       "https://token.actions.githubusercontent.com",
+		Audience:     "regauth",
+		Claims:       map[string]string{"sub": "repo:org/app:*"},
+		Username:     "team+ci",
+		Permission:   token.PermissionReadWrite,
+		Repositories: []token.RepositoryPattern{"team/app"},
+	}
+
+	if err := valid.IsValid(); err != nil {
+		t.Errorf("expected err to be nil, got %q", err)
+	}
+
+	cases := []struct {
+		name   string
+		modify func(p *Policy)
+	}{
+		{name: "no name", modify: func(p *Policy) { p.Name = "" }},
+		{name: "no issuer", modify: func(p *Policy) { p.Issuer = "" }},
+		{name: "no audience", modify: func(p *Policy) { p.Audience = "" }},
+		{name: "no claims", modify: func(p *Policy) { p.Claims = nil }},
+		{name: "no username", modify: func(p *Policy) { p.Username = "" }},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := valid
+			c.modify(&p)
+
+			var e InvalidPolicyError
+			if err := p.IsValid(); !errors.As(err, &e) {
+				t.Errorf("expected invalid policy error, got %q", err)
+			}
+		})
+	}
+
+	t.Run("invalid permission", func(t *testing.T) {
+		t.Parallel()
+
+		p := valid
+		p.Permission = "foo"
+		if err := p.IsValid(); !errors.Is(err, token.ErrInvalidPermission) {
+			t.Errorf("expected %q, got %q", token.ErrInvalidPermission, err)
+		}
+	})
+}
+
+func TestCompilePattern(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		pattern  string
+		value    string
+		expected bool
+	}{
+		{pattern: "repo:org/app:ref:refs/heads/main", value: "repo:org/app:ref:refs/heads/main", expected: true},
+		{pattern: "repo:org/app:*", value: "repo:org/app:ref:refs/heads/main", expected: true},
+		{pattern: "repo:org/*:ref:refs/tags/*", value: "repo:org/app:ref:refs/tags/v1.0.0", expected: true},
+		{pattern: "repo:org/app:*", value: "repo:org/app-fork:ref:refs/heads/main", expected: false},
+		{pattern: "repo:org/app", value: "repo:org/app:ref:refs/heads/main", expected: false},
+		{pattern: "repo:org/a.p", value: "repo:org/app", expected: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.pattern+" "+c.value, func(t *testing.T) {
+			t.Parallel()
+
+			re, err := compilePattern(c.pattern)
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			if actual := re.MatchString(c.value); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPolicy_matchesClaims(t *testing.T) {
+	t.Parallel()
+
+	p := Policy{
+		Name:   "deploy",
+		Claims: map[string]string{"sub": "repo:org/app:*", "event_name": "push"},
+	}
+
+	claims := map[string]any{"sub": "repo:org/app:ref:refs/heads/main", "event_name": "push"}
+	if p.matchesClaims(claims) {
+		t.Errorf("expected policy without compiled patterns not to match")
+	}
+
+	if err := p.compile(); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if !p.matchesClaims(claims) {
+		t.Errorf("expected claims to match")
+	}
+
+	if p.matchesClaims(map[string]any{"sub": "repo:org/app:ref:refs/heads/main", "event_name": "pull_request"}) {
+		t.Errorf("expected claims with other event not to match")
+	}
+
+	if p.matchesClaims(map[string]any{"sub": "repo:org/app:ref:refs/heads/main"}) {
+		t.Errorf("expected claims without event not to match")
+	}
+}
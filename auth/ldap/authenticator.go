@@ -0,0 +1,79 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/user"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// PasswordAuthenticator verifies passwords by binding to the directory as the user. Users are created on their first
+// login, and are matched on the DN of their entry in the directory.
+type PasswordAuthenticator struct {
+	conf      Config
+	userStore user.Store
+}
+
+func NewPasswordAuthenticator(conf Config, userStore user.Store) PasswordAuthenticator {
+	return PasswordAuthenticator{conf: conf, userStore: userStore}
+}
+
+func (a PasswordAuthenticator) AuthenticatePassword(ctx context.Context, username, password string) (user.User, error) {
+	if password == "" {
+		// an empty password would result in an unauthenticated bind, which succeeds on most servers
+		return user.User{}, errors.Join(auth.ErrAuthenticationFailed, errors.New("empty password"))
+	}
+
+	conn, err := dial(a.conf)
+	if err != nil {
+		return user.User{}, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	entry, err := findUser(conn, a.conf, username)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			err = errors.Join(auth.ErrAuthenticationFailed, err)
+		}
+
+		return user.User{}, err
+	}
+
+	// determine the role before binding as the user, since the user might not be allowed to read the group
+	var role user.Role
+	if a.conf.AdminGroup != "" {
+		admins, err := getGroupMembers(conn, a.conf, a.conf.AdminGroup)
+		if err != nil {
+			return user.User{}, err
+		}
+
+		role = user.RoleUser
+		if containsDN(admins, entry.DN) {
+			role = user.RoleAdmin
+		}
+	}
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			err = errors.Join(auth.ErrAuthenticationFailed, err)
+		}
+
+		return user.User{}, err
+	}
+
+	// use the username from the directory, since the search filter might match case-insensitively
+	username = entry.GetAttributeValue(a.conf.UsernameAttribute)
+
+	u, err := auth.ProvisionUser(ctx, a.userStore, identity(a.conf, entry.DN), username, role)
+	if err != nil {
+		var invalidUsername user.InvalidUsernameError
+		if errors.As(err, &invalidUsername) {
+			err = errors.Join(auth.ErrAuthenticationFailed, err)
+		}
+
+		return u, err
+	}
+
+	return u, nil
+}
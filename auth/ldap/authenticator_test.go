@@ -0,0 +1,124 @@
+package ldap
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"testing"
+)
+
+func TestPasswordAuthenticator_AuthenticatePassword(t *testing.T) {
+	t.Parallel()
+
+	d := newTestDirectory(t)
+	aliceDN := d.addUser("alice", "alice-password")
+	bobDN := d.addUser("bob", "bob-password")
+	d.addUser("team+ci", "robot-password")
+	adminGroup := d.setGroup("registry-admins", aliceDN)
+
+	t.Run("provisions new user", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+		a := NewPasswordAuthenticator(d.config(), userStore)
+
+		u, err := a.AuthenticatePassword(t.Context(), "bob", "bob-password")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if u.Username != "bob" || u.Role != user.RoleUser {
+			t.Errorf("expected user %q with role %q, got %q with role %q", "bob", user.RoleUser, u.Username, u.Role)
+		}
+
+		if _, err := userStore.GetByUsername(t.Context(), "bob"); err != nil {
+			t.Errorf("expected user to be created, got %q", err)
+		}
+	})
+
+	t.Run("members of admin group get admin role", func(t *testing.T) {
+		t.Parallel()
+		conf := d.config()
+		conf.AdminGroup = adminGroup
+		a := NewPasswordAuthenticator(conf, memory.NewUserStore())
+
+		u, err := a.AuthenticatePassword(t.Context(), "alice", "alice-password")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if u.Role != user.RoleAdmin {
+			t.Errorf("expected role %q, got %q", user.RoleAdmin, u.Role)
+		}
+	})
+
+	t.Run("role of existing user is kept without admin group", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+		a := NewPasswordAuthenticator(d.config(), userStore)
+
+		existing := user.User{ID: uuid.New(), Username: "bob", Role: user.RoleAdmin, Identity: identity(d.config(), bobDN)}
+		if err := userStore.Create(t.Context(), existing); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		u, err := a.AuthenticatePassword(t.Context(), "bob", "bob-password")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if u != existing {
+			t.Errorf("expected %+v, got %+v", existing, u)
+		}
+	})
+
+	t.Run("local user with same username is not taken over", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+		a := NewPasswordAuthenticator(d.config(), userStore)
+
+		local := user.User{ID: uuid.New(), Username: "bob", Role: user.RoleAdmin}
+		if err := userStore.Create(t.Context(), local); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		if _, err := a.AuthenticatePassword(t.Context(), "bob", "bob-password"); !errors.Is(err, auth.ErrAuthenticationFailed) {
+			t.Errorf("expected %q, got %q", auth.ErrAuthenticationFailed, err)
+		}
+	})
+
+	cases := []struct {
+		name     string
+		username string
+		password string
+	}{
+		{name: "wrong password", username: "bob", password: "wrong"},
+		{name: "empty password", username: "bob", password: ""},
+		{name: "user does not exist", username: "carol", password: "carol-password"},
+		{name: "invalid username", username: "team+ci", password: "robot-password"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			a := NewPasswordAuthenticator(d.config(), memory.NewUserStore())
+
+			if _, err := a.AuthenticatePassword(t.Context(), c.username, c.password); !errors.Is(err, auth.ErrAuthenticationFailed) {
+				t.Errorf("expected %q, got %q", auth.ErrAuthenticationFailed, err)
+			}
+		})
+	}
+
+	t.Run("wrong service account password", func(t *testing.T) {
+		t.Parallel()
+		conf := d.config()
+		conf.BindPassword = "wrong"
+		a := NewPasswordAuthenticator(conf, memory.NewUserStore())
+
+		_, err := a.AuthenticatePassword(t.Context(), "bob", "bob-password")
+		if err == nil || errors.Is(err, auth.ErrAuthenticationFailed) {
+			t.Errorf("expected server error, got %q", err)
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"fmt"
+	"github.com/evanebb/regauth/user"
+	goldap "github.com/go-ldap/ldap/v3"
+	"strings"
+)
+
+// dial connects to the directory server, and binds using the service account.
+func dial(conf Config) (*goldap.Conn, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: conf.InsecureSkipVerify}
+
+	conn, err := goldap.DialURL(conf.URL, goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to LDAP server: %w", err)
+	}
+
+	if conf.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if err := bindServiceAccount(conn, conf); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func bindServiceAccount(conn *goldap.Conn, conf Config) error {
+	if conf.BindDN == "" {
+		return conn.UnauthenticatedBind("")
+	}
+
+	if err := conn.Bind(conf.BindDN, conf.BindPassword); err != nil {
+		return fmt.Errorf("failed to bind as service account: %w", err)
+	}
+
+	return nil
+}
+
+// findUser searches for the entry of the user with the given username.
+func findUser(conn *goldap.Conn, conf Config, username string) (*goldap.Entry, error) {
+	req := goldap.NewSearchRequest(
+		conf.UserBaseDN,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 2, 0, false,
+		fmt.Sprintf(conf.UserFilter, goldap.EscapeFilter(username)),
+		[]string{conf.UsernameAttribute},
+		nil,
+	)
+
+	res, err := conn.Search(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(res.Entries) {
+	case 0:
+		return nil, ErrUserNotFound
+	case 1:
+		return res.Entries[0], nil
+	default:
+		return nil, fmt.Errorf("multiple entries found for user %q", username)
+	}
+}
+
+// getGroupMembers returns the DNs of the members of the given group.
+func getGroupMembers(conn *goldap.Conn, conf Config, groupDN string) ([]string, error) {
+	entry, err := getEntry(conn, groupDN, conf.GroupMemberAttribute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group %q: %w", groupDN, err)
+	}
+
+	return entry.GetAttributeValues(conf.GroupMemberAttribute), nil
+}
+
+// getUsername returns the username of the user with the given DN.
+func getUsername(conn *goldap.Conn, conf Config, dn string) (string, error) {
+	entry, err := getEntry(conn, dn, conf.UsernameAttribute)
+	if err != nil {
+		return "", err
+	}
+
+	username := entry.GetAttributeValue(conf.UsernameAttribute)
+	if username == "" {
+		return "", fmt.Errorf("entry %q has no %q attribute", dn, conf.UsernameAttribute)
+	}
+
+	return username, nil
+}
+
+func getEntry(conn *goldap.Conn, dn string, attributes ...string) (*goldap.Entry, error) {
+	req := goldap.NewSearchRequest(
+		dn,
+		goldap.ScopeBaseObject, goldap.NeverDerefAliases, 1, 0, false,
+		"(objectClass=*)",
+		attributes,
+		nil,
+	)
+
+	res, err := conn.Search(req)
+	if err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject) {
+			return nil, ErrEntryNotFound
+		}
+
+		return nil, err
+	}
+
+	if len(res.Entries) != 1 {
+		return nil, ErrEntryNotFound
+	}
+
+	return res.Entries[0], nil
+}
+
+// containsDN checks whether the given list of DNs contains the given DN, ignoring differences in case and spacing.
+func containsDN(dns []string, dn string) bool {
+	parsed, err := goldap.ParseDN(dn)
+	if err != nil {
+		return false
+	}
+
+	for _, d := range dns {
+		other, err := goldap.ParseDN(d)
+		if err != nil {
+			if strings.EqualFold(d, dn) {
+				return true
+			}
+			continue
+		}
+
+		if parsed.EqualFold(other) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// identity returns the identity of the user with the given DN in the directory. The DN is normalized, so that it is the
+// same regardless of differences in case and spacing.
+func identity(conf Config, dn string) user.Identity {
+	subject := dn
+	if parsed, err := goldap.ParseDN(dn); err == nil {
+		subject = parsed.String()
+	}
+
+	return user.Identity{Source: conf.URL, Subject: strings.ToLower(subject)}
+}
@@ -0,0 +1,201 @@
+package ldap
+
+import (
+	"fmt"
+	"github.com/hashicorp/go-hclog"
+	"github.com/jimlambrt/gldap"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testServiceDN       = "cn=regauth,dc=example,dc=com"
+	testServicePassword = "service"
+	testUserBaseDN      = "ou=people,dc=example,dc=com"
+	testGroupBaseDN     = "ou=groups,dc=example,dc=com"
+)
+
+// testDirectory is a minimal in-process LDAP server, which only supports simple binds, base object searches and subtree
+// searches using a single equality filter.
+type testDirectory struct {
+	url string
+
+	mu        sync.Mutex
+	entries   map[string]map[string][]string
+	passwords map[string]string
+}
+
+var equalityFilter = regexp.MustCompile(`^\(([a-zA-Z]+)=([^)]*)\)$`)
+
+func newTestDirectory(t *testing.T) *testDirectory {
+	t.Helper()
+
+	d := &testDirectory{
+		entries:   make(map[string]map[string][]string),
+		passwords: map[string]string{testServiceDN: testServicePassword},
+	}
+
+	s, err := gldap.NewServer(gldap.WithLogger(hclog.NewNullLogger()))
+	if err != nil {
+		t.Fatalf("failed to create LDAP server: %q", err)
+	}
+
+	mux, err := gldap.NewMux()
+	if err != nil {
+		t.Fatalf("failed to create LDAP router: %q", err)
+	}
+	_ = mux.Bind(d.handleBind)
+	_ = mux.Search(d.handleSearch)
+	_ = s.Router(mux)
+
+	// find a free port to listen on, since the server does not expose its listener address
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to find free port: %q", err)
+	}
+	addr := l.Addr().String()
+	_ = l.Close()
+
+	go func() { _ = s.Run(addr) }()
+	t.Cleanup(func() { _ = s.Stop() })
+
+	for i := 0; !s.Ready(); i++ {
+		if i > 100 {
+			t.Fatalf("LDAP server did not become ready")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	d.url = "ldap://" + addr
+	return d
+}
+
+func (d *testDirectory) config() Config {
+	return Config{
+		URL:                  d.url,
+		BindDN:               testServiceDN,
+		BindPassword:         testServicePassword,
+		UserBaseDN:           testUserBaseDN,
+		UserFilter:           "(uid=%s)",
+		UsernameAttribute:    "uid",
+		GroupMemberAttribute: "member",
+	}
+}
+
+// addUser adds a user entry and returns its DN.
+func (d *testDirectory) addUser(uid, password string) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	dn := fmt.Sprintf("uid=%s,%s", uid, testUserBaseDN)
+	d.entries[dn] = map[string][]string{"uid": {uid}}
+	d.passwords[dn] = password
+	return dn
+}
+
+// setGroup creates or replaces a group entry with the given member DNs, and returns its DN.
+func (d *testDirectory) setGroup(cn string, members ...string) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	dn := fmt.Sprintf("cn=%s,%s", cn, testGroupBaseDN)
+	d.entries[dn] = map[string][]string{"cn": {cn}, "member": members}
+	return dn
+}
+
+func (d *testDirectory) handleBind(w *gldap.ResponseWriter, r *gldap.Request) {
+	resp := r.NewBindResponse(gldap.WithResponseCode(gldap.ResultInvalidCredentials))
+	defer func() { _ = w.Write(resp) }()
+
+	m, err := r.GetSimpleBindMessage()
+	if err != nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if password, ok := d.passwords[m.UserName]; ok && password == string(m.Password) {
+		resp.SetResultCode(gldap.ResultSuccess)
+	}
+}
+
+func (d *testDirectory) handleSearch(w *gldap.ResponseWriter, r *gldap.Request) {
+	resp := r.NewSearchDoneResponse(gldap.WithResponseCode(gldap.ResultNoSuchObject))
+	defer func() { _ = w.Write(resp) }()
+
+	m, err := r.GetSearchMessage()
+	if err != nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for dn, attributes := range d.entries {
+		if !d.matches(m, dn, attributes) {
+			continue
+		}
+
+		entry := r.NewSearchResponseEntry(dn)
+		for name, values := range attributes {
+			entry.AddAttribute(name, values)
+		}
+		_ = w.Write(entry)
+	}
+
+	resp.SetResultCode(gldap.ResultSuccess)
+}
+
+func (d *testDirectory) matches(m *gldap.SearchMessage, dn string, attributes map[string][]string) bool {
+	if m.Scope == gldap.BaseObject {
+		return strings.EqualFold(dn, m.BaseDN)
+	}
+
+	if !strings.HasSuffix(strings.ToLower(dn), ","+strings.ToLower(m.BaseDN)) {
+		return false
+	}
+
+	match := equalityFilter.FindStringSubmatch(m.Filter)
+	if match == nil {
+		return false
+	}
+
+	for _, v := range attributes[match[1]] {
+		if strings.EqualFold(v, match[2]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestContainsDN(t *testing.T) {
+	t.Parallel()
+
+	dns := []string{"uid=user,ou=people,dc=example,dc=com", "not a dn"}
+
+	cases := []struct {
+		dn       string
+		expected bool
+	}{
+		{dn: "uid=user,ou=people,dc=example,dc=com", expected: true},
+		{dn: "UID=User, OU=People, DC=example, DC=com", expected: true},
+		{dn: "uid=other,ou=people,dc=example,dc=com", expected: false},
+		{dn: "NOT A DN", expected: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.dn, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := containsDN(dns, c.dn); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
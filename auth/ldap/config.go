@@ -0,0 +1,33 @@
+package ldap
+
+import "github.com/evanebb/regauth/user"
+
+// Config contains the settings that are required to use an LDAP directory.
+type Config struct {
+	// URL of the directory server, for example 'ldaps://ldap.example.com'.
+	URL                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	// BindDN and BindPassword are the credentials of the service account that is used to search the directory.
+	BindDN       string
+	BindPassword string
+	// UserBaseDN is the DN under which users are searched for.
+	UserBaseDN string
+	// UserFilter is the filter used to find a user, in which '%s' is replaced by the escaped username.
+	UserFilter string
+	// UsernameAttribute is the attribute of a user entry that contains the username.
+	UsernameAttribute string
+	// GroupMemberAttribute is the attribute of a group entry that contains the DNs of its members.
+	GroupMemberAttribute string
+	// AdminGroup is the DN of the group whose members get the admin role. If empty, roles are not managed through LDAP.
+	AdminGroup string
+	// Teams maps groups to the teams that their members are synchronized to.
+	Teams []TeamMapping
+}
+
+// TeamMapping maps the members of an LDAP group to members of a team, with the given role.
+type TeamMapping struct {
+	Group string
+	Team  user.TeamName
+	Role  user.TeamMemberRole
+}
@@ -0,0 +1,8 @@
+package ldap
+
+import "errors"
+
+var (
+	ErrUserNotFound  = errors.New("user not found in LDAP directory")
+	ErrEntryNotFound = errors.New("entry not found in LDAP directory")
+)
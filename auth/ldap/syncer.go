@@ -0,0 +1,179 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/user"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/google/uuid"
+	"log/slog"
+	"time"
+)
+
+// Syncer synchronizes the members of LDAP groups to the teams they are mapped to. The mapped groups are the source of
+// truth for the members of their team, so members that are not in the group are removed from the team.
+type Syncer struct {
+	logger    *slog.Logger
+	conf      Config
+	userStore user.Store
+	teamStore user.TeamStore
+}
+
+func NewSyncer(logger *slog.Logger, conf Config, userStore user.Store, teamStore user.TeamStore) Syncer {
+	return Syncer{logger: logger, conf: conf, userStore: userStore, teamStore: teamStore}
+}
+
+// Run synchronizes the teams immediately, and then on every interval until the context is cancelled.
+func (s Syncer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Sync(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "failed to synchronize LDAP groups", slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync synchronizes all mapped teams once. A failure to synchronize one team does not stop the others from being
+// synchronized, all errors are returned together.
+func (s Syncer) Sync(ctx context.Context) error {
+	conn, err := dial(s.conf)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	var admins []string
+	if s.conf.AdminGroup != "" {
+		admins, err = getGroupMembers(conn, s.conf, s.conf.AdminGroup)
+		if err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	for _, m := range s.conf.Teams {
+		if err := s.syncTeam(ctx, conn, m, admins); err != nil {
+			errs = append(errs, fmt.Errorf("failed to synchronize team %q: %w", m.Team, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s Syncer) syncTeam(ctx context.Context, conn *goldap.Conn, m TeamMapping, admins []string) error {
+	memberDNs, err := getGroupMembers(conn, s.conf, m.Group)
+	if err != nil {
+		return err
+	}
+
+	team, err := s.getOrCreateTeam(ctx, m.Team)
+	if err != nil {
+		return err
+	}
+
+	// the members that the team should have after synchronizing, by user ID
+	desired := make(map[uuid.UUID]user.User, len(memberDNs))
+	for _, dn := range memberDNs {
+		u, err := s.provisionMember(ctx, conn, dn, admins)
+		if err != nil {
+			// a single bad entry should not prevent the rest of the team from being synchronized
+			s.logger.WarnContext(ctx, "skipping LDAP group member", slog.String("group", m.Group),
+				slog.String("dn", dn), slog.Any("error", err))
+			continue
+		}
+
+		desired[u.ID] = u
+	}
+
+	current, err := s.teamStore.GetTeamMembers(ctx, team.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.teamStore.Tx(ctx, func(txCtx context.Context) error {
+		for _, member := range current {
+			if _, ok := desired[member.UserID]; ok && member.Role == m.Role {
+				delete(desired, member.UserID)
+				continue
+			}
+
+			// members that are no longer in the group are removed, and members with a different role are re-added below
+			if err := s.teamStore.RemoveTeamMember(txCtx, team.ID, member.UserID); err != nil {
+				return err
+			}
+		}
+
+		for _, u := range desired {
+			member := user.TeamMember{
+				UserID:    u.ID,
+				TeamID:    team.ID,
+				Username:  u.Username,
+				Role:      m.Role,
+				CreatedAt: time.Now(),
+			}
+
+			if err := s.teamStore.AddTeamMember(txCtx, member); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s Syncer) getOrCreateTeam(ctx context.Context, name user.TeamName) (user.Team, error) {
+	team, err := s.teamStore.GetByName(ctx, string(name))
+	if err == nil {
+		return team, nil
+	}
+
+	if !errors.Is(err, user.ErrTeamNotFound) {
+		return team, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return team, err
+	}
+
+	team = user.Team{
+		ID:        id,
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.teamStore.Create(ctx, team); err != nil {
+		return team, err
+	}
+
+	s.logger.InfoContext(ctx, "created team for LDAP group", slog.String("team", string(name)))
+	return team, nil
+}
+
+// provisionMember returns the user belonging to the given group member DN, creating it if it does not exist yet.
+func (s Syncer) provisionMember(ctx context.Context, conn *goldap.Conn, dn string, admins []string) (user.User, error) {
+	username, err := getUsername(conn, s.conf, dn)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	var role user.Role
+	if s.conf.AdminGroup != "" {
+		role = user.RoleUser
+		if containsDN(admins, dn) {
+			role = user.RoleAdmin
+		}
+	}
+
+	return auth.ProvisionUser(ctx, s.userStore, identity(s.conf, dn), username, role)
+}
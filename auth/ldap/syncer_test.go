@@ -0,0 +1,132 @@
+package ldap
+
+import (
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSyncer_Sync(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	d := newTestDirectory(t)
+	aliceDN := d.addUser("alice", "alice-password")
+	bobDN := d.addUser("bob", "bob-password")
+	carolDN := d.addUser("carol", "carol-password")
+	developers := d.setGroup("developers", aliceDN, bobDN)
+	adminGroup := d.setGroup("registry-admins", aliceDN)
+
+	conf := d.config()
+	conf.AdminGroup = adminGroup
+	conf.Teams = []TeamMapping{{Group: developers, Team: "developers", Role: user.TeamMemberRoleUser}}
+
+	userStore := memory.NewUserStore()
+	teamStore := memory.NewTeamStore()
+	s := NewSyncer(logger, conf, userStore, teamStore)
+
+	// a member that was added manually, and is not in the group
+	dave := user.User{ID: uuid.New(), Username: "dave", Role: user.RoleUser}
+	if err := userStore.Create(t.Context(), dave); err != nil {
+		t.Fatalf("failed to create user: %q", err)
+	}
+
+	if err := s.Sync(t.Context()); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	team, err := teamStore.GetByName(t.Context(), "developers")
+	if err != nil {
+		t.Fatalf("expected team to be created, got %q", err)
+	}
+
+	alice, err := userStore.GetByUsername(t.Context(), "alice")
+	if err != nil {
+		t.Fatalf("expected user to be created, got %q", err)
+	}
+
+	if alice.Role != user.RoleAdmin {
+		t.Errorf("expected role %q, got %q", user.RoleAdmin, alice.Role)
+	}
+
+	compareTeamMembers(t, teamStore, team.ID, []string{"alice", "bob"}, user.TeamMemberRoleUser)
+
+	if err := teamStore.AddTeamMember(t.Context(), user.TeamMember{UserID: dave.ID, TeamID: team.ID, Username: dave.Username, Role: user.TeamMemberRoleAdmin, CreatedAt: time.Now()}); err != nil {
+		t.Fatalf("failed to add team member: %q", err)
+	}
+
+	// change the group membership and the mapped role, and synchronize again
+	d.setGroup("developers", bobDN, carolDN)
+	conf.Teams[0].Role = user.TeamMemberRoleAdmin
+	s = NewSyncer(logger, conf, userStore, teamStore)
+
+	if err := s.Sync(t.Context()); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	compareTeamMembers(t, teamStore, team.ID, []string{"bob", "carol"}, user.TeamMemberRoleAdmin)
+}
+
+func TestSyncer_Sync_SkipsInvalidMembers(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	d := newTestDirectory(t)
+	aliceDN := d.addUser("alice", "alice-password")
+	group := d.setGroup("developers", aliceDN, "uid=missing,ou=people,dc=example,dc=com")
+
+	conf := d.config()
+	conf.Teams = []TeamMapping{
+		{Group: group, Team: "developers", Role: user.TeamMemberRoleUser},
+		{Group: "cn=missing,ou=groups,dc=example,dc=com", Team: "missing", Role: user.TeamMemberRoleUser},
+	}
+
+	teamStore := memory.NewTeamStore()
+	s := NewSyncer(logger, conf, memory.NewUserStore(), teamStore)
+
+	if err := s.Sync(t.Context()); err == nil {
+		t.Errorf("expected error for missing group, got nil")
+	}
+
+	team, err := teamStore.GetByName(t.Context(), "developers")
+	if err != nil {
+		t.Fatalf("expected team to be created, got %q", err)
+	}
+
+	compareTeamMembers(t, teamStore, team.ID, []string{"alice"}, user.TeamMemberRoleUser)
+}
+
+func compareTeamMembers(t *testing.T, teamStore user.TeamStore, teamID uuid.UUID, expected []string, role user.TeamMemberRole) {
+	t.Helper()
+
+	members, err := teamStore.GetTeamMembers(t.Context(), teamID)
+	if err != nil {
+		t.Fatalf("failed to get team members: %q", err)
+	}
+
+	var actual []string
+	for _, m := range members {
+		actual = append(actual, string(m.Username))
+		if m.Role != role {
+			t.Errorf("expected member %q to have role %q, got %q", m.Username, role, m.Role)
+		}
+	}
+	sort.Strings(actual)
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected members %v, got %v", expected, actual)
+	}
+
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("expected members %v, got %v", expected, actual)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/user"
+)
+
+// PasswordAuthenticator verifies passwords against the UserCredentials stored for local users.
+type PasswordAuthenticator struct {
+	userStore        user.Store
+	credentialsStore UserCredentialsStore
+}
+
+func NewPasswordAuthenticator(userStore user.Store, credentialsStore UserCredentialsStore) PasswordAuthenticator {
+	return PasswordAuthenticator{userStore: userStore, credentialsStore: credentialsStore}
+}
+
+func (a PasswordAuthenticator) AuthenticatePassword(ctx context.Context, username, password string) (user.User, error) {
+	u, err := a.userStore.GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, user.ErrNotFound) {
+			err = errors.Join(auth.ErrAuthenticationFailed, err)
+		}
+
+		return u, err
+	}
+
+	if u.Role == user.RoleRobot {
+		return u, errors.Join(auth.ErrAuthenticationFailed, errors.New("robots cannot log in using a password"))
+	}
+
+	credentials, err := a.credentialsStore.GetByUserID(ctx, u.ID)
+	if err != nil {
+		if errors.Is(err, ErrNoCredentials) {
+			err = errors.Join(auth.ErrAuthenticationFailed, err)
+		}
+
+		return u, err
+	}
+
+	if err := credentials.CheckPassword(password); err != nil {
+		return u, errors.Join(auth.ErrAuthenticationFailed, errors.New("password does not match"))
+	}
+
+	return u, nil
+}
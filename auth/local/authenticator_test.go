@@ -0,0 +1,94 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"testing"
+)
+
+// credentialsStore is a map-based UserCredentialsStore, since the memory store package cannot be used from here
+// without an import cycle.
+type credentialsStore map[uuid.UUID]UserCredentials
+
+func (s credentialsStore) Tx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (s credentialsStore) GetByUserID(ctx context.Context, id uuid.UUID) (UserCredentials, error) {
+	c, ok := s[id]
+	if !ok {
+		return c, ErrNoCredentials
+	}
+
+	return c, nil
+}
+
+func (s credentialsStore) Save(ctx context.Context, c UserCredentials) error {
+	s[c.UserID] = c
+	return nil
+}
+
+func TestPasswordAuthenticator_AuthenticatePassword(t *testing.T) {
+	t.Parallel()
+
+	userStore := memory.NewUserStore()
+	credentials := credentialsStore{}
+
+	u := user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}
+	withoutPassword := user.User{ID: uuid.New(), Username: "nopassword", Role: user.RoleUser}
+	robot := user.User{ID: uuid.New(), Username: user.NewRobotUsername("team", "ci"), Role: user.RoleRobot}
+
+	for _, usr := range []user.User{u, withoutPassword, robot} {
+		if err := userStore.Create(t.Context(), usr); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+	}
+
+	for _, usr := range []user.User{u, robot} {
+		c := UserCredentials{UserID: usr.ID}
+		if err := c.SetPassword("correct-password"); err != nil {
+			t.Fatalf("failed to set password: %q", err)
+		}
+		_ = credentials.Save(t.Context(), c)
+	}
+
+	a := NewPasswordAuthenticator(userStore, credentials)
+
+	t.Run("successful authentication", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := a.AuthenticatePassword(t.Context(), "user", "correct-password")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if actual != u {
+			t.Errorf("expected %+v, got %+v", u, actual)
+		}
+	})
+
+	cases := []struct {
+		name     string
+		username string
+		password string
+	}{
+		{name: "wrong password", username: "user", password: "wrong-password"},
+		{name: "user does not exist", username: "other", password: "correct-password"},
+		{name: "no password set", username: "nopassword", password: "correct-password"},
+		{name: "robot", username: "team+ci", password: "correct-password"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := a.AuthenticatePassword(t.Context(), c.username, c.password); !errors.Is(err, auth.ErrAuthenticationFailed) {
+				t.Errorf("expected %q, got %q", auth.ErrAuthenticationFailed, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/user"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"slices"
+)
+
+// Authenticator authenticates users using ID tokens from an OpenID Connect identity provider.
+type Authenticator struct {
+	provider  *Provider
+	userStore user.Store
+}
+
+func NewAuthenticator(provider *Provider, userStore user.Store) Authenticator {
+	return Authenticator{provider: provider, userStore: userStore}
+}
+
+// Authenticate verifies the given ID token and returns the user.User it belongs to. Users are matched on the issuer and
+// the 'sub' claim, which cannot be changed by the user unlike the username claim, and are created on their first login.
+// The role of the user is determined by the role claim on every login, so that changes in the identity provider are
+// reflected here.
+func (a Authenticator) Authenticate(ctx context.Context, rawIDToken string) (user.User, error) {
+	t, err := a.provider.Verify(ctx, rawIDToken)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	conf := a.provider.Config()
+
+	subject, err := stringClaim(t, "sub")
+	if err != nil {
+		return user.User{}, err
+	}
+
+	username, err := stringClaim(t, conf.UsernameClaim)
+	if err != nil {
+		return user.User{}, err
+	}
+
+	role := user.RoleUser
+	if hasAnyClaimValue(t, conf.RoleClaim, conf.AdminRoles) {
+		role = user.RoleAdmin
+	}
+
+	identity := user.Identity{Source: conf.Issuer, Subject: subject}
+	return auth.ProvisionUser(ctx, a.userStore, identity, username, role)
+}
+
+func stringClaim(t jwt.Token, name string) (string, error) {
+	v, ok := t.Get(name)
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrMissingClaim, name)
+	}
+
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return "", fmt.Errorf("%w %q", ErrMissingClaim, name)
+	}
+
+	return s, nil
+}
+
+// hasAnyClaimValue checks whether the given claim contains any of the given values. The claim can either be a single
+// string or a list of strings.
+func hasAnyClaimValue(t jwt.Token, name string, values []string) bool {
+	if name == "" {
+		return false
+	}
+
+	v, ok := t.Get(name)
+	if !ok {
+		return false
+	}
+
+	switch claim := v.(type) {
+	case string:
+		return slices.Contains(values, claim)
+	case []any:
+		for _, c := range claim {
+			if s, ok := c.(string); ok && slices.Contains(values, s) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,154 @@
+package oidc
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"testing"
+)
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	m := newMockIssuer(t)
+	p := newTestProvider(t, m)
+
+	t.Run("provisions new user", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+		a := NewAuthenticator(p, userStore)
+
+		u, err := a.Authenticate(t.Context(), m.sign(t, map[string]any{"preferred_username": "newuser"}))
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if u.Username != "newuser" || u.Role != user.RoleUser {
+			t.Errorf("expected user %q with role %q, got %q with role %q", "newuser", user.RoleUser, u.Username, u.Role)
+		}
+
+		stored, err := userStore.GetByUsername(t.Context(), "newuser")
+		if err != nil {
+			t.Fatalf("expected user to be created, got %q", err)
+		}
+
+		if stored != u {
+			t.Errorf("expected %+v, got %+v", u, stored)
+		}
+	})
+
+	t.Run("maps role claim to admin role", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+		a := NewAuthenticator(p, userStore)
+
+		claims := map[string]any{"preferred_username": "admin", "groups": []string{"developers", "registry-admins"}}
+		u, err := a.Authenticate(t.Context(), m.sign(t, claims))
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if u.Role != user.RoleAdmin {
+			t.Errorf("expected role %q, got %q", user.RoleAdmin, u.Role)
+		}
+	})
+
+	t.Run("updates role of existing user", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+		a := NewAuthenticator(p, userStore)
+
+		existing := user.User{ID: uuid.New(), Username: "existing", Role: user.RoleAdmin, Identity: user.Identity{Source: m.URL, Subject: "subject"}}
+		if err := userStore.Create(t.Context(), existing); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		u, err := a.Authenticate(t.Context(), m.sign(t, map[string]any{"preferred_username": "existing", "groups": "developers"}))
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if u.ID != existing.ID || u.Role != user.RoleUser {
+			t.Errorf("expected user %q with role %q, got %q with role %q", existing.ID, user.RoleUser, u.ID, u.Role)
+		}
+
+		stored, _ := userStore.GetByID(t.Context(), existing.ID)
+		if stored.Role != user.RoleUser {
+			t.Errorf("expected stored role %q, got %q", user.RoleUser, stored.Role)
+		}
+	})
+
+	t.Run("local user with same username is not taken over", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+		a := NewAuthenticator(p, userStore)
+
+		local := user.User{ID: uuid.New(), Username: "admin", Role: user.RoleAdmin}
+		if err := userStore.Create(t.Context(), local); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		if _, err := a.Authenticate(t.Context(), m.sign(t, map[string]any{"preferred_username": "admin"})); !errors.Is(err, auth.ErrAuthenticationFailed) {
+			t.Errorf("expected %q, got %q", auth.ErrAuthenticationFailed, err)
+		}
+
+		stored, _ := userStore.GetByID(t.Context(), local.ID)
+		if stored != local {
+			t.Errorf("expected local user to be unchanged, got %+v", stored)
+		}
+	})
+
+	t.Run("missing subject claim", func(t *testing.T) {
+		t.Parallel()
+		a := NewAuthenticator(p, memory.NewUserStore())
+
+		if _, err := a.Authenticate(t.Context(), m.sign(t, map[string]any{"sub": "", "preferred_username": "newuser"})); !errors.Is(err, ErrMissingClaim) {
+			t.Errorf("expected %q, got %q", ErrMissingClaim, err)
+		}
+	})
+
+	t.Run("missing username claim", func(t *testing.T) {
+		t.Parallel()
+		a := NewAuthenticator(p, memory.NewUserStore())
+
+		if _, err := a.Authenticate(t.Context(), m.sign(t, nil)); !errors.Is(err, ErrMissingClaim) {
+			t.Errorf("expected %q, got %q", ErrMissingClaim, err)
+		}
+	})
+
+	t.Run("invalid username claim", func(t *testing.T) {
+		t.Parallel()
+		a := NewAuthenticator(p, memory.NewUserStore())
+
+		var e user.InvalidUsernameError
+		if _, err := a.Authenticate(t.Context(), m.sign(t, map[string]any{"preferred_username": "user@example.com"})); !errors.As(err, &e) {
+			t.Errorf("expected invalid username error, got %q", err)
+		}
+	})
+
+	t.Run("robot cannot log in", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+		a := NewAuthenticator(p, userStore)
+
+		robot := user.User{ID: uuid.New(), Username: user.NewRobotUsername("team", "ci"), Role: user.RoleRobot}
+		if err := userStore.Create(t.Context(), robot); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		if _, err := a.Authenticate(t.Context(), m.sign(t, map[string]any{"preferred_username": "team+ci"})); !errors.Is(err, auth.ErrAuthenticationFailed) {
+			t.Errorf("expected %q, got %q", auth.ErrAuthenticationFailed, err)
+		}
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		t.Parallel()
+		a := NewAuthenticator(p, memory.NewUserStore())
+
+		if _, err := a.Authenticate(t.Context(), "foobar"); !errors.Is(err, ErrInvalidIDToken) {
+			t.Errorf("expected %q, got %q", ErrInvalidIDToken, err)
+		}
+	})
+}
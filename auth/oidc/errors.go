@@ -0,0 +1,8 @@
+package oidc
+
+import "errors"
+
+var (
+	ErrInvalidIDToken = errors.New("invalid ID token")
+	ErrMissingClaim   = errors.New("ID token is missing required claim")
+)
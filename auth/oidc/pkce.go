@@ -0,0 +1,106 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// NewCodeVerifier generates a random PKCE code verifier, as described in RFC 7636.
+func NewCodeVerifier() string {
+	return randomString(32)
+}
+
+// NewState generates a random value for the state parameter of an authorization request.
+func NewState() string {
+	return randomString(16)
+}
+
+func randomString(n int) string {
+	b := make([]byte, n)
+	// crypto/rand.Read never returns an error
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// CodeChallenge returns the S256 code challenge for the given code verifier.
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL builds the URL of the authorization request that the user has to be sent to in order to log in.
+func AuthCodeURL(m Metadata, clientID, redirectURI string, scopes []string, state, verifier string) string {
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", clientID)
+	v.Set("redirect_uri", redirectURI)
+	v.Set("scope", strings.Join(scopes, " "))
+	v.Set("state", state)
+	v.Set("code_challenge", CodeChallenge(verifier))
+	v.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(m.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+
+	return m.AuthorizationEndpoint + sep + v.Encode()
+}
+
+// TokenResponse is the subset of the token endpoint response that is used.
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+}
+
+// ExchangeCode exchanges the authorization code for tokens at the token endpoint of the provider.
+func ExchangeCode(ctx context.Context, client *http.Client, m Metadata, clientID, redirectURI, code, verifier string) (TokenResponse, error) {
+	var t TokenResponse
+
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("client_id", clientID)
+	v.Set("redirect_uri", redirectURI)
+	v.Set("code", code)
+	v.Set("code_verifier", verifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.TokenEndpoint, strings.NewReader(v.Encode()))
+	if err != nil {
+		return t, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return t, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&e)
+		return t, fmt.Errorf("token request failed with status code %d: %s %s", resp.StatusCode, e.Error, e.Description)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return t, err
+	}
+
+	if t.IDToken == "" {
+		return t, fmt.Errorf("token response did not contain an ID token")
+	}
+
+	return t, nil
+}
@@ -0,0 +1,82 @@
+package oidc
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestCodeChallenge(t *testing.T) {
+	t.Parallel()
+
+	// example from RFC 7636, appendix B
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	expected := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	if actual := CodeChallenge(verifier); actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	m := Metadata{AuthorizationEndpoint: "https://idp.example.com/authorize"}
+	u, err := url.Parse(AuthCodeURL(m, "regauth", "http://127.0.0.1:1234/callback", []string{"openid", "profile"}, "state", "verifier"))
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	expected := map[string]string{
+		"response_type":         "code",
+		"client_id":             "regauth",
+		"redirect_uri":          "http://127.0.0.1:1234/callback",
+		"scope":                 "openid profile",
+		"state":                 "state",
+		"code_challenge":        CodeChallenge("verifier"),
+		"code_challenge_method": "S256",
+	}
+
+	for k, v := range expected {
+		if actual := u.Query().Get(k); actual != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, actual)
+		}
+	}
+}
+
+func TestExchangeCode(t *testing.T) {
+	t.Parallel()
+
+	m := newMockIssuer(t)
+	metadata, err := Discover(t.Context(), http.DefaultClient, m.URL)
+	if err != nil {
+		t.Fatalf("failed to discover issuer: %q", err)
+	}
+
+	t.Run("successful exchange", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := NewCodeVerifier()
+		m.authorize("code-1", CodeChallenge(verifier), map[string]any{"preferred_username": "user"})
+
+		tok, err := ExchangeCode(t.Context(), http.DefaultClient, metadata, "regauth", "http://127.0.0.1/callback", "code-1", verifier)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if tok.IDToken == "" {
+			t.Errorf("expected ID token to be set")
+		}
+	})
+
+	t.Run("wrong code verifier", func(t *testing.T) {
+		t.Parallel()
+
+		m.authorize("code-2", CodeChallenge(NewCodeVerifier()), nil)
+
+		_, err := ExchangeCode(t.Context(), http.DefaultClient, metadata, "regauth", "http://127.0.0.1/callback", "code-2", NewCodeVerifier())
+		if err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
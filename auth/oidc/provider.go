@@ -0,0 +1,119 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"net/http"
+	"strings"
+)
+
+// Config contains the settings that are required to use an OpenID Connect identity provider.
+type Config struct {
+	Issuer   string
+	ClientID string
+	// Scopes are the scopes that are requested when logging in. The 'openid' scope should always be included.
+	Scopes []string
+	// UsernameClaim is the ID token claim that is used as the username.
+	UsernameClaim string
+	// RoleClaim is the ID token claim that contains the roles or groups of the user, which can either be a single string
+	// or a list of strings.
+	RoleClaim string
+	// AdminRoles are the values of the RoleClaim that grant the admin role. All other users get the regular user role.
+	AdminRoles []string
+}
+
+// Metadata is the subset of the OpenID provider metadata that is used.
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Discover fetches the OpenID provider metadata of the given issuer.
+func Discover(ctx context.Context, client *http.Client, issuer string) (Metadata, error) {
+	var m Metadata
+
+	u := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return m, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return m, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return m, fmt.Errorf("unexpected status code %d fetching provider metadata", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&m); err != nil {
+		return m, err
+	}
+
+	if m.Issuer != issuer {
+		return m, fmt.Errorf("issuer %q in provider metadata does not match configured issuer %q", m.Issuer, issuer)
+	}
+
+	return m, nil
+}
+
+// Provider verifies ID tokens issued by an OpenID Connect identity provider.
+type Provider struct {
+	conf     Config
+	metadata Metadata
+	keys     jwk.Set
+}
+
+// NewProvider discovers the provider metadata for the configured issuer, and registers its key set so that the keys
+// are refreshed in the background for as long as the given context is alive.
+func NewProvider(ctx context.Context, conf Config) (*Provider, error) {
+	m, err := Discover(ctx, http.DefaultClient, conf.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover OpenID provider: %w", err)
+	}
+
+	cache := jwk.NewCache(ctx)
+	if err := cache.Register(m.JWKSURI); err != nil {
+		return nil, err
+	}
+
+	if _, err := cache.Refresh(ctx, m.JWKSURI); err != nil {
+		return nil, fmt.Errorf("failed to fetch OpenID provider keys: %w", err)
+	}
+
+	return &Provider{conf: conf, metadata: m, keys: jwk.NewCachedSet(cache, m.JWKSURI)}, nil
+}
+
+func (p *Provider) Config() Config {
+	return p.conf
+}
+
+func (p *Provider) Metadata() Metadata {
+	return p.metadata
+}
+
+// Verify parses the given ID token, and verifies its signature, issuer, audience and expiration time.
+func (p *Provider) Verify(ctx context.Context, rawIDToken string) (jwt.Token, error) {
+	t, err := jwt.ParseString(
+		rawIDToken,
+		jwt.WithContext(ctx),
+		jwt.WithKeySet(p.keys, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(p.conf.Issuer),
+		jwt.WithAudience(p.conf.ClientID),
+	)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidIDToken, err)
+	}
+
+	return t, nil
+}
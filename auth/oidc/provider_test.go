@@ -0,0 +1,201 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mockIssuer is a minimal OpenID Connect provider, which supports discovery, serving its keys and the authorization
+// code flow with PKCE.
+type mockIssuer struct {
+	*httptest.Server
+	key jwk.Key
+
+	mu sync.Mutex
+	// codes maps the issued authorization codes to their code challenge and ID token claims
+	codes map[string]mockAuthorization
+}
+
+type mockAuthorization struct {
+	challenge string
+	claims    map[string]any
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	t.Helper()
+
+	raw, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %q", err)
+	}
+
+	key, err := jwk.FromRaw(raw)
+	if err != nil {
+		t.Fatalf("failed to create key: %q", err)
+	}
+	_ = key.Set(jwk.KeyIDKey, "test")
+
+	m := &mockIssuer{key: key, codes: make(map[string]mockAuthorization)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Metadata{
+			Issuer:                m.URL,
+			AuthorizationEndpoint: m.URL + "/authorize",
+			TokenEndpoint:         m.URL + "/token",
+			JWKSURI:               m.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		pub, _ := key.PublicKey()
+		set := jwk.NewSet()
+		_ = set.AddKey(pub)
+		_ = json.NewEncoder(w).Encode(set)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		a, ok := m.codes[r.PostFormValue("code")]
+		delete(m.codes, r.PostFormValue("code"))
+		m.mu.Unlock()
+
+		if !ok || CodeChallenge(r.PostFormValue("code_verifier")) != a.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(TokenResponse{
+			AccessToken: "access",
+			TokenType:   "Bearer",
+			IDToken:     m.sign(t, a.claims),
+		})
+	})
+
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+// authorize registers an authorization code as if the user logged in with the given claims.
+func (m *mockIssuer) authorize(code, challenge string, claims map[string]any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.codes[code] = mockAuthorization{challenge: challenge, claims: claims}
+}
+
+// sign creates an ID token with the given claims, which are added on top of valid default claims.
+func (m *mockIssuer) sign(t *testing.T, claims map[string]any) string {
+	t.Helper()
+
+	tok := jwt.New()
+	_ = tok.Set(jwt.IssuerKey, m.URL)
+	_ = tok.Set(jwt.AudienceKey, "regauth")
+	_ = tok.Set(jwt.SubjectKey, "subject")
+	_ = tok.Set(jwt.IssuedAtKey, time.Now())
+	_ = tok.Set(jwt.ExpirationKey, time.Now().Add(time.Hour))
+	for k, v := range claims {
+		if err := tok.Set(k, v); err != nil {
+			t.Fatalf("failed to set claim %q: %q", k, err)
+		}
+	}
+
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.RS256, m.key))
+	if err != nil {
+		t.Fatalf("failed to sign token: %q", err)
+	}
+
+	return string(signed)
+}
+
+func newTestProvider(t *testing.T, m *mockIssuer) *Provider {
+	t.Helper()
+
+	p, err := NewProvider(t.Context(), Config{
+		Issuer:        m.URL,
+		ClientID:      "regauth",
+		Scopes:        []string{"openid"},
+		UsernameClaim: "preferred_username",
+		RoleClaim:     "groups",
+		AdminRoles:    []string{"registry-admins"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create provider: %q", err)
+	}
+
+	return p
+}
+
+func TestDiscover(t *testing.T) {
+	t.Parallel()
+
+	m := newMockIssuer(t)
+
+	t.Run("successful discovery", func(t *testing.T) {
+		t.Parallel()
+
+		metadata, err := Discover(t.Context(), http.DefaultClient, m.URL)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if metadata.TokenEndpoint != m.URL+"/token" || metadata.JWKSURI != m.URL+"/keys" {
+			t.Errorf("unexpected metadata %+v", metadata)
+		}
+	})
+
+	t.Run("issuer mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := Discover(t.Context(), http.DefaultClient, m.URL+"/"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestProvider_Verify(t *testing.T) {
+	t.Parallel()
+
+	m := newMockIssuer(t)
+	p := newTestProvider(t, m)
+
+	other := newMockIssuer(t)
+
+	cases := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{name: "valid token", token: m.sign(t, nil), valid: true},
+		{name: "expired token", token: m.sign(t, map[string]any{jwt.ExpirationKey: time.Now().Add(-time.Hour)})},
+		{name: "wrong audience", token: m.sign(t, map[string]any{jwt.AudienceKey: "other"})},
+		{name: "wrong issuer", token: m.sign(t, map[string]any{jwt.IssuerKey: "https://other.example.com"})},
+		{name: "signed with unknown key", token: other.sign(t, map[string]any{jwt.IssuerKey: m.URL})},
+		{name: "malformed token", token: "foobar"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := p.Verify(t.Context(), c.token)
+			if c.valid && err != nil {
+				t.Errorf("expected err to be nil, got %q", err)
+			}
+
+			if !c.valid && !errors.Is(err, ErrInvalidIDToken) {
+				t.Errorf("expected %q, got %q", ErrInvalidIDToken, err)
+			}
+		})
+	}
+}
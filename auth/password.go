@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"github.com/evanebb/regauth/user"
+)
+
+// PasswordAuthenticator verifies a username and password against a credentials backend.
+type PasswordAuthenticator interface {
+	// AuthenticatePassword returns the user.User belonging to the given credentials. If the credentials are not valid
+	// for the backend, an error wrapping ErrAuthenticationFailed is returned.
+	AuthenticatePassword(ctx context.Context, username, password string) (user.User, error)
+}
+
+// NewChainPasswordAuthenticator returns a PasswordAuthenticator that tries each of the given backends in order, until
+// one of them accepts the credentials. A backend that cannot be reached does not stop the chain, so the next backends
+// (such as a local break-glass administrator) can still be used. If no backend accepts the credentials, an error
+// wrapping ErrAuthenticationFailed is only returned if every backend rejected them; otherwise the backend errors are
+// returned, since the credentials could not be verified.
+func NewChainPasswordAuthenticator(backends ...PasswordAuthenticator) PasswordAuthenticator {
+	return chainPasswordAuthenticator(backends)
+}
+
+type chainPasswordAuthenticator []PasswordAuthenticator
+
+func (c chainPasswordAuthenticator) AuthenticatePassword(ctx context.Context, username, password string) (user.User, error) {
+	rejections := []error{ErrAuthenticationFailed}
+	var backendErrs []error
+
+	for _, backend := range c {
+		u, err := backend.AuthenticatePassword(ctx, username, password)
+		if err == nil {
+			return u, nil
+		}
+
+		if errors.Is(err, ErrAuthenticationFailed) {
+			rejections = append(rejections, err)
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return user.User{}, err
+		}
+
+		backendErrs = append(backendErrs, err)
+	}
+
+	if len(backendErrs) > 0 {
+		return user.User{}, errors.Join(backendErrs...)
+	}
+
+	return user.User{}, errors.Join(rejections...)
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"testing"
+)
+
+type passwordAuthenticatorFunc func(ctx context.Context, username, password string) (user.User, error)
+
+func (f passwordAuthenticatorFunc) AuthenticatePassword(ctx context.Context, username, password string) (user.User, error) {
+	return f(ctx, username, password)
+}
+
+func TestChainPasswordAuthenticator_AuthenticatePassword(t *testing.T) {
+	t.Parallel()
+
+	expected := user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}
+	errBackend := errors.New("backend unavailable")
+
+	reject := passwordAuthenticatorFunc(func(ctx context.Context, username, password string) (user.User, error) {
+		return user.User{}, errors.Join(ErrAuthenticationFailed, errors.New("rejected"))
+	})
+	accept := passwordAuthenticatorFunc(func(ctx context.Context, username, password string) (user.User, error) {
+		return expected, nil
+	})
+	fail := passwordAuthenticatorFunc(func(ctx context.Context, username, password string) (user.User, error) {
+		return user.User{}, errBackend
+	})
+
+	cases := []struct {
+		name     string
+		backends []PasswordAuthenticator
+		err      error
+	}{
+		{name: "first backend accepts", backends: []PasswordAuthenticator{accept, fail}},
+		{name: "falls through to next backend", backends: []PasswordAuthenticator{reject, accept}},
+		{name: "all backends reject", backends: []PasswordAuthenticator{reject, reject}, err: ErrAuthenticationFailed},
+		{name: "backend error falls through to next backend", backends: []PasswordAuthenticator{fail, accept}},
+		{name: "backend error and rejection", backends: []PasswordAuthenticator{fail, reject}, err: errBackend},
+		{name: "rejection and backend error", backends: []PasswordAuthenticator{reject, fail}, err: errBackend},
+		{name: "no backends", backends: nil, err: ErrAuthenticationFailed},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := NewChainPasswordAuthenticator(c.backends...).AuthenticatePassword(t.Context(), "user", "password")
+			if c.err != nil {
+				if !errors.Is(err, c.err) {
+					t.Errorf("expected %q, got %q", c.err, err)
+				}
+				if c.err != ErrAuthenticationFailed && errors.Is(err, ErrAuthenticationFailed) {
+					t.Errorf("expected backend error not to be reported as rejected credentials, got %q", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			if u != expected {
+				t.Errorf("expected %+v, got %+v", expected, u)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"time"
+)
+
+// ProvisionUser returns the user that is linked to the given account in an external identity source, such as an OpenID
+// Connect identity provider or LDAP directory, and creates it with the given username if it does not exist yet. Users
+// are only ever linked on their identity, never on their username, so an account in the identity source cannot take
+// over a local user or a user from another identity source that has the same username.
+//
+// The role of the user is set to the given role, so that changes in the identity source are reflected. If the role is
+// empty, the role of existing users is left untouched, and new users get the regular user role.
+func ProvisionUser(ctx context.Context, userStore user.Store, identity user.Identity, username string, role user.Role) (user.User, error) {
+	if identity.IsZero() {
+		return user.User{}, errors.New("identity of user in identity source cannot be empty")
+	}
+
+	u, err := userStore.GetByIdentity(ctx, identity)
+	if err != nil {
+		if !errors.Is(err, user.ErrNotFound) {
+			return u, err
+		}
+
+		return createUser(ctx, userStore, identity, username, role)
+	}
+
+	if role != "" && u.Role != role {
+		if err := userStore.UpdateRole(ctx, u.ID, role); err != nil {
+			return u, err
+		}
+
+		u.Role = role
+	}
+
+	return u, nil
+}
+
+func createUser(ctx context.Context, userStore user.Store, identity user.Identity, username string, role user.Role) (user.User, error) {
+	_, err := userStore.GetByUsername(ctx, username)
+	if err == nil {
+		return user.User{}, errors.Join(ErrAuthenticationFailed, errors.New("a user with the same username already exists, and is not linked to this identity"))
+	}
+	if !errors.Is(err, user.ErrNotFound) {
+		return user.User{}, err
+	}
+
+	if role == "" {
+		role = user.RoleUser
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return user.User{}, err
+	}
+
+	u := user.User{
+		ID:        id,
+		Username:  user.Username(username),
+		Role:      role,
+		Identity:  identity,
+		CreatedAt: time.Now(),
+	}
+
+	if err := u.IsValid(); err != nil {
+		return u, err
+	}
+
+	if err := userStore.Create(ctx, u); err != nil {
+		return u, err
+	}
+
+	return u, nil
+}
@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"testing"
+)
+
+func TestProvisionUser(t *testing.T) {
+	t.Parallel()
+
+	identity := user.Identity{Source: "https://idp.example.com", Subject: "1234"}
+
+	t.Run("creates new user", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+
+		u, err := ProvisionUser(t.Context(), userStore, identity, "newuser", "")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if u.Username != "newuser" || u.Role != user.RoleUser || u.Identity != identity {
+			t.Errorf("expected user %q with role %q and identity %+v, got %+v", "newuser", user.RoleUser, identity, u)
+		}
+
+		if _, err := userStore.GetByIdentity(t.Context(), identity); err != nil {
+			t.Errorf("expected user to be created, got %q", err)
+		}
+	})
+
+	t.Run("updates role of linked user", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+
+		existing := user.User{ID: uuid.New(), Username: "existing", Role: user.RoleUser, Identity: identity}
+		if err := userStore.Create(t.Context(), existing); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		u, err := ProvisionUser(t.Context(), userStore, identity, "existing", user.RoleAdmin)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		stored, _ := userStore.GetByID(t.Context(), existing.ID)
+		if u.ID != existing.ID || u.Role != user.RoleAdmin || stored.Role != user.RoleAdmin {
+			t.Errorf("expected user %q to have role %q, got %+v", existing.ID, user.RoleAdmin, stored)
+		}
+	})
+
+	t.Run("keeps role of linked user without role", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+
+		existing := user.User{ID: uuid.New(), Username: "existing", Role: user.RoleAdmin, Identity: identity}
+		if err := userStore.Create(t.Context(), existing); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		u, err := ProvisionUser(t.Context(), userStore, identity, "existing", "")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if u != existing {
+			t.Errorf("expected %+v, got %+v", existing, u)
+		}
+	})
+
+	t.Run("linked user is found after username changes", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+
+		existing := user.User{ID: uuid.New(), Username: "existing", Role: user.RoleUser, Identity: identity}
+		if err := userStore.Create(t.Context(), existing); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		u, err := ProvisionUser(t.Context(), userStore, identity, "renamed", "")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if u != existing {
+			t.Errorf("expected %+v, got %+v", existing, u)
+		}
+	})
+
+	t.Run("local user with same username is not taken over", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+
+		local := user.User{ID: uuid.New(), Username: "admin", Role: user.RoleAdmin}
+		if err := userStore.Create(t.Context(), local); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		if _, err := ProvisionUser(t.Context(), userStore, identity, "admin", user.RoleUser); !errors.Is(err, ErrAuthenticationFailed) {
+			t.Errorf("expected %q, got %q", ErrAuthenticationFailed, err)
+		}
+
+		stored, _ := userStore.GetByID(t.Context(), local.ID)
+		if stored != local {
+			t.Errorf("expected local user to be unchanged, got %+v", stored)
+		}
+	})
+
+	t.Run("user of other identity source with same username is not taken over", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+
+		other := user.User{ID: uuid.New(), Username: "existing", Role: user.RoleUser, Identity: user.Identity{Source: "ldaps://ldap.example.com", Subject: "uid=existing,dc=example,dc=com"}}
+		if err := userStore.Create(t.Context(), other); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		if _, err := ProvisionUser(t.Context(), userStore, identity, "existing", ""); !errors.Is(err, ErrAuthenticationFailed) {
+			t.Errorf("expected %q, got %q", ErrAuthenticationFailed, err)
+		}
+	})
+
+	t.Run("robot cannot be provisioned", func(t *testing.T) {
+		t.Parallel()
+		userStore := memory.NewUserStore()
+
+		robot := user.User{ID: uuid.New(), Username: user.NewRobotUsername("team", "ci"), Role: user.RoleRobot}
+		if err := userStore.Create(t.Context(), robot); err != nil {
+			t.Fatalf("failed to create user: %q", err)
+		}
+
+		if _, err := ProvisionUser(t.Context(), userStore, identity, "team+ci", user.RoleUser); !errors.Is(err, ErrAuthenticationFailed) {
+			t.Errorf("expected %q, got %q", ErrAuthenticationFailed, err)
+		}
+	})
+
+	t.Run("empty identity", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := ProvisionUser(t.Context(), memory.NewUserStore(), user.Identity{}, "newuser", ""); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("invalid username", func(t *testing.T) {
+		t.Parallel()
+
+		var e user.InvalidUsernameError
+		if _, err := ProvisionUser(t.Context(), memory.NewUserStore(), identity, "user@example.com", ""); !errors.As(err, &e) {
+			t.Errorf("expected invalid username error, got %q", err)
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/evanebb/regauth/oas"
+	"github.com/spf13/cobra"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+func newAuditCmd(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Query the audit log",
+		Long:  "Query the audit log. Administrator privileges are required to query the audit log.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Usage()
+		},
+	}
+
+	cmd.AddCommand(newListAuditEventsCommand(client))
+
+	return cmd
+}
+
+func newListAuditEventsCommand(client *oas.Client) *cobra.Command {
+	var (
+		actor      string
+		action     string
+		targetType string
+		target     string
+		since      time.Duration
+		pages      pageFlags
+		jsonLines  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit events",
+		Long:  "List the events in the audit log, from newest to oldest.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			params := oas.ListAuditEventsParams{Limit: oas.NewOptInt(pages.limit)}
+
+			if actor != "" {
+				params.Actor = oas.NewOptString(actor)
+			}
+			if action != "" {
+				params.Action = oas.NewOptString(action)
+			}
+			if targetType != "" {
+				params.TargetType = oas.NewOptListAuditEventsTargetType(oas.ListAuditEventsTargetType(targetType))
+			}
+			if target != "" {
+				params.Target = oas.NewOptString(target)
+			}
+			if since != 0 {
+				params.Since = oas.NewOptDateTime(time.Now().Add(-since))
+			}
+
+			res, err := fetchPages(pages, func(cursor oas.OptString) ([]oas.AuditEventResponse, oas.OptString, error) {
+				params.Cursor = cursor
+
+				res, err := client.ListAuditEvents(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			if jsonLines {
+				enc := json.NewEncoder(os.Stdout)
+				for _, e := range res {
+					_ = enc.Encode(e)
+				}
+
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "TIMESTAMP\tACTOR\tSOURCE IP\tACTION\tTARGET\tDETAILS")
+			for _, e := range res {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", e.Timestamp, e.Actor, e.SourceIp.Or(""), e.Action, string(e.TargetType)+":"+e.Target, formatAuditDetails(e.Details))
+			}
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&actor, "actor", "", "only list events performed by the user with this username")
+	cmd.Flags().StringVar(&action, "action", "", "only list events with this action, for example 'repository.create'")
+	cmd.Flags().StringVar(&targetType, "target-type", "", "only list events for this type of target, can be 'repository', 'token', 'team', 'user', 'registry' or 'namespace'")
+	cmd.Flags().StringVar(&target, "target", "", "only list events for this target, for example 'namespace/name' for a repository")
+	cmd.Flags().DurationVar(&since, "since", 0, "only list events that happened within this duration, for example '24h'")
+	pages.register(cmd)
+	cmd.Flags().BoolVar(&jsonLines, "json-lines", false, "print each event as a single line of JSON, for exporting the audit log")
+
+	return cmd
+}
+
+// formatAuditDetails formats the details of an audit event as a sorted list of key=value pairs.
+func formatAuditDetails(details oas.AuditEventResponseDetails) string {
+	pairs := make([]string, 0, len(details))
+	for k, v := range details {
+		pairs = append(pairs, k+"="+v)
+	}
+
+	sort.Strings(pairs)
+	return strings.Join(pairs, " ")
+}
@@ -1,11 +1,14 @@
 package client
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"github.com/evanebb/regauth/oas"
 	"github.com/spf13/cobra"
 	"io"
 	"os"
+	"time"
 )
 
 func newLoginCmd(credentialStore CredentialStore) *cobra.Command {
@@ -15,12 +18,16 @@ func newLoginCmd(credentialStore CredentialStore) *cobra.Command {
 		username      string
 		password      string
 		passwordStdin bool
+		useOIDC       bool
+		permission    string
+		scopes        []string
+		lifetime      time.Duration
 	)
 
 	cmd := &cobra.Command{
 		Use:   "login <host>",
 		Short: "Log in to a regauth host",
-		Long:  "Log in to a regauth host using the specified credentials.\nYou can either use a username and password or a personal access token.",
+		Long:  "Log in to a regauth host using the specified credentials.\nYou can either use a username and password, a personal access token, or log in through the identity provider of the host using --oidc.\nWhen logging in through the identity provider, a new personal access token is created and stored.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				return errors.New("specify a host to log in to")
@@ -44,6 +51,15 @@ func newLoginCmd(credentialStore CredentialStore) *cobra.Command {
 				}
 			}
 
+			if useOIDC {
+				t, err := logInUsingOIDC(context.Background(), host, permission, scopes, lifetime)
+				if err != nil {
+					return err
+				}
+
+				token = t
+			}
+
 			if token != "" {
 				credentials.Token = token
 			} else if username != "" && password != "" {
@@ -66,6 +82,22 @@ func newLoginCmd(credentialStore CredentialStore) *cobra.Command {
 	cmd.Flags().StringVarP(&username, "username", "u", "", "username to use for authentication")
 	cmd.Flags().StringVarP(&password, "password", "p", "", "password to use for authentication")
 	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "read password from stdin")
+	cmd.Flags().BoolVar(&useOIDC, "oidc", false, "log in through the OpenID Connect identity provider of the host using your browser")
+	cmd.Flags().StringVar(&permission, "token-permission", "readWrite", "permission of the personal access token created when using --oidc, can be 'readOnly', 'readWrite' or 'readWriteDelete'")
+	cmd.Flags().StringArrayVar(&scopes, "token-scope", defaultLoginScopes(), "scope of the personal access token created when using --oidc, can be specified multiple times")
+	cmd.Flags().DurationVar(&lifetime, "token-lifetime", 30*24*time.Hour, "lifetime of the personal access token created when using --oidc")
+	cmd.MarkFlagsMutuallyExclusive("oidc", "token", "token-stdin", "username")
 
 	return cmd
 }
+
+// defaultLoginScopes returns all scopes, so that the token created when logging in can be used for every command.
+func defaultLoginScopes() []string {
+	values := oas.PersonalAccessTokenRequestScopesItem("").AllValues()
+	scopes := make([]string, len(values))
+	for i, v := range values {
+		scopes[i] = string(v)
+	}
+
+	return scopes
+}
@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/evanebb/regauth/oas"
+	"github.com/spf13/cobra"
+	"os"
+	"strconv"
+	"text/tabwriter"
+)
+
+func newNamespaceCmd(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "namespace",
+		Short: "Manage namespaces",
+		Long:  "Manage the settings of namespaces. You can manage your own namespace and the namespaces of your teams.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Usage()
+		},
+	}
+
+	cmd.AddCommand(newGetNamespaceSettingsCommand(client))
+	cmd.AddCommand(newUpdateNamespaceSettingsCommand(client))
+	cmd.AddCommand(newGetNamespaceUsageCommand(client))
+	cmd.AddCommand(newSetNamespaceQuotaCommand(client))
+	cmd.AddCommand(newResetNamespaceQuotaCommand(client))
+
+	return cmd
+}
+
+func newGetNamespaceSettingsCommand(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <namespace>",
+		Short: "Get the settings of a namespace",
+		Long:  "Get the settings of a namespace.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a namespace")
+			}
+
+			settings, err := client.GetNamespaceSettings(ctx, oas.GetNamespaceSettingsParams{
+				Namespace: args[0],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "NAMESPACE\tAUTO-CREATE REPOSITORIES\tAUTO-CREATE VISIBILITY")
+			_, _ = fmt.Fprintf(w, "%s\t%t\t%s\n", args[0], settings.AutoCreateRepositories, settings.AutoCreateVisibility)
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newUpdateNamespaceSettingsCommand(client *oas.Client) *cobra.Command {
+	var (
+		autoCreate bool
+		visibility string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update <namespace>",
+		Short: "Update the settings of a namespace",
+		Long: `Update the settings of a namespace. Only the given flags are changed.
+
+When automatic repository creation is enabled, pushing to a repository that does not exist yet creates it with the
+configured visibility, if you are allowed to push to the namespace.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a namespace")
+			}
+
+			settings, err := client.GetNamespaceSettings(ctx, oas.GetNamespaceSettingsParams{
+				Namespace: args[0],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			if cmd.Flags().Changed("auto-create") {
+				settings.AutoCreateRepositories = autoCreate
+			}
+			if cmd.Flags().Changed("auto-create-visibility") {
+				settings.AutoCreateVisibility = oas.NamespaceSettingsAutoCreateVisibility(visibility)
+			}
+
+			_, err = client.UpdateNamespaceSettings(ctx, settings, oas.UpdateNamespaceSettingsParams{
+				Namespace: args[0],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully updated settings of namespace " + args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&autoCreate, "auto-create", false, "create repositories automatically when they are pushed to for the first time")
+	cmd.Flags().StringVar(&visibility, "auto-create-visibility", "", "visibility of automatically created repositories, can be either 'private' or 'public'")
+
+	return cmd
+}
+
+func newGetNamespaceUsageCommand(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage <namespace>",
+		Short: "Get the usage and quota of a namespace",
+		Long:  "Get the number of repositories and the storage used by a namespace, along with its quota.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a namespace")
+			}
+
+			usage, err := client.GetNamespaceUsage(ctx, oas.GetNamespaceUsageParams{
+				Namespace: args[0],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			repositoryQuota := "unlimited"
+			if q, ok := usage.RepositoryQuota.Get(); ok {
+				repositoryQuota = strconv.Itoa(q)
+			}
+
+			bytesQuota := "unlimited"
+			if q, ok := usage.BytesQuota.Get(); ok {
+				bytesQuota = strconv.FormatInt(q, 10)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "NAMESPACE\tREPOSITORIES\tREPOSITORY QUOTA\tBYTES\tBYTES QUOTA")
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%s\n", args[0], usage.Repositories, repositoryQuota, usage.Bytes, bytesQuota)
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newSetNamespaceQuotaCommand(client *oas.Client) *cobra.Command {
+	var (
+		repositories int
+		bytes        int64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set-quota <namespace>",
+		Short: "Set the quota of a namespace",
+		Long: `Set the quota of a namespace, which takes precedence over the default quota for namespaces of users or teams. A
+limit of zero means that there is no limit. Only admins can set the quota of a namespace.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a namespace")
+			}
+
+			_, err := client.UpdateNamespaceQuota(ctx, &oas.NamespaceQuota{
+				Repositories: repositories,
+				Bytes:        bytes,
+			}, oas.UpdateNamespaceQuotaParams{
+				Namespace: args[0],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully set quota of namespace " + args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&repositories, "repositories", 0, "maximum number of repositories in the namespace, zero for no limit")
+	cmd.Flags().Int64Var(&bytes, "bytes", 0, "maximum total size of the repositories in the namespace, zero for no limit")
+
+	return cmd
+}
+
+func newResetNamespaceQuotaCommand(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-quota <namespace>",
+		Short: "Reset the quota of a namespace to the default",
+		Long:  "Remove the quota that was set for a namespace, so that the default quota for namespaces of users or teams applies again.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a namespace")
+			}
+
+			err := client.DeleteNamespaceQuota(ctx, oas.DeleteNamespaceQuotaParams{
+				Namespace: args[0],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully reset quota of namespace " + args[0])
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/evanebb/regauth/auth/oidc"
+	"github.com/evanebb/regauth/oas"
+	"net"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"time"
+)
+
+// oidcLoginTimeout is how long to wait for the user to complete the login in their browser.
+const oidcLoginTimeout = 5 * time.Minute
+
+// logInUsingOIDC logs in through the OpenID Connect identity provider of the given host using the authorization code
+// flow with PKCE, and uses the resulting ID token to create a new personal access token, which is returned.
+func logInUsingOIDC(ctx context.Context, host string, permission string, scopes []string, lifetime time.Duration) (string, error) {
+	anonymousClient, err := oas.NewClient(host, SecuritySource{})
+	if err != nil {
+		return "", err
+	}
+
+	conf, err := anonymousClient.GetOIDCConfiguration(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not get OpenID Connect configuration: %w", err)
+	}
+
+	metadata, err := oidc.Discover(ctx, http.DefaultClient, conf.Issuer)
+	if err != nil {
+		return "", err
+	}
+
+	idToken, err := authorizeInBrowser(ctx, metadata, conf.ClientId, conf.Scopes)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := oas.NewClient(host, SecuritySource{Token: idToken})
+	if err != nil {
+		return "", err
+	}
+
+	res, err := client.CreatePersonalAccessToken(ctx, &oas.PersonalAccessTokenRequest{
+		Description:    "regauth-cli login",
+		Permission:     oas.PersonalAccessTokenRequestPermission(permission),
+		Scopes:         parseTokenScopes(scopes),
+		ExpirationDate: time.Now().Add(lifetime),
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not create personal access token: %w", err)
+	}
+
+	return res.Token, nil
+}
+
+// authorizeInBrowser sends the user to the identity provider, and receives the authorization code on a loopback
+// redirect URI. The code is then exchanged for an ID token.
+func authorizeInBrowser(ctx context.Context, metadata oidc.Metadata, clientID string, scopes []string) (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+
+	redirectURI := fmt.Sprintf("http://%s/callback", listener.Addr().String())
+	state := oidc.NewState()
+	verifier := oidc.NewCodeVerifier()
+
+	type result struct {
+		code string
+		err  error
+	}
+	results := make(chan result, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var res result
+
+		switch {
+		case q.Get("state") != state:
+			res.err = errors.New("state returned by identity provider does not match")
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("identity provider returned error: %s %s", q.Get("error"), q.Get("error_description"))
+		case q.Get("code") == "":
+			res.err = errors.New("identity provider did not return an authorization code")
+		default:
+			res.code = q.Get("code")
+		}
+
+		if res.err != nil {
+			http.Error(w, "Login failed, you can close this window.", http.StatusBadRequest)
+		} else {
+			_, _ = w.Write([]byte("Login successful, you can close this window."))
+		}
+
+		select {
+		case results <- res:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Close() }()
+
+	authURL := oidc.AuthCodeURL(metadata, clientID, redirectURI, scopes, state, verifier)
+	fmt.Println("opening your browser to log in, if it does not open automatically, visit the following URL:")
+	fmt.Println(authURL)
+	_ = openBrowser(authURL)
+
+	ctx, cancel := context.WithTimeout(ctx, oidcLoginTimeout)
+	defer cancel()
+
+	var res result
+	select {
+	case res = <-results:
+	case <-ctx.Done():
+		return "", errors.New("timed out waiting for login to complete")
+	}
+
+	if res.err != nil {
+		return "", res.err
+	}
+
+	tok, err := oidc.ExchangeCode(ctx, http.DefaultClient, metadata, clientID, redirectURI, res.code, verifier)
+	if err != nil {
+		return "", err
+	}
+
+	return tok.IDToken, nil
+}
+
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	return cmd.Start()
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"fmt"
+	"github.com/evanebb/regauth/oas"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+// pageFlags are the pagination flags shared by all list commands.
+type pageFlags struct {
+	limit int
+	all   bool
+}
+
+func (f *pageFlags) register(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&f.limit, "limit", 100, "maximum number of items to return per page")
+	cmd.Flags().BoolVar(&f.all, "all", false, "fetch all pages instead of only the first one")
+}
+
+// listFlags are the pagination, sorting and filtering flags shared by the list commands of listings that can be sorted
+// and filtered on their name.
+type listFlags struct {
+	pageFlags
+	sort  string
+	order string
+	name  string
+}
+
+func (f *listFlags) register(cmd *cobra.Command, nameUsage string) {
+	f.pageFlags.register(cmd)
+	cmd.Flags().StringVar(&f.sort, "sort", "name", "field to sort by, can be 'name' or 'createdAt'")
+	cmd.Flags().StringVar(&f.order, "order", "asc", "order to sort in, can be 'asc' or 'desc'")
+	cmd.Flags().StringVar(&f.name, "name", "", nameUsage)
+}
+
+func (f *listFlags) params() (limit oas.OptInt, sort oas.OptListSort, order oas.OptListOrder, name oas.OptString) {
+	if f.name != "" {
+		name = oas.NewOptString(f.name)
+	}
+
+	return oas.NewOptInt(f.limit), oas.NewOptListSort(oas.ListSort(f.sort)), oas.NewOptListOrder(oas.ListOrder(f.order)), name
+}
+
+// fetchPages fetches the first page of a listing, or all pages if the --all flag is set. The fetch function retrieves
+// the page at the given cursor, and returns its items and the cursor of the next page.
+func fetchPages[T any](f pageFlags, fetch func(cursor oas.OptString) ([]T, oas.OptString, error)) ([]T, error) {
+	var (
+		items  []T
+		cursor oas.OptString
+	)
+
+	for {
+		page, next, err := fetch(cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page...)
+		if !next.IsSet() {
+			return items, nil
+		}
+
+		if !f.all {
+			_, _ = fmt.Fprintln(os.Stderr, "more results are available, use --all to list all of them")
+			return items, nil
+		}
+
+		cursor = next
+	}
+}
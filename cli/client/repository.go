@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"github.com/evanebb/regauth/oas"
 	"github.com/spf13/cobra"
+	"maps"
 	"os"
+	"slices"
 	"strings"
 	"text/tabwriter"
 )
@@ -23,21 +25,41 @@ func newRepositoryCmd(client *oas.Client) *cobra.Command {
 
 	cmd.AddCommand(newListRepositoriesCommand(client))
 	cmd.AddCommand(newGetRepositoryCommand(client))
+	cmd.AddCommand(newSearchRepositoriesCommand(client))
 	cmd.AddCommand(newCreateRepositoryCommand(client))
+	cmd.AddCommand(newUpdateRepositoryCommand(client))
 	cmd.AddCommand(newDeleteRepositoryCommand(client))
+	cmd.AddCommand(newListRepositoryTagsCommand(client))
+	cmd.AddCommand(newRepositoryCollaboratorCommand(client))
+	cmd.AddCommand(newRepositoryProtectedTagCommand(client))
 
 	return cmd
 }
 
 func newListRepositoriesCommand(client *oas.Client) *cobra.Command {
+	var (
+		flags  listFlags
+		labels []string
+	)
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all your repositories",
-		Long:  "List all your repositories.",
+		Long:  "List all your repositories, optionally only those that have all the given labels.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
-			res, err := client.ListRepositories(ctx)
+			res, err := fetchPages(flags.pageFlags, func(cursor oas.OptString) ([]oas.RepositoryResponse, oas.OptString, error) {
+				params := oas.ListRepositoriesParams{Label: labels, Cursor: cursor}
+				params.Limit, params.Sort, params.Order, params.Name = flags.params()
+
+				res, err := client.ListRepositories(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
+			})
 			if err != nil {
 				fmt.Println(err)
 				return nil
@@ -54,6 +76,77 @@ func newListRepositoriesCommand(client *oas.Client) *cobra.Command {
 		},
 	}
 
+	flags.register(cmd, "only list repositories whose name contains this value")
+	cmd.Flags().StringArrayVar(&labels, "label", nil, "only list repositories with the given label in the form of 'key=value', can be given multiple times")
+
+	return cmd
+}
+
+func newSearchRepositoriesCommand(client *oas.Client) *cobra.Command {
+	var (
+		namespace string
+		labels    []string
+		sortBy    string
+		order     string
+		pages     pageFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "search [query]",
+		Short: "Search the repository catalog",
+		Long: `Search all repositories that you are allowed to pull, including public repositories in other namespaces.
+If a query is given, only repositories whose name contains it are returned.
+Logging in is not required, but anonymous users can only find public repositories.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			params := oas.SearchCatalogParams{
+				Label: labels,
+				Sort:  oas.NewOptSearchCatalogSort(oas.SearchCatalogSort(sortBy)),
+				Order: oas.NewOptSearchCatalogOrder(oas.SearchCatalogOrder(order)),
+				Limit: oas.NewOptInt(pages.limit),
+			}
+
+			if len(args) == 1 {
+				params.Q = oas.NewOptString(args[0])
+			}
+			if namespace != "" {
+				params.Namespace = oas.NewOptString(namespace)
+			}
+
+			res, err := fetchPages(pages, func(cursor oas.OptString) ([]oas.RepositoryResponse, oas.OptString, error) {
+				params.Cursor = cursor
+
+				res, err := client.SearchCatalog(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tVISIBILITY\tCREATED\tDESCRIPTION")
+			for _, repo := range res {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", repo.Namespace, repo.Name, repo.Visibility, repo.CreatedAt, repo.Description.Or(""))
+			}
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&namespace, "namespace", "", "only return repositories in this namespace")
+	cmd.Flags().StringArrayVar(&labels, "label", nil, "only return repositories with the given label in the form of 'key=value', can be given multiple times")
+	cmd.Flags().StringVar(&sortBy, "sort", "name", "field to sort by, can be 'name', 'namespace' or 'createdAt'")
+	cmd.Flags().StringVar(&order, "order", "asc", "order to sort in, can be 'asc' or 'desc'")
+	pages.register(cmd)
+
 	return cmd
 }
 
@@ -80,10 +173,22 @@ func newGetRepositoryCommand(client *oas.Client) *cobra.Command {
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
-			_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tVISIBILITY\tCREATED\tID")
-			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", repo.Namespace, repo.Name, repo.Visibility, repo.CreatedAt, repo.ID)
+			_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tVISIBILITY\tCREATED\tID\tDESCRIPTION")
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", repo.Namespace, repo.Name, repo.Visibility, repo.CreatedAt, repo.ID, repo.Description.Or(""))
 			_ = w.Flush()
 
+			if labels := repo.Labels.Or(nil); len(labels) > 0 {
+				fmt.Println("\nLABELS")
+				for _, key := range slices.Sorted(maps.Keys(labels)) {
+					fmt.Println(key + "=" + labels[key])
+				}
+			}
+
+			if readme, ok := repo.Readme.Get(); ok {
+				fmt.Println("\nREADME")
+				fmt.Println(readme)
+			}
+
 			return nil
 		},
 	}
@@ -93,9 +198,12 @@ func newGetRepositoryCommand(client *oas.Client) *cobra.Command {
 
 func newCreateRepositoryCommand(client *oas.Client) *cobra.Command {
 	var (
-		namespace  string
-		name       string
-		visibility string
+		namespace   string
+		name        string
+		visibility  string
+		description string
+		labels      map[string]string
+		readmeFile  string
 	)
 
 	cmd := &cobra.Command{
@@ -103,11 +211,24 @@ func newCreateRepositoryCommand(client *oas.Client) *cobra.Command {
 		Short: "Create a new repository",
 		Long:  "Create a new repository.",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			repo, err := client.CreateRepository(context.Background(), &oas.RepositoryRequest{
-				Namespace:  namespace,
-				Name:       name,
-				Visibility: oas.RepositoryRequestVisibility(visibility),
-			})
+			req := &oas.RepositoryRequest{
+				Namespace:   namespace,
+				Name:        name,
+				Visibility:  oas.RepositoryRequestVisibility(visibility),
+				Description: oas.NewOptString(description),
+				Labels:      oas.NewOptRepositoryRequestLabels(labels),
+			}
+
+			if readmeFile != "" {
+				readme, err := os.ReadFile(readmeFile)
+				if err != nil {
+					return fmt.Errorf("failed to read README: %w", err)
+				}
+
+				req.Readme = oas.NewOptString(string(readme))
+			}
+
+			repo, err := client.CreateRepository(context.Background(), req)
 			if err != nil {
 				fmt.Println(err)
 				return nil
@@ -120,10 +241,88 @@ func newCreateRepositoryCommand(client *oas.Client) *cobra.Command {
 
 	cmd.Flags().StringVar(&namespace, "namespace", "", "namespace of the new repository")
 	_ = cmd.MarkFlagRequired("namespace")
-	cmd.Flags().StringVar(&name, "name", "", "name of the new repository")
+	cmd.Flags().StringVar(&name, "name", "", "name of the new repository, which may contain slashes for nested repositories such as 'payments/api'")
 	_ = cmd.MarkFlagRequired("name")
 	cmd.Flags().StringVar(&visibility, "visibility", "", "visibility of the new repository, can be either 'private' or 'public'")
 	_ = cmd.MarkFlagRequired("visibility")
+	cmd.Flags().StringVar(&description, "description", "", "description of the new repository")
+	cmd.Flags().StringToStringVar(&labels, "label", nil, "label to set on the new repository in the form of 'key=value', can be given multiple times")
+	cmd.Flags().StringVar(&readmeFile, "readme-file", "", "path to a Markdown file to use as the README of the new repository")
+
+	return cmd
+}
+
+func newUpdateRepositoryCommand(client *oas.Client) *cobra.Command {
+	var (
+		newNamespace string
+		newName      string
+		visibility   string
+		description  string
+		labels       map[string]string
+		readmeFile   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update <namespace>/<name>",
+		Short: "Update a repository",
+		Long: `Update the visibility, description, labels, README or name of a repository. Only the given flags are changed.
+When labels are given, they replace all existing labels of the repository.
+
+The repository can be moved to another namespace you have access to using the --namespace flag.
+Note that images that have already been pushed are not moved in the storage of the registry.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			namespace, name, err := parseRepositoryNameFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			req := &oas.RepositoryUpdateRequest{}
+			if cmd.Flags().Changed("namespace") {
+				req.Namespace = oas.NewOptString(newNamespace)
+			}
+			if cmd.Flags().Changed("name") {
+				req.Name = oas.NewOptString(newName)
+			}
+			if cmd.Flags().Changed("visibility") {
+				req.Visibility = oas.NewOptRepositoryUpdateRequestVisibility(oas.RepositoryUpdateRequestVisibility(visibility))
+			}
+			if cmd.Flags().Changed("description") {
+				req.Description = oas.NewOptString(description)
+			}
+			if cmd.Flags().Changed("label") {
+				req.Labels = oas.NewOptRepositoryUpdateRequestLabels(labels)
+			}
+			if readmeFile != "" {
+				readme, err := os.ReadFile(readmeFile)
+				if err != nil {
+					return fmt.Errorf("failed to read README: %w", err)
+				}
+
+				req.Readme = oas.NewOptString(string(readme))
+			}
+
+			repo, err := client.UpdateRepository(ctx, req, oas.UpdateRepositoryParams{
+				Namespace: namespace,
+				Name:      name,
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully updated repository " + repo.Namespace + "/" + repo.Name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&newNamespace, "namespace", "", "namespace to move the repository to")
+	cmd.Flags().StringVar(&newName, "name", "", "new name of the repository")
+	cmd.Flags().StringVar(&visibility, "visibility", "", "new visibility of the repository, can be either 'private' or 'public'")
+	cmd.Flags().StringVar(&description, "description", "", "new description of the repository")
+	cmd.Flags().StringToStringVar(&labels, "label", nil, "label to set on the repository in the form of 'key=value', can be given multiple times")
+	cmd.Flags().StringVar(&readmeFile, "readme-file", "", "path to a Markdown file to use as the new README of the repository")
 
 	return cmd
 }
@@ -158,16 +357,342 @@ func newDeleteRepositoryCommand(client *oas.Client) *cobra.Command {
 	return cmd
 }
 
+func newListRepositoryTagsCommand(client *oas.Client) *cobra.Command {
+	var flags listFlags
+
+	cmd := &cobra.Command{
+		Use:   "tags <namespace>/<name>",
+		Short: "List the tags pushed to a repository",
+		Long:  "List the tags pushed to a repository.\nTags are tracked through the notifications sent by the registry.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			namespace, name, err := parseRepositoryNameFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			res, err := fetchPages(flags.pageFlags, func(cursor oas.OptString) ([]oas.TagResponse, oas.OptString, error) {
+				params := oas.ListRepositoryTagsParams{Namespace: namespace, Name: name, Cursor: cursor}
+				params.Limit, params.Sort, params.Order, params.Tag = flags.params()
+
+				res, err := client.ListRepositoryTags(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "TAG\tDIGEST\tMEDIA TYPE\tSIZE\tPUSHED")
+			for _, tag := range res {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", tag.Name, tag.Digest, tag.MediaType, tag.Size, tag.PushedAt)
+			}
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	flags.register(cmd, "only list tags whose name contains this value")
+
+	return cmd
+}
+
+func newRepositoryCollaboratorCommand(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collaborator",
+		Short: "Manage the users and teams that have access to a repository",
+		Long:  "Manage the users and teams that have access to a repository outside of their own namespaces.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Usage()
+		},
+	}
+
+	cmd.AddCommand(newListRepositoryCollaboratorsCommand(client))
+	cmd.AddCommand(newAddRepositoryCollaboratorCommand(client))
+	cmd.AddCommand(newRemoveRepositoryCollaboratorCommand(client))
+
+	return cmd
+}
+
+func newListRepositoryCollaboratorsCommand(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <namespace>/<name>",
+		Short: "List all collaborators for a repository",
+		Long:  "List all collaborators for a repository.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			namespace, name, err := parseRepositoryNameFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.ListRepositoryCollaborators(ctx, oas.ListRepositoryCollaboratorsParams{
+				Namespace: namespace,
+				Name:      name,
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "TYPE\tNAME\tPERMISSION\tCREATED")
+			for _, c := range res {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Type, c.Name, c.Permission, c.CreatedAt)
+			}
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newAddRepositoryCollaboratorCommand(client *oas.Client) *cobra.Command {
+	var (
+		username   string
+		team       string
+		permission string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add <namespace>/<name>",
+		Short: "Grant a user or team access to a repository",
+		Long:  "Grant a user or team access to a repository.\nIf the user or team is already a collaborator, their permission is updated.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			namespace, name, err := parseRepositoryNameFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			req := &oas.RepositoryCollaboratorRequest{
+				Type:       oas.RepositoryCollaboratorRequestTypeUser,
+				Name:       username,
+				Permission: oas.RepositoryCollaboratorRequestPermission(permission),
+			}
+			if team != "" {
+				req.Type = oas.RepositoryCollaboratorRequestTypeTeam
+				req.Name = team
+			}
+
+			res, err := client.AddRepositoryCollaborator(ctx, req, oas.AddRepositoryCollaboratorParams{
+				Namespace: namespace,
+				Name:      name,
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Printf("successfully granted %s %s %s access to repository %s/%s\n", res.Type, res.Name, res.Permission, namespace, name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&username, "user", "", "username of the user to grant access")
+	cmd.Flags().StringVar(&team, "team", "", "name of the team to grant access")
+	cmd.MarkFlagsOneRequired("user", "team")
+	cmd.MarkFlagsMutuallyExclusive("user", "team")
+	cmd.Flags().StringVar(&permission, "permission", "", "permission of the collaborator, can be either 'pull', 'push' or 'delete'")
+	_ = cmd.MarkFlagRequired("permission")
+
+	return cmd
+}
+
+func newRemoveRepositoryCollaboratorCommand(client *oas.Client) *cobra.Command {
+	var (
+		username string
+		team     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "remove <namespace>/<name>",
+		Short: "Revoke the access of a user or team to a repository",
+		Long:  "Revoke the access of a user or team to a repository.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			namespace, name, err := parseRepositoryNameFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			params := oas.RemoveRepositoryCollaboratorParams{
+				Namespace:    namespace,
+				Name:         name,
+				Type:         oas.RemoveRepositoryCollaboratorTypeUser,
+				Collaborator: username,
+			}
+			if team != "" {
+				params.Type = oas.RemoveRepositoryCollaboratorTypeTeam
+				params.Collaborator = team
+			}
+
+			if err := client.RemoveRepositoryCollaborator(ctx, params); err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully removed collaborator")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&username, "user", "", "username of the collaborator to remove")
+	cmd.Flags().StringVar(&team, "team", "", "name of the team to remove")
+	cmd.MarkFlagsOneRequired("user", "team")
+	cmd.MarkFlagsMutuallyExclusive("user", "team")
+
+	return cmd
+}
+
+func newRepositoryProtectedTagCommand(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protected-tag",
+		Short: "Manage the protected tags of a repository",
+		Long: `Manage the patterns of the tags that are protected in a repository.
+Delete access to a repository with protected tags is only granted to the owner of a user namespace and the admins of
+a team namespace, and overwriting or deleting a protected tag is recorded in the audit log.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Usage()
+		},
+	}
+
+	cmd.AddCommand(newListRepositoryProtectedTagsCommand(client))
+	cmd.AddCommand(newAddRepositoryProtectedTagCommand(client))
+	cmd.AddCommand(newRemoveRepositoryProtectedTagCommand(client))
+
+	return cmd
+}
+
+func newListRepositoryProtectedTagsCommand(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <namespace>/<name>",
+		Short: "List all protected tag patterns for a repository",
+		Long:  "List all protected tag patterns for a repository.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			namespace, name, err := parseRepositoryNameFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.ListRepositoryProtectedTags(ctx, oas.ListRepositoryProtectedTagsParams{
+				Namespace: namespace,
+				Name:      name,
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "PATTERN\tCREATED")
+			for _, p := range res {
+				_, _ = fmt.Fprintf(w, "%s\t%s\n", p.Pattern, p.CreatedAt)
+			}
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newAddRepositoryProtectedTagCommand(client *oas.Client) *cobra.Command {
+	var pattern string
+
+	cmd := &cobra.Command{
+		Use:   "add <namespace>/<name>",
+		Short: "Protect the tags of a repository matching a pattern",
+		Long:  "Protect the tags of a repository matching a glob pattern, for example 'v*' or 'latest'.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			namespace, name, err := parseRepositoryNameFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.AddRepositoryProtectedTag(ctx, &oas.ProtectedTagRequest{Pattern: pattern}, oas.AddRepositoryProtectedTagParams{
+				Namespace: namespace,
+				Name:      name,
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Printf("successfully protected tags matching %s in repository %s/%s\n", res.Pattern, namespace, name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&pattern, "pattern", "", "glob pattern matching the tags to protect")
+	_ = cmd.MarkFlagRequired("pattern")
+
+	return cmd
+}
+
+func newRemoveRepositoryProtectedTagCommand(client *oas.Client) *cobra.Command {
+	var pattern string
+
+	cmd := &cobra.Command{
+		Use:   "remove <namespace>/<name>",
+		Short: "Remove a protected tag pattern from a repository",
+		Long:  "Remove a protected tag pattern from a repository.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			namespace, name, err := parseRepositoryNameFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			err = client.RemoveRepositoryProtectedTag(ctx, oas.RemoveRepositoryProtectedTagParams{
+				Namespace: namespace,
+				Name:      name,
+				Pattern:   pattern,
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully removed protected tag pattern")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&pattern, "pattern", "", "protected tag pattern to remove")
+	_ = cmd.MarkFlagRequired("pattern")
+
+	return cmd
+}
+
 func parseRepositoryNameFromArgs(args []string) (namespace string, name string, err error) {
 	argCount := len(args)
 
 	if argCount == 1 {
-		// assume repository name is given as <namespace>/<name>
-		split := strings.Split(args[0], "/")
-		if len(split) != 2 {
+		// assume repository name is given as <namespace>/<name>, in which the name itself may contain slashes
+		namespace, name, ok := strings.Cut(args[0], "/")
+		if !ok || namespace == "" || name == "" {
 			return "", "", errors.New("invalid repository name given")
 		}
-		return split[0], split[1], nil
+		return namespace, name, nil
 	}
 
 	if argCount == 2 {
@@ -0,0 +1,375 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/evanebb/regauth/oas"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+func newTeamRobotCmd(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "robot",
+		Short: "Manage robots for a team",
+		Long:  "Manage robots for a team.\nRobots are non-interactive accounts owned by a team, which can be used by pipelines to access the repositories of the team.\nRobots authenticate to the registry as '<team>+<robot>' using their personal access tokens.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Usage()
+		},
+	}
+
+	cmd.AddCommand(newListTeamRobotsCmd(client))
+	cmd.AddCommand(newGetTeamRobotCmd(client))
+	cmd.AddCommand(newCreateTeamRobotCmd(client))
+	cmd.AddCommand(newDeleteTeamRobotCmd(client))
+
+	cmd.AddCommand(newTeamRobotTokenCmd(client))
+
+	return cmd
+}
+
+func newListTeamRobotsCmd(client *oas.Client) *cobra.Command {
+	var flags listFlags
+
+	cmd := &cobra.Command{
+		Use:   "list <team>",
+		Short: "List all robots for a team",
+		Long:  "List all robots for a team.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a team name")
+			}
+
+			res, err := fetchPages(flags.pageFlags, func(cursor oas.OptString) ([]oas.TeamRobotResponse, oas.OptString, error) {
+				params := oas.ListTeamRobotsParams{Name: args[0], Cursor: cursor}
+				params.Limit, params.Sort, params.Order, params.Robot = flags.params()
+
+				res, err := client.ListTeamRobots(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "NAME\tUSERNAME\tCREATED\tID")
+			for _, robot := range res {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", robot.Name, robot.Username, robot.CreatedAt, robot.ID)
+			}
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	flags.register(cmd, "only list robots whose name contains this value")
+
+	return cmd
+}
+
+func newGetTeamRobotCmd(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get <team> <robot>",
+		Short: "Get information about a specific robot",
+		Long:  "Get information about a specific robot.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 2 {
+				return errors.New("specify a team name and robot name")
+			}
+
+			robot, err := client.GetTeamRobot(ctx, oas.GetTeamRobotParams{
+				Name:  args[0],
+				Robot: args[1],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "NAME\tUSERNAME\tCREATED\tID")
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", robot.Name, robot.Username, robot.CreatedAt, robot.ID)
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newCreateTeamRobotCmd(client *oas.Client) *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "create <team>",
+		Short: "Create a new robot for a team",
+		Long:  "Create a new robot for a team.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a team name")
+			}
+
+			res, err := client.CreateTeamRobot(ctx,
+				&oas.TeamRobotRequest{
+					Name: name,
+				},
+				oas.CreateTeamRobotParams{
+					Name: args[0],
+				},
+			)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully created robot " + res.Username)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "name of the new robot")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func newDeleteTeamRobotCmd(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <team> <robot>",
+		Short: "Delete a robot and all of its personal access tokens",
+		Long:  "Delete a robot and all of its personal access tokens.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 2 {
+				return errors.New("specify a team name and robot name")
+			}
+
+			err := client.DeleteTeamRobot(ctx, oas.DeleteTeamRobotParams{
+				Name:  args[0],
+				Robot: args[1],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully deleted robot")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newTeamRobotTokenCmd(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Manage personal access tokens for a robot",
+		Long:  "Manage personal access tokens for a robot.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Usage()
+		},
+	}
+
+	cmd.AddCommand(newListTeamRobotTokensCmd(client))
+	cmd.AddCommand(newCreateTeamRobotTokenCmd(client))
+	cmd.AddCommand(newDeleteTeamRobotTokenCmd(client))
+	cmd.AddCommand(newRevokeTeamRobotTokenCmd(client))
+
+	return cmd
+}
+
+func newListTeamRobotTokensCmd(client *oas.Client) *cobra.Command {
+	var flags listFlags
+
+	cmd := &cobra.Command{
+		Use:   "list <team> <robot>",
+		Short: "List all personal access tokens for a robot",
+		Long:  "List all personal access tokens for a robot.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 2 {
+				return errors.New("specify a team name and robot name")
+			}
+
+			res, err := fetchPages(flags.pageFlags, func(cursor oas.OptString) ([]oas.PersonalAccessTokenResponse, oas.OptString, error) {
+				params := oas.ListTeamRobotTokensParams{Name: args[0], Robot: args[1], Cursor: cursor}
+				params.Limit, params.Sort, params.Order, params.Description = flags.params()
+
+				res, err := client.ListTeamRobotTokens(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "ID\tDESCRIPTION\tPERMISSION\tREPOSITORIES\tALLOWED CIDRS\tEXPIRATION\tREVOKED\tCREATED\tLAST USED")
+			for _, token := range res {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", token.ID, token.Description, token.Permission, formatTokenRepositories(token.Repositories), formatTokenAllowedCIDRs(token.AllowedCidrs), token.ExpirationDate, formatTokenRevoked(token.RevokedAt), token.CreatedAt, formatTokenLastUsed(token.LastUsedAt, token.LastUsedFrom))
+			}
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	flags.register(cmd, "only list tokens whose description contains this value")
+
+	return cmd
+}
+
+func newCreateTeamRobotTokenCmd(client *oas.Client) *cobra.Command {
+	var (
+		description       string
+		permission        string
+		repositories      []string
+		allowedCIDRs      []string
+		expirationDateStr string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <team> <robot>",
+		Short: "Create a new personal access token for a robot",
+		Long:  "Create a new personal access token for a robot.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 2 {
+				return errors.New("specify a team name and robot name")
+			}
+
+			expirationDate, err := time.Parse(time.RFC3339, expirationDateStr)
+			if err != nil {
+				return fmt.Errorf("invalid expiration date %s: %w", expirationDate, err)
+			}
+
+			res, err := client.CreateTeamRobotToken(ctx,
+				&oas.PersonalAccessTokenRequest{
+					Description:    description,
+					Permission:     oas.PersonalAccessTokenRequestPermission(permission),
+					Repositories:   repositories,
+					AllowedCidrs:   allowedCIDRs,
+					ExpirationDate: expirationDate,
+				},
+				oas.CreateTeamRobotTokenParams{
+					Name:  args[0],
+					Robot: args[1],
+				},
+			)
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("new personal access token: " + color.New(color.FgGreen, color.Bold).Sprint(res.Token))
+			fmt.Println("make sure to copy this token immediately! it cannot be retrieved afterwards.")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&description, "description", "", "description of the new personal access token")
+	_ = cmd.MarkFlagRequired("description")
+	cmd.Flags().StringVar(&permission, "permission", "", "permission of the new personal access token, can be 'readOnly', 'readWrite' or 'readWriteDelete'")
+	_ = cmd.MarkFlagRequired("permission")
+	cmd.Flags().StringArrayVar(&repositories, "repository", nil, "restrict the new personal access token to the given repository, formatted as 'namespace/name' and optionally containing glob patterns like 'myteam/*', can be specified multiple times")
+	cmd.Flags().StringArrayVar(&allowedCIDRs, "allow-cidr", nil, "only allow the new personal access token to be used from source IP addresses in the given CIDR range, for example '10.0.0.0/8', can be specified multiple times")
+	cmd.Flags().StringVar(&expirationDateStr, "expirationDate", "", "expiration date of the new personal access token, must be a valid RFC3339 date")
+	_ = cmd.MarkFlagRequired("expirationDate")
+
+	return cmd
+}
+
+func newDeleteTeamRobotTokenCmd(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <team> <robot> <token>",
+		Short: "Delete a personal access token of a robot",
+		Long:  "Delete a personal access token of a robot.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 3 {
+				return errors.New("specify a team name, robot name and personal access token ID")
+			}
+
+			id, err := uuid.Parse(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid ID given: %w", err)
+			}
+
+			err = client.DeleteTeamRobotToken(ctx, oas.DeleteTeamRobotTokenParams{
+				Name:  args[0],
+				Robot: args[1],
+				ID:    id,
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully deleted personal access token")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newRevokeTeamRobotTokenCmd(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke <team> <robot> <token>",
+		Short: "Revoke a personal access token of a robot",
+		Long:  "Revoke a personal access token of a robot, keeping the token and its usage log.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 3 {
+				return errors.New("specify a team name, robot name and personal access token ID")
+			}
+
+			id, err := uuid.Parse(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid ID given: %w", err)
+			}
+
+			_, err = client.RevokeTeamRobotToken(ctx, oas.RevokeTeamRobotTokenParams{
+				Name:  args[0],
+				Robot: args[1],
+				ID:    id,
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully revoked personal access token")
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -45,10 +45,12 @@ func NewRootCmd() (*cobra.Command, error) {
 	cmd.AddCommand(newConfigCmd(credentialStore))
 	cmd.AddCommand(newLoginCmd(credentialStore))
 	cmd.AddCommand(newLogoutCmd(credentialStore))
+	cmd.AddCommand(newNamespaceCmd(client))
 	cmd.AddCommand(newRepositoryCmd(client))
 	cmd.AddCommand(newTokenCmd(client, credentialStore))
 	cmd.AddCommand(newTeamCmd(client))
 	cmd.AddCommand(newUserCmd(client))
+	cmd.AddCommand(newAuditCmd(client))
 
 	return cmd, nil
 }
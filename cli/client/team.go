@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"os"
 	"text/tabwriter"
+	"time"
 )
 
 func newTeamCmd(client *oas.Client) *cobra.Command {
@@ -23,14 +24,18 @@ func newTeamCmd(client *oas.Client) *cobra.Command {
 	cmd.AddCommand(newListTeamsCommand(client))
 	cmd.AddCommand(newGetTeamCommand(client))
 	cmd.AddCommand(newCreateTeamCommand(client))
+	cmd.AddCommand(newUpdateTeamCommand(client))
 	cmd.AddCommand(newDeleteTeamCommand(client))
 
 	cmd.AddCommand(newTeamMemberCmd(client))
+	cmd.AddCommand(newTeamRobotCmd(client))
 
 	return cmd
 }
 
 func newListTeamsCommand(client *oas.Client) *cobra.Command {
+	var flags listFlags
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all your teams",
@@ -38,16 +43,26 @@ func newListTeamsCommand(client *oas.Client) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
-			res, err := client.ListTeams(ctx)
+			res, err := fetchPages(flags.pageFlags, func(cursor oas.OptString) ([]oas.TeamResponse, oas.OptString, error) {
+				params := oas.ListTeamsParams{Cursor: cursor}
+				params.Limit, params.Sort, params.Order, params.Name = flags.params()
+
+				res, err := client.ListTeams(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
+			})
 			if err != nil {
 				fmt.Println(err)
 				return nil
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
-			_, _ = fmt.Fprintln(w, "NAME\tCREATED\tID")
+			_, _ = fmt.Fprintln(w, "NAME\tMAX TOKEN LIFETIME\tCREATED\tID")
 			for _, team := range res {
-				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", team.Name, team.CreatedAt, team.ID)
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", team.Name, formatMaxTokenLifetime(team.MaxTokenLifetime), team.CreatedAt, team.ID)
 			}
 			_ = w.Flush()
 
@@ -55,6 +70,8 @@ func newListTeamsCommand(client *oas.Client) *cobra.Command {
 		},
 	}
 
+	flags.register(cmd, "only list teams whose name contains this value")
+
 	return cmd
 }
 
@@ -79,8 +96,8 @@ func newGetTeamCommand(client *oas.Client) *cobra.Command {
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
-			_, _ = fmt.Fprintln(w, "NAME\tCREATED\tID")
-			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", team.Name, team.CreatedAt, team.ID)
+			_, _ = fmt.Fprintln(w, "NAME\tMAX TOKEN LIFETIME\tCREATED\tID")
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", team.Name, formatMaxTokenLifetime(team.MaxTokenLifetime), team.CreatedAt, team.ID)
 			_ = w.Flush()
 
 			return nil
@@ -117,6 +134,51 @@ func newCreateTeamCommand(client *oas.Client) *cobra.Command {
 	return cmd
 }
 
+func newUpdateTeamCommand(client *oas.Client) *cobra.Command {
+	var maxTokenLifetime time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "update <team>",
+		Short: "Update a team",
+		Long: `Update the maximum lifetime of the personal access tokens of the members and robots of a team. The maximum
+lifetime configured on the server applies as well, so the team can only shorten it.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a team name")
+			}
+
+			_, err := client.UpdateTeam(ctx, &oas.TeamUpdateRequest{
+				MaxTokenLifetime: int(maxTokenLifetime.Seconds()),
+			}, oas.UpdateTeamParams{
+				Name: args[0],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully updated team " + args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&maxTokenLifetime, "max-token-lifetime", 0, "maximum lifetime of the personal access tokens of the members and robots of the team, for example '720h', or '0' to not limit it")
+	_ = cmd.MarkFlagRequired("max-token-lifetime")
+
+	return cmd
+}
+
+// formatMaxTokenLifetime formats the maximum token lifetime of a team, given in seconds, for displaying.
+func formatMaxTokenLifetime(seconds int) string {
+	if seconds == 0 {
+		return "unlimited"
+	}
+
+	return (time.Duration(seconds) * time.Second).String()
+}
+
 func newDeleteTeamCommand(client *oas.Client) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete <team>",
@@ -163,6 +225,8 @@ func newTeamMemberCmd(client *oas.Client) *cobra.Command {
 }
 
 func newListTeamMembersCmd(client *oas.Client) *cobra.Command {
+	var flags listFlags
+
 	cmd := &cobra.Command{
 		Use:   "list <team>",
 		Short: "List all team members for a team",
@@ -174,8 +238,16 @@ func newListTeamMembersCmd(client *oas.Client) *cobra.Command {
 				return errors.New("specify a team name")
 			}
 
-			res, err := client.ListTeamMembers(ctx, oas.ListTeamMembersParams{
-				Name: args[0],
+			res, err := fetchPages(flags.pageFlags, func(cursor oas.OptString) ([]oas.TeamMemberResponse, oas.OptString, error) {
+				params := oas.ListTeamMembersParams{Name: args[0], Cursor: cursor}
+				params.Limit, params.Sort, params.Order, params.Username = flags.params()
+
+				res, err := client.ListTeamMembers(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
 			})
 			if err != nil {
 				fmt.Println(err)
@@ -193,6 +265,8 @@ func newListTeamMembersCmd(client *oas.Client) *cobra.Command {
 		},
 	}
 
+	flags.register(cmd, "only list members whose username contains this value")
+
 	return cmd
 }
 
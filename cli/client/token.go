@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"os"
+	"strings"
 	"text/tabwriter"
 	"time"
 )
@@ -27,11 +28,16 @@ func newTokenCmd(client *oas.Client, credentialStore CredentialStore) *cobra.Com
 	cmd.AddCommand(newGetTokenCommand(client))
 	cmd.AddCommand(newCreateTokenCommand(client, credentialStore))
 	cmd.AddCommand(newDeleteTokenCommand(client))
+	cmd.AddCommand(newRevokeTokenCommand(client))
+	cmd.AddCommand(newRotateTokenCommand(client, credentialStore))
+	cmd.AddCommand(newTokenUsageCommand(client))
 
 	return cmd
 }
 
 func newListTokensCommand(client *oas.Client) *cobra.Command {
+	var flags listFlags
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all your personal access tokens",
@@ -39,16 +45,26 @@ func newListTokensCommand(client *oas.Client) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
-			res, err := client.ListPersonalAccessTokens(ctx)
+			res, err := fetchPages(flags.pageFlags, func(cursor oas.OptString) ([]oas.PersonalAccessTokenResponse, oas.OptString, error) {
+				params := oas.ListPersonalAccessTokensParams{Cursor: cursor}
+				params.Limit, params.Sort, params.Order, params.Name = flags.params()
+
+				res, err := client.ListPersonalAccessTokens(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
+			})
 			if err != nil {
 				fmt.Println(err)
 				return nil
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
-			_, _ = fmt.Fprintln(w, "ID\tDESCRIPTION\tPERMISSION\tEXPIRATION\tCREATED")
+			_, _ = fmt.Fprintln(w, "ID\tDESCRIPTION\tPERMISSION\tREPOSITORIES\tSCOPES\tALLOWED CIDRS\tEXPIRATION\tREVOKED\tCREATED\tLAST USED")
 			for _, token := range res {
-				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", token.ID, token.Description, token.Permission, token.ExpirationDate, token.CreatedAt)
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", token.ID, token.Description, token.Permission, formatTokenRepositories(token.Repositories), formatTokenScopes(token.Scopes), formatTokenAllowedCIDRs(token.AllowedCidrs), token.ExpirationDate, formatTokenRevoked(token.RevokedAt), token.CreatedAt, formatTokenLastUsed(token.LastUsedAt, token.LastUsedFrom))
 			}
 			_ = w.Flush()
 
@@ -56,6 +72,8 @@ func newListTokensCommand(client *oas.Client) *cobra.Command {
 		},
 	}
 
+	flags.register(cmd, "only list tokens whose description contains this value")
+
 	return cmd
 }
 
@@ -85,8 +103,8 @@ func newGetTokenCommand(client *oas.Client) *cobra.Command {
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
-			_, _ = fmt.Fprintln(w, "ID\tDESCRIPTION\tPERMISSION\tEXPIRATION\tCREATED")
-			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", token.ID, token.Description, token.Permission, token.ExpirationDate, token.CreatedAt)
+			_, _ = fmt.Fprintln(w, "ID\tDESCRIPTION\tPERMISSION\tREPOSITORIES\tSCOPES\tALLOWED CIDRS\tEXPIRATION\tREVOKED\tCREATED\tLAST USED")
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", token.ID, token.Description, token.Permission, formatTokenRepositories(token.Repositories), formatTokenScopes(token.Scopes), formatTokenAllowedCIDRs(token.AllowedCidrs), token.ExpirationDate, formatTokenRevoked(token.RevokedAt), token.CreatedAt, formatTokenLastUsed(token.LastUsedAt, token.LastUsedFrom))
 			_ = w.Flush()
 
 			return nil
@@ -100,6 +118,9 @@ func newCreateTokenCommand(client *oas.Client, credentialStore CredentialStore)
 	var (
 		description       string
 		permission        string
+		repositories      []string
+		scopes            []string
+		allowedCIDRs      []string
 		expirationDateStr string
 		login             bool
 	)
@@ -117,6 +138,9 @@ func newCreateTokenCommand(client *oas.Client, credentialStore CredentialStore)
 			res, err := client.CreatePersonalAccessToken(context.Background(), &oas.PersonalAccessTokenRequest{
 				Description:    description,
 				Permission:     oas.PersonalAccessTokenRequestPermission(permission),
+				Repositories:   repositories,
+				Scopes:         parseTokenScopes(scopes),
+				AllowedCidrs:   allowedCIDRs,
 				ExpirationDate: expirationDate,
 			})
 			if err != nil {
@@ -142,6 +166,9 @@ func newCreateTokenCommand(client *oas.Client, credentialStore CredentialStore)
 	_ = cmd.MarkFlagRequired("description")
 	cmd.Flags().StringVar(&permission, "permission", "", "permission of the new personal access token, can be 'readOnly', 'readWrite' or 'readWriteDelete'")
 	_ = cmd.MarkFlagRequired("permission")
+	cmd.Flags().StringArrayVar(&repositories, "repository", nil, "restrict the new personal access token to the given repository, formatted as 'namespace/name' and optionally containing glob patterns like 'myteam/*', can be specified multiple times")
+	cmd.Flags().StringArrayVar(&scopes, "scope", nil, "allow the new personal access token to be used for the API operations covered by the given scope, for example 'repositories:read', can be specified multiple times; without any scopes, the token can only be used to log in to the registry")
+	cmd.Flags().StringArrayVar(&allowedCIDRs, "allow-cidr", nil, "only allow the new personal access token to be used from source IP addresses in the given CIDR range, for example '10.0.0.0/8', can be specified multiple times")
 	cmd.Flags().StringVar(&expirationDateStr, "expirationDate", "", "expiration date of the new personal access token, must be a valid RFC3339 date")
 	_ = cmd.MarkFlagRequired("expirationDate")
 	cmd.Flags().BoolVar(&login, "login", false, "immediately log in using the newly generated token and replace your current credentials")
@@ -149,6 +176,152 @@ func newCreateTokenCommand(client *oas.Client, credentialStore CredentialStore)
 	return cmd
 }
 
+// formatTokenRepositories formats the repositories a personal access token is restricted to for displaying.
+func newTokenUsageCommand(client *oas.Client) *cobra.Command {
+	var (
+		since time.Duration
+		pages pageFlags
+		daily bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "usage <token>",
+		Short: "Show when a personal access token was used",
+		Long:  "Show when and from where a personal access token was used to log in to the registry, from newest to oldest.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a personal access token ID")
+			}
+
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ID given: %w", err)
+			}
+
+			if daily {
+				return printTokenDailyUsage(ctx, client, id)
+			}
+
+			params := oas.GetPersonalAccessTokenUsageParams{
+				ID:    id,
+				Limit: oas.NewOptInt(pages.limit),
+			}
+
+			if since != 0 {
+				params.Since = oas.NewOptDateTime(time.Now().Add(-since))
+			}
+
+			res, err := fetchPages(pages, func(cursor oas.OptString) ([]oas.PersonalAccessTokenUsageResponse, oas.OptString, error) {
+				params.Cursor = cursor
+
+				res, err := client.GetPersonalAccessTokenUsage(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+			_, _ = fmt.Fprintln(w, "TIMESTAMP\tSOURCE IP")
+			for _, e := range res {
+				_, _ = fmt.Fprintf(w, "%s\t%s\n", e.Timestamp, e.SourceIp)
+			}
+			_ = w.Flush()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&since, "since", 0, "only show usage within this duration, for example '24h'")
+	pages.register(cmd)
+	cmd.Flags().BoolVar(&daily, "daily", false, "show the daily usage that has been removed from the usage log by its retention policy")
+
+	return cmd
+}
+
+func printTokenDailyUsage(ctx context.Context, client *oas.Client, id uuid.UUID) error {
+	res, err := client.GetPersonalAccessTokenDailyUsage(ctx, oas.GetPersonalAccessTokenDailyUsageParams{ID: id})
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
+	_, _ = fmt.Fprintln(w, "DAY\tSOURCE IP\tCOUNT")
+	for _, r := range res {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\n", r.Day.Format(time.DateOnly), r.SourceIp, r.Count)
+	}
+	_ = w.Flush()
+
+	return nil
+}
+
+// formatTokenLastUsed formats when and from where a token was last used, or 'never' if it has not been used yet.
+func formatTokenLastUsed(lastUsedAt oas.OptDateTime, lastUsedFrom oas.OptString) string {
+	at, ok := lastUsedAt.Get()
+	if !ok {
+		return "never"
+	}
+
+	return fmt.Sprintf("%s from %s", at, lastUsedFrom.Or("unknown"))
+}
+
+func formatTokenRevoked(revokedAt oas.OptDateTime) string {
+	at, ok := revokedAt.Get()
+	if !ok {
+		return "-"
+	}
+
+	return at.String()
+}
+
+func formatTokenRepositories(repositories []string) string {
+	if len(repositories) == 0 {
+		return "*"
+	}
+
+	return strings.Join(repositories, ",")
+}
+
+// formatTokenAllowedCIDRs formats the CIDR ranges a personal access token can be used from for displaying.
+func formatTokenAllowedCIDRs(cidrs []string) string {
+	if len(cidrs) == 0 {
+		return "*"
+	}
+
+	return strings.Join(cidrs, ",")
+}
+
+func parseTokenScopes(scopes []string) []oas.PersonalAccessTokenRequestScopesItem {
+	parsed := make([]oas.PersonalAccessTokenRequestScopesItem, len(scopes))
+	for i, s := range scopes {
+		parsed[i] = oas.PersonalAccessTokenRequestScopesItem(s)
+	}
+
+	return parsed
+}
+
+// formatTokenScopes formats the scopes of a token, showing "-" for tokens that can only be used for the registry.
+func formatTokenScopes(scopes []oas.PersonalAccessTokenResponseScopesItem) string {
+	if len(scopes) == 0 {
+		return "-"
+	}
+
+	formatted := make([]string, len(scopes))
+	for i, s := range scopes {
+		formatted[i] = string(s)
+	}
+
+	return strings.Join(formatted, ",")
+}
+
 func logInUsingToken(credentialStore CredentialStore, token string) error {
 	host, credentials, err := credentialStore.GetCurrent()
 	if err != nil {
@@ -197,3 +370,94 @@ func newDeleteTokenCommand(client *oas.Client) *cobra.Command {
 
 	return cmd
 }
+
+func newRevokeTokenCommand(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke <token>",
+		Short: "Revoke a personal access token",
+		Long:  "Revoke a personal access token, so that it cannot be used anymore.\nUnlike deleting the token, the token and its usage log are kept, so they can still be inspected afterwards.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a personal access token ID")
+			}
+
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ID given: %w", err)
+			}
+
+			_, err = client.RevokePersonalAccessToken(ctx, oas.RevokePersonalAccessTokenParams{
+				ID: id,
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully revoked personal access token")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newRotateTokenCommand(client *oas.Client, credentialStore CredentialStore) *cobra.Command {
+	var (
+		gracePeriod time.Duration
+		login       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rotate <token>",
+		Short: "Rotate a personal access token",
+		Long: `Generate a new plain-text token for a personal access token, keeping its description, permission, repositories,
+scopes, allowed CIDR ranges and expiration date. The previous plain-text token keeps working until the grace period has passed, so that it
+can be replaced everywhere it is used without interruption.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a personal access token ID")
+			}
+
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid ID given: %w", err)
+			}
+
+			req := &oas.PersonalAccessTokenRotationRequest{}
+			if cmd.Flags().Changed("grace-period") {
+				req.GracePeriod = oas.NewOptInt(int(gracePeriod.Seconds()))
+			}
+
+			res, err := client.RotatePersonalAccessToken(ctx, req, oas.RotatePersonalAccessTokenParams{
+				ID: id,
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			if login {
+				if err := logInUsingToken(credentialStore, res.Token); err != nil {
+					fmt.Println("could not log in using new token: " + err.Error())
+				} else {
+					fmt.Println("logged in using new token!")
+				}
+			}
+
+			fmt.Println("new personal access token: " + color.New(color.FgGreen, color.Bold).Sprint(res.Token))
+			fmt.Println("make sure to copy this token immediately! it cannot be retrieved afterwards.")
+			fmt.Println("the previous token keeps working until " + res.PreviousTokenExpiresAt.Format(time.RFC3339))
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&gracePeriod, "grace-period", 0, "how long the previous token keeps working, for example '1h'; defaults to the grace period configured on the server, which is also the maximum")
+	cmd.Flags().BoolVar(&login, "login", false, "immediately log in using the newly generated token and replace your current credentials")
+
+	return cmd
+}
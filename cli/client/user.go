@@ -25,12 +25,16 @@ func newUserCmd(client *oas.Client) *cobra.Command {
 	cmd.AddCommand(newGetUserCommand(client))
 	cmd.AddCommand(newCreateUserCommand(client))
 	cmd.AddCommand(newDeleteUserCommand(client))
+	cmd.AddCommand(newDisableUserCommand(client))
+	cmd.AddCommand(newEnableUserCommand(client))
 	cmd.AddCommand(newChangeUserPasswordCommand(client))
 
 	return cmd
 }
 
 func newListUsersCommand(client *oas.Client) *cobra.Command {
+	var flags listFlags
+
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all users",
@@ -38,16 +42,26 @@ func newListUsersCommand(client *oas.Client) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
-			res, err := client.ListUsers(ctx)
+			res, err := fetchPages(flags.pageFlags, func(cursor oas.OptString) ([]oas.UserResponse, oas.OptString, error) {
+				params := oas.ListUsersParams{Cursor: cursor}
+				params.Limit, params.Sort, params.Order, params.Name = flags.params()
+
+				res, err := client.ListUsers(ctx, params)
+				if err != nil {
+					return nil, oas.OptString{}, err
+				}
+
+				return res.Response, res.XNextCursor, nil
+			})
 			if err != nil {
 				fmt.Println(err)
 				return nil
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
-			_, _ = fmt.Fprintln(w, "USERNAME\tROLE\tCREATED\tID")
+			_, _ = fmt.Fprintln(w, "USERNAME\tROLE\tDISABLED\tCREATED\tID")
 			for _, user := range res {
-				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", user.Username, user.Role, user.CreatedAt, user.ID)
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\n", user.Username, user.Role, user.Disabled, user.CreatedAt, user.ID)
 			}
 			_ = w.Flush()
 
@@ -55,6 +69,8 @@ func newListUsersCommand(client *oas.Client) *cobra.Command {
 		},
 	}
 
+	flags.register(cmd, "only list users whose username contains this value")
+
 	return cmd
 }
 
@@ -79,8 +95,8 @@ func newGetUserCommand(client *oas.Client) *cobra.Command {
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 10, 1, 5, ' ', 0)
-			_, _ = fmt.Fprintln(w, "USERNAME\tROLE\tCREATED\tID")
-			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", user.Username, user.Role, user.CreatedAt, user.ID)
+			_, _ = fmt.Fprintln(w, "USERNAME\tROLE\tDISABLED\tCREATED\tID")
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\n", user.Username, user.Role, user.Disabled, user.CreatedAt, user.ID)
 			_ = w.Flush()
 
 			return nil
@@ -151,6 +167,62 @@ func newDeleteUserCommand(client *oas.Client) *cobra.Command {
 	return cmd
 }
 
+func newDisableUserCommand(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable <username>",
+		Short: "Disable a user",
+		Long:  "Disable a user. Disabled users cannot authenticate in any way, including using their personal access tokens,\nbut their namespace, repositories and tokens are kept.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a username")
+			}
+
+			_, err := client.UpdateUser(ctx, &oas.UserUpdateRequest{Disabled: true}, oas.UpdateUserParams{
+				Username: args[0],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully disabled user")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newEnableUserCommand(client *oas.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable <username>",
+		Short: "Enable a user",
+		Long:  "Enable a disabled user, allowing them to authenticate again.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(args) != 1 {
+				return errors.New("specify a username")
+			}
+
+			_, err := client.UpdateUser(ctx, &oas.UserUpdateRequest{Disabled: false}, oas.UpdateUserParams{
+				Username: args[0],
+			})
+			if err != nil {
+				fmt.Println(err)
+				return nil
+			}
+
+			fmt.Println("successfully enabled user")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 func newChangeUserPasswordCommand(client *oas.Client) *cobra.Command {
 	var (
 		password      string
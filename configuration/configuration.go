@@ -2,16 +2,28 @@ package configuration
 
 import (
 	"errors"
+	"fmt"
+	"github.com/evanebb/regauth/user"
 	"github.com/spf13/viper"
+	"net"
+	"net/url"
+	"strings"
+	"time"
 )
 
 type Configuration struct {
-	InitialAdmin InitialAdmin
-	Log          Log
-	HTTP         HTTP
-	Database     Database
-	Token        Token
-	Pat          Pat
+	InitialAdmin  InitialAdmin
+	Log           Log
+	HTTP          HTTP
+	Database      Database
+	Token         Token
+	Pat           Pat
+	Notifications Notifications
+	Quota         Quota
+	Audit         Audit
+	OIDC          OIDC
+	LDAP          LDAP
+	Federation    Federation
 }
 
 // SetDefaults sets the defaults for the configuration on a viper.Viper instance.
@@ -21,14 +33,30 @@ func SetDefaults(v *viper.Viper) {
 	v.SetDefault("http.addr", ":8000")
 	v.SetDefault("database.port", 5432)
 	v.SetDefault("pat.prefix", "registry_pat_")
+	v.SetDefault("pat.usagelog.pruneinterval", time.Hour)
+	v.SetDefault("pat.rotation.graceperiod", 24*time.Hour)
+	v.SetDefault("pat.expirywarnings.before", 7*24*time.Hour)
+	v.SetDefault("pat.expirywarnings.interval", time.Hour)
+	v.SetDefault("pat.expirywarnings.smtp.port", 587)
+	v.SetDefault("oidc.scopes", []string{"openid", "profile"})
+	v.SetDefault("oidc.usernameclaim", "preferred_username")
+	v.SetDefault("oidc.roleclaim", "groups")
+	v.SetDefault("ldap.userfilter", "(uid=%s)")
+	v.SetDefault("ldap.usernameattribute", "uid")
+	v.SetDefault("ldap.groupmemberattribute", "member")
+	v.SetDefault("ldap.syncinterval", 15*time.Minute)
 }
 
 func (c Configuration) IsValid() error {
 	errs := newErrorCollection()
 
+	c.HTTP.isValid(errs)
 	c.Database.isValid(errs)
 	c.Token.isValid(errs)
 	c.Pat.isValid(errs)
+	c.Quota.isValid(errs)
+	c.OIDC.isValid(errs)
+	c.LDAP.isValid(errs)
 
 	if errs.HasErrors() {
 		return errs
@@ -51,6 +79,17 @@ type HTTP struct {
 	Addr        string
 	Certificate string
 	Key         string
+	// TrustedProxies are the CIDR ranges of the proxies in front of the server, which are trusted to set the
+	// X-Forwarded-For header to the IP address of the client.
+	TrustedProxies []string
+}
+
+func (c HTTP) isValid(errs *errorCollection) {
+	for i, p := range c.TrustedProxies {
+		if _, _, err := net.ParseCIDR(p); err != nil {
+			errs.Add(fmt.Errorf("invalid http.trustedproxies[%d]: must be a CIDR range, for example '10.0.0.0/8'", i))
+		}
+	}
 }
 
 type Database struct {
@@ -85,6 +124,8 @@ type Token struct {
 	Certificate string
 	Key         string
 	Alg         string
+	// CatalogRoles are the user roles that are allowed to list the catalog of the registry, in addition to admins.
+	CatalogRoles []string
 }
 
 func (c Token) isValid(errs *errorCollection) {
@@ -107,14 +148,293 @@ func (c Token) isValid(errs *errorCollection) {
 	if c.Alg == "" {
 		errs.Add(errors.New("missing token.alg"))
 	}
+
+	for i, r := range c.CatalogRoles {
+		if err := user.Role(r).IsValid(); err != nil {
+			errs.Add(fmt.Errorf("invalid token.catalogroles[%d]: %w", i, err))
+		}
+	}
 }
 
 type Pat struct {
 	Prefix string
+	// MaxLifetime is the maximum time between creating a personal access token and its expiration date. Zero means that
+	// there is no maximum lifetime, although every token still has to expire.
+	MaxLifetime    time.Duration
+	UsageLog       PatUsageLog
+	Rotation       PatRotation
+	ExpiryWarnings PatExpiryWarnings
+}
+
+// PatUsageLog configures the retention of the usage log of personal access tokens. If neither a maximum age nor a
+// maximum number of entries is configured, the usage log is kept indefinitely.
+type PatUsageLog struct {
+	MaxAge             time.Duration
+	MaxEntriesPerToken int
+	// RollUp aggregates removed entries into daily usage per token and source IP.
+	RollUp        bool
+	PruneInterval time.Duration
+}
+
+// PatRotation configures the rotation of personal access tokens.
+type PatRotation struct {
+	// GracePeriod is how long the previous plain-text token of a rotated token keeps working by default, which is also
+	// the maximum grace period that can be requested when rotating a token.
+	GracePeriod time.Duration
+}
+
+// PatExpiryWarnings configures the warnings that are sent when personal access tokens are about to expire. If neither
+// an SMTP server nor a webhook is configured, no warnings are sent.
+type PatExpiryWarnings struct {
+	// Before is how long before the expiration date of a token the warning is sent.
+	Before   time.Duration
+	Interval time.Duration
+	SMTP     PatExpiryWarningsSMTP
+	Webhook  PatExpiryWarningsWebhook
+}
+
+// PatExpiryWarningsSMTP configures sending expiry warnings by email. Since users do not have an email address in
+// regauth, the warnings are sent to a fixed list of recipients.
+type PatExpiryWarningsSMTP struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// PatExpiryWarningsWebhook configures sending expiry warnings as JSON to a webhook.
+type PatExpiryWarningsWebhook struct {
+	URL string
+	// Secret is sent as a bearer token in the Authorization header of each request, if it is configured.
+	Secret string
+}
+
+func (c PatExpiryWarnings) Enabled() bool {
+	return c.SMTP.Host != "" || c.Webhook.URL != ""
 }
 
 func (c Pat) isValid(errs *errorCollection) {
 	if c.Prefix == "" {
 		errs.Add(errors.New("missing pat.prefix"))
 	}
+
+	if c.UsageLog.MaxAge < 0 {
+		errs.Add(errors.New("pat.usagelog.maxage cannot be negative"))
+	}
+
+	if c.UsageLog.MaxEntriesPerToken < 0 {
+		errs.Add(errors.New("pat.usagelog.maxentriespertoken cannot be negative"))
+	}
+
+	if (c.UsageLog.MaxAge > 0 || c.UsageLog.MaxEntriesPerToken > 0) && c.UsageLog.PruneInterval <= 0 {
+		errs.Add(errors.New("pat.usagelog.pruneinterval must be positive"))
+	}
+
+	if c.MaxLifetime < 0 {
+		errs.Add(errors.New("pat.maxlifetime cannot be negative"))
+	}
+
+	if c.Rotation.GracePeriod < 0 {
+		errs.Add(errors.New("pat.rotation.graceperiod cannot be negative"))
+	}
+
+	c.ExpiryWarnings.isValid(errs)
+}
+
+func (c PatExpiryWarnings) isValid(errs *errorCollection) {
+	if !c.Enabled() {
+		return
+	}
+
+	if c.Before <= 0 {
+		errs.Add(errors.New("pat.expirywarnings.before must be positive"))
+	}
+
+	if c.Interval <= 0 {
+		errs.Add(errors.New("pat.expirywarnings.interval must be positive"))
+	}
+
+	if c.SMTP.Host != "" {
+		if c.SMTP.From == "" {
+			errs.Add(errors.New("missing pat.expirywarnings.smtp.from"))
+		}
+
+		if len(c.SMTP.To) == 0 {
+			errs.Add(errors.New("missing pat.expirywarnings.smtp.to"))
+		}
+	}
+
+	if c.Webhook.URL != "" {
+		if u, err := url.Parse(c.Webhook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			errs.Add(errors.New("pat.expirywarnings.webhook.url must be an http or https URL"))
+		}
+	}
+}
+
+// Notifications configures the endpoint that receives notifications from the registry.
+type Notifications struct {
+	// Secret is the bearer token that the registry has to send in the Authorization header of each notification.
+	// If no secret is configured, the notification endpoint is disabled.
+	Secret string
+}
+
+// Quota configures the quotas of the namespaces of users and teams.
+type Quota struct {
+	User NamespaceQuota
+	Team NamespaceQuota
+}
+
+// NamespaceQuota limits the number of repositories in a namespace and the storage they use. A limit of zero means that
+// there is no limit.
+type NamespaceQuota struct {
+	Repositories int
+	// Bytes is the total size of the blobs and manifests pushed to the repositories in a namespace, which is tracked
+	// through the notifications of the registry.
+	Bytes int64
+}
+
+func (c Quota) isValid(errs *errorCollection) {
+	c.User.isValid(errs, "quota.user")
+	c.Team.isValid(errs, "quota.team")
+}
+
+func (c NamespaceQuota) isValid(errs *errorCollection, key string) {
+	if c.Repositories < 0 {
+		errs.Add(errors.New(key + ".repositories cannot be negative"))
+	}
+
+	if c.Bytes < 0 {
+		errs.Add(errors.New(key + ".bytes cannot be negative"))
+	}
+}
+
+// Audit configures the audit log.
+type Audit struct {
+	// ExportFile is the path of a file that every audit event is additionally appended to as a line of JSON. If it is
+	// empty, audit events are only stored in the database.
+	ExportFile string
+}
+
+// OIDC configures single sign-on using an OpenID Connect identity provider.
+type OIDC struct {
+	// Issuer is the URL of the identity provider. If no issuer is configured, OpenID Connect authentication is disabled.
+	Issuer   string
+	ClientID string
+	Scopes   []string
+	// UsernameClaim is the ID token claim that is used as the username.
+	UsernameClaim string
+	// RoleClaim is the ID token claim containing the roles or groups of the user.
+	RoleClaim string
+	// AdminRoles are the values of the RoleClaim that grant the admin role.
+	AdminRoles []string
+}
+
+func (c OIDC) Enabled() bool {
+	return c.Issuer != ""
+}
+
+func (c OIDC) isValid(errs *errorCollection) {
+	if !c.Enabled() {
+		return
+	}
+
+	if c.ClientID == "" {
+		errs.Add(errors.New("missing oidc.clientid"))
+	}
+
+	if c.UsernameClaim == "" {
+		errs.Add(errors.New("missing oidc.usernameclaim"))
+	}
+}
+
+// LDAP configures password authentication against an LDAP directory, and synchronizing LDAP group membership to teams.
+type LDAP struct {
+	// URL of the directory server. If no URL is configured, LDAP authentication is disabled.
+	URL                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	BindDN             string
+	BindPassword       string
+	UserBaseDN         string
+	// UserFilter is the filter used to find a user, in which '%s' is replaced by the username.
+	UserFilter           string
+	UsernameAttribute    string
+	GroupMemberAttribute string
+	// AdminGroup is the DN of the group whose members get the admin role.
+	AdminGroup string
+	// DisableLocalAuthentication only allows LDAP passwords, instead of also allowing local passwords.
+	DisableLocalAuthentication bool
+	SyncInterval               time.Duration
+	Teams                      []LDAPTeam
+}
+
+// LDAPTeam maps the members of an LDAP group to the members of a team.
+type LDAPTeam struct {
+	Group string
+	Team  string
+	Role  string
+}
+
+func (c LDAP) Enabled() bool {
+	return c.URL != ""
+}
+
+func (c LDAP) isValid(errs *errorCollection) {
+	if !c.Enabled() {
+		return
+	}
+
+	if c.UserBaseDN == "" {
+		errs.Add(errors.New("missing ldap.userbasedn"))
+	}
+
+	if strings.Count(c.UserFilter, "%s") != 1 {
+		errs.Add(errors.New("ldap.userfilter must contain '%s' exactly once"))
+	}
+
+	if c.UsernameAttribute == "" {
+		errs.Add(errors.New("missing ldap.usernameattribute"))
+	}
+
+	if c.GroupMemberAttribute == "" {
+		errs.Add(errors.New("missing ldap.groupmemberattribute"))
+	}
+
+	if c.SyncInterval <= 0 {
+		errs.Add(errors.New("ldap.syncinterval must be positive"))
+	}
+
+	for i, t := range c.Teams {
+		if t.Group == "" {
+			errs.Add(fmt.Errorf("missing ldap.teams[%d].group", i))
+		}
+
+		if err := user.TeamName(t.Team).IsValid(); err != nil {
+			errs.Add(fmt.Errorf("invalid ldap.teams[%d].team: %w", i, err))
+		}
+
+		if err := user.TeamMemberRole(t.Role).IsValid(); err != nil {
+			errs.Add(fmt.Errorf("invalid ldap.teams[%d].role: %w", i, err))
+		}
+	}
+}
+
+// Federation configures the trust policies that allow workloads, such as CI jobs, to get registry tokens using a token
+// issued by a trusted identity provider instead of a personal access token.
+type Federation struct {
+	Policies []FederationPolicy
+}
+
+type FederationPolicy struct {
+	Name     string
+	Issuer   string
+	JWKSURL  string
+	Audience string
+	// Claims maps claim names to the patterns that their values must match.
+	Claims       map[string]string
+	Username     string
+	Permission   string
+	Repositories []string
 }
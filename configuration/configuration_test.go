@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"testing"
+	"time"
 )
 
 func TestConfiguration_IsValid(t *testing.T) {
@@ -18,6 +19,235 @@ func TestConfiguration_IsValid(t *testing.T) {
 		}
 	})
 
+	t.Run("invalid OIDC configuration", func(t *testing.T) {
+		t.Parallel()
+
+		conf := &Configuration{
+			Database: Database{
+				Host:     "host",
+				Name:     "name",
+				User:     "user",
+				Password: "password",
+			},
+			Token: Token{
+				Issuer:      "issuer",
+				Service:     "service",
+				Certificate: "certificate",
+				Key:         "key",
+				Alg:         "alg",
+			},
+			Pat: Pat{
+				Prefix: "prefix",
+			},
+			OIDC: OIDC{
+				Issuer: "https://idp.example.com",
+			},
+		}
+
+		expectedMsg := "missing oidc.clientid, missing oidc.usernameclaim"
+		if err := conf.IsValid(); err == nil || err.Error() != expectedMsg {
+			t.Errorf("expected error message to be %q, got %q", expectedMsg, err)
+		}
+	})
+
+	t.Run("invalid LDAP configuration", func(t *testing.T) {
+		t.Parallel()
+
+		conf := &Configuration{
+			Database: Database{
+				Host:     "host",
+				Name:     "name",
+				User:     "user",
+				Password: "password",
+			},
+			Token: Token{
+				Issuer:      "issuer",
+				Service:     "service",
+				Certificate: "certificate",
+				Key:         "key",
+				Alg:         "alg",
+			},
+			Pat: Pat{
+				Prefix: "prefix",
+			},
+			LDAP: LDAP{
+				URL:                  "ldaps://ldap.example.com",
+				UserFilter:           "(uid=foo)",
+				UsernameAttribute:    "uid",
+				GroupMemberAttribute: "member",
+				SyncInterval:         time.Minute,
+				Teams:                []LDAPTeam{{Group: "cn=developers,dc=example,dc=com", Team: "developers", Role: "owner"}},
+			},
+		}
+
+		expectedMsg := "missing ldap.userbasedn, ldap.userfilter must contain '%s' exactly once, invalid ldap.teams[0].role: team member role is not valid, must be one of 'admin', 'user'"
+		if err := conf.IsValid(); err == nil || err.Error() != expectedMsg {
+			t.Errorf("expected error message to be %q, got %q", expectedMsg, err)
+		}
+	})
+
+	t.Run("invalid personal access token usage log and rotation configuration", func(t *testing.T) {
+		t.Parallel()
+
+		conf := &Configuration{
+			Database: Database{
+				Host:     "host",
+				Name:     "name",
+				User:     "user",
+				Password: "password",
+			},
+			Token: Token{
+				Issuer:      "issuer",
+				Service:     "service",
+				Certificate: "certificate",
+				Key:         "key",
+				Alg:         "alg",
+			},
+			Pat: Pat{
+				Prefix: "prefix",
+				UsageLog: PatUsageLog{
+					MaxAge:             24 * time.Hour,
+					MaxEntriesPerToken: -1,
+				},
+				Rotation: PatRotation{
+					GracePeriod: -time.Hour,
+				},
+			},
+		}
+
+		expectedMsg := "pat.usagelog.maxentriespertoken cannot be negative, pat.usagelog.pruneinterval must be positive, pat.rotation.graceperiod cannot be negative"
+		if err := conf.IsValid(); err == nil || err.Error() != expectedMsg {
+			t.Errorf("expected error message to be %q, got %q", expectedMsg, err)
+		}
+	})
+
+	t.Run("invalid personal access token lifetime and expiry warnings configuration", func(t *testing.T) {
+		t.Parallel()
+
+		conf := &Configuration{
+			Database: Database{
+				Host:     "host",
+				Name:     "name",
+				User:     "user",
+				Password: "password",
+			},
+			Token: Token{
+				Issuer:      "issuer",
+				Service:     "service",
+				Certificate: "certificate",
+				Key:         "key",
+				Alg:         "alg",
+			},
+			Pat: Pat{
+				Prefix:      "prefix",
+				MaxLifetime: -time.Hour,
+				ExpiryWarnings: PatExpiryWarnings{
+					Before:   7 * 24 * time.Hour,
+					Interval: time.Hour,
+					SMTP:     PatExpiryWarningsSMTP{Host: "smtp.example.com", Port: 587, From: "regauth@example.com"},
+					Webhook:  PatExpiryWarningsWebhook{URL: "ftp://hooks.example.com"},
+				},
+			},
+		}
+
+		expectedMsg := "pat.maxlifetime cannot be negative, missing pat.expirywarnings.smtp.to, pat.expirywarnings.webhook.url must be an http or https URL"
+		if err := conf.IsValid(); err == nil || err.Error() != expectedMsg {
+			t.Errorf("expected error message to be %q, got %q", expectedMsg, err)
+		}
+	})
+
+	t.Run("invalid catalog roles", func(t *testing.T) {
+		t.Parallel()
+
+		conf := &Configuration{
+			Database: Database{
+				Host:     "host",
+				Name:     "name",
+				User:     "user",
+				Password: "password",
+			},
+			Token: Token{
+				Issuer:       "issuer",
+				Service:      "service",
+				Certificate:  "certificate",
+				Key:          "key",
+				Alg:          "alg",
+				CatalogRoles: []string{"user", "nonexistent"},
+			},
+			Pat: Pat{
+				Prefix: "prefix",
+			},
+		}
+
+		expectedMsg := "invalid token.catalogroles[1]: role is not valid, must be one of 'admin', 'user'"
+		if err := conf.IsValid(); err == nil || err.Error() != expectedMsg {
+			t.Errorf("expected error message to be %q, got %q", expectedMsg, err)
+		}
+	})
+
+	t.Run("invalid trusted proxies", func(t *testing.T) {
+		t.Parallel()
+
+		conf := &Configuration{
+			HTTP: HTTP{
+				TrustedProxies: []string{"10.0.0.0/8", "10.0.0.1"},
+			},
+			Database: Database{
+				Host:     "host",
+				Name:     "name",
+				User:     "user",
+				Password: "password",
+			},
+			Token: Token{
+				Issuer:      "issuer",
+				Service:     "service",
+				Certificate: "certificate",
+				Key:         "key",
+				Alg:         "alg",
+			},
+			Pat: Pat{
+				Prefix: "prefix",
+			},
+		}
+
+		expectedMsg := "invalid http.trustedproxies[1]: must be a CIDR range, for example '10.0.0.0/8'"
+		if err := conf.IsValid(); err == nil || err.Error() != expectedMsg {
+			t.Errorf("expected error message to be %q, got %q", expectedMsg, err)
+		}
+	})
+
+	t.Run("invalid quota configuration", func(t *testing.T) {
+		t.Parallel()
+
+		conf := &Configuration{
+			Database: Database{
+				Host:     "host",
+				Name:     "name",
+				User:     "user",
+				Password: "password",
+			},
+			Token: Token{
+				Issuer:      "issuer",
+				Service:     "service",
+				Certificate: "certificate",
+				Key:         "key",
+				Alg:         "alg",
+			},
+			Pat: Pat{
+				Prefix: "prefix",
+			},
+			Quota: Quota{
+				User: NamespaceQuota{Repositories: -1},
+				Team: NamespaceQuota{Repositories: 100, Bytes: -1},
+			},
+		}
+
+		expectedMsg := "quota.user.repositories cannot be negative, quota.team.bytes cannot be negative"
+		if err := conf.IsValid(); err == nil || err.Error() != expectedMsg {
+			t.Errorf("expected error message to be %q, got %q", expectedMsg, err)
+		}
+	})
+
 	t.Run("valid configuration", func(t *testing.T) {
 		t.Parallel()
 
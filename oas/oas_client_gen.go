@@ -22,16 +22,143 @@ func trimTrailingSlashes(u *url.URL) {
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	AuditInvoker
+	AuthInvoker
+	CatalogInvoker
+	NamespaceInvoker
 	RepositoryInvoker
 	TeamInvoker
 	TokenInvoker
 	UserInvoker
 }
 
+// AuditInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Audit
+type AuditInvoker interface {
+	// ListAuditEvents invokes listAuditEvents operation.
+	//
+	// Returns the events in the audit log matching the given filters, from newest to oldest.
+	// Administrator privileges are required to query the audit log.
+	//
+	// GET /v1/audit
+	ListAuditEvents(ctx context.Context, params ListAuditEventsParams) (*ListAuditEventsOKHeaders, error)
+}
+
+// AuthInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Auth
+type AuthInvoker interface {
+	// GetOIDCConfiguration invokes getOIDCConfiguration operation.
+	//
+	// Returns the settings that clients need to log in through the configured OpenID Connect identity
+	// provider.
+	//
+	// GET /v1/auth/oidc
+	GetOIDCConfiguration(ctx context.Context) (*OIDCConfigurationResponse, error)
+}
+
+// CatalogInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Catalog
+type CatalogInvoker interface {
+	// ListCatalogRepositories invokes listCatalogRepositories operation.
+	//
+	// Lists the names of all repositories that the caller is allowed to pull, in the same format as the
+	// `/v2/_catalog` endpoint of the registry. Unlike the catalog of the registry, which requires the
+	// `registry:catalog:*` scope and contains every repository, this is available to every caller.
+	// Authentication is optional; anonymous callers only get public repositories.
+	// The repositories are sorted by their full name.
+	//
+	// GET /v1/catalog/repositories
+	ListCatalogRepositories(ctx context.Context, params ListCatalogRepositoriesParams) (*CatalogResponseHeaders, error)
+	// SearchCatalog invokes searchCatalog operation.
+	//
+	// Searches all repositories that the caller is allowed to pull: public repositories, repositories in
+	// the namespaces
+	// of the caller, and repositories that the caller or one of their teams is a collaborator on.
+	// Authentication is optional; anonymous callers can only find public repositories.
+	// The README of the repositories is not included.
+	//
+	// GET /v1/catalog
+	SearchCatalog(ctx context.Context, params SearchCatalogParams) (*SearchCatalogOKHeaders, error)
+}
+
+// NamespaceInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Namespace
+type NamespaceInvoker interface {
+	// DeleteNamespaceQuota invokes deleteNamespaceQuota operation.
+	//
+	// Removes the quota that was set for the namespace, so that the default quota for namespaces of
+	// users or teams
+	// applies again.
+	// Only admins can delete the quota of a namespace.
+	//
+	// DELETE /v1/namespaces/{namespace}/quota
+	DeleteNamespaceQuota(ctx context.Context, params DeleteNamespaceQuotaParams) error
+	// GetNamespaceSettings invokes getNamespaceSettings operation.
+	//
+	// Get namespace settings.
+	//
+	// GET /v1/namespaces/{namespace}/settings
+	GetNamespaceSettings(ctx context.Context, params GetNamespaceSettingsParams) (*NamespaceSettings, error)
+	// GetNamespaceUsage invokes getNamespaceUsage operation.
+	//
+	// Gets the number of repositories in the namespace and the storage they use, together with the quota
+	// of the namespace.
+	// Push access is not granted to repositories in a namespace that has reached its storage quota, and
+	// no repositories can be created in a namespace that has reached its repository quota.
+	// Storage is tracked through the notifications sent by the registry, and is an approximation since
+	// the registry only frees storage when it is garbage collected.
+	// Admins can get the usage of every namespace.
+	//
+	// GET /v1/namespaces/{namespace}/usage
+	GetNamespaceUsage(ctx context.Context, params GetNamespaceUsageParams) (*NamespaceUsage, error)
+	// UpdateNamespaceQuota invokes updateNamespaceQuota operation.
+	//
+	// Sets the quota of the namespace, which takes precedence over the default quota for namespaces of
+	// users or teams
+	// from the server configuration. A limit of zero means that there is no limit.
+	// Only admins can update the quota of a namespace.
+	//
+	// PUT /v1/namespaces/{namespace}/quota
+	UpdateNamespaceQuota(ctx context.Context, request *NamespaceQuota, params UpdateNamespaceQuotaParams) (*NamespaceUsage, error)
+	// UpdateNamespaceSettings invokes updateNamespaceSettings operation.
+	//
+	// Updates the settings of a namespace that the current user can create repositories in.
+	// If automatic repository creation is enabled, requesting push access to a repository that does not
+	// exist yet in the
+	// namespace creates it, as long as the caller is allowed to push to the namespace and the personal
+	// access token allows
+	// pushing to the repository. The repository is recorded as created by the user of the token.
+	//
+	// PUT /v1/namespaces/{namespace}/settings
+	UpdateNamespaceSettings(ctx context.Context, request *NamespaceSettings, params UpdateNamespaceSettingsParams) (*NamespaceSettings, error)
+}
+
 // RepositoryInvoker invokes operations described by OpenAPI v3 specification.
 //
 // x-gen-operation-group: Repository
 type RepositoryInvoker interface {
+	// AddRepositoryCollaborator invokes addRepositoryCollaborator operation.
+	//
+	// Grants a user or team access to the repository. If the user or team is already a collaborator,
+	// their permission is updated.
+	//
+	// POST /v1/repositories/{namespace}/{name}/collaborators
+	AddRepositoryCollaborator(ctx context.Context, request *RepositoryCollaboratorRequest, params AddRepositoryCollaboratorParams) (*RepositoryCollaboratorResponse, error)
+	// AddRepositoryProtectedTag invokes addRepositoryProtectedTag operation.
+	//
+	// Protects the tags in the repository matching the given pattern.
+	// Delete access is no longer granted for repositories with protected tags, except to the owner of a
+	// user namespace and the admins of a team namespace.
+	// Overwriting or deleting a protected tag is recorded in the audit log, which requires the registry
+	// to be configured to send notifications to regauth.
+	// Only the owner of a user namespace and the admins of a team namespace can manage protected tags.
+	//
+	// POST /v1/repositories/{namespace}/{name}/protected-tags
+	AddRepositoryProtectedTag(ctx context.Context, request *ProtectedTagRequest, params AddRepositoryProtectedTagParams) (*ProtectedTagResponse, error)
 	// CreateRepository invokes createRepository operation.
 	//
 	// Create repository.
@@ -50,12 +177,64 @@ type RepositoryInvoker interface {
 	//
 	// GET /v1/repositories/{namespace}/{name}
 	GetRepository(ctx context.Context, params GetRepositoryParams) (*RepositoryResponse, error)
+	// GetRepositoryTag invokes getRepositoryTag operation.
+	//
+	// Get repository tag.
+	//
+	// GET /v1/repositories/{namespace}/{name}/tags/{tag}
+	GetRepositoryTag(ctx context.Context, params GetRepositoryTagParams) (*TagResponse, error)
 	// ListRepositories invokes listRepositories operation.
 	//
-	// List repositories.
+	// Lists the repositories in the namespaces of the current user. The README of the repositories is
+	// not included.
 	//
 	// GET /v1/repositories
-	ListRepositories(ctx context.Context) ([]RepositoryResponse, error)
+	ListRepositories(ctx context.Context, params ListRepositoriesParams) (*ListRepositoriesOKHeaders, error)
+	// ListRepositoryCollaborators invokes listRepositoryCollaborators operation.
+	//
+	// Lists the users and teams that have been granted access to the repository outside of their own
+	// namespaces.
+	//
+	// GET /v1/repositories/{namespace}/{name}/collaborators
+	ListRepositoryCollaborators(ctx context.Context, params ListRepositoryCollaboratorsParams) ([]RepositoryCollaboratorResponse, error)
+	// ListRepositoryProtectedTags invokes listRepositoryProtectedTags operation.
+	//
+	// Lists the patterns of the tags that are protected in the repository.
+	//
+	// GET /v1/repositories/{namespace}/{name}/protected-tags
+	ListRepositoryProtectedTags(ctx context.Context, params ListRepositoryProtectedTagsParams) ([]ProtectedTagResponse, error)
+	// ListRepositoryTags invokes listRepositoryTags operation.
+	//
+	// Lists the tags that have been pushed to the repository.
+	// Tags are tracked through the notifications sent by the registry, so this requires the registry to
+	// be configured to send notifications to regauth.
+	//
+	// GET /v1/repositories/{namespace}/{name}/tags
+	ListRepositoryTags(ctx context.Context, params ListRepositoryTagsParams) (*ListRepositoryTagsOKHeaders, error)
+	// RemoveRepositoryCollaborator invokes removeRepositoryCollaborator operation.
+	//
+	// Remove repository collaborator.
+	//
+	// DELETE /v1/repositories/{namespace}/{name}/collaborators/{type}/{collaborator}
+	RemoveRepositoryCollaborator(ctx context.Context, params RemoveRepositoryCollaboratorParams) error
+	// RemoveRepositoryProtectedTag invokes removeRepositoryProtectedTag operation.
+	//
+	// Only the owner of a user namespace and the admins of a team namespace can manage protected tags.
+	//
+	// DELETE /v1/repositories/{namespace}/{name}/protected-tags/{pattern}
+	RemoveRepositoryProtectedTag(ctx context.Context, params RemoveRepositoryProtectedTagParams) error
+	// UpdateRepository invokes updateRepository operation.
+	//
+	// Updates the visibility, description or name of the repository. Only the properties that are given
+	// are changed.
+	// The repository can be moved to another namespace by specifying the namespace property, as long as
+	// the current user
+	// has access to the target namespace. Note that renaming or moving a repository only changes its
+	// metadata; images
+	// that have already been pushed are not moved in the storage of the registry.
+	//
+	// PATCH /v1/repositories/{namespace}/{name}
+	UpdateRepository(ctx context.Context, request *RepositoryUpdateRequest, params UpdateRepositoryParams) (*RepositoryResponse, error)
 }
 
 // TeamInvoker invokes operations described by OpenAPI v3 specification.
@@ -74,36 +253,95 @@ type TeamInvoker interface {
 	//
 	// POST /v1/teams
 	CreateTeam(ctx context.Context, request *TeamRequest) (*TeamResponse, error)
+	// CreateTeamRobot invokes createTeamRobot operation.
+	//
+	// Creates a robot account owned by the team, which can be used by automated processes like pipelines
+	// to access the repositories of the team.
+	// Robots cannot log in using a password and cannot use the API, they can only authenticate to the
+	// registry using their personal access tokens.
+	// Only team admins can manage robots.
+	//
+	// POST /v1/teams/{name}/robots
+	CreateTeamRobot(ctx context.Context, request *TeamRobotRequest, params CreateTeamRobotParams) (*TeamRobotResponse, error)
+	// CreateTeamRobotToken invokes createTeamRobotToken operation.
+	//
+	// Create team robot personal access token.
+	//
+	// POST /v1/teams/{name}/robots/{robot}/tokens
+	CreateTeamRobotToken(ctx context.Context, request *PersonalAccessTokenRequest, params CreateTeamRobotTokenParams) (*PersonalAccessTokenCreationResponse, error)
 	// DeleteTeam invokes deleteTeam operation.
 	//
 	// Delete team.
 	//
 	// DELETE /v1/teams/{name}
 	DeleteTeam(ctx context.Context, params DeleteTeamParams) error
+	// DeleteTeamRobot invokes deleteTeamRobot operation.
+	//
+	// Deletes the robot, including all of its personal access tokens.
+	//
+	// DELETE /v1/teams/{name}/robots/{robot}
+	DeleteTeamRobot(ctx context.Context, params DeleteTeamRobotParams) error
+	// DeleteTeamRobotToken invokes deleteTeamRobotToken operation.
+	//
+	// Delete team robot personal access token.
+	//
+	// DELETE /v1/teams/{name}/robots/{robot}/tokens/{id}
+	DeleteTeamRobotToken(ctx context.Context, params DeleteTeamRobotTokenParams) error
 	// GetTeam invokes getTeam operation.
 	//
 	// Get team.
 	//
 	// GET /v1/teams/{name}
 	GetTeam(ctx context.Context, params GetTeamParams) (*TeamResponse, error)
+	// GetTeamRobot invokes getTeamRobot operation.
+	//
+	// Get team robot.
+	//
+	// GET /v1/teams/{name}/robots/{robot}
+	GetTeamRobot(ctx context.Context, params GetTeamRobotParams) (*TeamRobotResponse, error)
 	// ListTeamMembers invokes listTeamMembers operation.
 	//
 	// List team members.
 	//
 	// GET /v1/teams/{name}/members
-	ListTeamMembers(ctx context.Context, params ListTeamMembersParams) ([]TeamMemberResponse, error)
+	ListTeamMembers(ctx context.Context, params ListTeamMembersParams) (*ListTeamMembersOKHeaders, error)
+	// ListTeamRobotTokens invokes listTeamRobotTokens operation.
+	//
+	// List team robot personal access tokens.
+	//
+	// GET /v1/teams/{name}/robots/{robot}/tokens
+	ListTeamRobotTokens(ctx context.Context, params ListTeamRobotTokensParams) (*ListTeamRobotTokensOKHeaders, error)
+	// ListTeamRobots invokes listTeamRobots operation.
+	//
+	// List team robots.
+	//
+	// GET /v1/teams/{name}/robots
+	ListTeamRobots(ctx context.Context, params ListTeamRobotsParams) (*ListTeamRobotsOKHeaders, error)
 	// ListTeams invokes listTeams operation.
 	//
 	// List teams.
 	//
 	// GET /v1/teams
-	ListTeams(ctx context.Context) ([]TeamResponse, error)
+	ListTeams(ctx context.Context, params ListTeamsParams) (*ListTeamsOKHeaders, error)
 	// RemoveTeamMember invokes removeTeamMember operation.
 	//
 	// Remove team member.
 	//
 	// DELETE /v1/teams/{name}/members/{username}
 	RemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) error
+	// RevokeTeamRobotToken invokes revokeTeamRobotToken operation.
+	//
+	// Revokes the personal access token of the robot, keeping the token and its usage log.
+	//
+	// POST /v1/teams/{name}/robots/{robot}/tokens/{id}/revoke
+	RevokeTeamRobotToken(ctx context.Context, params RevokeTeamRobotTokenParams) (*PersonalAccessTokenResponse, error)
+	// UpdateTeam invokes updateTeam operation.
+	//
+	// Updates the maximum lifetime of the personal access tokens of the members and robots of the team.
+	// Only team admins can update the team.
+	//
+	// PATCH /v1/teams/{name}
+	UpdateTeam(ctx context.Context, request *TeamUpdateRequest, params UpdateTeamParams) (*TeamResponse, error)
 }
 
 // TokenInvoker invokes operations described by OpenAPI v3 specification.
@@ -112,7 +350,11 @@ type TeamInvoker interface {
 type TokenInvoker interface {
 	// CreatePersonalAccessToken invokes createPersonalAccessToken operation.
 	//
-	// Create personal access token.
+	// Creates a new personal access token for the authenticated user.
+	// When authenticating with a personal access token, the new token cannot have more access than the
+	// token used to create it: its scopes, permission, repositories, allowed CIDR ranges and expiration
+	// date are limited to those of the current token.
+	// If the repositories or allowed CIDR ranges are omitted, those of the current token are used.
 	//
 	// POST /v1/tokens
 	CreatePersonalAccessToken(ctx context.Context, request *PersonalAccessTokenRequest) (*PersonalAccessTokenCreationResponse, error)
@@ -128,12 +370,45 @@ type TokenInvoker interface {
 	//
 	// GET /v1/tokens/{id}
 	GetPersonalAccessToken(ctx context.Context, params GetPersonalAccessTokenParams) (*PersonalAccessTokenResponse, error)
+	// GetPersonalAccessTokenDailyUsage invokes getPersonalAccessTokenDailyUsage operation.
+	//
+	// Returns the number of times per day and source IP address that the personal access token was used,
+	// from newest to oldest.
+	// This only includes usage that has been removed from the usage log by its retention policy.
+	//
+	// GET /v1/tokens/{id}/usage/daily
+	GetPersonalAccessTokenDailyUsage(ctx context.Context, params GetPersonalAccessTokenDailyUsageParams) ([]PersonalAccessTokenDailyUsageResponse, error)
+	// GetPersonalAccessTokenUsage invokes getPersonalAccessTokenUsage operation.
+	//
+	// Returns the times that the personal access token was used to log in to the registry, from newest
+	// to oldest.
+	//
+	// GET /v1/tokens/{id}/usage
+	GetPersonalAccessTokenUsage(ctx context.Context, params GetPersonalAccessTokenUsageParams) (*GetPersonalAccessTokenUsageOKHeaders, error)
 	// ListPersonalAccessTokens invokes listPersonalAccessTokens operation.
 	//
 	// List personal access tokens.
 	//
 	// GET /v1/tokens
-	ListPersonalAccessTokens(ctx context.Context) ([]PersonalAccessTokenResponse, error)
+	ListPersonalAccessTokens(ctx context.Context, params ListPersonalAccessTokensParams) (*ListPersonalAccessTokensOKHeaders, error)
+	// RevokePersonalAccessToken invokes revokePersonalAccessToken operation.
+	//
+	// Revokes the personal access token, so that it cannot be used anymore.
+	// Unlike deleting the token, the token and its usage log are kept, so they can still be inspected
+	// afterward.
+	//
+	// POST /v1/tokens/{id}/revoke
+	RevokePersonalAccessToken(ctx context.Context, params RevokePersonalAccessTokenParams) (*PersonalAccessTokenResponse, error)
+	// RotatePersonalAccessToken invokes rotatePersonalAccessToken operation.
+	//
+	// Generates a new plain-text token for the personal access token, keeping its description,
+	// permission, repositories, scopes and expiration date.
+	// The previous plain-text token keeps working until the grace period has passed, so that it can be
+	// replaced everywhere it is used without interruption.
+	// Rotating the token again ends the grace period of the token before it.
+	//
+	// POST /v1/tokens/{id}/rotate
+	RotatePersonalAccessToken(ctx context.Context, request *PersonalAccessTokenRotationRequest, params RotatePersonalAccessTokenParams) (*PersonalAccessTokenRotationResponse, error)
 }
 
 // UserInvoker invokes operations described by OpenAPI v3 specification.
@@ -169,7 +444,15 @@ type UserInvoker interface {
 	// List users.
 	//
 	// GET /v1/users
-	ListUsers(ctx context.Context) ([]UserResponse, error)
+	ListUsers(ctx context.Context, params ListUsersParams) (*ListUsersOKHeaders, error)
+	// UpdateUser invokes updateUser operation.
+	//
+	// Disables or enables the user. Disabled users cannot authenticate in any way, including using their
+	// personal
+	// access tokens, but their namespace, repositories and tokens are kept.
+	//
+	// PATCH /v1/users/{username}
+	UpdateUser(ctx context.Context, request *UserUpdateRequest, params UpdateUserParams) (*UserResponse, error)
 }
 
 // Client implements OAS client.
@@ -221,21 +504,41 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// AddTeamMember invokes addTeamMember operation.
+// AddRepositoryCollaborator invokes addRepositoryCollaborator operation.
 //
-// Add team member.
+// Grants a user or team access to the repository. If the user or team is already a collaborator,
+// their permission is updated.
 //
-// POST /v1/teams/{name}/members
-func (c *Client) AddTeamMember(ctx context.Context, request *TeamMemberRequest, params AddTeamMemberParams) (*TeamMemberResponse, error) {
-	res, err := c.sendAddTeamMember(ctx, request, params)
+// POST /v1/repositories/{namespace}/{name}/collaborators
+func (c *Client) AddRepositoryCollaborator(ctx context.Context, request *RepositoryCollaboratorRequest, params AddRepositoryCollaboratorParams) (*RepositoryCollaboratorResponse, error) {
+	res, err := c.sendAddRepositoryCollaborator(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendAddTeamMember(ctx context.Context, request *TeamMemberRequest, params AddTeamMemberParams) (res *TeamMemberResponse, err error) {
+func (c *Client) sendAddRepositoryCollaborator(ctx context.Context, request *RepositoryCollaboratorRequest, params AddRepositoryCollaboratorParams) (res *RepositoryCollaboratorResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/teams/"
+	var pathParts [5]string
+	pathParts[0] = "/v1/repositories/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
 	{
 		// Encode "name" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -252,16 +555,16 @@ func (c *Client) sendAddTeamMember(ctx context.Context, request *TeamMemberReque
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[1] = encoded
+		pathParts[3] = encoded
 	}
-	pathParts[2] = "/members"
+	pathParts[4] = "/collaborators"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAddTeamMemberRequest(request, r); err != nil {
+	if err := encodeAddRepositoryCollaboratorRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -270,7 +573,7 @@ func (c *Client) sendAddTeamMember(ctx context.Context, request *TeamMemberReque
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, AddTeamMemberOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, AddRepositoryCollaboratorOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -304,7 +607,7 @@ func (c *Client) sendAddTeamMember(ctx context.Context, request *TeamMemberReque
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeAddTeamMemberResponse(resp)
+	result, err := decodeAddRepositoryCollaboratorResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -312,30 +615,35 @@ func (c *Client) sendAddTeamMember(ctx context.Context, request *TeamMemberReque
 	return result, nil
 }
 
-// ChangeUserPassword invokes changeUserPassword operation.
+// AddRepositoryProtectedTag invokes addRepositoryProtectedTag operation.
 //
-// Change password for user.
+// Protects the tags in the repository matching the given pattern.
+// Delete access is no longer granted for repositories with protected tags, except to the owner of a
+// user namespace and the admins of a team namespace.
+// Overwriting or deleting a protected tag is recorded in the audit log, which requires the registry
+// to be configured to send notifications to regauth.
+// Only the owner of a user namespace and the admins of a team namespace can manage protected tags.
 //
-// POST /v1/users/{username}/password
-func (c *Client) ChangeUserPassword(ctx context.Context, request *UserPasswordChangeRequest, params ChangeUserPasswordParams) error {
-	_, err := c.sendChangeUserPassword(ctx, request, params)
-	return err
+// POST /v1/repositories/{namespace}/{name}/protected-tags
+func (c *Client) AddRepositoryProtectedTag(ctx context.Context, request *ProtectedTagRequest, params AddRepositoryProtectedTagParams) (*ProtectedTagResponse, error) {
+	res, err := c.sendAddRepositoryProtectedTag(ctx, request, params)
+	return res, err
 }
 
-func (c *Client) sendChangeUserPassword(ctx context.Context, request *UserPasswordChangeRequest, params ChangeUserPasswordParams) (res *ChangeUserPasswordNoContent, err error) {
+func (c *Client) sendAddRepositoryProtectedTag(ctx context.Context, request *ProtectedTagRequest, params AddRepositoryProtectedTagParams) (res *ProtectedTagResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/users/"
+	var pathParts [5]string
+	pathParts[0] = "/v1/repositories/"
 	{
-		// Encode "username" parameter.
+		// Encode "namespace" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "username",
+			Param:   "namespace",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Username))
+			return e.EncodeValue(conv.StringToString(params.Namespace))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -345,14 +653,33 @@ func (c *Client) sendChangeUserPassword(ctx context.Context, request *UserPasswo
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/password"
+	pathParts[2] = "/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/protected-tags"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeChangeUserPasswordRequest(request, r); err != nil {
+	if err := encodeAddRepositoryProtectedTagRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -361,7 +688,7 @@ func (c *Client) sendChangeUserPassword(ctx context.Context, request *UserPasswo
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, ChangeUserPasswordOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, AddRepositoryProtectedTagOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -395,7 +722,7 @@ func (c *Client) sendChangeUserPassword(ctx context.Context, request *UserPasswo
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeChangeUserPasswordResponse(resp)
+	result, err := decodeAddRepositoryProtectedTagResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -403,28 +730,47 @@ func (c *Client) sendChangeUserPassword(ctx context.Context, request *UserPasswo
 	return result, nil
 }
 
-// CreatePersonalAccessToken invokes createPersonalAccessToken operation.
+// AddTeamMember invokes addTeamMember operation.
 //
-// Create personal access token.
+// Add team member.
 //
-// POST /v1/tokens
-func (c *Client) CreatePersonalAccessToken(ctx context.Context, request *PersonalAccessTokenRequest) (*PersonalAccessTokenCreationResponse, error) {
-	res, err := c.sendCreatePersonalAccessToken(ctx, request)
+// POST /v1/teams/{name}/members
+func (c *Client) AddTeamMember(ctx context.Context, request *TeamMemberRequest, params AddTeamMemberParams) (*TeamMemberResponse, error) {
+	res, err := c.sendAddTeamMember(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreatePersonalAccessToken(ctx context.Context, request *PersonalAccessTokenRequest) (res *PersonalAccessTokenCreationResponse, err error) {
+func (c *Client) sendAddTeamMember(ctx context.Context, request *TeamMemberRequest, params AddTeamMemberParams) (res *TeamMemberResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/tokens"
+	var pathParts [3]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/members"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreatePersonalAccessTokenRequest(request, r); err != nil {
+	if err := encodeAddTeamMemberRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -433,7 +779,7 @@ func (c *Client) sendCreatePersonalAccessToken(ctx context.Context, request *Per
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, CreatePersonalAccessTokenOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, AddTeamMemberOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -442,23 +788,11 @@ func (c *Client) sendCreatePersonalAccessToken(ctx context.Context, request *Per
 				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
 			}
 		}
-		{
-
-			switch err := c.securityUsernamePassword(ctx, CreatePersonalAccessTokenOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 1
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"UsernamePassword\"")
-			}
-		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
-				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -479,7 +813,7 @@ func (c *Client) sendCreatePersonalAccessToken(ctx context.Context, request *Per
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeCreatePersonalAccessTokenResponse(resp)
+	result, err := decodeAddTeamMemberResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -487,28 +821,47 @@ func (c *Client) sendCreatePersonalAccessToken(ctx context.Context, request *Per
 	return result, nil
 }
 
-// CreateRepository invokes createRepository operation.
+// ChangeUserPassword invokes changeUserPassword operation.
 //
-// Create repository.
+// Change password for user.
 //
-// POST /v1/repositories
-func (c *Client) CreateRepository(ctx context.Context, request *RepositoryRequest) (*RepositoryResponse, error) {
-	res, err := c.sendCreateRepository(ctx, request)
-	return res, err
+// POST /v1/users/{username}/password
+func (c *Client) ChangeUserPassword(ctx context.Context, request *UserPasswordChangeRequest, params ChangeUserPasswordParams) error {
+	_, err := c.sendChangeUserPassword(ctx, request, params)
+	return err
 }
 
-func (c *Client) sendCreateRepository(ctx context.Context, request *RepositoryRequest) (res *RepositoryResponse, err error) {
+func (c *Client) sendChangeUserPassword(ctx context.Context, request *UserPasswordChangeRequest, params ChangeUserPasswordParams) (res *ChangeUserPasswordNoContent, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/repositories"
+	var pathParts [3]string
+	pathParts[0] = "/v1/users/"
+	{
+		// Encode "username" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "username",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Username))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/password"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateRepositoryRequest(request, r); err != nil {
+	if err := encodeChangeUserPasswordRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -517,7 +870,7 @@ func (c *Client) sendCreateRepository(ctx context.Context, request *RepositoryRe
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, CreateRepositoryOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, ChangeUserPasswordOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -551,7 +904,7 @@ func (c *Client) sendCreateRepository(ctx context.Context, request *RepositoryRe
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeCreateRepositoryResponse(resp)
+	result, err := decodeChangeUserPasswordResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -559,28 +912,32 @@ func (c *Client) sendCreateRepository(ctx context.Context, request *RepositoryRe
 	return result, nil
 }
 
-// CreateTeam invokes createTeam operation.
+// CreatePersonalAccessToken invokes createPersonalAccessToken operation.
 //
-// Create team.
+// Creates a new personal access token for the authenticated user.
+// When authenticating with a personal access token, the new token cannot have more access than the
+// token used to create it: its scopes, permission, repositories, allowed CIDR ranges and expiration
+// date are limited to those of the current token.
+// If the repositories or allowed CIDR ranges are omitted, those of the current token are used.
 //
-// POST /v1/teams
-func (c *Client) CreateTeam(ctx context.Context, request *TeamRequest) (*TeamResponse, error) {
-	res, err := c.sendCreateTeam(ctx, request)
+// POST /v1/tokens
+func (c *Client) CreatePersonalAccessToken(ctx context.Context, request *PersonalAccessTokenRequest) (*PersonalAccessTokenCreationResponse, error) {
+	res, err := c.sendCreatePersonalAccessToken(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateTeam(ctx context.Context, request *TeamRequest) (res *TeamResponse, err error) {
+func (c *Client) sendCreatePersonalAccessToken(ctx context.Context, request *PersonalAccessTokenRequest) (res *PersonalAccessTokenCreationResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/teams"
+	pathParts[0] = "/v1/tokens"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateTeamRequest(request, r); err != nil {
+	if err := encodeCreatePersonalAccessTokenRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -589,7 +946,7 @@ func (c *Client) sendCreateTeam(ctx context.Context, request *TeamRequest) (res
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, CreateTeamOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, CreatePersonalAccessTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -598,11 +955,23 @@ func (c *Client) sendCreateTeam(ctx context.Context, request *TeamRequest) (res
 				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
 			}
 		}
+		{
+
+			switch err := c.securityUsernamePassword(ctx, CreatePersonalAccessTokenOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"UsernamePassword\"")
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -623,7 +992,7 @@ func (c *Client) sendCreateTeam(ctx context.Context, request *TeamRequest) (res
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeCreateTeamResponse(resp)
+	result, err := decodeCreatePersonalAccessTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -631,28 +1000,28 @@ func (c *Client) sendCreateTeam(ctx context.Context, request *TeamRequest) (res
 	return result, nil
 }
 
-// CreateUser invokes createUser operation.
+// CreateRepository invokes createRepository operation.
 //
-// Create user.
+// Create repository.
 //
-// POST /v1/users
-func (c *Client) CreateUser(ctx context.Context, request *UserRequest) (*UserResponse, error) {
-	res, err := c.sendCreateUser(ctx, request)
+// POST /v1/repositories
+func (c *Client) CreateRepository(ctx context.Context, request *RepositoryRequest) (*RepositoryResponse, error) {
+	res, err := c.sendCreateRepository(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendCreateUser(ctx context.Context, request *UserRequest) (res *UserResponse, err error) {
+func (c *Client) sendCreateRepository(ctx context.Context, request *RepositoryRequest) (res *RepositoryResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/users"
+	pathParts[0] = "/v1/repositories"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateUserRequest(request, r); err != nil {
+	if err := encodeCreateRepositoryRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -661,7 +1030,7 @@ func (c *Client) sendCreateUser(ctx context.Context, request *UserRequest) (res
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, CreateUserOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, CreateRepositoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -695,7 +1064,7 @@ func (c *Client) sendCreateUser(ctx context.Context, request *UserRequest) (res
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeCreateUserResponse(resp)
+	result, err := decodeCreateRepositoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -703,30 +1072,106 @@ func (c *Client) sendCreateUser(ctx context.Context, request *UserRequest) (res
 	return result, nil
 }
 
-// DeletePersonalAccessToken invokes deletePersonalAccessToken operation.
+// CreateTeam invokes createTeam operation.
 //
-// Delete personal access token.
+// Create team.
 //
-// DELETE /v1/tokens/{id}
-func (c *Client) DeletePersonalAccessToken(ctx context.Context, params DeletePersonalAccessTokenParams) error {
-	_, err := c.sendDeletePersonalAccessToken(ctx, params)
-	return err
+// POST /v1/teams
+func (c *Client) CreateTeam(ctx context.Context, request *TeamRequest) (*TeamResponse, error) {
+	res, err := c.sendCreateTeam(ctx, request)
+	return res, err
 }
 
-func (c *Client) sendDeletePersonalAccessToken(ctx context.Context, params DeletePersonalAccessTokenParams) (res *DeletePersonalAccessTokenNoContent, err error) {
+func (c *Client) sendCreateTeam(ctx context.Context, request *TeamRequest) (res *TeamResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/tokens/"
+	var pathParts [1]string
+	pathParts[0] = "/v1/teams"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreateTeamRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
 	{
-		// Encode "id" parameter.
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, CreateTeamOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeCreateTeamResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreateTeamRobot invokes createTeamRobot operation.
+//
+// Creates a robot account owned by the team, which can be used by automated processes like pipelines
+// to access the repositories of the team.
+// Robots cannot log in using a password and cannot use the API, they can only authenticate to the
+// registry using their personal access tokens.
+// Only team admins can manage robots.
+//
+// POST /v1/teams/{name}/robots
+func (c *Client) CreateTeamRobot(ctx context.Context, request *TeamRobotRequest, params CreateTeamRobotParams) (*TeamRobotResponse, error) {
+	res, err := c.sendCreateTeamRobot(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendCreateTeamRobot(ctx context.Context, request *TeamRobotRequest, params CreateTeamRobotParams) (res *TeamRobotResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
+			Param:   "name",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.ID))
+			return e.EncodeValue(conv.StringToString(params.Name))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -736,19 +1181,23 @@ func (c *Client) sendDeletePersonalAccessToken(ctx context.Context, params Delet
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/robots"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateTeamRobotRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, DeletePersonalAccessTokenOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, CreateTeamRobotOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -782,7 +1231,7 @@ func (c *Client) sendDeletePersonalAccessToken(ctx context.Context, params Delet
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDeletePersonalAccessTokenResponse(resp)
+	result, err := decodeCreateTeamRobotResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -790,30 +1239,30 @@ func (c *Client) sendDeletePersonalAccessToken(ctx context.Context, params Delet
 	return result, nil
 }
 
-// DeleteRepository invokes deleteRepository operation.
+// CreateTeamRobotToken invokes createTeamRobotToken operation.
 //
-// Delete repository.
+// Create team robot personal access token.
 //
-// DELETE /v1/repositories/{namespace}/{name}
-func (c *Client) DeleteRepository(ctx context.Context, params DeleteRepositoryParams) error {
-	_, err := c.sendDeleteRepository(ctx, params)
-	return err
+// POST /v1/teams/{name}/robots/{robot}/tokens
+func (c *Client) CreateTeamRobotToken(ctx context.Context, request *PersonalAccessTokenRequest, params CreateTeamRobotTokenParams) (*PersonalAccessTokenCreationResponse, error) {
+	res, err := c.sendCreateTeamRobotToken(ctx, request, params)
+	return res, err
 }
 
-func (c *Client) sendDeleteRepository(ctx context.Context, params DeleteRepositoryParams) (res *DeleteRepositoryNoContent, err error) {
+func (c *Client) sendCreateTeamRobotToken(ctx context.Context, request *PersonalAccessTokenRequest, params CreateTeamRobotTokenParams) (res *PersonalAccessTokenCreationResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/v1/repositories/"
+	var pathParts [5]string
+	pathParts[0] = "/v1/teams/"
 	{
-		// Encode "namespace" parameter.
+		// Encode "name" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "namespace",
+			Param:   "name",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Namespace))
+			return e.EncodeValue(conv.StringToString(params.Name))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -823,16 +1272,16 @@ func (c *Client) sendDeleteRepository(ctx context.Context, params DeleteReposito
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/"
+	pathParts[2] = "/robots/"
 	{
-		// Encode "name" parameter.
+		// Encode "robot" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "name",
+			Param:   "robot",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Name))
+			return e.EncodeValue(conv.StringToString(params.Robot))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -842,19 +1291,23 @@ func (c *Client) sendDeleteRepository(ctx context.Context, params DeleteReposito
 		}
 		pathParts[3] = encoded
 	}
+	pathParts[4] = "/tokens"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateTeamRobotTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, DeleteRepositoryOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, CreateTeamRobotTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -888,7 +1341,7 @@ func (c *Client) sendDeleteRepository(ctx context.Context, params DeleteReposito
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDeleteRepositoryResponse(resp)
+	result, err := decodeCreateTeamRobotTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -896,52 +1349,37 @@ func (c *Client) sendDeleteRepository(ctx context.Context, params DeleteReposito
 	return result, nil
 }
 
-// DeleteTeam invokes deleteTeam operation.
+// CreateUser invokes createUser operation.
 //
-// Delete team.
+// Create user.
 //
-// DELETE /v1/teams/{name}
-func (c *Client) DeleteTeam(ctx context.Context, params DeleteTeamParams) error {
-	_, err := c.sendDeleteTeam(ctx, params)
-	return err
+// POST /v1/users
+func (c *Client) CreateUser(ctx context.Context, request *UserRequest) (*UserResponse, error) {
+	res, err := c.sendCreateUser(ctx, request)
+	return res, err
 }
 
-func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (res *DeleteTeamNoContent, err error) {
+func (c *Client) sendCreateUser(ctx context.Context, request *UserRequest) (res *UserResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/teams/"
-	{
-		// Encode "name" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "name",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Name))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/v1/users"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateUserRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, DeleteTeamOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, CreateUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -975,7 +1413,7 @@ func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (r
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDeleteTeamResponse(resp)
+	result, err := decodeCreateUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -983,30 +1421,33 @@ func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (r
 	return result, nil
 }
 
-// DeleteUser invokes deleteUser operation.
+// DeleteNamespaceQuota invokes deleteNamespaceQuota operation.
 //
-// Delete user.
+// Removes the quota that was set for the namespace, so that the default quota for namespaces of
+// users or teams
+// applies again.
+// Only admins can delete the quota of a namespace.
 //
-// DELETE /v1/users/{username}
-func (c *Client) DeleteUser(ctx context.Context, params DeleteUserParams) error {
-	_, err := c.sendDeleteUser(ctx, params)
+// DELETE /v1/namespaces/{namespace}/quota
+func (c *Client) DeleteNamespaceQuota(ctx context.Context, params DeleteNamespaceQuotaParams) error {
+	_, err := c.sendDeleteNamespaceQuota(ctx, params)
 	return err
 }
 
-func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (res *DeleteUserNoContent, err error) {
+func (c *Client) sendDeleteNamespaceQuota(ctx context.Context, params DeleteNamespaceQuotaParams) (res *DeleteNamespaceQuotaNoContent, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/users/"
+	var pathParts [3]string
+	pathParts[0] = "/v1/namespaces/"
 	{
-		// Encode "username" parameter.
+		// Encode "namespace" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "username",
+			Param:   "namespace",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Username))
+			return e.EncodeValue(conv.StringToString(params.Namespace))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1016,6 +1457,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/quota"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "DELETE", u)
@@ -1028,7 +1470,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, DeleteUserOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, DeleteNamespaceQuotaOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1062,7 +1504,7 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDeleteUserResponse(resp)
+	result, err := decodeDeleteNamespaceQuotaResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1070,17 +1512,17 @@ func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (r
 	return result, nil
 }
 
-// GetPersonalAccessToken invokes getPersonalAccessToken operation.
+// DeletePersonalAccessToken invokes deletePersonalAccessToken operation.
 //
-// Get personal access token.
+// Delete personal access token.
 //
-// GET /v1/tokens/{id}
-func (c *Client) GetPersonalAccessToken(ctx context.Context, params GetPersonalAccessTokenParams) (*PersonalAccessTokenResponse, error) {
-	res, err := c.sendGetPersonalAccessToken(ctx, params)
-	return res, err
+// DELETE /v1/tokens/{id}
+func (c *Client) DeletePersonalAccessToken(ctx context.Context, params DeletePersonalAccessTokenParams) error {
+	_, err := c.sendDeletePersonalAccessToken(ctx, params)
+	return err
 }
 
-func (c *Client) sendGetPersonalAccessToken(ctx context.Context, params GetPersonalAccessTokenParams) (res *PersonalAccessTokenResponse, err error) {
+func (c *Client) sendDeletePersonalAccessToken(ctx context.Context, params DeletePersonalAccessTokenParams) (res *DeletePersonalAccessTokenNoContent, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
@@ -1105,7 +1547,7 @@ func (c *Client) sendGetPersonalAccessToken(ctx context.Context, params GetPerso
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1115,7 +1557,7 @@ func (c *Client) sendGetPersonalAccessToken(ctx context.Context, params GetPerso
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, GetPersonalAccessTokenOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, DeletePersonalAccessTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1149,7 +1591,7 @@ func (c *Client) sendGetPersonalAccessToken(ctx context.Context, params GetPerso
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetPersonalAccessTokenResponse(resp)
+	result, err := decodeDeletePersonalAccessTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1157,17 +1599,17 @@ func (c *Client) sendGetPersonalAccessToken(ctx context.Context, params GetPerso
 	return result, nil
 }
 
-// GetRepository invokes getRepository operation.
+// DeleteRepository invokes deleteRepository operation.
 //
-// Get repository.
+// Delete repository.
 //
-// GET /v1/repositories/{namespace}/{name}
-func (c *Client) GetRepository(ctx context.Context, params GetRepositoryParams) (*RepositoryResponse, error) {
-	res, err := c.sendGetRepository(ctx, params)
-	return res, err
-}
-
-func (c *Client) sendGetRepository(ctx context.Context, params GetRepositoryParams) (res *RepositoryResponse, err error) {
+// DELETE /v1/repositories/{namespace}/{name}
+func (c *Client) DeleteRepository(ctx context.Context, params DeleteRepositoryParams) error {
+	_, err := c.sendDeleteRepository(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeleteRepository(ctx context.Context, params DeleteRepositoryParams) (res *DeleteRepositoryNoContent, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [4]string
@@ -1211,7 +1653,7 @@ func (c *Client) sendGetRepository(ctx context.Context, params GetRepositoryPara
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1221,7 +1663,7 @@ func (c *Client) sendGetRepository(ctx context.Context, params GetRepositoryPara
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, GetRepositoryOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, DeleteRepositoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1255,7 +1697,7 @@ func (c *Client) sendGetRepository(ctx context.Context, params GetRepositoryPara
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetRepositoryResponse(resp)
+	result, err := decodeDeleteRepositoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1263,30 +1705,3939 @@ func (c *Client) sendGetRepository(ctx context.Context, params GetRepositoryPara
 	return result, nil
 }
 
-// GetTeam invokes getTeam operation.
+// DeleteTeam invokes deleteTeam operation.
 //
-// Get team.
+// Delete team.
 //
-// GET /v1/teams/{name}
-func (c *Client) GetTeam(ctx context.Context, params GetTeamParams) (*TeamResponse, error) {
-	res, err := c.sendGetTeam(ctx, params)
-	return res, err
+// DELETE /v1/teams/{name}
+func (c *Client) DeleteTeam(ctx context.Context, params DeleteTeamParams) error {
+	_, err := c.sendDeleteTeam(ctx, params)
+	return err
 }
 
-func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res *TeamResponse, err error) {
+func (c *Client) sendDeleteTeam(ctx context.Context, params DeleteTeamParams) (res *DeleteTeamNoContent, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
 	pathParts[0] = "/v1/teams/"
 	{
-		// Encode "name" parameter.
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, DeleteTeamOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDeleteTeamResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteTeamRobot invokes deleteTeamRobot operation.
+//
+// Deletes the robot, including all of its personal access tokens.
+//
+// DELETE /v1/teams/{name}/robots/{robot}
+func (c *Client) DeleteTeamRobot(ctx context.Context, params DeleteTeamRobotParams) error {
+	_, err := c.sendDeleteTeamRobot(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeleteTeamRobot(ctx context.Context, params DeleteTeamRobotParams) (res *DeleteTeamRobotNoContent, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/robots/"
+	{
+		// Encode "robot" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "robot",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Robot))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, DeleteTeamRobotOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDeleteTeamRobotResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteTeamRobotToken invokes deleteTeamRobotToken operation.
+//
+// Delete team robot personal access token.
+//
+// DELETE /v1/teams/{name}/robots/{robot}/tokens/{id}
+func (c *Client) DeleteTeamRobotToken(ctx context.Context, params DeleteTeamRobotTokenParams) error {
+	_, err := c.sendDeleteTeamRobotToken(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeleteTeamRobotToken(ctx context.Context, params DeleteTeamRobotTokenParams) (res *DeleteTeamRobotTokenNoContent, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [6]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/robots/"
+	{
+		// Encode "robot" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "robot",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Robot))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/tokens/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, DeleteTeamRobotTokenOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDeleteTeamRobotTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteUser invokes deleteUser operation.
+//
+// Delete user.
+//
+// DELETE /v1/users/{username}
+func (c *Client) DeleteUser(ctx context.Context, params DeleteUserParams) error {
+	_, err := c.sendDeleteUser(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeleteUser(ctx context.Context, params DeleteUserParams) (res *DeleteUserNoContent, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/users/"
+	{
+		// Encode "username" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "username",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Username))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, DeleteUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDeleteUserResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetNamespaceSettings invokes getNamespaceSettings operation.
+//
+// Get namespace settings.
+//
+// GET /v1/namespaces/{namespace}/settings
+func (c *Client) GetNamespaceSettings(ctx context.Context, params GetNamespaceSettingsParams) (*NamespaceSettings, error) {
+	res, err := c.sendGetNamespaceSettings(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetNamespaceSettings(ctx context.Context, params GetNamespaceSettingsParams) (res *NamespaceSettings, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/namespaces/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/settings"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, GetNamespaceSettingsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetNamespaceSettingsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetNamespaceUsage invokes getNamespaceUsage operation.
+//
+// Gets the number of repositories in the namespace and the storage they use, together with the quota
+// of the namespace.
+// Push access is not granted to repositories in a namespace that has reached its storage quota, and
+// no repositories can be created in a namespace that has reached its repository quota.
+// Storage is tracked through the notifications sent by the registry, and is an approximation since
+// the registry only frees storage when it is garbage collected.
+// Admins can get the usage of every namespace.
+//
+// GET /v1/namespaces/{namespace}/usage
+func (c *Client) GetNamespaceUsage(ctx context.Context, params GetNamespaceUsageParams) (*NamespaceUsage, error) {
+	res, err := c.sendGetNamespaceUsage(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetNamespaceUsage(ctx context.Context, params GetNamespaceUsageParams) (res *NamespaceUsage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/namespaces/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/usage"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, GetNamespaceUsageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetNamespaceUsageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetOIDCConfiguration invokes getOIDCConfiguration operation.
+//
+// Returns the settings that clients need to log in through the configured OpenID Connect identity
+// provider.
+//
+// GET /v1/auth/oidc
+func (c *Client) GetOIDCConfiguration(ctx context.Context) (*OIDCConfigurationResponse, error) {
+	res, err := c.sendGetOIDCConfiguration(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetOIDCConfiguration(ctx context.Context) (res *OIDCConfigurationResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/oidc"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetOIDCConfigurationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPersonalAccessToken invokes getPersonalAccessToken operation.
+//
+// Get personal access token.
+//
+// GET /v1/tokens/{id}
+func (c *Client) GetPersonalAccessToken(ctx context.Context, params GetPersonalAccessTokenParams) (*PersonalAccessTokenResponse, error) {
+	res, err := c.sendGetPersonalAccessToken(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetPersonalAccessToken(ctx context.Context, params GetPersonalAccessTokenParams) (res *PersonalAccessTokenResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/tokens/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, GetPersonalAccessTokenOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetPersonalAccessTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPersonalAccessTokenDailyUsage invokes getPersonalAccessTokenDailyUsage operation.
+//
+// Returns the number of times per day and source IP address that the personal access token was used,
+// from newest to oldest.
+// This only includes usage that has been removed from the usage log by its retention policy.
+//
+// GET /v1/tokens/{id}/usage/daily
+func (c *Client) GetPersonalAccessTokenDailyUsage(ctx context.Context, params GetPersonalAccessTokenDailyUsageParams) ([]PersonalAccessTokenDailyUsageResponse, error) {
+	res, err := c.sendGetPersonalAccessTokenDailyUsage(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetPersonalAccessTokenDailyUsage(ctx context.Context, params GetPersonalAccessTokenDailyUsageParams) (res []PersonalAccessTokenDailyUsageResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/tokens/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/usage/daily"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, GetPersonalAccessTokenDailyUsageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetPersonalAccessTokenDailyUsageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetPersonalAccessTokenUsage invokes getPersonalAccessTokenUsage operation.
+//
+// Returns the times that the personal access token was used to log in to the registry, from newest
+// to oldest.
+//
+// GET /v1/tokens/{id}/usage
+func (c *Client) GetPersonalAccessTokenUsage(ctx context.Context, params GetPersonalAccessTokenUsageParams) (*GetPersonalAccessTokenUsageOKHeaders, error) {
+	res, err := c.sendGetPersonalAccessTokenUsage(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetPersonalAccessTokenUsage(ctx context.Context, params GetPersonalAccessTokenUsageParams) (res *GetPersonalAccessTokenUsageOKHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/tokens/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/usage"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "until" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Until.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, GetPersonalAccessTokenUsageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetPersonalAccessTokenUsageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetRepository invokes getRepository operation.
+//
+// Get repository.
+//
+// GET /v1/repositories/{namespace}/{name}
+func (c *Client) GetRepository(ctx context.Context, params GetRepositoryParams) (*RepositoryResponse, error) {
+	res, err := c.sendGetRepository(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetRepository(ctx context.Context, params GetRepositoryParams) (res *RepositoryResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/v1/repositories/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, GetRepositoryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetRepositoryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetRepositoryTag invokes getRepositoryTag operation.
+//
+// Get repository tag.
+//
+// GET /v1/repositories/{namespace}/{name}/tags/{tag}
+func (c *Client) GetRepositoryTag(ctx context.Context, params GetRepositoryTagParams) (*TagResponse, error) {
+	res, err := c.sendGetRepositoryTag(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetRepositoryTag(ctx context.Context, params GetRepositoryTagParams) (res *TagResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [6]string
+	pathParts[0] = "/v1/repositories/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/tags/"
+	{
+		// Encode "tag" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "tag",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Tag))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, GetRepositoryTagOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetRepositoryTagResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTeam invokes getTeam operation.
+//
+// Get team.
+//
+// GET /v1/teams/{name}
+func (c *Client) GetTeam(ctx context.Context, params GetTeamParams) (*TeamResponse, error) {
+	res, err := c.sendGetTeam(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res *TeamResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, GetTeamOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetTeamResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetTeamRobot invokes getTeamRobot operation.
+//
+// Get team robot.
+//
+// GET /v1/teams/{name}/robots/{robot}
+func (c *Client) GetTeamRobot(ctx context.Context, params GetTeamRobotParams) (*TeamRobotResponse, error) {
+	res, err := c.sendGetTeamRobot(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetTeamRobot(ctx context.Context, params GetTeamRobotParams) (res *TeamRobotResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/robots/"
+	{
+		// Encode "robot" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "robot",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Robot))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, GetTeamRobotOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetTeamRobotResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetUser invokes getUser operation.
+//
+// Get user.
+//
+// GET /v1/users/{username}
+func (c *Client) GetUser(ctx context.Context, params GetUserParams) (*UserResponse, error) {
+	res, err := c.sendGetUser(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *UserResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/users/"
+	{
+		// Encode "username" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "username",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Username))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, GetUserOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetUserResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListAuditEvents invokes listAuditEvents operation.
+//
+// Returns the events in the audit log matching the given filters, from newest to oldest.
+// Administrator privileges are required to query the audit log.
+//
+// GET /v1/audit
+func (c *Client) ListAuditEvents(ctx context.Context, params ListAuditEventsParams) (*ListAuditEventsOKHeaders, error) {
+	res, err := c.sendListAuditEvents(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListAuditEvents(ctx context.Context, params ListAuditEventsParams) (res *ListAuditEventsOKHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/audit"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "actor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "actor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Actor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "action" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "action",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Action.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "targetType" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "targetType",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.TargetType.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "target" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "target",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Target.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "until" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Until.Get(); ok {
+				return e.EncodeValue(conv.DateTimeToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListAuditEventsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListAuditEventsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCatalogRepositories invokes listCatalogRepositories operation.
+//
+// Lists the names of all repositories that the caller is allowed to pull, in the same format as the
+// `/v2/_catalog` endpoint of the registry. Unlike the catalog of the registry, which requires the
+// `registry:catalog:*` scope and contains every repository, this is available to every caller.
+// Authentication is optional; anonymous callers only get public repositories.
+// The repositories are sorted by their full name.
+//
+// GET /v1/catalog/repositories
+func (c *Client) ListCatalogRepositories(ctx context.Context, params ListCatalogRepositoriesParams) (*CatalogResponseHeaders, error) {
+	res, err := c.sendListCatalogRepositories(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListCatalogRepositories(ctx context.Context, params ListCatalogRepositoriesParams) (res *CatalogResponseHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/catalog/repositories"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "n" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "n",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.N.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "last" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "last",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Last.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListCatalogRepositoriesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListCatalogRepositoriesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListPersonalAccessTokens invokes listPersonalAccessTokens operation.
+//
+// List personal access tokens.
+//
+// GET /v1/tokens
+func (c *Client) ListPersonalAccessTokens(ctx context.Context, params ListPersonalAccessTokensParams) (*ListPersonalAccessTokensOKHeaders, error) {
+	res, err := c.sendListPersonalAccessTokens(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListPersonalAccessTokens(ctx context.Context, params ListPersonalAccessTokensParams) (res *ListPersonalAccessTokensOKHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/tokens"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListPersonalAccessTokensOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListPersonalAccessTokensResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRepositories invokes listRepositories operation.
+//
+// Lists the repositories in the namespaces of the current user. The README of the repositories is
+// not included.
+//
+// GET /v1/repositories
+func (c *Client) ListRepositories(ctx context.Context, params ListRepositoriesParams) (*ListRepositoriesOKHeaders, error) {
+	res, err := c.sendListRepositories(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListRepositories(ctx context.Context, params ListRepositoriesParams) (res *ListRepositoriesOKHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/repositories"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "label" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "label",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Label != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Label {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListRepositoriesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListRepositoriesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRepositoryCollaborators invokes listRepositoryCollaborators operation.
+//
+// Lists the users and teams that have been granted access to the repository outside of their own
+// namespaces.
+//
+// GET /v1/repositories/{namespace}/{name}/collaborators
+func (c *Client) ListRepositoryCollaborators(ctx context.Context, params ListRepositoryCollaboratorsParams) ([]RepositoryCollaboratorResponse, error) {
+	res, err := c.sendListRepositoryCollaborators(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListRepositoryCollaborators(ctx context.Context, params ListRepositoryCollaboratorsParams) (res []RepositoryCollaboratorResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/v1/repositories/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/collaborators"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListRepositoryCollaboratorsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListRepositoryCollaboratorsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRepositoryProtectedTags invokes listRepositoryProtectedTags operation.
+//
+// Lists the patterns of the tags that are protected in the repository.
+//
+// GET /v1/repositories/{namespace}/{name}/protected-tags
+func (c *Client) ListRepositoryProtectedTags(ctx context.Context, params ListRepositoryProtectedTagsParams) ([]ProtectedTagResponse, error) {
+	res, err := c.sendListRepositoryProtectedTags(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListRepositoryProtectedTags(ctx context.Context, params ListRepositoryProtectedTagsParams) (res []ProtectedTagResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/v1/repositories/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/protected-tags"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListRepositoryProtectedTagsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListRepositoryProtectedTagsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListRepositoryTags invokes listRepositoryTags operation.
+//
+// Lists the tags that have been pushed to the repository.
+// Tags are tracked through the notifications sent by the registry, so this requires the registry to
+// be configured to send notifications to regauth.
+//
+// GET /v1/repositories/{namespace}/{name}/tags
+func (c *Client) ListRepositoryTags(ctx context.Context, params ListRepositoryTagsParams) (*ListRepositoryTagsOKHeaders, error) {
+	res, err := c.sendListRepositoryTags(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListRepositoryTags(ctx context.Context, params ListRepositoryTagsParams) (res *ListRepositoryTagsOKHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/v1/repositories/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/tags"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tag" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tag",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tag.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListRepositoryTagsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListRepositoryTagsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTeamMembers invokes listTeamMembers operation.
+//
+// List team members.
+//
+// GET /v1/teams/{name}/members
+func (c *Client) ListTeamMembers(ctx context.Context, params ListTeamMembersParams) (*ListTeamMembersOKHeaders, error) {
+	res, err := c.sendListTeamMembers(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListTeamMembers(ctx context.Context, params ListTeamMembersParams) (res *ListTeamMembersOKHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/members"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "username" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "username",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Username.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListTeamMembersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListTeamMembersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTeamRobotTokens invokes listTeamRobotTokens operation.
+//
+// List team robot personal access tokens.
+//
+// GET /v1/teams/{name}/robots/{robot}/tokens
+func (c *Client) ListTeamRobotTokens(ctx context.Context, params ListTeamRobotTokensParams) (*ListTeamRobotTokensOKHeaders, error) {
+	res, err := c.sendListTeamRobotTokens(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListTeamRobotTokens(ctx context.Context, params ListTeamRobotTokensParams) (res *ListTeamRobotTokensOKHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/robots/"
+	{
+		// Encode "robot" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "robot",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Robot))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/tokens"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "description" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "description",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Description.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListTeamRobotTokensOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListTeamRobotTokensResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTeamRobots invokes listTeamRobots operation.
+//
+// List team robots.
+//
+// GET /v1/teams/{name}/robots
+func (c *Client) ListTeamRobots(ctx context.Context, params ListTeamRobotsParams) (*ListTeamRobotsOKHeaders, error) {
+	res, err := c.sendListTeamRobots(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListTeamRobots(ctx context.Context, params ListTeamRobotsParams) (res *ListTeamRobotsOKHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/robots"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "robot" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "robot",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Robot.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListTeamRobotsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListTeamRobotsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListTeams invokes listTeams operation.
+//
+// List teams.
+//
+// GET /v1/teams
+func (c *Client) ListTeams(ctx context.Context, params ListTeamsParams) (*ListTeamsOKHeaders, error) {
+	res, err := c.sendListTeams(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListTeams(ctx context.Context, params ListTeamsParams) (res *ListTeamsOKHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/teams"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListTeamsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListTeamsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListUsers invokes listUsers operation.
+//
+// List users.
+//
+// GET /v1/users
+func (c *Client) ListUsers(ctx context.Context, params ListUsersParams) (*ListUsersOKHeaders, error) {
+	res, err := c.sendListUsers(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListUsers(ctx context.Context, params ListUsersParams) (res *ListUsersOKHeaders, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, ListUsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListUsersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RemoveRepositoryCollaborator invokes removeRepositoryCollaborator operation.
+//
+// Remove repository collaborator.
+//
+// DELETE /v1/repositories/{namespace}/{name}/collaborators/{type}/{collaborator}
+func (c *Client) RemoveRepositoryCollaborator(ctx context.Context, params RemoveRepositoryCollaboratorParams) error {
+	_, err := c.sendRemoveRepositoryCollaborator(ctx, params)
+	return err
+}
+
+func (c *Client) sendRemoveRepositoryCollaborator(ctx context.Context, params RemoveRepositoryCollaboratorParams) (res *RemoveRepositoryCollaboratorNoContent, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [8]string
+	pathParts[0] = "/v1/repositories/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/collaborators/"
+	{
+		// Encode "type" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "type",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(string(params.Type)))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	pathParts[6] = "/"
+	{
+		// Encode "collaborator" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "collaborator",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Collaborator))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[7] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, RemoveRepositoryCollaboratorOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeRemoveRepositoryCollaboratorResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RemoveRepositoryProtectedTag invokes removeRepositoryProtectedTag operation.
+//
+// Only the owner of a user namespace and the admins of a team namespace can manage protected tags.
+//
+// DELETE /v1/repositories/{namespace}/{name}/protected-tags/{pattern}
+func (c *Client) RemoveRepositoryProtectedTag(ctx context.Context, params RemoveRepositoryProtectedTagParams) error {
+	_, err := c.sendRemoveRepositoryProtectedTag(ctx, params)
+	return err
+}
+
+func (c *Client) sendRemoveRepositoryProtectedTag(ctx context.Context, params RemoveRepositoryProtectedTagParams) (res *RemoveRepositoryProtectedTagNoContent, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [6]string
+	pathParts[0] = "/v1/repositories/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/protected-tags/"
+	{
+		// Encode "pattern" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "pattern",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Pattern))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, RemoveRepositoryProtectedTagOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeRemoveRepositoryProtectedTagResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RemoveTeamMember invokes removeTeamMember operation.
+//
+// Remove team member.
+//
+// DELETE /v1/teams/{name}/members/{username}
+func (c *Client) RemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) error {
+	_, err := c.sendRemoveTeamMember(ctx, params)
+	return err
+}
+
+func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) (res *RemoveTeamMemberNoContent, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/members/"
+	{
+		// Encode "username" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "username",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Username))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, RemoveTeamMemberOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeRemoveTeamMemberResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RevokePersonalAccessToken invokes revokePersonalAccessToken operation.
+//
+// Revokes the personal access token, so that it cannot be used anymore.
+// Unlike deleting the token, the token and its usage log are kept, so they can still be inspected
+// afterward.
+//
+// POST /v1/tokens/{id}/revoke
+func (c *Client) RevokePersonalAccessToken(ctx context.Context, params RevokePersonalAccessTokenParams) (*PersonalAccessTokenResponse, error) {
+	res, err := c.sendRevokePersonalAccessToken(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRevokePersonalAccessToken(ctx context.Context, params RevokePersonalAccessTokenParams) (res *PersonalAccessTokenResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/tokens/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/revoke"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityPersonalAccessToken(ctx, RevokePersonalAccessTokenOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"PersonalAccessToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeRevokePersonalAccessTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RevokeTeamRobotToken invokes revokeTeamRobotToken operation.
+//
+// Revokes the personal access token of the robot, keeping the token and its usage log.
+//
+// POST /v1/teams/{name}/robots/{robot}/tokens/{id}/revoke
+func (c *Client) RevokeTeamRobotToken(ctx context.Context, params RevokeTeamRobotTokenParams) (*PersonalAccessTokenResponse, error) {
+	res, err := c.sendRevokeTeamRobotToken(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendRevokeTeamRobotToken(ctx context.Context, params RevokeTeamRobotTokenParams) (res *PersonalAccessTokenResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [7]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/robots/"
+	{
+		// Encode "robot" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "robot",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Robot))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/tokens/"
+	{
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "name",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Name))
+			return e.EncodeValue(conv.UUIDToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1294,11 +5645,12 @@ func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res *Te
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[1] = encoded
+		pathParts[5] = encoded
 	}
+	pathParts[6] = "/revoke"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1308,7 +5660,7 @@ func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res *Te
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, GetTeamOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, RevokeTeamRobotTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1342,7 +5694,7 @@ func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res *Te
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetTeamResponse(resp)
+	result, err := decodeRevokeTeamRobotTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1350,30 +5702,34 @@ func (c *Client) sendGetTeam(ctx context.Context, params GetTeamParams) (res *Te
 	return result, nil
 }
 
-// GetUser invokes getUser operation.
+// RotatePersonalAccessToken invokes rotatePersonalAccessToken operation.
 //
-// Get user.
+// Generates a new plain-text token for the personal access token, keeping its description,
+// permission, repositories, scopes and expiration date.
+// The previous plain-text token keeps working until the grace period has passed, so that it can be
+// replaced everywhere it is used without interruption.
+// Rotating the token again ends the grace period of the token before it.
 //
-// GET /v1/users/{username}
-func (c *Client) GetUser(ctx context.Context, params GetUserParams) (*UserResponse, error) {
-	res, err := c.sendGetUser(ctx, params)
+// POST /v1/tokens/{id}/rotate
+func (c *Client) RotatePersonalAccessToken(ctx context.Context, request *PersonalAccessTokenRotationRequest, params RotatePersonalAccessTokenParams) (*PersonalAccessTokenRotationResponse, error) {
+	res, err := c.sendRotatePersonalAccessToken(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *UserResponse, err error) {
+func (c *Client) sendRotatePersonalAccessToken(ctx context.Context, request *PersonalAccessTokenRotationRequest, params RotatePersonalAccessTokenParams) (res *PersonalAccessTokenRotationResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/users/"
+	var pathParts [3]string
+	pathParts[0] = "/v1/tokens/"
 	{
-		// Encode "username" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "username",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Username))
+			return e.EncodeValue(conv.UUIDToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1383,19 +5739,23 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/rotate"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeRotatePersonalAccessTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, GetUserOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, RotatePersonalAccessTokenOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1429,7 +5789,7 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetUserResponse(resp)
+	result, err := decodeRotatePersonalAccessTokenResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1437,23 +5797,158 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	return result, nil
 }
 
-// ListPersonalAccessTokens invokes listPersonalAccessTokens operation.
+// SearchCatalog invokes searchCatalog operation.
 //
-// List personal access tokens.
+// Searches all repositories that the caller is allowed to pull: public repositories, repositories in
+// the namespaces
+// of the caller, and repositories that the caller or one of their teams is a collaborator on.
+// Authentication is optional; anonymous callers can only find public repositories.
+// The README of the repositories is not included.
 //
-// GET /v1/tokens
-func (c *Client) ListPersonalAccessTokens(ctx context.Context) ([]PersonalAccessTokenResponse, error) {
-	res, err := c.sendListPersonalAccessTokens(ctx)
+// GET /v1/catalog
+func (c *Client) SearchCatalog(ctx context.Context, params SearchCatalogParams) (*SearchCatalogOKHeaders, error) {
+	res, err := c.sendSearchCatalog(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListPersonalAccessTokens(ctx context.Context) (res []PersonalAccessTokenResponse, err error) {
+func (c *Client) sendSearchCatalog(ctx context.Context, params SearchCatalogParams) (res *SearchCatalogOKHeaders, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/tokens"
+	pathParts[0] = "/v1/catalog"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Q.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "namespace" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "namespace",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Namespace.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "label" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "label",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if params.Label != nil {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range params.Label {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -1464,7 +5959,7 @@ func (c *Client) sendListPersonalAccessTokens(ctx context.Context) (res []Person
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, ListPersonalAccessTokensOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, SearchCatalogOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1478,6 +5973,7 @@ func (c *Client) sendListPersonalAccessTokens(ctx context.Context) (res []Person
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1498,7 +5994,7 @@ func (c *Client) sendListPersonalAccessTokens(ctx context.Context) (res []Person
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeListPersonalAccessTokensResponse(resp)
+	result, err := decodeSearchCatalogResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1506,34 +6002,59 @@ func (c *Client) sendListPersonalAccessTokens(ctx context.Context) (res []Person
 	return result, nil
 }
 
-// ListRepositories invokes listRepositories operation.
+// UpdateNamespaceQuota invokes updateNamespaceQuota operation.
 //
-// List repositories.
+// Sets the quota of the namespace, which takes precedence over the default quota for namespaces of
+// users or teams
+// from the server configuration. A limit of zero means that there is no limit.
+// Only admins can update the quota of a namespace.
 //
-// GET /v1/repositories
-func (c *Client) ListRepositories(ctx context.Context) ([]RepositoryResponse, error) {
-	res, err := c.sendListRepositories(ctx)
+// PUT /v1/namespaces/{namespace}/quota
+func (c *Client) UpdateNamespaceQuota(ctx context.Context, request *NamespaceQuota, params UpdateNamespaceQuotaParams) (*NamespaceUsage, error) {
+	res, err := c.sendUpdateNamespaceQuota(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListRepositories(ctx context.Context) (res []RepositoryResponse, err error) {
+func (c *Client) sendUpdateNamespaceQuota(ctx context.Context, request *NamespaceQuota, params UpdateNamespaceQuotaParams) (res *NamespaceUsage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/repositories"
+	var pathParts [3]string
+	pathParts[0] = "/v1/namespaces/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/quota"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateNamespaceQuotaRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, ListRepositoriesOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, UpdateNamespaceQuotaOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1567,7 +6088,7 @@ func (c *Client) sendListRepositories(ctx context.Context) (res []RepositoryResp
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeListRepositoriesResponse(resp)
+	result, err := decodeUpdateNamespaceQuotaResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1575,30 +6096,35 @@ func (c *Client) sendListRepositories(ctx context.Context) (res []RepositoryResp
 	return result, nil
 }
 
-// ListTeamMembers invokes listTeamMembers operation.
+// UpdateNamespaceSettings invokes updateNamespaceSettings operation.
 //
-// List team members.
+// Updates the settings of a namespace that the current user can create repositories in.
+// If automatic repository creation is enabled, requesting push access to a repository that does not
+// exist yet in the
+// namespace creates it, as long as the caller is allowed to push to the namespace and the personal
+// access token allows
+// pushing to the repository. The repository is recorded as created by the user of the token.
 //
-// GET /v1/teams/{name}/members
-func (c *Client) ListTeamMembers(ctx context.Context, params ListTeamMembersParams) ([]TeamMemberResponse, error) {
-	res, err := c.sendListTeamMembers(ctx, params)
+// PUT /v1/namespaces/{namespace}/settings
+func (c *Client) UpdateNamespaceSettings(ctx context.Context, request *NamespaceSettings, params UpdateNamespaceSettingsParams) (*NamespaceSettings, error) {
+	res, err := c.sendUpdateNamespaceSettings(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListTeamMembers(ctx context.Context, params ListTeamMembersParams) (res []TeamMemberResponse, err error) {
+func (c *Client) sendUpdateNamespaceSettings(ctx context.Context, request *NamespaceSettings, params UpdateNamespaceSettingsParams) (res *NamespaceSettings, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/v1/teams/"
+	pathParts[0] = "/v1/namespaces/"
 	{
-		// Encode "name" parameter.
+		// Encode "namespace" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "name",
+			Param:   "namespace",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Name))
+			return e.EncodeValue(conv.StringToString(params.Namespace))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1608,20 +6134,23 @@ func (c *Client) sendListTeamMembers(ctx context.Context, params ListTeamMembers
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/members"
+	pathParts[2] = "/settings"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateNamespaceSettingsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, ListTeamMembersOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, UpdateNamespaceSettingsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1655,7 +6184,7 @@ func (c *Client) sendListTeamMembers(ctx context.Context, params ListTeamMembers
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeListTeamMembersResponse(resp)
+	result, err := decodeUpdateNamespaceSettingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1663,34 +6192,80 @@ func (c *Client) sendListTeamMembers(ctx context.Context, params ListTeamMembers
 	return result, nil
 }
 
-// ListTeams invokes listTeams operation.
+// UpdateRepository invokes updateRepository operation.
 //
-// List teams.
+// Updates the visibility, description or name of the repository. Only the properties that are given
+// are changed.
+// The repository can be moved to another namespace by specifying the namespace property, as long as
+// the current user
+// has access to the target namespace. Note that renaming or moving a repository only changes its
+// metadata; images
+// that have already been pushed are not moved in the storage of the registry.
 //
-// GET /v1/teams
-func (c *Client) ListTeams(ctx context.Context) ([]TeamResponse, error) {
-	res, err := c.sendListTeams(ctx)
+// PATCH /v1/repositories/{namespace}/{name}
+func (c *Client) UpdateRepository(ctx context.Context, request *RepositoryUpdateRequest, params UpdateRepositoryParams) (*RepositoryResponse, error) {
+	res, err := c.sendUpdateRepository(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListTeams(ctx context.Context) (res []TeamResponse, err error) {
+func (c *Client) sendUpdateRepository(ctx context.Context, request *RepositoryUpdateRequest, params UpdateRepositoryParams) (res *RepositoryResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/teams"
+	var pathParts [4]string
+	pathParts[0] = "/v1/repositories/"
+	{
+		// Encode "namespace" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "namespace",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Namespace))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateRepositoryRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, ListTeamsOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, UpdateRepositoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1724,7 +6299,7 @@ func (c *Client) sendListTeams(ctx context.Context) (res []TeamResponse, err err
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeListTeamsResponse(resp)
+	result, err := decodeUpdateRepositoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1732,34 +6307,56 @@ func (c *Client) sendListTeams(ctx context.Context) (res []TeamResponse, err err
 	return result, nil
 }
 
-// ListUsers invokes listUsers operation.
+// UpdateTeam invokes updateTeam operation.
 //
-// List users.
+// Updates the maximum lifetime of the personal access tokens of the members and robots of the team.
+// Only team admins can update the team.
 //
-// GET /v1/users
-func (c *Client) ListUsers(ctx context.Context) ([]UserResponse, error) {
-	res, err := c.sendListUsers(ctx)
+// PATCH /v1/teams/{name}
+func (c *Client) UpdateTeam(ctx context.Context, request *TeamUpdateRequest, params UpdateTeamParams) (*TeamResponse, error) {
+	res, err := c.sendUpdateTeam(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListUsers(ctx context.Context) (res []UserResponse, err error) {
+func (c *Client) sendUpdateTeam(ctx context.Context, request *TeamUpdateRequest, params UpdateTeamParams) (res *TeamResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/users"
+	var pathParts [2]string
+	pathParts[0] = "/v1/teams/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateTeamRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, ListUsersOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, UpdateTeamOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1793,7 +6390,7 @@ func (c *Client) sendListUsers(ctx context.Context) (res []UserResponse, err err
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeListUsersResponse(resp)
+	result, err := decodeUpdateTeamResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1801,40 +6398,23 @@ func (c *Client) sendListUsers(ctx context.Context) (res []UserResponse, err err
 	return result, nil
 }
 
-// RemoveTeamMember invokes removeTeamMember operation.
+// UpdateUser invokes updateUser operation.
 //
-// Remove team member.
+// Disables or enables the user. Disabled users cannot authenticate in any way, including using their
+// personal
+// access tokens, but their namespace, repositories and tokens are kept.
 //
-// DELETE /v1/teams/{name}/members/{username}
-func (c *Client) RemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) error {
-	_, err := c.sendRemoveTeamMember(ctx, params)
-	return err
+// PATCH /v1/users/{username}
+func (c *Client) UpdateUser(ctx context.Context, request *UserUpdateRequest, params UpdateUserParams) (*UserResponse, error) {
+	res, err := c.sendUpdateUser(ctx, request, params)
+	return res, err
 }
 
-func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) (res *RemoveTeamMemberNoContent, err error) {
+func (c *Client) sendUpdateUser(ctx context.Context, request *UserUpdateRequest, params UpdateUserParams) (res *UserResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
-	pathParts[0] = "/v1/teams/"
-	{
-		// Encode "name" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "name",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Name))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/members/"
+	var pathParts [2]string
+	pathParts[0] = "/v1/users/"
 	{
 		// Encode "username" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1851,21 +6431,24 @@ func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemb
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[3] = encoded
+		pathParts[1] = encoded
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeUpdateUserRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityPersonalAccessToken(ctx, RemoveTeamMemberOperation, r); {
+			switch err := c.securityPersonalAccessToken(ctx, UpdateUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1899,7 +6482,7 @@ func (c *Client) sendRemoveTeamMember(ctx context.Context, params RemoveTeamMemb
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeRemoveTeamMemberResponse(resp)
+	result, err := decodeUpdateUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -25,12 +25,13 @@ func (c *codeRecorder) WriteHeader(status int) {
 
 func recordError(string, error) {}
 
-// handleAddTeamMemberRequest handles addTeamMember operation.
+// handleAddRepositoryCollaboratorRequest handles addRepositoryCollaborator operation.
 //
-// Add team member.
+// Grants a user or team access to the repository. If the user or team is already a collaborator,
+// their permission is updated.
 //
-// POST /v1/teams/{name}/members
-func (s *Server) handleAddTeamMemberRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/repositories/{namespace}/{name}/collaborators
+func (s *Server) handleAddRepositoryCollaboratorRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -38,15 +39,15 @@ func (s *Server) handleAddTeamMemberRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: AddTeamMemberOperation,
-			ID:   "addTeamMember",
+			Name: AddRepositoryCollaboratorOperation,
+			ID:   "addRepositoryCollaborator",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, AddTeamMemberOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, AddRepositoryCollaboratorOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -88,7 +89,7 @@ func (s *Server) handleAddTeamMemberRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeAddTeamMemberParams(args, argsEscaped, r)
+	params, err := decodeAddRepositoryCollaboratorParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -98,7 +99,7 @@ func (s *Server) handleAddTeamMemberRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeAddTeamMemberRequest(r)
+	request, close, err := s.decodeAddRepositoryCollaboratorRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -114,15 +115,19 @@ func (s *Server) handleAddTeamMemberRequest(args [1]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *TeamMemberResponse
+	var response *RepositoryCollaboratorResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    AddTeamMemberOperation,
-			OperationSummary: "Add team member",
-			OperationID:      "addTeamMember",
+			OperationName:    AddRepositoryCollaboratorOperation,
+			OperationSummary: "Add repository collaborator",
+			OperationID:      "addRepositoryCollaborator",
 			Body:             request,
 			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
 				{
 					Name: "name",
 					In:   "path",
@@ -132,9 +137,9 @@ func (s *Server) handleAddTeamMemberRequest(args [1]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = *TeamMemberRequest
-			Params   = AddTeamMemberParams
-			Response = *TeamMemberResponse
+			Request  = *RepositoryCollaboratorRequest
+			Params   = AddRepositoryCollaboratorParams
+			Response = *RepositoryCollaboratorResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -143,14 +148,14 @@ func (s *Server) handleAddTeamMemberRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackAddTeamMemberParams,
+			unpackAddRepositoryCollaboratorParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.AddTeamMember(ctx, request, params)
+				response, err = s.h.AddRepositoryCollaborator(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.AddTeamMember(ctx, request, params)
+		response, err = s.h.AddRepositoryCollaborator(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -169,7 +174,7 @@ func (s *Server) handleAddTeamMemberRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeAddTeamMemberResponse(response, w); err != nil {
+	if err := encodeAddRepositoryCollaboratorResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -178,12 +183,17 @@ func (s *Server) handleAddTeamMemberRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleChangeUserPasswordRequest handles changeUserPassword operation.
+// handleAddRepositoryProtectedTagRequest handles addRepositoryProtectedTag operation.
 //
-// Change password for user.
+// Protects the tags in the repository matching the given pattern.
+// Delete access is no longer granted for repositories with protected tags, except to the owner of a
+// user namespace and the admins of a team namespace.
+// Overwriting or deleting a protected tag is recorded in the audit log, which requires the registry
+// to be configured to send notifications to regauth.
+// Only the owner of a user namespace and the admins of a team namespace can manage protected tags.
 //
-// POST /v1/users/{username}/password
-func (s *Server) handleChangeUserPasswordRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/repositories/{namespace}/{name}/protected-tags
+func (s *Server) handleAddRepositoryProtectedTagRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -191,15 +201,15 @@ func (s *Server) handleChangeUserPasswordRequest(args [1]string, argsEscaped boo
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ChangeUserPasswordOperation,
-			ID:   "changeUserPassword",
+			Name: AddRepositoryProtectedTagOperation,
+			ID:   "addRepositoryProtectedTag",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, ChangeUserPasswordOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, AddRepositoryProtectedTagOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -241,7 +251,7 @@ func (s *Server) handleChangeUserPasswordRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeChangeUserPasswordParams(args, argsEscaped, r)
+	params, err := decodeAddRepositoryProtectedTagParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -251,7 +261,7 @@ func (s *Server) handleChangeUserPasswordRequest(args [1]string, argsEscaped boo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeChangeUserPasswordRequest(r)
+	request, close, err := s.decodeAddRepositoryProtectedTagRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -267,27 +277,31 @@ func (s *Server) handleChangeUserPasswordRequest(args [1]string, argsEscaped boo
 		}
 	}()
 
-	var response *ChangeUserPasswordNoContent
+	var response *ProtectedTagResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ChangeUserPasswordOperation,
-			OperationSummary: "Change password for user",
-			OperationID:      "changeUserPassword",
+			OperationName:    AddRepositoryProtectedTagOperation,
+			OperationSummary: "Add protected tag pattern",
+			OperationID:      "addRepositoryProtectedTag",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "username",
+					Name: "namespace",
 					In:   "path",
-				}: params.Username,
+				}: params.Namespace,
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *UserPasswordChangeRequest
-			Params   = ChangeUserPasswordParams
-			Response = *ChangeUserPasswordNoContent
+			Request  = *ProtectedTagRequest
+			Params   = AddRepositoryProtectedTagParams
+			Response = *ProtectedTagResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -296,14 +310,14 @@ func (s *Server) handleChangeUserPasswordRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackChangeUserPasswordParams,
+			unpackAddRepositoryProtectedTagParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.ChangeUserPassword(ctx, request, params)
+				response, err = s.h.AddRepositoryProtectedTag(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.ChangeUserPassword(ctx, request, params)
+		response, err = s.h.AddRepositoryProtectedTag(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -322,7 +336,7 @@ func (s *Server) handleChangeUserPasswordRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeChangeUserPasswordResponse(response, w); err != nil {
+	if err := encodeAddRepositoryProtectedTagResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -331,12 +345,12 @@ func (s *Server) handleChangeUserPasswordRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleCreatePersonalAccessTokenRequest handles createPersonalAccessToken operation.
+// handleAddTeamMemberRequest handles addTeamMember operation.
 //
-// Create personal access token.
+// Add team member.
 //
-// POST /v1/tokens
-func (s *Server) handleCreatePersonalAccessTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/teams/{name}/members
+func (s *Server) handleAddTeamMemberRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -344,15 +358,15 @@ func (s *Server) handleCreatePersonalAccessTokenRequest(args [0]string, argsEsca
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreatePersonalAccessTokenOperation,
-			ID:   "createPersonalAccessToken",
+			Name: AddTeamMemberOperation,
+			ID:   "addTeamMember",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, CreatePersonalAccessTokenOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, AddTeamMemberOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -369,30 +383,11 @@ func (s *Server) handleCreatePersonalAccessTokenRequest(args [0]string, argsEsca
 				ctx = sctx
 			}
 		}
-		{
-			sctx, ok, err := s.securityUsernamePassword(ctx, CreatePersonalAccessTokenOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "UsernamePassword",
-					Err:              err,
-				}
-				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
-					defer recordError("Security:UsernamePassword", err)
-				}
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 1
-				ctx = sctx
-			}
-		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
-				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -413,7 +408,17 @@ func (s *Server) handleCreatePersonalAccessTokenRequest(args [0]string, argsEsca
 			return
 		}
 	}
-	request, close, err := s.decodeCreatePersonalAccessTokenRequest(r)
+	params, err := decodeAddTeamMemberParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeAddTeamMemberRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -429,22 +434,27 @@ func (s *Server) handleCreatePersonalAccessTokenRequest(args [0]string, argsEsca
 		}
 	}()
 
-	var response *PersonalAccessTokenCreationResponse
+	var response *TeamMemberResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreatePersonalAccessTokenOperation,
-			OperationSummary: "Create personal access token",
-			OperationID:      "createPersonalAccessToken",
+			OperationName:    AddTeamMemberOperation,
+			OperationSummary: "Add team member",
+			OperationID:      "addTeamMember",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *PersonalAccessTokenRequest
-			Params   = struct{}
-			Response = *PersonalAccessTokenCreationResponse
+			Request  = *TeamMemberRequest
+			Params   = AddTeamMemberParams
+			Response = *TeamMemberResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -453,14 +463,14 @@ func (s *Server) handleCreatePersonalAccessTokenRequest(args [0]string, argsEsca
 		](
 			m,
 			mreq,
-			nil,
+			unpackAddTeamMemberParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreatePersonalAccessToken(ctx, request)
+				response, err = s.h.AddTeamMember(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreatePersonalAccessToken(ctx, request)
+		response, err = s.h.AddTeamMember(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -479,7 +489,7 @@ func (s *Server) handleCreatePersonalAccessTokenRequest(args [0]string, argsEsca
 		return
 	}
 
-	if err := encodeCreatePersonalAccessTokenResponse(response, w); err != nil {
+	if err := encodeAddTeamMemberResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -488,12 +498,12 @@ func (s *Server) handleCreatePersonalAccessTokenRequest(args [0]string, argsEsca
 	}
 }
 
-// handleCreateRepositoryRequest handles createRepository operation.
+// handleChangeUserPasswordRequest handles changeUserPassword operation.
 //
-// Create repository.
+// Change password for user.
 //
-// POST /v1/repositories
-func (s *Server) handleCreateRepositoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/users/{username}/password
+func (s *Server) handleChangeUserPasswordRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -501,15 +511,15 @@ func (s *Server) handleCreateRepositoryRequest(args [0]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateRepositoryOperation,
-			ID:   "createRepository",
+			Name: ChangeUserPasswordOperation,
+			ID:   "changeUserPassword",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, CreateRepositoryOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ChangeUserPasswordOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -551,7 +561,17 @@ func (s *Server) handleCreateRepositoryRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	request, close, err := s.decodeCreateRepositoryRequest(r)
+	params, err := decodeChangeUserPasswordParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeChangeUserPasswordRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -567,22 +587,27 @@ func (s *Server) handleCreateRepositoryRequest(args [0]string, argsEscaped bool,
 		}
 	}()
 
-	var response *RepositoryResponse
+	var response *ChangeUserPasswordNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateRepositoryOperation,
-			OperationSummary: "Create repository",
-			OperationID:      "createRepository",
+			OperationName:    ChangeUserPasswordOperation,
+			OperationSummary: "Change password for user",
+			OperationID:      "changeUserPassword",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "username",
+					In:   "path",
+				}: params.Username,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *RepositoryRequest
-			Params   = struct{}
-			Response = *RepositoryResponse
+			Request  = *UserPasswordChangeRequest
+			Params   = ChangeUserPasswordParams
+			Response = *ChangeUserPasswordNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -591,14 +616,14 @@ func (s *Server) handleCreateRepositoryRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackChangeUserPasswordParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateRepository(ctx, request)
+				err = s.h.ChangeUserPassword(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateRepository(ctx, request)
+		err = s.h.ChangeUserPassword(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -617,7 +642,7 @@ func (s *Server) handleCreateRepositoryRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeCreateRepositoryResponse(response, w); err != nil {
+	if err := encodeChangeUserPasswordResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -626,12 +651,16 @@ func (s *Server) handleCreateRepositoryRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleCreateTeamRequest handles createTeam operation.
+// handleCreatePersonalAccessTokenRequest handles createPersonalAccessToken operation.
 //
-// Create team.
+// Creates a new personal access token for the authenticated user.
+// When authenticating with a personal access token, the new token cannot have more access than the
+// token used to create it: its scopes, permission, repositories, allowed CIDR ranges and expiration
+// date are limited to those of the current token.
+// If the repositories or allowed CIDR ranges are omitted, those of the current token are used.
 //
-// POST /v1/teams
-func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/tokens
+func (s *Server) handleCreatePersonalAccessTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -639,15 +668,15 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateTeamOperation,
-			ID:   "createTeam",
+			Name: CreatePersonalAccessTokenOperation,
+			ID:   "createPersonalAccessToken",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, CreateTeamOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, CreatePersonalAccessTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -664,11 +693,30 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityUsernamePassword(ctx, CreatePersonalAccessTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "UsernamePassword",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:UsernamePassword", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -689,7 +737,7 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	request, close, err := s.decodeCreateTeamRequest(r)
+	request, close, err := s.decodeCreatePersonalAccessTokenRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -705,22 +753,22 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response *TeamResponse
+	var response *PersonalAccessTokenCreationResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateTeamOperation,
-			OperationSummary: "Create team",
-			OperationID:      "createTeam",
+			OperationName:    CreatePersonalAccessTokenOperation,
+			OperationSummary: "Create personal access token",
+			OperationID:      "createPersonalAccessToken",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *TeamRequest
+			Request  = *PersonalAccessTokenRequest
 			Params   = struct{}
-			Response = *TeamResponse
+			Response = *PersonalAccessTokenCreationResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -731,12 +779,12 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateTeam(ctx, request)
+				response, err = s.h.CreatePersonalAccessToken(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateTeam(ctx, request)
+		response, err = s.h.CreatePersonalAccessToken(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -755,7 +803,7 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeCreateTeamResponse(response, w); err != nil {
+	if err := encodeCreatePersonalAccessTokenResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -764,12 +812,12 @@ func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleCreateUserRequest handles createUser operation.
+// handleCreateRepositoryRequest handles createRepository operation.
 //
-// Create user.
+// Create repository.
 //
-// POST /v1/users
-func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/repositories
+func (s *Server) handleCreateRepositoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -777,15 +825,15 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateUserOperation,
-			ID:   "createUser",
+			Name: CreateRepositoryOperation,
+			ID:   "createRepository",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, CreateUserOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, CreateRepositoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -827,7 +875,7 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	request, close, err := s.decodeCreateUserRequest(r)
+	request, close, err := s.decodeCreateRepositoryRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -843,22 +891,22 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response *UserResponse
+	var response *RepositoryResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateUserOperation,
-			OperationSummary: "Create user",
-			OperationID:      "createUser",
+			OperationName:    CreateRepositoryOperation,
+			OperationSummary: "Create repository",
+			OperationID:      "createRepository",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *UserRequest
+			Request  = *RepositoryRequest
 			Params   = struct{}
-			Response = *UserResponse
+			Response = *RepositoryResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -869,12 +917,12 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateUser(ctx, request)
+				response, err = s.h.CreateRepository(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateUser(ctx, request)
+		response, err = s.h.CreateRepository(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -893,7 +941,7 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeCreateUserResponse(response, w); err != nil {
+	if err := encodeCreateRepositoryResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -902,12 +950,12 @@ func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDeletePersonalAccessTokenRequest handles deletePersonalAccessToken operation.
+// handleCreateTeamRequest handles createTeam operation.
 //
-// Delete personal access token.
+// Create team.
 //
-// DELETE /v1/tokens/{id}
-func (s *Server) handleDeletePersonalAccessTokenRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/teams
+func (s *Server) handleCreateTeamRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -915,15 +963,15 @@ func (s *Server) handleDeletePersonalAccessTokenRequest(args [1]string, argsEsca
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeletePersonalAccessTokenOperation,
-			ID:   "deletePersonalAccessToken",
+			Name: CreateTeamOperation,
+			ID:   "createTeam",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeletePersonalAccessTokenOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, CreateTeamOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -965,38 +1013,38 @@ func (s *Server) handleDeletePersonalAccessTokenRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeDeletePersonalAccessTokenParams(args, argsEscaped, r)
+	request, close, err := s.decodeCreateTeamRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DeletePersonalAccessTokenNoContent
+	var response *TeamResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeletePersonalAccessTokenOperation,
-			OperationSummary: "Delete personal access token",
-			OperationID:      "deletePersonalAccessToken",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			OperationName:    CreateTeamOperation,
+			OperationSummary: "Create team",
+			OperationID:      "createTeam",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeletePersonalAccessTokenParams
-			Response = *DeletePersonalAccessTokenNoContent
+			Request  = *TeamRequest
+			Params   = struct{}
+			Response = *TeamResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1005,14 +1053,14 @@ func (s *Server) handleDeletePersonalAccessTokenRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackDeletePersonalAccessTokenParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DeletePersonalAccessToken(ctx, params)
+				response, err = s.h.CreateTeam(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DeletePersonalAccessToken(ctx, params)
+		response, err = s.h.CreateTeam(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1031,7 +1079,7 @@ func (s *Server) handleDeletePersonalAccessTokenRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeDeletePersonalAccessTokenResponse(response, w); err != nil {
+	if err := encodeCreateTeamResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1040,12 +1088,16 @@ func (s *Server) handleDeletePersonalAccessTokenRequest(args [1]string, argsEsca
 	}
 }
 
-// handleDeleteRepositoryRequest handles deleteRepository operation.
+// handleCreateTeamRobotRequest handles createTeamRobot operation.
 //
-// Delete repository.
+// Creates a robot account owned by the team, which can be used by automated processes like pipelines
+// to access the repositories of the team.
+// Robots cannot log in using a password and cannot use the API, they can only authenticate to the
+// registry using their personal access tokens.
+// Only team admins can manage robots.
 //
-// DELETE /v1/repositories/{namespace}/{name}
-func (s *Server) handleDeleteRepositoryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/teams/{name}/robots
+func (s *Server) handleCreateTeamRobotRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1053,15 +1105,15 @@ func (s *Server) handleDeleteRepositoryRequest(args [2]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteRepositoryOperation,
-			ID:   "deleteRepository",
+			Name: CreateTeamRobotOperation,
+			ID:   "createTeamRobot",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeleteRepositoryOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, CreateTeamRobotOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1103,7 +1155,7 @@ func (s *Server) handleDeleteRepositoryRequest(args [2]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeDeleteRepositoryParams(args, argsEscaped, r)
+	params, err := decodeCreateTeamRobotParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1113,20 +1165,31 @@ func (s *Server) handleDeleteRepositoryRequest(args [2]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeCreateTeamRobotRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DeleteRepositoryNoContent
+	var response *TeamRobotResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteRepositoryOperation,
-			OperationSummary: "Delete repository",
-			OperationID:      "deleteRepository",
-			Body:             nil,
+			OperationName:    CreateTeamRobotOperation,
+			OperationSummary: "Create team robot",
+			OperationID:      "createTeamRobot",
+			Body:             request,
 			Params: middleware.Parameters{
-				{
-					Name: "namespace",
-					In:   "path",
-				}: params.Namespace,
 				{
 					Name: "name",
 					In:   "path",
@@ -1136,9 +1199,9 @@ func (s *Server) handleDeleteRepositoryRequest(args [2]string, argsEscaped bool,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteRepositoryParams
-			Response = *DeleteRepositoryNoContent
+			Request  = *TeamRobotRequest
+			Params   = CreateTeamRobotParams
+			Response = *TeamRobotResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1147,14 +1210,14 @@ func (s *Server) handleDeleteRepositoryRequest(args [2]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackDeleteRepositoryParams,
+			unpackCreateTeamRobotParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DeleteRepository(ctx, params)
+				response, err = s.h.CreateTeamRobot(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DeleteRepository(ctx, params)
+		response, err = s.h.CreateTeamRobot(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1173,7 +1236,7 @@ func (s *Server) handleDeleteRepositoryRequest(args [2]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeDeleteRepositoryResponse(response, w); err != nil {
+	if err := encodeCreateTeamRobotResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1182,12 +1245,12 @@ func (s *Server) handleDeleteRepositoryRequest(args [2]string, argsEscaped bool,
 	}
 }
 
-// handleDeleteTeamRequest handles deleteTeam operation.
+// handleCreateTeamRobotTokenRequest handles createTeamRobotToken operation.
 //
-// Delete team.
+// Create team robot personal access token.
 //
-// DELETE /v1/teams/{name}
-func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/teams/{name}/robots/{robot}/tokens
+func (s *Server) handleCreateTeamRobotTokenRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1195,15 +1258,15 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteTeamOperation,
-			ID:   "deleteTeam",
+			Name: CreateTeamRobotTokenOperation,
+			ID:   "createTeamRobotToken",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeleteTeamOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, CreateTeamRobotTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1245,7 +1308,7 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeDeleteTeamParams(args, argsEscaped, r)
+	params, err := decodeCreateTeamRobotTokenParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1255,28 +1318,47 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeCreateTeamRobotTokenRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DeleteTeamNoContent
+	var response *PersonalAccessTokenCreationResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteTeamOperation,
-			OperationSummary: "Delete team",
-			OperationID:      "deleteTeam",
-			Body:             nil,
+			OperationName:    CreateTeamRobotTokenOperation,
+			OperationSummary: "Create team robot personal access token",
+			OperationID:      "createTeamRobotToken",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "name",
 					In:   "path",
 				}: params.Name,
+				{
+					Name: "robot",
+					In:   "path",
+				}: params.Robot,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteTeamParams
-			Response = *DeleteTeamNoContent
+			Request  = *PersonalAccessTokenRequest
+			Params   = CreateTeamRobotTokenParams
+			Response = *PersonalAccessTokenCreationResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1285,14 +1367,14 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackDeleteTeamParams,
+			unpackCreateTeamRobotTokenParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DeleteTeam(ctx, params)
+				response, err = s.h.CreateTeamRobotToken(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DeleteTeam(ctx, params)
+		response, err = s.h.CreateTeamRobotToken(ctx, request, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1311,7 +1393,7 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDeleteTeamResponse(response, w); err != nil {
+	if err := encodeCreateTeamRobotTokenResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1320,12 +1402,12 @@ func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDeleteUserRequest handles deleteUser operation.
+// handleCreateUserRequest handles createUser operation.
 //
-// Delete user.
+// Create user.
 //
-// DELETE /v1/users/{username}
-func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/users
+func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1333,15 +1415,15 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteUserOperation,
-			ID:   "deleteUser",
+			Name: CreateUserOperation,
+			ID:   "createUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeleteUserOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, CreateUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1383,38 +1465,38 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeDeleteUserParams(args, argsEscaped, r)
+	request, close, err := s.decodeCreateUserRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *DeleteUserNoContent
+	var response *UserResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteUserOperation,
-			OperationSummary: "Delete user",
-			OperationID:      "deleteUser",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "username",
-					In:   "path",
-				}: params.Username,
-			},
-			Raw: r,
+			OperationName:    CreateUserOperation,
+			OperationSummary: "Create user",
+			OperationID:      "createUser",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteUserParams
-			Response = *DeleteUserNoContent
+			Request  = *UserRequest
+			Params   = struct{}
+			Response = *UserResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1423,14 +1505,14 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackDeleteUserParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DeleteUser(ctx, params)
+				response, err = s.h.CreateUser(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DeleteUser(ctx, params)
+		response, err = s.h.CreateUser(ctx, request)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1449,7 +1531,7 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDeleteUserResponse(response, w); err != nil {
+	if err := encodeCreateUserResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1458,12 +1540,15 @@ func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetPersonalAccessTokenRequest handles getPersonalAccessToken operation.
+// handleDeleteNamespaceQuotaRequest handles deleteNamespaceQuota operation.
 //
-// Get personal access token.
+// Removes the quota that was set for the namespace, so that the default quota for namespaces of
+// users or teams
+// applies again.
+// Only admins can delete the quota of a namespace.
 //
-// GET /v1/tokens/{id}
-func (s *Server) handleGetPersonalAccessTokenRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v1/namespaces/{namespace}/quota
+func (s *Server) handleDeleteNamespaceQuotaRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1471,15 +1556,15 @@ func (s *Server) handleGetPersonalAccessTokenRequest(args [1]string, argsEscaped
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPersonalAccessTokenOperation,
-			ID:   "getPersonalAccessToken",
+			Name: DeleteNamespaceQuotaOperation,
+			ID:   "deleteNamespaceQuota",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetPersonalAccessTokenOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeleteNamespaceQuotaOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1521,7 +1606,7 @@ func (s *Server) handleGetPersonalAccessTokenRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeGetPersonalAccessTokenParams(args, argsEscaped, r)
+	params, err := decodeDeleteNamespaceQuotaParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1532,27 +1617,27 @@ func (s *Server) handleGetPersonalAccessTokenRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response *PersonalAccessTokenResponse
+	var response *DeleteNamespaceQuotaNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPersonalAccessTokenOperation,
-			OperationSummary: "Get personal access token",
-			OperationID:      "getPersonalAccessToken",
+			OperationName:    DeleteNamespaceQuotaOperation,
+			OperationSummary: "Delete namespace quota",
+			OperationID:      "deleteNamespaceQuota",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
+					Name: "namespace",
 					In:   "path",
-				}: params.ID,
+				}: params.Namespace,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPersonalAccessTokenParams
-			Response = *PersonalAccessTokenResponse
+			Params   = DeleteNamespaceQuotaParams
+			Response = *DeleteNamespaceQuotaNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1561,14 +1646,14 @@ func (s *Server) handleGetPersonalAccessTokenRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetPersonalAccessTokenParams,
+			unpackDeleteNamespaceQuotaParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPersonalAccessToken(ctx, params)
+				err = s.h.DeleteNamespaceQuota(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPersonalAccessToken(ctx, params)
+		err = s.h.DeleteNamespaceQuota(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1587,7 +1672,7 @@ func (s *Server) handleGetPersonalAccessTokenRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetPersonalAccessTokenResponse(response, w); err != nil {
+	if err := encodeDeleteNamespaceQuotaResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1596,12 +1681,12 @@ func (s *Server) handleGetPersonalAccessTokenRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetRepositoryRequest handles getRepository operation.
+// handleDeletePersonalAccessTokenRequest handles deletePersonalAccessToken operation.
 //
-// Get repository.
+// Delete personal access token.
 //
-// GET /v1/repositories/{namespace}/{name}
-func (s *Server) handleGetRepositoryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v1/tokens/{id}
+func (s *Server) handleDeletePersonalAccessTokenRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1609,15 +1694,15 @@ func (s *Server) handleGetRepositoryRequest(args [2]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetRepositoryOperation,
-			ID:   "getRepository",
+			Name: DeletePersonalAccessTokenOperation,
+			ID:   "deletePersonalAccessToken",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetRepositoryOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeletePersonalAccessTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1659,7 +1744,7 @@ func (s *Server) handleGetRepositoryRequest(args [2]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetRepositoryParams(args, argsEscaped, r)
+	params, err := decodeDeletePersonalAccessTokenParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1670,31 +1755,27 @@ func (s *Server) handleGetRepositoryRequest(args [2]string, argsEscaped bool, w
 		return
 	}
 
-	var response *RepositoryResponse
+	var response *DeletePersonalAccessTokenNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetRepositoryOperation,
-			OperationSummary: "Get repository",
-			OperationID:      "getRepository",
+			OperationName:    DeletePersonalAccessTokenOperation,
+			OperationSummary: "Delete personal access token",
+			OperationID:      "deletePersonalAccessToken",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "namespace",
-					In:   "path",
-				}: params.Namespace,
-				{
-					Name: "name",
+					Name: "id",
 					In:   "path",
-				}: params.Name,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetRepositoryParams
-			Response = *RepositoryResponse
+			Params   = DeletePersonalAccessTokenParams
+			Response = *DeletePersonalAccessTokenNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1703,14 +1784,14 @@ func (s *Server) handleGetRepositoryRequest(args [2]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetRepositoryParams,
+			unpackDeletePersonalAccessTokenParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetRepository(ctx, params)
+				err = s.h.DeletePersonalAccessToken(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetRepository(ctx, params)
+		err = s.h.DeletePersonalAccessToken(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1729,7 +1810,7 @@ func (s *Server) handleGetRepositoryRequest(args [2]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetRepositoryResponse(response, w); err != nil {
+	if err := encodeDeletePersonalAccessTokenResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1738,12 +1819,12 @@ func (s *Server) handleGetRepositoryRequest(args [2]string, argsEscaped bool, w
 	}
 }
 
-// handleGetTeamRequest handles getTeam operation.
+// handleDeleteRepositoryRequest handles deleteRepository operation.
 //
-// Get team.
+// Delete repository.
 //
-// GET /v1/teams/{name}
-func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v1/repositories/{namespace}/{name}
+func (s *Server) handleDeleteRepositoryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1751,15 +1832,15 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTeamOperation,
-			ID:   "getTeam",
+			Name: DeleteRepositoryOperation,
+			ID:   "deleteRepository",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetTeamOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeleteRepositoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1801,7 +1882,7 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	params, err := decodeGetTeamParams(args, argsEscaped, r)
+	params, err := decodeDeleteRepositoryParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1812,15 +1893,19 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	var response *TeamResponse
+	var response *DeleteRepositoryNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTeamOperation,
-			OperationSummary: "Get team",
-			OperationID:      "getTeam",
+			OperationName:    DeleteRepositoryOperation,
+			OperationSummary: "Delete repository",
+			OperationID:      "deleteRepository",
 			Body:             nil,
 			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
 				{
 					Name: "name",
 					In:   "path",
@@ -1831,8 +1916,8 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 
 		type (
 			Request  = struct{}
-			Params   = GetTeamParams
-			Response = *TeamResponse
+			Params   = DeleteRepositoryParams
+			Response = *DeleteRepositoryNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1841,14 +1926,14 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetTeamParams,
+			unpackDeleteRepositoryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTeam(ctx, params)
+				err = s.h.DeleteRepository(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTeam(ctx, params)
+		err = s.h.DeleteRepository(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -1867,7 +1952,7 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetTeamResponse(response, w); err != nil {
+	if err := encodeDeleteRepositoryResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1876,12 +1961,12 @@ func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetUserRequest handles getUser operation.
+// handleDeleteTeamRequest handles deleteTeam operation.
 //
-// Get user.
+// Delete team.
 //
-// GET /v1/users/{username}
-func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v1/teams/{name}
+func (s *Server) handleDeleteTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1889,15 +1974,15 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserOperation,
-			ID:   "getUser",
+			Name: DeleteTeamOperation,
+			ID:   "deleteTeam",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetUserOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeleteTeamOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1939,7 +2024,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	params, err := decodeGetUserParams(args, argsEscaped, r)
+	params, err := decodeDeleteTeamParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1950,27 +2035,27 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	var response *UserResponse
+	var response *DeleteTeamNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserOperation,
-			OperationSummary: "Get user",
-			OperationID:      "getUser",
+			OperationName:    DeleteTeamOperation,
+			OperationSummary: "Delete team",
+			OperationID:      "deleteTeam",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "username",
+					Name: "name",
 					In:   "path",
-				}: params.Username,
+				}: params.Name,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetUserParams
-			Response = *UserResponse
+			Params   = DeleteTeamParams
+			Response = *DeleteTeamNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1979,14 +2064,14 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetUserParams,
+			unpackDeleteTeamParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUser(ctx, params)
+				err = s.h.DeleteTeam(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUser(ctx, params)
+		err = s.h.DeleteTeam(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2005,7 +2090,7 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetUserResponse(response, w); err != nil {
+	if err := encodeDeleteTeamResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2014,12 +2099,12 @@ func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleListPersonalAccessTokensRequest handles listPersonalAccessTokens operation.
+// handleDeleteTeamRobotRequest handles deleteTeamRobot operation.
 //
-// List personal access tokens.
+// Deletes the robot, including all of its personal access tokens.
 //
-// GET /v1/tokens
-func (s *Server) handleListPersonalAccessTokensRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v1/teams/{name}/robots/{robot}
+func (s *Server) handleDeleteTeamRobotRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2027,15 +2112,15 @@ func (s *Server) handleListPersonalAccessTokensRequest(args [0]string, argsEscap
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListPersonalAccessTokensOperation,
-			ID:   "listPersonalAccessTokens",
+			Name: DeleteTeamRobotOperation,
+			ID:   "deleteTeamRobot",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListPersonalAccessTokensOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeleteTeamRobotOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2077,23 +2162,42 @@ func (s *Server) handleListPersonalAccessTokensRequest(args [0]string, argsEscap
 			return
 		}
 	}
+	params, err := decodeDeleteTeamRobotParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response []PersonalAccessTokenResponse
+	var response *DeleteTeamRobotNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListPersonalAccessTokensOperation,
-			OperationSummary: "List personal access tokens",
-			OperationID:      "listPersonalAccessTokens",
+			OperationName:    DeleteTeamRobotOperation,
+			OperationSummary: "Delete team robot",
+			OperationID:      "deleteTeamRobot",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "robot",
+					In:   "path",
+				}: params.Robot,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []PersonalAccessTokenResponse
+			Params   = DeleteTeamRobotParams
+			Response = *DeleteTeamRobotNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2102,14 +2206,14 @@ func (s *Server) handleListPersonalAccessTokensRequest(args [0]string, argsEscap
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeleteTeamRobotParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListPersonalAccessTokens(ctx)
+				err = s.h.DeleteTeamRobot(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListPersonalAccessTokens(ctx)
+		err = s.h.DeleteTeamRobot(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2128,7 +2232,7 @@ func (s *Server) handleListPersonalAccessTokensRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeListPersonalAccessTokensResponse(response, w); err != nil {
+	if err := encodeDeleteTeamRobotResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2137,12 +2241,12 @@ func (s *Server) handleListPersonalAccessTokensRequest(args [0]string, argsEscap
 	}
 }
 
-// handleListRepositoriesRequest handles listRepositories operation.
+// handleDeleteTeamRobotTokenRequest handles deleteTeamRobotToken operation.
 //
-// List repositories.
+// Delete team robot personal access token.
 //
-// GET /v1/repositories
-func (s *Server) handleListRepositoriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v1/teams/{name}/robots/{robot}/tokens/{id}
+func (s *Server) handleDeleteTeamRobotTokenRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2150,15 +2254,15 @@ func (s *Server) handleListRepositoriesRequest(args [0]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListRepositoriesOperation,
-			ID:   "listRepositories",
+			Name: DeleteTeamRobotTokenOperation,
+			ID:   "deleteTeamRobotToken",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListRepositoriesOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeleteTeamRobotTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2200,23 +2304,46 @@ func (s *Server) handleListRepositoriesRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodeDeleteTeamRobotTokenParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response []RepositoryResponse
+	var response *DeleteTeamRobotTokenNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListRepositoriesOperation,
-			OperationSummary: "List repositories",
-			OperationID:      "listRepositories",
+			OperationName:    DeleteTeamRobotTokenOperation,
+			OperationSummary: "Delete team robot personal access token",
+			OperationID:      "deleteTeamRobotToken",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "robot",
+					In:   "path",
+				}: params.Robot,
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []RepositoryResponse
+			Params   = DeleteTeamRobotTokenParams
+			Response = *DeleteTeamRobotTokenNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2225,14 +2352,14 @@ func (s *Server) handleListRepositoriesRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeleteTeamRobotTokenParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListRepositories(ctx)
+				err = s.h.DeleteTeamRobotToken(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListRepositories(ctx)
+		err = s.h.DeleteTeamRobotToken(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2251,7 +2378,7 @@ func (s *Server) handleListRepositoriesRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListRepositoriesResponse(response, w); err != nil {
+	if err := encodeDeleteTeamRobotTokenResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2260,12 +2387,12 @@ func (s *Server) handleListRepositoriesRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleListTeamMembersRequest handles listTeamMembers operation.
+// handleDeleteUserRequest handles deleteUser operation.
 //
-// List team members.
+// Delete user.
 //
-// GET /v1/teams/{name}/members
-func (s *Server) handleListTeamMembersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /v1/users/{username}
+func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2273,15 +2400,15 @@ func (s *Server) handleListTeamMembersRequest(args [1]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListTeamMembersOperation,
-			ID:   "listTeamMembers",
+			Name: DeleteUserOperation,
+			ID:   "deleteUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListTeamMembersOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, DeleteUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2323,7 +2450,7 @@ func (s *Server) handleListTeamMembersRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeListTeamMembersParams(args, argsEscaped, r)
+	params, err := decodeDeleteUserParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2334,27 +2461,27 @@ func (s *Server) handleListTeamMembersRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	var response []TeamMemberResponse
+	var response *DeleteUserNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListTeamMembersOperation,
-			OperationSummary: "List team members",
-			OperationID:      "listTeamMembers",
+			OperationName:    DeleteUserOperation,
+			OperationSummary: "Delete user",
+			OperationID:      "deleteUser",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "name",
+					Name: "username",
 					In:   "path",
-				}: params.Name,
+				}: params.Username,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListTeamMembersParams
-			Response = []TeamMemberResponse
+			Params   = DeleteUserParams
+			Response = *DeleteUserNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2363,14 +2490,14 @@ func (s *Server) handleListTeamMembersRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackListTeamMembersParams,
+			unpackDeleteUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListTeamMembers(ctx, params)
+				err = s.h.DeleteUser(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListTeamMembers(ctx, params)
+		err = s.h.DeleteUser(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2389,7 +2516,7 @@ func (s *Server) handleListTeamMembersRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListTeamMembersResponse(response, w); err != nil {
+	if err := encodeDeleteUserResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2398,12 +2525,12 @@ func (s *Server) handleListTeamMembersRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleListTeamsRequest handles listTeams operation.
+// handleGetNamespaceSettingsRequest handles getNamespaceSettings operation.
 //
-// List teams.
+// Get namespace settings.
 //
-// GET /v1/teams
-func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/namespaces/{namespace}/settings
+func (s *Server) handleGetNamespaceSettingsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2411,15 +2538,15 @@ func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListTeamsOperation,
-			ID:   "listTeams",
+			Name: GetNamespaceSettingsOperation,
+			ID:   "getNamespaceSettings",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListTeamsOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetNamespaceSettingsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2461,23 +2588,38 @@ func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
+	params, err := decodeGetNamespaceSettingsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response []TeamResponse
+	var response *NamespaceSettings
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListTeamsOperation,
-			OperationSummary: "List teams",
-			OperationID:      "listTeams",
+			OperationName:    GetNamespaceSettingsOperation,
+			OperationSummary: "Get namespace settings",
+			OperationID:      "getNamespaceSettings",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []TeamResponse
+			Params   = GetNamespaceSettingsParams
+			Response = *NamespaceSettings
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2486,14 +2628,14 @@ func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetNamespaceSettingsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListTeams(ctx)
+				response, err = s.h.GetNamespaceSettings(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListTeams(ctx)
+		response, err = s.h.GetNamespaceSettings(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2512,7 +2654,7 @@ func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeListTeamsResponse(response, w); err != nil {
+	if err := encodeGetNamespaceSettingsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2521,12 +2663,18 @@ func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleListUsersRequest handles listUsers operation.
+// handleGetNamespaceUsageRequest handles getNamespaceUsage operation.
 //
-// List users.
+// Gets the number of repositories in the namespace and the storage they use, together with the quota
+// of the namespace.
+// Push access is not granted to repositories in a namespace that has reached its storage quota, and
+// no repositories can be created in a namespace that has reached its repository quota.
+// Storage is tracked through the notifications sent by the registry, and is an approximation since
+// the registry only frees storage when it is garbage collected.
+// Admins can get the usage of every namespace.
 //
-// GET /v1/users
-func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/namespaces/{namespace}/usage
+func (s *Server) handleGetNamespaceUsageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2534,15 +2682,15 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListUsersOperation,
-			ID:   "listUsers",
+			Name: GetNamespaceUsageOperation,
+			ID:   "getNamespaceUsage",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListUsersOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetNamespaceUsageOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2584,14 +2732,103 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
+	params, err := decodeGetNamespaceUsageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response []UserResponse
+	var response *NamespaceUsage
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListUsersOperation,
-			OperationSummary: "List users",
-			OperationID:      "listUsers",
+			OperationName:    GetNamespaceUsageOperation,
+			OperationSummary: "Get namespace usage",
+			OperationID:      "getNamespaceUsage",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetNamespaceUsageParams
+			Response = *NamespaceUsage
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetNamespaceUsageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetNamespaceUsage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetNamespaceUsage(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetNamespaceUsageResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOIDCConfigurationRequest handles getOIDCConfiguration operation.
+//
+// Returns the settings that clients need to log in through the configured OpenID Connect identity
+// provider.
+//
+// GET /v1/auth/oidc
+func (s *Server) handleGetOIDCConfigurationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err error
+	)
+
+	var response *OIDCConfigurationResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOIDCConfigurationOperation,
+			OperationSummary: "Get OpenID Connect configuration",
+			OperationID:      "getOIDCConfiguration",
 			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
@@ -2600,7 +2837,7 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = []UserResponse
+			Response = *OIDCConfigurationResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2611,12 +2848,12 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListUsers(ctx)
+				response, err = s.h.GetOIDCConfiguration(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListUsers(ctx)
+		response, err = s.h.GetOIDCConfiguration(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2635,7 +2872,7 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeListUsersResponse(response, w); err != nil {
+	if err := encodeGetOIDCConfigurationResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2644,12 +2881,12 @@ func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleRemoveTeamMemberRequest handles removeTeamMember operation.
+// handleGetPersonalAccessTokenRequest handles getPersonalAccessToken operation.
 //
-// Remove team member.
+// Get personal access token.
 //
-// DELETE /v1/teams/{name}/members/{username}
-func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /v1/tokens/{id}
+func (s *Server) handleGetPersonalAccessTokenRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2657,15 +2894,15 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveTeamMemberOperation,
-			ID:   "removeTeamMember",
+			Name: GetPersonalAccessTokenOperation,
+			ID:   "getPersonalAccessToken",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityPersonalAccessToken(ctx, RemoveTeamMemberOperation, r)
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetPersonalAccessTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2707,7 +2944,7 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeRemoveTeamMemberParams(args, argsEscaped, r)
+	params, err := decodeGetPersonalAccessTokenParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2718,31 +2955,167 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 		return
 	}
 
-	var response *RemoveTeamMemberNoContent
+	var response *PersonalAccessTokenResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveTeamMemberOperation,
-			OperationSummary: "Remove team member",
-			OperationID:      "removeTeamMember",
+			OperationName:    GetPersonalAccessTokenOperation,
+			OperationSummary: "Get personal access token",
+			OperationID:      "getPersonalAccessToken",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "name",
+					Name: "id",
 					In:   "path",
-				}: params.Name,
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetPersonalAccessTokenParams
+			Response = *PersonalAccessTokenResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetPersonalAccessTokenParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPersonalAccessToken(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPersonalAccessToken(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetPersonalAccessTokenResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPersonalAccessTokenDailyUsageRequest handles getPersonalAccessTokenDailyUsage operation.
+//
+// Returns the number of times per day and source IP address that the personal access token was used,
+// from newest to oldest.
+// This only includes usage that has been removed from the usage log by its retention policy.
+//
+// GET /v1/tokens/{id}/usage/daily
+func (s *Server) handleGetPersonalAccessTokenDailyUsageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetPersonalAccessTokenDailyUsageOperation,
+			ID:   "getPersonalAccessTokenDailyUsage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetPersonalAccessTokenDailyUsageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetPersonalAccessTokenDailyUsageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response []PersonalAccessTokenDailyUsageResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetPersonalAccessTokenDailyUsageOperation,
+			OperationSummary: "Get personal access token daily usage",
+			OperationID:      "getPersonalAccessTokenDailyUsage",
+			Body:             nil,
+			Params: middleware.Parameters{
 				{
-					Name: "username",
+					Name: "id",
 					In:   "path",
-				}: params.Username,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = RemoveTeamMemberParams
-			Response = *RemoveTeamMemberNoContent
+			Params   = GetPersonalAccessTokenDailyUsageParams
+			Response = []PersonalAccessTokenDailyUsageResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2751,14 +3124,14 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackRemoveTeamMemberParams,
+			unpackGetPersonalAccessTokenDailyUsageParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.RemoveTeamMember(ctx, params)
+				response, err = s.h.GetPersonalAccessTokenDailyUsage(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.RemoveTeamMember(ctx, params)
+		response, err = s.h.GetPersonalAccessTokenDailyUsage(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
@@ -2777,7 +3150,4564 @@ func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeRemoveTeamMemberResponse(response, w); err != nil {
+	if err := encodeGetPersonalAccessTokenDailyUsageResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPersonalAccessTokenUsageRequest handles getPersonalAccessTokenUsage operation.
+//
+// Returns the times that the personal access token was used to log in to the registry, from newest
+// to oldest.
+//
+// GET /v1/tokens/{id}/usage
+func (s *Server) handleGetPersonalAccessTokenUsageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetPersonalAccessTokenUsageOperation,
+			ID:   "getPersonalAccessTokenUsage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetPersonalAccessTokenUsageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetPersonalAccessTokenUsageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *GetPersonalAccessTokenUsageOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetPersonalAccessTokenUsageOperation,
+			OperationSummary: "Get personal access token usage",
+			OperationID:      "getPersonalAccessTokenUsage",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+				{
+					Name: "since",
+					In:   "query",
+				}: params.Since,
+				{
+					Name: "until",
+					In:   "query",
+				}: params.Until,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetPersonalAccessTokenUsageParams
+			Response = *GetPersonalAccessTokenUsageOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetPersonalAccessTokenUsageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPersonalAccessTokenUsage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPersonalAccessTokenUsage(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetPersonalAccessTokenUsageResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetRepositoryRequest handles getRepository operation.
+//
+// Get repository.
+//
+// GET /v1/repositories/{namespace}/{name}
+func (s *Server) handleGetRepositoryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetRepositoryOperation,
+			ID:   "getRepository",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetRepositoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetRepositoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *RepositoryResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetRepositoryOperation,
+			OperationSummary: "Get repository",
+			OperationID:      "getRepository",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetRepositoryParams
+			Response = *RepositoryResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetRepositoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetRepository(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetRepository(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetRepositoryResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetRepositoryTagRequest handles getRepositoryTag operation.
+//
+// Get repository tag.
+//
+// GET /v1/repositories/{namespace}/{name}/tags/{tag}
+func (s *Server) handleGetRepositoryTagRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetRepositoryTagOperation,
+			ID:   "getRepositoryTag",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetRepositoryTagOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetRepositoryTagParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *TagResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetRepositoryTagOperation,
+			OperationSummary: "Get repository tag",
+			OperationID:      "getRepositoryTag",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "tag",
+					In:   "path",
+				}: params.Tag,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetRepositoryTagParams
+			Response = *TagResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetRepositoryTagParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetRepositoryTag(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetRepositoryTag(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetRepositoryTagResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTeamRequest handles getTeam operation.
+//
+// Get team.
+//
+// GET /v1/teams/{name}
+func (s *Server) handleGetTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTeamOperation,
+			ID:   "getTeam",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetTeamOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetTeamParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *TeamResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTeamOperation,
+			OperationSummary: "Get team",
+			OperationID:      "getTeam",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTeamParams
+			Response = *TeamResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTeamParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTeam(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTeam(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetTeamResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTeamRobotRequest handles getTeamRobot operation.
+//
+// Get team robot.
+//
+// GET /v1/teams/{name}/robots/{robot}
+func (s *Server) handleGetTeamRobotRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTeamRobotOperation,
+			ID:   "getTeamRobot",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetTeamRobotOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetTeamRobotParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *TeamRobotResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTeamRobotOperation,
+			OperationSummary: "Get team robot",
+			OperationID:      "getTeamRobot",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "robot",
+					In:   "path",
+				}: params.Robot,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTeamRobotParams
+			Response = *TeamRobotResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTeamRobotParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTeamRobot(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTeamRobot(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetTeamRobotResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserRequest handles getUser operation.
+//
+// Get user.
+//
+// GET /v1/users/{username}
+func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserOperation,
+			ID:   "getUser",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, GetUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeGetUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *UserResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserOperation,
+			OperationSummary: "Get user",
+			OperationID:      "getUser",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "username",
+					In:   "path",
+				}: params.Username,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserParams
+			Response = *UserResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUser(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUser(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetUserResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListAuditEventsRequest handles listAuditEvents operation.
+//
+// Returns the events in the audit log matching the given filters, from newest to oldest.
+// Administrator privileges are required to query the audit log.
+//
+// GET /v1/audit
+func (s *Server) handleListAuditEventsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListAuditEventsOperation,
+			ID:   "listAuditEvents",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListAuditEventsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListAuditEventsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ListAuditEventsOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListAuditEventsOperation,
+			OperationSummary: "List audit events",
+			OperationID:      "listAuditEvents",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "actor",
+					In:   "query",
+				}: params.Actor,
+				{
+					Name: "action",
+					In:   "query",
+				}: params.Action,
+				{
+					Name: "targetType",
+					In:   "query",
+				}: params.TargetType,
+				{
+					Name: "target",
+					In:   "query",
+				}: params.Target,
+				{
+					Name: "since",
+					In:   "query",
+				}: params.Since,
+				{
+					Name: "until",
+					In:   "query",
+				}: params.Until,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListAuditEventsParams
+			Response = *ListAuditEventsOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListAuditEventsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListAuditEvents(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListAuditEvents(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListAuditEventsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListCatalogRepositoriesRequest handles listCatalogRepositories operation.
+//
+// Lists the names of all repositories that the caller is allowed to pull, in the same format as the
+// `/v2/_catalog` endpoint of the registry. Unlike the catalog of the registry, which requires the
+// `registry:catalog:*` scope and contains every repository, this is available to every caller.
+// Authentication is optional; anonymous callers only get public repositories.
+// The repositories are sorted by their full name.
+//
+// GET /v1/catalog/repositories
+func (s *Server) handleListCatalogRepositoriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListCatalogRepositoriesOperation,
+			ID:   "listCatalogRepositories",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListCatalogRepositoriesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListCatalogRepositoriesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *CatalogResponseHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListCatalogRepositoriesOperation,
+			OperationSummary: "List repositories in the registry catalog format",
+			OperationID:      "listCatalogRepositories",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "n",
+					In:   "query",
+				}: params.N,
+				{
+					Name: "last",
+					In:   "query",
+				}: params.Last,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListCatalogRepositoriesParams
+			Response = *CatalogResponseHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListCatalogRepositoriesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListCatalogRepositories(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListCatalogRepositories(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListCatalogRepositoriesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListPersonalAccessTokensRequest handles listPersonalAccessTokens operation.
+//
+// List personal access tokens.
+//
+// GET /v1/tokens
+func (s *Server) handleListPersonalAccessTokensRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListPersonalAccessTokensOperation,
+			ID:   "listPersonalAccessTokens",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListPersonalAccessTokensOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListPersonalAccessTokensParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ListPersonalAccessTokensOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListPersonalAccessTokensOperation,
+			OperationSummary: "List personal access tokens",
+			OperationID:      "listPersonalAccessTokens",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "name",
+					In:   "query",
+				}: params.Name,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListPersonalAccessTokensParams
+			Response = *ListPersonalAccessTokensOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListPersonalAccessTokensParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListPersonalAccessTokens(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListPersonalAccessTokens(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListPersonalAccessTokensResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListRepositoriesRequest handles listRepositories operation.
+//
+// Lists the repositories in the namespaces of the current user. The README of the repositories is
+// not included.
+//
+// GET /v1/repositories
+func (s *Server) handleListRepositoriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListRepositoriesOperation,
+			ID:   "listRepositories",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListRepositoriesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListRepositoriesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ListRepositoriesOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListRepositoriesOperation,
+			OperationSummary: "List repositories",
+			OperationID:      "listRepositories",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "label",
+					In:   "query",
+				}: params.Label,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "name",
+					In:   "query",
+				}: params.Name,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListRepositoriesParams
+			Response = *ListRepositoriesOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListRepositoriesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListRepositories(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListRepositories(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListRepositoriesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListRepositoryCollaboratorsRequest handles listRepositoryCollaborators operation.
+//
+// Lists the users and teams that have been granted access to the repository outside of their own
+// namespaces.
+//
+// GET /v1/repositories/{namespace}/{name}/collaborators
+func (s *Server) handleListRepositoryCollaboratorsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListRepositoryCollaboratorsOperation,
+			ID:   "listRepositoryCollaborators",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListRepositoryCollaboratorsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListRepositoryCollaboratorsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response []RepositoryCollaboratorResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListRepositoryCollaboratorsOperation,
+			OperationSummary: "List repository collaborators",
+			OperationID:      "listRepositoryCollaborators",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListRepositoryCollaboratorsParams
+			Response = []RepositoryCollaboratorResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListRepositoryCollaboratorsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListRepositoryCollaborators(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListRepositoryCollaborators(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListRepositoryCollaboratorsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListRepositoryProtectedTagsRequest handles listRepositoryProtectedTags operation.
+//
+// Lists the patterns of the tags that are protected in the repository.
+//
+// GET /v1/repositories/{namespace}/{name}/protected-tags
+func (s *Server) handleListRepositoryProtectedTagsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListRepositoryProtectedTagsOperation,
+			ID:   "listRepositoryProtectedTags",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListRepositoryProtectedTagsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListRepositoryProtectedTagsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response []ProtectedTagResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListRepositoryProtectedTagsOperation,
+			OperationSummary: "List protected tag patterns",
+			OperationID:      "listRepositoryProtectedTags",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListRepositoryProtectedTagsParams
+			Response = []ProtectedTagResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListRepositoryProtectedTagsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListRepositoryProtectedTags(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListRepositoryProtectedTags(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListRepositoryProtectedTagsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListRepositoryTagsRequest handles listRepositoryTags operation.
+//
+// Lists the tags that have been pushed to the repository.
+// Tags are tracked through the notifications sent by the registry, so this requires the registry to
+// be configured to send notifications to regauth.
+//
+// GET /v1/repositories/{namespace}/{name}/tags
+func (s *Server) handleListRepositoryTagsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListRepositoryTagsOperation,
+			ID:   "listRepositoryTags",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListRepositoryTagsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListRepositoryTagsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ListRepositoryTagsOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListRepositoryTagsOperation,
+			OperationSummary: "List repository tags",
+			OperationID:      "listRepositoryTags",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "tag",
+					In:   "query",
+				}: params.Tag,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListRepositoryTagsParams
+			Response = *ListRepositoryTagsOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListRepositoryTagsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListRepositoryTags(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListRepositoryTags(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListRepositoryTagsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListTeamMembersRequest handles listTeamMembers operation.
+//
+// List team members.
+//
+// GET /v1/teams/{name}/members
+func (s *Server) handleListTeamMembersRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListTeamMembersOperation,
+			ID:   "listTeamMembers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListTeamMembersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListTeamMembersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ListTeamMembersOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListTeamMembersOperation,
+			OperationSummary: "List team members",
+			OperationID:      "listTeamMembers",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "username",
+					In:   "query",
+				}: params.Username,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListTeamMembersParams
+			Response = *ListTeamMembersOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListTeamMembersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTeamMembers(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTeamMembers(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListTeamMembersResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListTeamRobotTokensRequest handles listTeamRobotTokens operation.
+//
+// List team robot personal access tokens.
+//
+// GET /v1/teams/{name}/robots/{robot}/tokens
+func (s *Server) handleListTeamRobotTokensRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListTeamRobotTokensOperation,
+			ID:   "listTeamRobotTokens",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListTeamRobotTokensOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListTeamRobotTokensParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ListTeamRobotTokensOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListTeamRobotTokensOperation,
+			OperationSummary: "List team robot personal access tokens",
+			OperationID:      "listTeamRobotTokens",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "robot",
+					In:   "path",
+				}: params.Robot,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "description",
+					In:   "query",
+				}: params.Description,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListTeamRobotTokensParams
+			Response = *ListTeamRobotTokensOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListTeamRobotTokensParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTeamRobotTokens(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTeamRobotTokens(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListTeamRobotTokensResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListTeamRobotsRequest handles listTeamRobots operation.
+//
+// List team robots.
+//
+// GET /v1/teams/{name}/robots
+func (s *Server) handleListTeamRobotsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListTeamRobotsOperation,
+			ID:   "listTeamRobots",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListTeamRobotsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListTeamRobotsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ListTeamRobotsOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListTeamRobotsOperation,
+			OperationSummary: "List team robots",
+			OperationID:      "listTeamRobots",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "robot",
+					In:   "query",
+				}: params.Robot,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListTeamRobotsParams
+			Response = *ListTeamRobotsOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListTeamRobotsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTeamRobots(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTeamRobots(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListTeamRobotsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListTeamsRequest handles listTeams operation.
+//
+// List teams.
+//
+// GET /v1/teams
+func (s *Server) handleListTeamsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListTeamsOperation,
+			ID:   "listTeams",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListTeamsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListTeamsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ListTeamsOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListTeamsOperation,
+			OperationSummary: "List teams",
+			OperationID:      "listTeams",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "name",
+					In:   "query",
+				}: params.Name,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListTeamsParams
+			Response = *ListTeamsOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListTeamsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListTeams(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListTeams(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListTeamsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListUsersRequest handles listUsers operation.
+//
+// List users.
+//
+// GET /v1/users
+func (s *Server) handleListUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListUsersOperation,
+			ID:   "listUsers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, ListUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeListUsersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ListUsersOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListUsersOperation,
+			OperationSummary: "List users",
+			OperationID:      "listUsers",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "name",
+					In:   "query",
+				}: params.Name,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListUsersParams
+			Response = *ListUsersOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListUsersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListUsers(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListUsers(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeListUsersResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRemoveRepositoryCollaboratorRequest handles removeRepositoryCollaborator operation.
+//
+// Remove repository collaborator.
+//
+// DELETE /v1/repositories/{namespace}/{name}/collaborators/{type}/{collaborator}
+func (s *Server) handleRemoveRepositoryCollaboratorRequest(args [4]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RemoveRepositoryCollaboratorOperation,
+			ID:   "removeRepositoryCollaborator",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, RemoveRepositoryCollaboratorOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRemoveRepositoryCollaboratorParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *RemoveRepositoryCollaboratorNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RemoveRepositoryCollaboratorOperation,
+			OperationSummary: "Remove repository collaborator",
+			OperationID:      "removeRepositoryCollaborator",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "type",
+					In:   "path",
+				}: params.Type,
+				{
+					Name: "collaborator",
+					In:   "path",
+				}: params.Collaborator,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RemoveRepositoryCollaboratorParams
+			Response = *RemoveRepositoryCollaboratorNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRemoveRepositoryCollaboratorParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.RemoveRepositoryCollaborator(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.RemoveRepositoryCollaborator(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRemoveRepositoryCollaboratorResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRemoveRepositoryProtectedTagRequest handles removeRepositoryProtectedTag operation.
+//
+// Only the owner of a user namespace and the admins of a team namespace can manage protected tags.
+//
+// DELETE /v1/repositories/{namespace}/{name}/protected-tags/{pattern}
+func (s *Server) handleRemoveRepositoryProtectedTagRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RemoveRepositoryProtectedTagOperation,
+			ID:   "removeRepositoryProtectedTag",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, RemoveRepositoryProtectedTagOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRemoveRepositoryProtectedTagParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *RemoveRepositoryProtectedTagNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RemoveRepositoryProtectedTagOperation,
+			OperationSummary: "Remove protected tag pattern",
+			OperationID:      "removeRepositoryProtectedTag",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "pattern",
+					In:   "path",
+				}: params.Pattern,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RemoveRepositoryProtectedTagParams
+			Response = *RemoveRepositoryProtectedTagNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRemoveRepositoryProtectedTagParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.RemoveRepositoryProtectedTag(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.RemoveRepositoryProtectedTag(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRemoveRepositoryProtectedTagResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRemoveTeamMemberRequest handles removeTeamMember operation.
+//
+// Remove team member.
+//
+// DELETE /v1/teams/{name}/members/{username}
+func (s *Server) handleRemoveTeamMemberRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RemoveTeamMemberOperation,
+			ID:   "removeTeamMember",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, RemoveTeamMemberOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRemoveTeamMemberParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *RemoveTeamMemberNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RemoveTeamMemberOperation,
+			OperationSummary: "Remove team member",
+			OperationID:      "removeTeamMember",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "username",
+					In:   "path",
+				}: params.Username,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RemoveTeamMemberParams
+			Response = *RemoveTeamMemberNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRemoveTeamMemberParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.RemoveTeamMember(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.RemoveTeamMember(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRemoveTeamMemberResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRevokePersonalAccessTokenRequest handles revokePersonalAccessToken operation.
+//
+// Revokes the personal access token, so that it cannot be used anymore.
+// Unlike deleting the token, the token and its usage log are kept, so they can still be inspected
+// afterward.
+//
+// POST /v1/tokens/{id}/revoke
+func (s *Server) handleRevokePersonalAccessTokenRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RevokePersonalAccessTokenOperation,
+			ID:   "revokePersonalAccessToken",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, RevokePersonalAccessTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRevokePersonalAccessTokenParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *PersonalAccessTokenResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RevokePersonalAccessTokenOperation,
+			OperationSummary: "Revoke personal access token",
+			OperationID:      "revokePersonalAccessToken",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RevokePersonalAccessTokenParams
+			Response = *PersonalAccessTokenResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRevokePersonalAccessTokenParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RevokePersonalAccessToken(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RevokePersonalAccessToken(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRevokePersonalAccessTokenResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRevokeTeamRobotTokenRequest handles revokeTeamRobotToken operation.
+//
+// Revokes the personal access token of the robot, keeping the token and its usage log.
+//
+// POST /v1/teams/{name}/robots/{robot}/tokens/{id}/revoke
+func (s *Server) handleRevokeTeamRobotTokenRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RevokeTeamRobotTokenOperation,
+			ID:   "revokeTeamRobotToken",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, RevokeTeamRobotTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRevokeTeamRobotTokenParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *PersonalAccessTokenResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RevokeTeamRobotTokenOperation,
+			OperationSummary: "Revoke team robot personal access token",
+			OperationID:      "revokeTeamRobotToken",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+				{
+					Name: "robot",
+					In:   "path",
+				}: params.Robot,
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = RevokeTeamRobotTokenParams
+			Response = *PersonalAccessTokenResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRevokeTeamRobotTokenParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RevokeTeamRobotToken(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RevokeTeamRobotToken(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRevokeTeamRobotTokenResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRotatePersonalAccessTokenRequest handles rotatePersonalAccessToken operation.
+//
+// Generates a new plain-text token for the personal access token, keeping its description,
+// permission, repositories, scopes and expiration date.
+// The previous plain-text token keeps working until the grace period has passed, so that it can be
+// replaced everywhere it is used without interruption.
+// Rotating the token again ends the grace period of the token before it.
+//
+// POST /v1/tokens/{id}/rotate
+func (s *Server) handleRotatePersonalAccessTokenRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: RotatePersonalAccessTokenOperation,
+			ID:   "rotatePersonalAccessToken",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, RotatePersonalAccessTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeRotatePersonalAccessTokenParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeRotatePersonalAccessTokenRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *PersonalAccessTokenRotationResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    RotatePersonalAccessTokenOperation,
+			OperationSummary: "Rotate personal access token",
+			OperationID:      "rotatePersonalAccessToken",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *PersonalAccessTokenRotationRequest
+			Params   = RotatePersonalAccessTokenParams
+			Response = *PersonalAccessTokenRotationResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRotatePersonalAccessTokenParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.RotatePersonalAccessToken(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.RotatePersonalAccessToken(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeRotatePersonalAccessTokenResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleSearchCatalogRequest handles searchCatalog operation.
+//
+// Searches all repositories that the caller is allowed to pull: public repositories, repositories in
+// the namespaces
+// of the caller, and repositories that the caller or one of their teams is a collaborator on.
+// Authentication is optional; anonymous callers can only find public repositories.
+// The README of the repositories is not included.
+//
+// GET /v1/catalog
+func (s *Server) handleSearchCatalogRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SearchCatalogOperation,
+			ID:   "searchCatalog",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, SearchCatalogOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeSearchCatalogParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *SearchCatalogOKHeaders
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    SearchCatalogOperation,
+			OperationSummary: "Search repository catalog",
+			OperationID:      "searchCatalog",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "q",
+					In:   "query",
+				}: params.Q,
+				{
+					Name: "namespace",
+					In:   "query",
+				}: params.Namespace,
+				{
+					Name: "label",
+					In:   "query",
+				}: params.Label,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = SearchCatalogParams
+			Response = *SearchCatalogOKHeaders
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackSearchCatalogParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.SearchCatalog(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.SearchCatalog(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeSearchCatalogResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUpdateNamespaceQuotaRequest handles updateNamespaceQuota operation.
+//
+// Sets the quota of the namespace, which takes precedence over the default quota for namespaces of
+// users or teams
+// from the server configuration. A limit of zero means that there is no limit.
+// Only admins can update the quota of a namespace.
+//
+// PUT /v1/namespaces/{namespace}/quota
+func (s *Server) handleUpdateNamespaceQuotaRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateNamespaceQuotaOperation,
+			ID:   "updateNamespaceQuota",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, UpdateNamespaceQuotaOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUpdateNamespaceQuotaParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateNamespaceQuotaRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *NamespaceUsage
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UpdateNamespaceQuotaOperation,
+			OperationSummary: "Update namespace quota",
+			OperationID:      "updateNamespaceQuota",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *NamespaceQuota
+			Params   = UpdateNamespaceQuotaParams
+			Response = *NamespaceUsage
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUpdateNamespaceQuotaParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UpdateNamespaceQuota(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UpdateNamespaceQuota(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUpdateNamespaceQuotaResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUpdateNamespaceSettingsRequest handles updateNamespaceSettings operation.
+//
+// Updates the settings of a namespace that the current user can create repositories in.
+// If automatic repository creation is enabled, requesting push access to a repository that does not
+// exist yet in the
+// namespace creates it, as long as the caller is allowed to push to the namespace and the personal
+// access token allows
+// pushing to the repository. The repository is recorded as created by the user of the token.
+//
+// PUT /v1/namespaces/{namespace}/settings
+func (s *Server) handleUpdateNamespaceSettingsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateNamespaceSettingsOperation,
+			ID:   "updateNamespaceSettings",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, UpdateNamespaceSettingsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUpdateNamespaceSettingsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateNamespaceSettingsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *NamespaceSettings
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UpdateNamespaceSettingsOperation,
+			OperationSummary: "Update namespace settings",
+			OperationID:      "updateNamespaceSettings",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *NamespaceSettings
+			Params   = UpdateNamespaceSettingsParams
+			Response = *NamespaceSettings
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUpdateNamespaceSettingsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UpdateNamespaceSettings(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UpdateNamespaceSettings(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUpdateNamespaceSettingsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUpdateRepositoryRequest handles updateRepository operation.
+//
+// Updates the visibility, description or name of the repository. Only the properties that are given
+// are changed.
+// The repository can be moved to another namespace by specifying the namespace property, as long as
+// the current user
+// has access to the target namespace. Note that renaming or moving a repository only changes its
+// metadata; images
+// that have already been pushed are not moved in the storage of the registry.
+//
+// PATCH /v1/repositories/{namespace}/{name}
+func (s *Server) handleUpdateRepositoryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateRepositoryOperation,
+			ID:   "updateRepository",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, UpdateRepositoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUpdateRepositoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateRepositoryRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *RepositoryResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UpdateRepositoryOperation,
+			OperationSummary: "Update repository",
+			OperationID:      "updateRepository",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "namespace",
+					In:   "path",
+				}: params.Namespace,
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *RepositoryUpdateRequest
+			Params   = UpdateRepositoryParams
+			Response = *RepositoryResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUpdateRepositoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UpdateRepository(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UpdateRepository(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUpdateRepositoryResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUpdateTeamRequest handles updateTeam operation.
+//
+// Updates the maximum lifetime of the personal access tokens of the members and robots of the team.
+// Only team admins can update the team.
+//
+// PATCH /v1/teams/{name}
+func (s *Server) handleUpdateTeamRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateTeamOperation,
+			ID:   "updateTeam",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, UpdateTeamOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUpdateTeamParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateTeamRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *TeamResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UpdateTeamOperation,
+			OperationSummary: "Update team",
+			OperationID:      "updateTeam",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "path",
+				}: params.Name,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *TeamUpdateRequest
+			Params   = UpdateTeamParams
+			Response = *TeamResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUpdateTeamParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UpdateTeam(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UpdateTeam(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUpdateTeamResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleUpdateUserRequest handles updateUser operation.
+//
+// Disables or enables the user. Disabled users cannot authenticate in any way, including using their
+// personal
+// access tokens, but their namespace, repositories and tokens are kept.
+//
+// PATCH /v1/users/{username}
+func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateUserOperation,
+			ID:   "updateUser",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityPersonalAccessToken(ctx, UpdateUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "PersonalAccessToken",
+					Err:              err,
+				}
+				if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+					defer recordError("Security:PersonalAccessToken", err)
+				}
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			if encodeErr := encodeErrorResponse(s.h.NewError(ctx, err), w); encodeErr != nil {
+				defer recordError("Security", err)
+			}
+			return
+		}
+	}
+	params, err := decodeUpdateUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateUserRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *UserResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UpdateUserOperation,
+			OperationSummary: "Update user",
+			OperationID:      "updateUser",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "username",
+					In:   "path",
+				}: params.Username,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *UserUpdateRequest
+			Params   = UpdateUserParams
+			Response = *UserResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackUpdateUserParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UpdateUser(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UpdateUser(ctx, request, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*ErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeUpdateUserResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
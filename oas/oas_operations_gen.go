@@ -6,24 +6,56 @@ package oas
 type OperationName = string
 
 const (
-	AddTeamMemberOperation             OperationName = "AddTeamMember"
-	ChangeUserPasswordOperation        OperationName = "ChangeUserPassword"
-	CreatePersonalAccessTokenOperation OperationName = "CreatePersonalAccessToken"
-	CreateRepositoryOperation          OperationName = "CreateRepository"
-	CreateTeamOperation                OperationName = "CreateTeam"
-	CreateUserOperation                OperationName = "CreateUser"
-	DeletePersonalAccessTokenOperation OperationName = "DeletePersonalAccessToken"
-	DeleteRepositoryOperation          OperationName = "DeleteRepository"
-	DeleteTeamOperation                OperationName = "DeleteTeam"
-	DeleteUserOperation                OperationName = "DeleteUser"
-	GetPersonalAccessTokenOperation    OperationName = "GetPersonalAccessToken"
-	GetRepositoryOperation             OperationName = "GetRepository"
-	GetTeamOperation                   OperationName = "GetTeam"
-	GetUserOperation                   OperationName = "GetUser"
-	ListPersonalAccessTokensOperation  OperationName = "ListPersonalAccessTokens"
-	ListRepositoriesOperation          OperationName = "ListRepositories"
-	ListTeamMembersOperation           OperationName = "ListTeamMembers"
-	ListTeamsOperation                 OperationName = "ListTeams"
-	ListUsersOperation                 OperationName = "ListUsers"
-	RemoveTeamMemberOperation          OperationName = "RemoveTeamMember"
+	AddRepositoryCollaboratorOperation        OperationName = "AddRepositoryCollaborator"
+	AddRepositoryProtectedTagOperation        OperationName = "AddRepositoryProtectedTag"
+	AddTeamMemberOperation                    OperationName = "AddTeamMember"
+	ChangeUserPasswordOperation               OperationName = "ChangeUserPassword"
+	CreatePersonalAccessTokenOperation        OperationName = "CreatePersonalAccessToken"
+	CreateRepositoryOperation                 OperationName = "CreateRepository"
+	CreateTeamOperation                       OperationName = "CreateTeam"
+	CreateTeamRobotOperation                  OperationName = "CreateTeamRobot"
+	CreateTeamRobotTokenOperation             OperationName = "CreateTeamRobotToken"
+	CreateUserOperation                       OperationName = "CreateUser"
+	DeleteNamespaceQuotaOperation             OperationName = "DeleteNamespaceQuota"
+	DeletePersonalAccessTokenOperation        OperationName = "DeletePersonalAccessToken"
+	DeleteRepositoryOperation                 OperationName = "DeleteRepository"
+	DeleteTeamOperation                       OperationName = "DeleteTeam"
+	DeleteTeamRobotOperation                  OperationName = "DeleteTeamRobot"
+	DeleteTeamRobotTokenOperation             OperationName = "DeleteTeamRobotToken"
+	DeleteUserOperation                       OperationName = "DeleteUser"
+	GetNamespaceSettingsOperation             OperationName = "GetNamespaceSettings"
+	GetNamespaceUsageOperation                OperationName = "GetNamespaceUsage"
+	GetOIDCConfigurationOperation             OperationName = "GetOIDCConfiguration"
+	GetPersonalAccessTokenOperation           OperationName = "GetPersonalAccessToken"
+	GetPersonalAccessTokenDailyUsageOperation OperationName = "GetPersonalAccessTokenDailyUsage"
+	GetPersonalAccessTokenUsageOperation      OperationName = "GetPersonalAccessTokenUsage"
+	GetRepositoryOperation                    OperationName = "GetRepository"
+	GetRepositoryTagOperation                 OperationName = "GetRepositoryTag"
+	GetTeamOperation                          OperationName = "GetTeam"
+	GetTeamRobotOperation                     OperationName = "GetTeamRobot"
+	GetUserOperation                          OperationName = "GetUser"
+	ListAuditEventsOperation                  OperationName = "ListAuditEvents"
+	ListCatalogRepositoriesOperation          OperationName = "ListCatalogRepositories"
+	ListPersonalAccessTokensOperation         OperationName = "ListPersonalAccessTokens"
+	ListRepositoriesOperation                 OperationName = "ListRepositories"
+	ListRepositoryCollaboratorsOperation      OperationName = "ListRepositoryCollaborators"
+	ListRepositoryProtectedTagsOperation      OperationName = "ListRepositoryProtectedTags"
+	ListRepositoryTagsOperation               OperationName = "ListRepositoryTags"
+	ListTeamMembersOperation                  OperationName = "ListTeamMembers"
+	ListTeamRobotTokensOperation              OperationName = "ListTeamRobotTokens"
+	ListTeamRobotsOperation                   OperationName = "ListTeamRobots"
+	ListTeamsOperation                        OperationName = "ListTeams"
+	ListUsersOperation                        OperationName = "ListUsers"
+	RemoveRepositoryCollaboratorOperation     OperationName = "RemoveRepositoryCollaborator"
+	RemoveRepositoryProtectedTagOperation     OperationName = "RemoveRepositoryProtectedTag"
+	RemoveTeamMemberOperation                 OperationName = "RemoveTeamMember"
+	RevokePersonalAccessTokenOperation        OperationName = "RevokePersonalAccessToken"
+	RevokeTeamRobotTokenOperation             OperationName = "RevokeTeamRobotToken"
+	RotatePersonalAccessTokenOperation        OperationName = "RotatePersonalAccessToken"
+	SearchCatalogOperation                    OperationName = "SearchCatalog"
+	UpdateNamespaceQuotaOperation             OperationName = "UpdateNamespaceQuota"
+	UpdateNamespaceSettingsOperation          OperationName = "UpdateNamespaceSettings"
+	UpdateRepositoryOperation                 OperationName = "UpdateRepository"
+	UpdateTeamOperation                       OperationName = "UpdateTeam"
+	UpdateUserOperation                       OperationName = "UpdateUser"
 )
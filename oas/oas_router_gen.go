@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [2]string{}
+	args := [4]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -61,6 +61,212 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
+			case 'a': // Prefix: "au"
+
+				if l := len("au"); len(elem) >= l && elem[0:l] == "au" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dit"
+
+					if l := len("dit"); len(elem) >= l && elem[0:l] == "dit" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListAuditEventsRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 't': // Prefix: "th/oidc"
+
+					if l := len("th/oidc"); len(elem) >= l && elem[0:l] == "th/oidc" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetOIDCConfigurationRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
+			case 'c': // Prefix: "catalog"
+
+				if l := len("catalog"); len(elem) >= l && elem[0:l] == "catalog" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleSearchCatalogRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/repositories"
+
+					if l := len("/repositories"); len(elem) >= l && elem[0:l] == "/repositories" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListCatalogRepositoriesRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
+			case 'n': // Prefix: "namespaces/"
+
+				if l := len("namespaces/"); len(elem) >= l && elem[0:l] == "namespaces/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "namespace"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'q': // Prefix: "quota"
+
+						if l := len("quota"); len(elem) >= l && elem[0:l] == "quota" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteNamespaceQuotaRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdateNamespaceQuotaRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,PUT")
+							}
+
+							return
+						}
+
+					case 's': // Prefix: "settings"
+
+						if l := len("settings"); len(elem) >= l && elem[0:l] == "settings" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetNamespaceSettingsRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdateNamespaceSettingsRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PUT")
+							}
+
+							return
+						}
+
+					case 'u': // Prefix: "usage"
+
+						if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetNamespaceUsageRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
 			case 'r': // Prefix: "repositories"
 
 				if l := len("repositories"); len(elem) >= l && elem[0:l] == "repositories" {
@@ -112,16 +318,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 
 						// Param: "name"
-						// Leaf parameter, slashes are prohibited
+						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
+						if idx < 0 {
+							idx = len(elem)
 						}
-						args[1] = elem
-						elem = ""
+						args[1] = elem[:idx]
+						elem = elem[idx:]
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
 							case "DELETE":
 								s.handleDeleteRepositoryRequest([2]string{
@@ -133,12 +338,238 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									args[0],
 									args[1],
 								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUpdateRepositoryRequest([2]string{
+									args[0],
+									args[1],
+								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "DELETE,GET")
+								s.notAllowed(w, r, "DELETE,GET,PATCH")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "collaborators"
+
+								if l := len("collaborators"); len(elem) >= l && elem[0:l] == "collaborators" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListRepositoryCollaboratorsRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleAddRepositoryCollaboratorRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "type"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[2] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "collaborator"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[3] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleRemoveRepositoryCollaboratorRequest([4]string{
+													args[0],
+													args[1],
+													args[2],
+													args[3],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							case 'p': // Prefix: "protected-tags"
+
+								if l := len("protected-tags"); len(elem) >= l && elem[0:l] == "protected-tags" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListRepositoryProtectedTagsRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleAddRepositoryProtectedTagRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "pattern"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[2] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleRemoveRepositoryProtectedTagRequest([3]string{
+												args[0],
+												args[1],
+												args[2],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								}
+
+							case 't': // Prefix: "tags"
+
+								if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListRepositoryTagsRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "tag"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[2] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetRepositoryTagRequest([3]string{
+												args[0],
+												args[1],
+												args[2],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
 
 					}
 
@@ -169,7 +600,295 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						case "GET":
 							s.handleListTeamsRequest([0]string{}, elemIsEscaped, w, r)
 						case "POST":
-							s.handleCreateTeamRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleCreateTeamRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "name"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteTeamRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetTeamRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUpdateTeamRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PATCH")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'm': // Prefix: "members"
+
+								if l := len("members"); len(elem) >= l && elem[0:l] == "members" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListTeamMembersRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleAddTeamMemberRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "username"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleRemoveTeamMemberRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								}
+
+							case 'r': // Prefix: "robots"
+
+								if l := len("robots"); len(elem) >= l && elem[0:l] == "robots" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListTeamRobotsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleCreateTeamRobotRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "robot"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteTeamRobotRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetTeamRobotRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/tokens"
+
+										if l := len("/tokens"); len(elem) >= l && elem[0:l] == "/tokens" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleListTeamRobotTokensRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											case "POST":
+												s.handleCreateTeamRobotTokenRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET,POST")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "id"
+											// Match until "/"
+											idx := strings.IndexByte(elem, '/')
+											if idx < 0 {
+												idx = len(elem)
+											}
+											args[2] = elem[:idx]
+											elem = elem[idx:]
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "DELETE":
+													s.handleDeleteTeamRobotTokenRequest([3]string{
+														args[0],
+														args[1],
+														args[2],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "DELETE")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/revoke"
+
+												if l := len("/revoke"); len(elem) >= l && elem[0:l] == "/revoke" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handleRevokeTeamRobotTokenRequest([3]string{
+															args[0],
+															args[1],
+															args[2],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+											}
+
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'o': // Prefix: "okens"
+
+					if l := len("okens"); len(elem) >= l && elem[0:l] == "okens" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleListPersonalAccessTokensRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreatePersonalAccessTokenRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "GET,POST")
 						}
@@ -185,7 +904,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 
-						// Param: "name"
+						// Param: "id"
 						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
 						if idx < 0 {
@@ -197,11 +916,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						if len(elem) == 0 {
 							switch r.Method {
 							case "DELETE":
-								s.handleDeleteTeamRequest([1]string{
+								s.handleDeletePersonalAccessTokenRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							case "GET":
-								s.handleGetTeamRequest([1]string{
+								s.handleGetPersonalAccessTokenRequest([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
 							default:
@@ -211,123 +930,123 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/members"
+						case '/': // Prefix: "/"
 
-							if l := len("/members"); len(elem) >= l && elem[0:l] == "/members" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								switch r.Method {
-								case "GET":
-									s.handleListTeamMembersRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleAddTeamMemberRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET,POST")
-								}
-
-								return
+								break
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'r': // Prefix: "r"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("r"); len(elem) >= l && elem[0:l] == "r" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "username"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "evoke"
+
+									if l := len("evoke"); len(elem) >= l && elem[0:l] == "evoke" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRevokePersonalAccessTokenRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'o': // Prefix: "otate"
+
+									if l := len("otate"); len(elem) >= l && elem[0:l] == "otate" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRotatePersonalAccessTokenRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							case 'u': // Prefix: "usage"
+
+								if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
+									elem = elem[l:]
+								} else {
 									break
 								}
-								args[1] = elem
-								elem = ""
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
-									case "DELETE":
-										s.handleRemoveTeamMemberRequest([2]string{
+									case "GET":
+										s.handleGetPersonalAccessTokenUsageRequest([1]string{
 											args[0],
-											args[1],
 										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "DELETE")
+										s.notAllowed(w, r, "GET")
 									}
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/daily"
 
-							}
-
-						}
-
-					}
-
-				case 'o': // Prefix: "okens"
-
-					if l := len("okens"); len(elem) >= l && elem[0:l] == "okens" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleListPersonalAccessTokensRequest([0]string{}, elemIsEscaped, w, r)
-						case "POST":
-							s.handleCreatePersonalAccessTokenRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET,POST")
-						}
+									if l := len("/daily"); len(elem) >= l && elem[0:l] == "/daily" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetPersonalAccessTokenDailyUsageRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+										return
+									}
 
-						// Param: "id"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
+								}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "DELETE":
-								s.handleDeletePersonalAccessTokenRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							case "GET":
-								s.handleGetPersonalAccessTokenRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "DELETE,GET")
 							}
 
-							return
 						}
 
 					}
@@ -382,8 +1101,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							s.handleGetUserRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleUpdateUserRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE,GET")
+							s.notAllowed(w, r, "DELETE,GET,PATCH")
 						}
 
 						return
@@ -429,7 +1152,7 @@ type Route struct {
 	operationID string
 	pathPattern string
 	count       int
-	args        [2]string
+	args        [4]string
 }
 
 // Name returns ogen operation name.
@@ -485,30 +1208,266 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 		}()
 	}
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/v1/"
+
+			if l := len("/v1/"); len(elem) >= l && elem[0:l] == "/v1/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "au"
+
+				if l := len("au"); len(elem) >= l && elem[0:l] == "au" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dit"
+
+					if l := len("dit"); len(elem) >= l && elem[0:l] == "dit" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ListAuditEventsOperation
+							r.summary = "List audit events"
+							r.operationID = "listAuditEvents"
+							r.pathPattern = "/v1/audit"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 't': // Prefix: "th/oidc"
+
+					if l := len("th/oidc"); len(elem) >= l && elem[0:l] == "th/oidc" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetOIDCConfigurationOperation
+							r.summary = "Get OpenID Connect configuration"
+							r.operationID = "getOIDCConfiguration"
+							r.pathPattern = "/v1/auth/oidc"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'c': // Prefix: "catalog"
+
+				if l := len("catalog"); len(elem) >= l && elem[0:l] == "catalog" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = SearchCatalogOperation
+						r.summary = "Search repository catalog"
+						r.operationID = "searchCatalog"
+						r.pathPattern = "/v1/catalog"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/repositories"
+
+					if l := len("/repositories"); len(elem) >= l && elem[0:l] == "/repositories" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ListCatalogRepositoriesOperation
+							r.summary = "List repositories in the registry catalog format"
+							r.operationID = "listCatalogRepositories"
+							r.pathPattern = "/v1/catalog/repositories"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'n': // Prefix: "namespaces/"
+
+				if l := len("namespaces/"); len(elem) >= l && elem[0:l] == "namespaces/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				// Param: "namespace"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'q': // Prefix: "quota"
+
+						if l := len("quota"); len(elem) >= l && elem[0:l] == "quota" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = DeleteNamespaceQuotaOperation
+								r.summary = "Delete namespace quota"
+								r.operationID = "deleteNamespaceQuota"
+								r.pathPattern = "/v1/namespaces/{namespace}/quota"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = UpdateNamespaceQuotaOperation
+								r.summary = "Update namespace quota"
+								r.operationID = "updateNamespaceQuota"
+								r.pathPattern = "/v1/namespaces/{namespace}/quota"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 's': // Prefix: "settings"
+
+						if l := len("settings"); len(elem) >= l && elem[0:l] == "settings" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetNamespaceSettingsOperation
+								r.summary = "Get namespace settings"
+								r.operationID = "getNamespaceSettings"
+								r.pathPattern = "/v1/namespaces/{namespace}/settings"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = UpdateNamespaceSettingsOperation
+								r.summary = "Update namespace settings"
+								r.operationID = "updateNamespaceSettings"
+								r.pathPattern = "/v1/namespaces/{namespace}/settings"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'u': // Prefix: "usage"
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/v1/"
+						if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-			if l := len("/v1/"); len(elem) >= l && elem[0:l] == "/v1/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetNamespaceUsageOperation
+								r.summary = "Get namespace usage"
+								r.operationID = "getNamespaceUsage"
+								r.pathPattern = "/v1/namespaces/{namespace}/usage"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
 			case 'r': // Prefix: "repositories"
 
 				if l := len("repositories"); len(elem) >= l && elem[0:l] == "repositories" {
@@ -570,16 +1529,15 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						// Param: "name"
-						// Leaf parameter, slashes are prohibited
+						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
+						if idx < 0 {
+							idx = len(elem)
 						}
-						args[1] = elem
-						elem = ""
+						args[1] = elem[:idx]
+						elem = elem[idx:]
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch method {
 							case "DELETE":
 								r.name = DeleteRepositoryOperation
@@ -597,10 +1555,247 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								r.args = args
 								r.count = 2
 								return r, true
+							case "PATCH":
+								r.name = UpdateRepositoryOperation
+								r.summary = "Update repository"
+								r.operationID = "updateRepository"
+								r.pathPattern = "/v1/repositories/{namespace}/{name}"
+								r.args = args
+								r.count = 2
+								return r, true
 							default:
 								return
 							}
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "collaborators"
+
+								if l := len("collaborators"); len(elem) >= l && elem[0:l] == "collaborators" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListRepositoryCollaboratorsOperation
+										r.summary = "List repository collaborators"
+										r.operationID = "listRepositoryCollaborators"
+										r.pathPattern = "/v1/repositories/{namespace}/{name}/collaborators"
+										r.args = args
+										r.count = 2
+										return r, true
+									case "POST":
+										r.name = AddRepositoryCollaboratorOperation
+										r.summary = "Add repository collaborator"
+										r.operationID = "addRepositoryCollaborator"
+										r.pathPattern = "/v1/repositories/{namespace}/{name}/collaborators"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "type"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[2] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "collaborator"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[3] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = RemoveRepositoryCollaboratorOperation
+												r.summary = "Remove repository collaborator"
+												r.operationID = "removeRepositoryCollaborator"
+												r.pathPattern = "/v1/repositories/{namespace}/{name}/collaborators/{type}/{collaborator}"
+												r.args = args
+												r.count = 4
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 'p': // Prefix: "protected-tags"
+
+								if l := len("protected-tags"); len(elem) >= l && elem[0:l] == "protected-tags" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListRepositoryProtectedTagsOperation
+										r.summary = "List protected tag patterns"
+										r.operationID = "listRepositoryProtectedTags"
+										r.pathPattern = "/v1/repositories/{namespace}/{name}/protected-tags"
+										r.args = args
+										r.count = 2
+										return r, true
+									case "POST":
+										r.name = AddRepositoryProtectedTagOperation
+										r.summary = "Add protected tag pattern"
+										r.operationID = "addRepositoryProtectedTag"
+										r.pathPattern = "/v1/repositories/{namespace}/{name}/protected-tags"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "pattern"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[2] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = RemoveRepositoryProtectedTagOperation
+											r.summary = "Remove protected tag pattern"
+											r.operationID = "removeRepositoryProtectedTag"
+											r.pathPattern = "/v1/repositories/{namespace}/{name}/protected-tags/{pattern}"
+											r.args = args
+											r.count = 3
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 't': // Prefix: "tags"
+
+								if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListRepositoryTagsOperation
+										r.summary = "List repository tags"
+										r.operationID = "listRepositoryTags"
+										r.pathPattern = "/v1/repositories/{namespace}/{name}/tags"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "tag"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[2] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetRepositoryTagOperation
+											r.summary = "Get repository tag"
+											r.operationID = "getRepositoryTag"
+											r.pathPattern = "/v1/repositories/{namespace}/{name}/tags/{tag}"
+											r.args = args
+											r.count = 3
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
 
 					}
 
@@ -684,74 +1879,262 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								r.args = args
 								r.count = 1
 								return r, true
+							case "PATCH":
+								r.name = UpdateTeamOperation
+								r.summary = "Update team"
+								r.operationID = "updateTeam"
+								r.pathPattern = "/v1/teams/{name}"
+								r.args = args
+								r.count = 1
+								return r, true
 							default:
 								return
 							}
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/members"
+						case '/': // Prefix: "/"
 
-							if l := len("/members"); len(elem) >= l && elem[0:l] == "/members" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								switch method {
-								case "GET":
-									r.name = ListTeamMembersOperation
-									r.summary = "List team members"
-									r.operationID = "listTeamMembers"
-									r.pathPattern = "/v1/teams/{name}/members"
-									r.args = args
-									r.count = 1
-									return r, true
-								case "POST":
-									r.name = AddTeamMemberOperation
-									r.summary = "Add team member"
-									r.operationID = "addTeamMember"
-									r.pathPattern = "/v1/teams/{name}/members"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
+								break
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case 'm': // Prefix: "members"
 
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("members"); len(elem) >= l && elem[0:l] == "members" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "username"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListTeamMembersOperation
+										r.summary = "List team members"
+										r.operationID = "listTeamMembers"
+										r.pathPattern = "/v1/teams/{name}/members"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = AddTeamMemberOperation
+										r.summary = "Add team member"
+										r.operationID = "addTeamMember"
+										r.pathPattern = "/v1/teams/{name}/members"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "username"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = RemoveTeamMemberOperation
+											r.summary = "Remove team member"
+											r.operationID = "removeTeamMember"
+											r.pathPattern = "/v1/teams/{name}/members/{username}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'r': // Prefix: "robots"
+
+								if l := len("robots"); len(elem) >= l && elem[0:l] == "robots" {
+									elem = elem[l:]
+								} else {
 									break
 								}
-								args[1] = elem
-								elem = ""
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch method {
-									case "DELETE":
-										r.name = RemoveTeamMemberOperation
-										r.summary = "Remove team member"
-										r.operationID = "removeTeamMember"
-										r.pathPattern = "/v1/teams/{name}/members/{username}"
+									case "GET":
+										r.name = ListTeamRobotsOperation
+										r.summary = "List team robots"
+										r.operationID = "listTeamRobots"
+										r.pathPattern = "/v1/teams/{name}/robots"
 										r.args = args
-										r.count = 2
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = CreateTeamRobotOperation
+										r.summary = "Create team robot"
+										r.operationID = "createTeamRobot"
+										r.pathPattern = "/v1/teams/{name}/robots"
+										r.args = args
+										r.count = 1
 										return r, true
 									default:
 										return
 									}
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "robot"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch method {
+										case "DELETE":
+											r.name = DeleteTeamRobotOperation
+											r.summary = "Delete team robot"
+											r.operationID = "deleteTeamRobot"
+											r.pathPattern = "/v1/teams/{name}/robots/{robot}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "GET":
+											r.name = GetTeamRobotOperation
+											r.summary = "Get team robot"
+											r.operationID = "getTeamRobot"
+											r.pathPattern = "/v1/teams/{name}/robots/{robot}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/tokens"
+
+										if l := len("/tokens"); len(elem) >= l && elem[0:l] == "/tokens" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = ListTeamRobotTokensOperation
+												r.summary = "List team robot personal access tokens"
+												r.operationID = "listTeamRobotTokens"
+												r.pathPattern = "/v1/teams/{name}/robots/{robot}/tokens"
+												r.args = args
+												r.count = 2
+												return r, true
+											case "POST":
+												r.name = CreateTeamRobotTokenOperation
+												r.summary = "Create team robot personal access token"
+												r.operationID = "createTeamRobotToken"
+												r.pathPattern = "/v1/teams/{name}/robots/{robot}/tokens"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "id"
+											// Match until "/"
+											idx := strings.IndexByte(elem, '/')
+											if idx < 0 {
+												idx = len(elem)
+											}
+											args[2] = elem[:idx]
+											elem = elem[idx:]
+
+											if len(elem) == 0 {
+												switch method {
+												case "DELETE":
+													r.name = DeleteTeamRobotTokenOperation
+													r.summary = "Delete team robot personal access token"
+													r.operationID = "deleteTeamRobotToken"
+													r.pathPattern = "/v1/teams/{name}/robots/{robot}/tokens/{id}"
+													r.args = args
+													r.count = 3
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/revoke"
+
+												if l := len("/revoke"); len(elem) >= l && elem[0:l] == "/revoke" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = RevokeTeamRobotTokenOperation
+														r.summary = "Revoke team robot personal access token"
+														r.operationID = "revokeTeamRobotToken"
+														r.pathPattern = "/v1/teams/{name}/robots/{robot}/tokens/{id}/revoke"
+														r.args = args
+														r.count = 3
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										}
+
+									}
+
+								}
 
 							}
 
@@ -799,16 +2182,15 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						// Param: "id"
-						// Leaf parameter, slashes are prohibited
+						// Match until "/"
 						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
+						if idx < 0 {
+							idx = len(elem)
 						}
-						args[0] = elem
-						elem = ""
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch method {
 							case "DELETE":
 								r.name = DeletePersonalAccessTokenOperation
@@ -830,6 +2212,133 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								return
 							}
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'r': // Prefix: "r"
+
+								if l := len("r"); len(elem) >= l && elem[0:l] == "r" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'e': // Prefix: "evoke"
+
+									if l := len("evoke"); len(elem) >= l && elem[0:l] == "evoke" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = RevokePersonalAccessTokenOperation
+											r.summary = "Revoke personal access token"
+											r.operationID = "revokePersonalAccessToken"
+											r.pathPattern = "/v1/tokens/{id}/revoke"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'o': // Prefix: "otate"
+
+									if l := len("otate"); len(elem) >= l && elem[0:l] == "otate" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = RotatePersonalAccessTokenOperation
+											r.summary = "Rotate personal access token"
+											r.operationID = "rotatePersonalAccessToken"
+											r.pathPattern = "/v1/tokens/{id}/rotate"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'u': // Prefix: "usage"
+
+								if l := len("usage"); len(elem) >= l && elem[0:l] == "usage" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = GetPersonalAccessTokenUsageOperation
+										r.summary = "Get personal access token usage"
+										r.operationID = "getPersonalAccessTokenUsage"
+										r.pathPattern = "/v1/tokens/{id}/usage"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/daily"
+
+									if l := len("/daily"); len(elem) >= l && elem[0:l] == "/daily" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetPersonalAccessTokenDailyUsageOperation
+											r.summary = "Get personal access token daily usage"
+											r.operationID = "getPersonalAccessTokenDailyUsage"
+											r.pathPattern = "/v1/tokens/{id}/usage/daily"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
 
 					}
 
@@ -901,6 +2410,14 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							r.args = args
 							r.count = 1
 							return r, true
+						case "PATCH":
+							r.name = UpdateUserOperation
+							r.summary = "Update user"
+							r.operationID = "updateUser"
+							r.pathPattern = "/v1/users/{username}"
+							r.args = args
+							r.count = 1
+							return r, true
 						default:
 							return
 						}
@@ -14,9 +14,229 @@ func (s *ErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+// Ref: #/components/schemas/AuditEventResponse
+type AuditEventResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Timestamp time.Time `json:"timestamp"`
+	Action    string    `json:"action"`
+	// The username of the user that performed the action. Empty for anonymous actors.
+	Actor string `json:"actor"`
+	// The IP address that the action was performed from, if known.
+	SourceIp   OptString                    `json:"sourceIp"`
+	TargetType AuditEventResponseTargetType `json:"targetType"`
+	Target     string                       `json:"target"`
+	// Additional information about the action, which differs per action.
+	Details AuditEventResponseDetails `json:"details"`
+}
+
+// GetID returns the value of ID.
+func (s *AuditEventResponse) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetTimestamp returns the value of Timestamp.
+func (s *AuditEventResponse) GetTimestamp() time.Time {
+	return s.Timestamp
+}
+
+// GetAction returns the value of Action.
+func (s *AuditEventResponse) GetAction() string {
+	return s.Action
+}
+
+// GetActor returns the value of Actor.
+func (s *AuditEventResponse) GetActor() string {
+	return s.Actor
+}
+
+// GetSourceIp returns the value of SourceIp.
+func (s *AuditEventResponse) GetSourceIp() OptString {
+	return s.SourceIp
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *AuditEventResponse) GetTargetType() AuditEventResponseTargetType {
+	return s.TargetType
+}
+
+// GetTarget returns the value of Target.
+func (s *AuditEventResponse) GetTarget() string {
+	return s.Target
+}
+
+// GetDetails returns the value of Details.
+func (s *AuditEventResponse) GetDetails() AuditEventResponseDetails {
+	return s.Details
+}
+
+// SetID sets the value of ID.
+func (s *AuditEventResponse) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetTimestamp sets the value of Timestamp.
+func (s *AuditEventResponse) SetTimestamp(val time.Time) {
+	s.Timestamp = val
+}
+
+// SetAction sets the value of Action.
+func (s *AuditEventResponse) SetAction(val string) {
+	s.Action = val
+}
+
+// SetActor sets the value of Actor.
+func (s *AuditEventResponse) SetActor(val string) {
+	s.Actor = val
+}
+
+// SetSourceIp sets the value of SourceIp.
+func (s *AuditEventResponse) SetSourceIp(val OptString) {
+	s.SourceIp = val
+}
+
+// SetTargetType sets the value of TargetType.
+func (s *AuditEventResponse) SetTargetType(val AuditEventResponseTargetType) {
+	s.TargetType = val
+}
+
+// SetTarget sets the value of Target.
+func (s *AuditEventResponse) SetTarget(val string) {
+	s.Target = val
+}
+
+// SetDetails sets the value of Details.
+func (s *AuditEventResponse) SetDetails(val AuditEventResponseDetails) {
+	s.Details = val
+}
+
+// Additional information about the action, which differs per action.
+type AuditEventResponseDetails map[string]string
+
+func (s *AuditEventResponseDetails) init() AuditEventResponseDetails {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+type AuditEventResponseTargetType string
+
+const (
+	AuditEventResponseTargetTypeRepository AuditEventResponseTargetType = "repository"
+	AuditEventResponseTargetTypeNamespace  AuditEventResponseTargetType = "namespace"
+	AuditEventResponseTargetTypeToken      AuditEventResponseTargetType = "token"
+	AuditEventResponseTargetTypeTeam       AuditEventResponseTargetType = "team"
+	AuditEventResponseTargetTypeUser       AuditEventResponseTargetType = "user"
+	AuditEventResponseTargetTypeRegistry   AuditEventResponseTargetType = "registry"
+)
+
+// AllValues returns all AuditEventResponseTargetType values.
+func (AuditEventResponseTargetType) AllValues() []AuditEventResponseTargetType {
+	return []AuditEventResponseTargetType{
+		AuditEventResponseTargetTypeRepository,
+		AuditEventResponseTargetTypeNamespace,
+		AuditEventResponseTargetTypeToken,
+		AuditEventResponseTargetTypeTeam,
+		AuditEventResponseTargetTypeUser,
+		AuditEventResponseTargetTypeRegistry,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s AuditEventResponseTargetType) MarshalText() ([]byte, error) {
+	switch s {
+	case AuditEventResponseTargetTypeRepository:
+		return []byte(s), nil
+	case AuditEventResponseTargetTypeNamespace:
+		return []byte(s), nil
+	case AuditEventResponseTargetTypeToken:
+		return []byte(s), nil
+	case AuditEventResponseTargetTypeTeam:
+		return []byte(s), nil
+	case AuditEventResponseTargetTypeUser:
+		return []byte(s), nil
+	case AuditEventResponseTargetTypeRegistry:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuditEventResponseTargetType) UnmarshalText(data []byte) error {
+	switch AuditEventResponseTargetType(data) {
+	case AuditEventResponseTargetTypeRepository:
+		*s = AuditEventResponseTargetTypeRepository
+		return nil
+	case AuditEventResponseTargetTypeNamespace:
+		*s = AuditEventResponseTargetTypeNamespace
+		return nil
+	case AuditEventResponseTargetTypeToken:
+		*s = AuditEventResponseTargetTypeToken
+		return nil
+	case AuditEventResponseTargetTypeTeam:
+		*s = AuditEventResponseTargetTypeTeam
+		return nil
+	case AuditEventResponseTargetTypeUser:
+		*s = AuditEventResponseTargetTypeUser
+		return nil
+	case AuditEventResponseTargetTypeRegistry:
+		*s = AuditEventResponseTargetTypeRegistry
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CatalogResponse
+type CatalogResponse struct {
+	Repositories []string `json:"repositories"`
+}
+
+// GetRepositories returns the value of Repositories.
+func (s *CatalogResponse) GetRepositories() []string {
+	return s.Repositories
+}
+
+// SetRepositories sets the value of Repositories.
+func (s *CatalogResponse) SetRepositories(val []string) {
+	s.Repositories = val
+}
+
+// CatalogResponseHeaders wraps CatalogResponse with response headers.
+type CatalogResponseHeaders struct {
+	Link     OptString
+	Response CatalogResponse
+}
+
+// GetLink returns the value of Link.
+func (s *CatalogResponseHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetResponse returns the value of Response.
+func (s *CatalogResponseHeaders) GetResponse() CatalogResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *CatalogResponseHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetResponse sets the value of Response.
+func (s *CatalogResponseHeaders) SetResponse(val CatalogResponse) {
+	s.Response = val
+}
+
 // ChangeUserPasswordNoContent is response for ChangeUserPassword operation.
 type ChangeUserPasswordNoContent struct{}
 
+// DeleteNamespaceQuotaNoContent is response for DeleteNamespaceQuota operation.
+type DeleteNamespaceQuotaNoContent struct{}
+
 // DeletePersonalAccessTokenNoContent is response for DeletePersonalAccessToken operation.
 type DeletePersonalAccessTokenNoContent struct{}
 
@@ -26,6 +246,12 @@ type DeleteRepositoryNoContent struct{}
 // DeleteTeamNoContent is response for DeleteTeam operation.
 type DeleteTeamNoContent struct{}
 
+// DeleteTeamRobotNoContent is response for DeleteTeamRobot operation.
+type DeleteTeamRobotNoContent struct{}
+
+// DeleteTeamRobotTokenNoContent is response for DeleteTeamRobotToken operation.
+type DeleteTeamRobotTokenNoContent struct{}
+
 // DeleteUserNoContent is response for DeleteUser operation.
 type DeleteUserNoContent struct{}
 
@@ -70,118 +296,117 @@ func (s *ErrorStatusCode) SetResponse(val Error) {
 	s.Response = val
 }
 
-type PersonalAccessToken struct {
-	Token string
-}
-
-// GetToken returns the value of Token.
-func (s *PersonalAccessToken) GetToken() string {
-	return s.Token
-}
-
-// SetToken sets the value of Token.
-func (s *PersonalAccessToken) SetToken(val string) {
-	s.Token = val
+// GetPersonalAccessTokenUsageOKHeaders wraps []PersonalAccessTokenUsageResponse with response headers.
+type GetPersonalAccessTokenUsageOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []PersonalAccessTokenUsageResponse
 }
 
-// Merged schema.
-// Ref: #/components/schemas/PersonalAccessTokenCreationResponse
-type PersonalAccessTokenCreationResponse struct {
-	ID             uuid.UUID                                     `json:"id"`
-	CreatedAt      time.Time                                     `json:"createdAt"`
-	Description    string                                        `json:"description"`
-	Permission     PersonalAccessTokenCreationResponsePermission `json:"permission"`
-	ExpirationDate time.Time                                     `json:"expirationDate"`
-	// The newly generated plain-text token. This needs to be stored by the caller, since it cannot be
-	// retrieved afterwards.
-	Token string `json:"token"`
+// GetLink returns the value of Link.
+func (s *GetPersonalAccessTokenUsageOKHeaders) GetLink() OptString {
+	return s.Link
 }
 
-// GetID returns the value of ID.
-func (s *PersonalAccessTokenCreationResponse) GetID() uuid.UUID {
-	return s.ID
+// GetXNextCursor returns the value of XNextCursor.
+func (s *GetPersonalAccessTokenUsageOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *PersonalAccessTokenCreationResponse) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetResponse returns the value of Response.
+func (s *GetPersonalAccessTokenUsageOKHeaders) GetResponse() []PersonalAccessTokenUsageResponse {
+	return s.Response
 }
 
-// GetDescription returns the value of Description.
-func (s *PersonalAccessTokenCreationResponse) GetDescription() string {
-	return s.Description
+// SetLink sets the value of Link.
+func (s *GetPersonalAccessTokenUsageOKHeaders) SetLink(val OptString) {
+	s.Link = val
 }
 
-// GetPermission returns the value of Permission.
-func (s *PersonalAccessTokenCreationResponse) GetPermission() PersonalAccessTokenCreationResponsePermission {
-	return s.Permission
+// SetXNextCursor sets the value of XNextCursor.
+func (s *GetPersonalAccessTokenUsageOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
 }
 
-// GetExpirationDate returns the value of ExpirationDate.
-func (s *PersonalAccessTokenCreationResponse) GetExpirationDate() time.Time {
-	return s.ExpirationDate
+// SetResponse sets the value of Response.
+func (s *GetPersonalAccessTokenUsageOKHeaders) SetResponse(val []PersonalAccessTokenUsageResponse) {
+	s.Response = val
 }
 
-// GetToken returns the value of Token.
-func (s *PersonalAccessTokenCreationResponse) GetToken() string {
-	return s.Token
+// ListAuditEventsOKHeaders wraps []AuditEventResponse with response headers.
+type ListAuditEventsOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []AuditEventResponse
 }
 
-// SetID sets the value of ID.
-func (s *PersonalAccessTokenCreationResponse) SetID(val uuid.UUID) {
-	s.ID = val
+// GetLink returns the value of Link.
+func (s *ListAuditEventsOKHeaders) GetLink() OptString {
+	return s.Link
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *PersonalAccessTokenCreationResponse) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListAuditEventsOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
 }
 
-// SetDescription sets the value of Description.
-func (s *PersonalAccessTokenCreationResponse) SetDescription(val string) {
-	s.Description = val
+// GetResponse returns the value of Response.
+func (s *ListAuditEventsOKHeaders) GetResponse() []AuditEventResponse {
+	return s.Response
 }
 
-// SetPermission sets the value of Permission.
-func (s *PersonalAccessTokenCreationResponse) SetPermission(val PersonalAccessTokenCreationResponsePermission) {
-	s.Permission = val
+// SetLink sets the value of Link.
+func (s *ListAuditEventsOKHeaders) SetLink(val OptString) {
+	s.Link = val
 }
 
-// SetExpirationDate sets the value of ExpirationDate.
-func (s *PersonalAccessTokenCreationResponse) SetExpirationDate(val time.Time) {
-	s.ExpirationDate = val
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListAuditEventsOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
 }
 
-// SetToken sets the value of Token.
-func (s *PersonalAccessTokenCreationResponse) SetToken(val string) {
-	s.Token = val
+// SetResponse sets the value of Response.
+func (s *ListAuditEventsOKHeaders) SetResponse(val []AuditEventResponse) {
+	s.Response = val
 }
 
-type PersonalAccessTokenCreationResponsePermission string
+type ListAuditEventsTargetType string
 
 const (
-	PersonalAccessTokenCreationResponsePermissionReadOnly        PersonalAccessTokenCreationResponsePermission = "readOnly"
-	PersonalAccessTokenCreationResponsePermissionReadWrite       PersonalAccessTokenCreationResponsePermission = "readWrite"
-	PersonalAccessTokenCreationResponsePermissionReadWriteDelete PersonalAccessTokenCreationResponsePermission = "readWriteDelete"
+	ListAuditEventsTargetTypeRepository ListAuditEventsTargetType = "repository"
+	ListAuditEventsTargetTypeNamespace  ListAuditEventsTargetType = "namespace"
+	ListAuditEventsTargetTypeToken      ListAuditEventsTargetType = "token"
+	ListAuditEventsTargetTypeTeam       ListAuditEventsTargetType = "team"
+	ListAuditEventsTargetTypeUser       ListAuditEventsTargetType = "user"
+	ListAuditEventsTargetTypeRegistry   ListAuditEventsTargetType = "registry"
 )
 
-// AllValues returns all PersonalAccessTokenCreationResponsePermission values.
-func (PersonalAccessTokenCreationResponsePermission) AllValues() []PersonalAccessTokenCreationResponsePermission {
-	return []PersonalAccessTokenCreationResponsePermission{
-		PersonalAccessTokenCreationResponsePermissionReadOnly,
-		PersonalAccessTokenCreationResponsePermissionReadWrite,
-		PersonalAccessTokenCreationResponsePermissionReadWriteDelete,
+// AllValues returns all ListAuditEventsTargetType values.
+func (ListAuditEventsTargetType) AllValues() []ListAuditEventsTargetType {
+	return []ListAuditEventsTargetType{
+		ListAuditEventsTargetTypeRepository,
+		ListAuditEventsTargetTypeNamespace,
+		ListAuditEventsTargetTypeToken,
+		ListAuditEventsTargetTypeTeam,
+		ListAuditEventsTargetTypeUser,
+		ListAuditEventsTargetTypeRegistry,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s PersonalAccessTokenCreationResponsePermission) MarshalText() ([]byte, error) {
+func (s ListAuditEventsTargetType) MarshalText() ([]byte, error) {
 	switch s {
-	case PersonalAccessTokenCreationResponsePermissionReadOnly:
+	case ListAuditEventsTargetTypeRepository:
 		return []byte(s), nil
-	case PersonalAccessTokenCreationResponsePermissionReadWrite:
+	case ListAuditEventsTargetTypeNamespace:
 		return []byte(s), nil
-	case PersonalAccessTokenCreationResponsePermissionReadWriteDelete:
+	case ListAuditEventsTargetTypeToken:
+		return []byte(s), nil
+	case ListAuditEventsTargetTypeTeam:
+		return []byte(s), nil
+	case ListAuditEventsTargetTypeUser:
+		return []byte(s), nil
+	case ListAuditEventsTargetTypeRegistry:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -189,84 +414,52 @@ func (s PersonalAccessTokenCreationResponsePermission) MarshalText() ([]byte, er
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *PersonalAccessTokenCreationResponsePermission) UnmarshalText(data []byte) error {
-	switch PersonalAccessTokenCreationResponsePermission(data) {
-	case PersonalAccessTokenCreationResponsePermissionReadOnly:
-		*s = PersonalAccessTokenCreationResponsePermissionReadOnly
+func (s *ListAuditEventsTargetType) UnmarshalText(data []byte) error {
+	switch ListAuditEventsTargetType(data) {
+	case ListAuditEventsTargetTypeRepository:
+		*s = ListAuditEventsTargetTypeRepository
 		return nil
-	case PersonalAccessTokenCreationResponsePermissionReadWrite:
-		*s = PersonalAccessTokenCreationResponsePermissionReadWrite
+	case ListAuditEventsTargetTypeNamespace:
+		*s = ListAuditEventsTargetTypeNamespace
 		return nil
-	case PersonalAccessTokenCreationResponsePermissionReadWriteDelete:
-		*s = PersonalAccessTokenCreationResponsePermissionReadWriteDelete
+	case ListAuditEventsTargetTypeToken:
+		*s = ListAuditEventsTargetTypeToken
+		return nil
+	case ListAuditEventsTargetTypeTeam:
+		*s = ListAuditEventsTargetTypeTeam
+		return nil
+	case ListAuditEventsTargetTypeUser:
+		*s = ListAuditEventsTargetTypeUser
+		return nil
+	case ListAuditEventsTargetTypeRegistry:
+		*s = ListAuditEventsTargetTypeRegistry
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/PersonalAccessTokenRequest
-type PersonalAccessTokenRequest struct {
-	Description    string                               `json:"description"`
-	Permission     PersonalAccessTokenRequestPermission `json:"permission"`
-	ExpirationDate time.Time                            `json:"expirationDate"`
-}
-
-// GetDescription returns the value of Description.
-func (s *PersonalAccessTokenRequest) GetDescription() string {
-	return s.Description
-}
-
-// GetPermission returns the value of Permission.
-func (s *PersonalAccessTokenRequest) GetPermission() PersonalAccessTokenRequestPermission {
-	return s.Permission
-}
-
-// GetExpirationDate returns the value of ExpirationDate.
-func (s *PersonalAccessTokenRequest) GetExpirationDate() time.Time {
-	return s.ExpirationDate
-}
-
-// SetDescription sets the value of Description.
-func (s *PersonalAccessTokenRequest) SetDescription(val string) {
-	s.Description = val
-}
-
-// SetPermission sets the value of Permission.
-func (s *PersonalAccessTokenRequest) SetPermission(val PersonalAccessTokenRequestPermission) {
-	s.Permission = val
-}
-
-// SetExpirationDate sets the value of ExpirationDate.
-func (s *PersonalAccessTokenRequest) SetExpirationDate(val time.Time) {
-	s.ExpirationDate = val
-}
-
-type PersonalAccessTokenRequestPermission string
+type ListOrder string
 
 const (
-	PersonalAccessTokenRequestPermissionReadOnly        PersonalAccessTokenRequestPermission = "readOnly"
-	PersonalAccessTokenRequestPermissionReadWrite       PersonalAccessTokenRequestPermission = "readWrite"
-	PersonalAccessTokenRequestPermissionReadWriteDelete PersonalAccessTokenRequestPermission = "readWriteDelete"
+	ListOrderAsc  ListOrder = "asc"
+	ListOrderDesc ListOrder = "desc"
 )
 
-// AllValues returns all PersonalAccessTokenRequestPermission values.
-func (PersonalAccessTokenRequestPermission) AllValues() []PersonalAccessTokenRequestPermission {
-	return []PersonalAccessTokenRequestPermission{
-		PersonalAccessTokenRequestPermissionReadOnly,
-		PersonalAccessTokenRequestPermissionReadWrite,
-		PersonalAccessTokenRequestPermissionReadWriteDelete,
+// AllValues returns all ListOrder values.
+func (ListOrder) AllValues() []ListOrder {
+	return []ListOrder{
+		ListOrderAsc,
+		ListOrderDesc,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s PersonalAccessTokenRequestPermission) MarshalText() ([]byte, error) {
+func (s ListOrder) MarshalText() ([]byte, error) {
 	switch s {
-	case PersonalAccessTokenRequestPermissionReadOnly:
-		return []byte(s), nil
-	case PersonalAccessTokenRequestPermissionReadWrite:
+	case ListOrderAsc:
 		return []byte(s), nil
-	case PersonalAccessTokenRequestPermissionReadWriteDelete:
+	case ListOrderDesc:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -274,107 +467,3020 @@ func (s PersonalAccessTokenRequestPermission) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *PersonalAccessTokenRequestPermission) UnmarshalText(data []byte) error {
-	switch PersonalAccessTokenRequestPermission(data) {
-	case PersonalAccessTokenRequestPermissionReadOnly:
-		*s = PersonalAccessTokenRequestPermissionReadOnly
-		return nil
-	case PersonalAccessTokenRequestPermissionReadWrite:
-		*s = PersonalAccessTokenRequestPermissionReadWrite
+func (s *ListOrder) UnmarshalText(data []byte) error {
+	switch ListOrder(data) {
+	case ListOrderAsc:
+		*s = ListOrderAsc
 		return nil
-	case PersonalAccessTokenRequestPermissionReadWriteDelete:
-		*s = PersonalAccessTokenRequestPermissionReadWriteDelete
+	case ListOrderDesc:
+		*s = ListOrderDesc
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Merged schema.
-// Ref: #/components/schemas/PersonalAccessTokenResponse
-type PersonalAccessTokenResponse struct {
-	ID             uuid.UUID                             `json:"id"`
-	CreatedAt      time.Time                             `json:"createdAt"`
-	Description    string                                `json:"description"`
-	Permission     PersonalAccessTokenResponsePermission `json:"permission"`
-	ExpirationDate time.Time                             `json:"expirationDate"`
+// ListPersonalAccessTokensOKHeaders wraps []PersonalAccessTokenResponse with response headers.
+type ListPersonalAccessTokensOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []PersonalAccessTokenResponse
 }
 
-// GetID returns the value of ID.
-func (s *PersonalAccessTokenResponse) GetID() uuid.UUID {
-	return s.ID
+// GetLink returns the value of Link.
+func (s *ListPersonalAccessTokensOKHeaders) GetLink() OptString {
+	return s.Link
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *PersonalAccessTokenResponse) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListPersonalAccessTokensOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
 }
 
-// GetDescription returns the value of Description.
+// GetResponse returns the value of Response.
+func (s *ListPersonalAccessTokensOKHeaders) GetResponse() []PersonalAccessTokenResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListPersonalAccessTokensOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListPersonalAccessTokensOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListPersonalAccessTokensOKHeaders) SetResponse(val []PersonalAccessTokenResponse) {
+	s.Response = val
+}
+
+// ListRepositoriesOKHeaders wraps []RepositoryResponse with response headers.
+type ListRepositoriesOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []RepositoryResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListRepositoriesOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListRepositoriesOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetResponse returns the value of Response.
+func (s *ListRepositoriesOKHeaders) GetResponse() []RepositoryResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListRepositoriesOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListRepositoriesOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListRepositoriesOKHeaders) SetResponse(val []RepositoryResponse) {
+	s.Response = val
+}
+
+// ListRepositoryTagsOKHeaders wraps []TagResponse with response headers.
+type ListRepositoryTagsOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []TagResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListRepositoryTagsOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListRepositoryTagsOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetResponse returns the value of Response.
+func (s *ListRepositoryTagsOKHeaders) GetResponse() []TagResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListRepositoryTagsOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListRepositoryTagsOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListRepositoryTagsOKHeaders) SetResponse(val []TagResponse) {
+	s.Response = val
+}
+
+type ListSort string
+
+const (
+	ListSortName      ListSort = "name"
+	ListSortCreatedAt ListSort = "createdAt"
+)
+
+// AllValues returns all ListSort values.
+func (ListSort) AllValues() []ListSort {
+	return []ListSort{
+		ListSortName,
+		ListSortCreatedAt,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListSort) MarshalText() ([]byte, error) {
+	switch s {
+	case ListSortName:
+		return []byte(s), nil
+	case ListSortCreatedAt:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListSort) UnmarshalText(data []byte) error {
+	switch ListSort(data) {
+	case ListSortName:
+		*s = ListSortName
+		return nil
+	case ListSortCreatedAt:
+		*s = ListSortCreatedAt
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// ListTeamMembersOKHeaders wraps []TeamMemberResponse with response headers.
+type ListTeamMembersOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []TeamMemberResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListTeamMembersOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListTeamMembersOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetResponse returns the value of Response.
+func (s *ListTeamMembersOKHeaders) GetResponse() []TeamMemberResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListTeamMembersOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListTeamMembersOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListTeamMembersOKHeaders) SetResponse(val []TeamMemberResponse) {
+	s.Response = val
+}
+
+// ListTeamRobotTokensOKHeaders wraps []PersonalAccessTokenResponse with response headers.
+type ListTeamRobotTokensOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []PersonalAccessTokenResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListTeamRobotTokensOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListTeamRobotTokensOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetResponse returns the value of Response.
+func (s *ListTeamRobotTokensOKHeaders) GetResponse() []PersonalAccessTokenResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListTeamRobotTokensOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListTeamRobotTokensOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListTeamRobotTokensOKHeaders) SetResponse(val []PersonalAccessTokenResponse) {
+	s.Response = val
+}
+
+// ListTeamRobotsOKHeaders wraps []TeamRobotResponse with response headers.
+type ListTeamRobotsOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []TeamRobotResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListTeamRobotsOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListTeamRobotsOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetResponse returns the value of Response.
+func (s *ListTeamRobotsOKHeaders) GetResponse() []TeamRobotResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListTeamRobotsOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListTeamRobotsOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListTeamRobotsOKHeaders) SetResponse(val []TeamRobotResponse) {
+	s.Response = val
+}
+
+// ListTeamsOKHeaders wraps []TeamResponse with response headers.
+type ListTeamsOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []TeamResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListTeamsOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListTeamsOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetResponse returns the value of Response.
+func (s *ListTeamsOKHeaders) GetResponse() []TeamResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListTeamsOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListTeamsOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListTeamsOKHeaders) SetResponse(val []TeamResponse) {
+	s.Response = val
+}
+
+// ListUsersOKHeaders wraps []UserResponse with response headers.
+type ListUsersOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []UserResponse
+}
+
+// GetLink returns the value of Link.
+func (s *ListUsersOKHeaders) GetLink() OptString {
+	return s.Link
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListUsersOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetResponse returns the value of Response.
+func (s *ListUsersOKHeaders) GetResponse() []UserResponse {
+	return s.Response
+}
+
+// SetLink sets the value of Link.
+func (s *ListUsersOKHeaders) SetLink(val OptString) {
+	s.Link = val
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListUsersOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListUsersOKHeaders) SetResponse(val []UserResponse) {
+	s.Response = val
+}
+
+// Ref: #/components/schemas/NamespaceQuota
+type NamespaceQuota struct {
+	// The maximum number of repositories in the namespace, or zero for no limit.
+	Repositories int `json:"repositories"`
+	// The maximum total size of the repositories in the namespace, or zero for no limit.
+	Bytes int64 `json:"bytes"`
+}
+
+// GetRepositories returns the value of Repositories.
+func (s *NamespaceQuota) GetRepositories() int {
+	return s.Repositories
+}
+
+// GetBytes returns the value of Bytes.
+func (s *NamespaceQuota) GetBytes() int64 {
+	return s.Bytes
+}
+
+// SetRepositories sets the value of Repositories.
+func (s *NamespaceQuota) SetRepositories(val int) {
+	s.Repositories = val
+}
+
+// SetBytes sets the value of Bytes.
+func (s *NamespaceQuota) SetBytes(val int64) {
+	s.Bytes = val
+}
+
+// Ref: #/components/schemas/NamespaceSettings
+type NamespaceSettings struct {
+	// Create repositories automatically when they are pushed to for the first time.
+	AutoCreateRepositories bool `json:"autoCreateRepositories"`
+	// The visibility of automatically created repositories.
+	AutoCreateVisibility NamespaceSettingsAutoCreateVisibility `json:"autoCreateVisibility"`
+}
+
+// GetAutoCreateRepositories returns the value of AutoCreateRepositories.
+func (s *NamespaceSettings) GetAutoCreateRepositories() bool {
+	return s.AutoCreateRepositories
+}
+
+// GetAutoCreateVisibility returns the value of AutoCreateVisibility.
+func (s *NamespaceSettings) GetAutoCreateVisibility() NamespaceSettingsAutoCreateVisibility {
+	return s.AutoCreateVisibility
+}
+
+// SetAutoCreateRepositories sets the value of AutoCreateRepositories.
+func (s *NamespaceSettings) SetAutoCreateRepositories(val bool) {
+	s.AutoCreateRepositories = val
+}
+
+// SetAutoCreateVisibility sets the value of AutoCreateVisibility.
+func (s *NamespaceSettings) SetAutoCreateVisibility(val NamespaceSettingsAutoCreateVisibility) {
+	s.AutoCreateVisibility = val
+}
+
+// The visibility of automatically created repositories.
+type NamespaceSettingsAutoCreateVisibility string
+
+const (
+	NamespaceSettingsAutoCreateVisibilityPrivate NamespaceSettingsAutoCreateVisibility = "private"
+	NamespaceSettingsAutoCreateVisibilityPublic  NamespaceSettingsAutoCreateVisibility = "public"
+)
+
+// AllValues returns all NamespaceSettingsAutoCreateVisibility values.
+func (NamespaceSettingsAutoCreateVisibility) AllValues() []NamespaceSettingsAutoCreateVisibility {
+	return []NamespaceSettingsAutoCreateVisibility{
+		NamespaceSettingsAutoCreateVisibilityPrivate,
+		NamespaceSettingsAutoCreateVisibilityPublic,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s NamespaceSettingsAutoCreateVisibility) MarshalText() ([]byte, error) {
+	switch s {
+	case NamespaceSettingsAutoCreateVisibilityPrivate:
+		return []byte(s), nil
+	case NamespaceSettingsAutoCreateVisibilityPublic:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *NamespaceSettingsAutoCreateVisibility) UnmarshalText(data []byte) error {
+	switch NamespaceSettingsAutoCreateVisibility(data) {
+	case NamespaceSettingsAutoCreateVisibilityPrivate:
+		*s = NamespaceSettingsAutoCreateVisibilityPrivate
+		return nil
+	case NamespaceSettingsAutoCreateVisibilityPublic:
+		*s = NamespaceSettingsAutoCreateVisibilityPublic
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/NamespaceUsage
+type NamespaceUsage struct {
+	// The number of repositories in the namespace.
+	Repositories int `json:"repositories"`
+	// The total size of the distinct blobs and manifests pushed to the repositories in the namespace.
+	Bytes int64 `json:"bytes"`
+	// The maximum number of repositories in the namespace. Omitted if there is no limit.
+	RepositoryQuota OptInt `json:"repositoryQuota"`
+	// The maximum total size of the repositories in the namespace. Omitted if there is no limit.
+	BytesQuota OptInt64 `json:"bytesQuota"`
+}
+
+// GetRepositories returns the value of Repositories.
+func (s *NamespaceUsage) GetRepositories() int {
+	return s.Repositories
+}
+
+// GetBytes returns the value of Bytes.
+func (s *NamespaceUsage) GetBytes() int64 {
+	return s.Bytes
+}
+
+// GetRepositoryQuota returns the value of RepositoryQuota.
+func (s *NamespaceUsage) GetRepositoryQuota() OptInt {
+	return s.RepositoryQuota
+}
+
+// GetBytesQuota returns the value of BytesQuota.
+func (s *NamespaceUsage) GetBytesQuota() OptInt64 {
+	return s.BytesQuota
+}
+
+// SetRepositories sets the value of Repositories.
+func (s *NamespaceUsage) SetRepositories(val int) {
+	s.Repositories = val
+}
+
+// SetBytes sets the value of Bytes.
+func (s *NamespaceUsage) SetBytes(val int64) {
+	s.Bytes = val
+}
+
+// SetRepositoryQuota sets the value of RepositoryQuota.
+func (s *NamespaceUsage) SetRepositoryQuota(val OptInt) {
+	s.RepositoryQuota = val
+}
+
+// SetBytesQuota sets the value of BytesQuota.
+func (s *NamespaceUsage) SetBytesQuota(val OptInt64) {
+	s.BytesQuota = val
+}
+
+// Ref: #/components/schemas/OIDCConfigurationResponse
+type OIDCConfigurationResponse struct {
+	Issuer   string   `json:"issuer"`
+	ClientId string   `json:"clientId"`
+	Scopes   []string `json:"scopes"`
+}
+
+// GetIssuer returns the value of Issuer.
+func (s *OIDCConfigurationResponse) GetIssuer() string {
+	return s.Issuer
+}
+
+// GetClientId returns the value of ClientId.
+func (s *OIDCConfigurationResponse) GetClientId() string {
+	return s.ClientId
+}
+
+// GetScopes returns the value of Scopes.
+func (s *OIDCConfigurationResponse) GetScopes() []string {
+	return s.Scopes
+}
+
+// SetIssuer sets the value of Issuer.
+func (s *OIDCConfigurationResponse) SetIssuer(val string) {
+	s.Issuer = val
+}
+
+// SetClientId sets the value of ClientId.
+func (s *OIDCConfigurationResponse) SetClientId(val string) {
+	s.ClientId = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *OIDCConfigurationResponse) SetScopes(val []string) {
+	s.Scopes = val
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListAuditEventsTargetType returns new OptListAuditEventsTargetType with value set to v.
+func NewOptListAuditEventsTargetType(v ListAuditEventsTargetType) OptListAuditEventsTargetType {
+	return OptListAuditEventsTargetType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListAuditEventsTargetType is optional ListAuditEventsTargetType.
+type OptListAuditEventsTargetType struct {
+	Value ListAuditEventsTargetType
+	Set   bool
+}
+
+// IsSet returns true if OptListAuditEventsTargetType was set.
+func (o OptListAuditEventsTargetType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListAuditEventsTargetType) Reset() {
+	var v ListAuditEventsTargetType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListAuditEventsTargetType) SetTo(v ListAuditEventsTargetType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListAuditEventsTargetType) Get() (v ListAuditEventsTargetType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListAuditEventsTargetType) Or(d ListAuditEventsTargetType) ListAuditEventsTargetType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListOrder returns new OptListOrder with value set to v.
+func NewOptListOrder(v ListOrder) OptListOrder {
+	return OptListOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListOrder is optional ListOrder.
+type OptListOrder struct {
+	Value ListOrder
+	Set   bool
+}
+
+// IsSet returns true if OptListOrder was set.
+func (o OptListOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListOrder) Reset() {
+	var v ListOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListOrder) SetTo(v ListOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListOrder) Get() (v ListOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListOrder) Or(d ListOrder) ListOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListSort returns new OptListSort with value set to v.
+func NewOptListSort(v ListSort) OptListSort {
+	return OptListSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListSort is optional ListSort.
+type OptListSort struct {
+	Value ListSort
+	Set   bool
+}
+
+// IsSet returns true if OptListSort was set.
+func (o OptListSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListSort) Reset() {
+	var v ListSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListSort) SetTo(v ListSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListSort) Get() (v ListSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListSort) Or(d ListSort) ListSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRepositoryRequestLabels returns new OptRepositoryRequestLabels with value set to v.
+func NewOptRepositoryRequestLabels(v RepositoryRequestLabels) OptRepositoryRequestLabels {
+	return OptRepositoryRequestLabels{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRepositoryRequestLabels is optional RepositoryRequestLabels.
+type OptRepositoryRequestLabels struct {
+	Value RepositoryRequestLabels
+	Set   bool
+}
+
+// IsSet returns true if OptRepositoryRequestLabels was set.
+func (o OptRepositoryRequestLabels) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRepositoryRequestLabels) Reset() {
+	var v RepositoryRequestLabels
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRepositoryRequestLabels) SetTo(v RepositoryRequestLabels) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRepositoryRequestLabels) Get() (v RepositoryRequestLabels, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRepositoryRequestLabels) Or(d RepositoryRequestLabels) RepositoryRequestLabels {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRepositoryResponseLabels returns new OptRepositoryResponseLabels with value set to v.
+func NewOptRepositoryResponseLabels(v RepositoryResponseLabels) OptRepositoryResponseLabels {
+	return OptRepositoryResponseLabels{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRepositoryResponseLabels is optional RepositoryResponseLabels.
+type OptRepositoryResponseLabels struct {
+	Value RepositoryResponseLabels
+	Set   bool
+}
+
+// IsSet returns true if OptRepositoryResponseLabels was set.
+func (o OptRepositoryResponseLabels) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRepositoryResponseLabels) Reset() {
+	var v RepositoryResponseLabels
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRepositoryResponseLabels) SetTo(v RepositoryResponseLabels) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRepositoryResponseLabels) Get() (v RepositoryResponseLabels, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRepositoryResponseLabels) Or(d RepositoryResponseLabels) RepositoryResponseLabels {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRepositoryUpdateRequestLabels returns new OptRepositoryUpdateRequestLabels with value set to v.
+func NewOptRepositoryUpdateRequestLabels(v RepositoryUpdateRequestLabels) OptRepositoryUpdateRequestLabels {
+	return OptRepositoryUpdateRequestLabels{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRepositoryUpdateRequestLabels is optional RepositoryUpdateRequestLabels.
+type OptRepositoryUpdateRequestLabels struct {
+	Value RepositoryUpdateRequestLabels
+	Set   bool
+}
+
+// IsSet returns true if OptRepositoryUpdateRequestLabels was set.
+func (o OptRepositoryUpdateRequestLabels) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRepositoryUpdateRequestLabels) Reset() {
+	var v RepositoryUpdateRequestLabels
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRepositoryUpdateRequestLabels) SetTo(v RepositoryUpdateRequestLabels) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRepositoryUpdateRequestLabels) Get() (v RepositoryUpdateRequestLabels, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRepositoryUpdateRequestLabels) Or(d RepositoryUpdateRequestLabels) RepositoryUpdateRequestLabels {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRepositoryUpdateRequestVisibility returns new OptRepositoryUpdateRequestVisibility with value set to v.
+func NewOptRepositoryUpdateRequestVisibility(v RepositoryUpdateRequestVisibility) OptRepositoryUpdateRequestVisibility {
+	return OptRepositoryUpdateRequestVisibility{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRepositoryUpdateRequestVisibility is optional RepositoryUpdateRequestVisibility.
+type OptRepositoryUpdateRequestVisibility struct {
+	Value RepositoryUpdateRequestVisibility
+	Set   bool
+}
+
+// IsSet returns true if OptRepositoryUpdateRequestVisibility was set.
+func (o OptRepositoryUpdateRequestVisibility) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRepositoryUpdateRequestVisibility) Reset() {
+	var v RepositoryUpdateRequestVisibility
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRepositoryUpdateRequestVisibility) SetTo(v RepositoryUpdateRequestVisibility) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRepositoryUpdateRequestVisibility) Get() (v RepositoryUpdateRequestVisibility, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRepositoryUpdateRequestVisibility) Or(d RepositoryUpdateRequestVisibility) RepositoryUpdateRequestVisibility {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchCatalogOrder returns new OptSearchCatalogOrder with value set to v.
+func NewOptSearchCatalogOrder(v SearchCatalogOrder) OptSearchCatalogOrder {
+	return OptSearchCatalogOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchCatalogOrder is optional SearchCatalogOrder.
+type OptSearchCatalogOrder struct {
+	Value SearchCatalogOrder
+	Set   bool
+}
+
+// IsSet returns true if OptSearchCatalogOrder was set.
+func (o OptSearchCatalogOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchCatalogOrder) Reset() {
+	var v SearchCatalogOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchCatalogOrder) SetTo(v SearchCatalogOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchCatalogOrder) Get() (v SearchCatalogOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchCatalogOrder) Or(d SearchCatalogOrder) SearchCatalogOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchCatalogSort returns new OptSearchCatalogSort with value set to v.
+func NewOptSearchCatalogSort(v SearchCatalogSort) OptSearchCatalogSort {
+	return OptSearchCatalogSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchCatalogSort is optional SearchCatalogSort.
+type OptSearchCatalogSort struct {
+	Value SearchCatalogSort
+	Set   bool
+}
+
+// IsSet returns true if OptSearchCatalogSort was set.
+func (o OptSearchCatalogSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchCatalogSort) Reset() {
+	var v SearchCatalogSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchCatalogSort) SetTo(v SearchCatalogSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchCatalogSort) Get() (v SearchCatalogSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchCatalogSort) Or(d SearchCatalogSort) SearchCatalogSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+type PersonalAccessToken struct {
+	Token string
+}
+
+// GetToken returns the value of Token.
+func (s *PersonalAccessToken) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *PersonalAccessToken) SetToken(val string) {
+	s.Token = val
+}
+
+// Merged schema.
+// Ref: #/components/schemas/PersonalAccessTokenCreationResponse
+type PersonalAccessTokenCreationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	// The last time that the token was used to log in to the registry. Omitted if the token has never
+	// been used.
+	LastUsedAt OptDateTime `json:"lastUsedAt"`
+	// The IP address that the token was last used from. Omitted if the token has never been used.
+	LastUsedFrom OptString `json:"lastUsedFrom"`
+	// The time that the token was revoked. Omitted if the token has not been revoked.
+	RevokedAt   OptDateTime                                   `json:"revokedAt"`
+	Description string                                        `json:"description"`
+	Permission  PersonalAccessTokenCreationResponsePermission `json:"permission"`
+	// Optionally restricts the token to the given repositories, formatted as 'namespace/name'.
+	// Both parts may contain glob patterns, for example 'myteam/*'.
+	// If omitted, the token can be used for all repositories that the user has access to.
+	Repositories []string `json:"repositories"`
+	// The scopes that determine which operations of the API the token can be used for.
+	// If omitted, the token can only be used to log in to the registry.
+	Scopes []PersonalAccessTokenCreationResponseScopesItem `json:"scopes"`
+	// Optionally restricts the source IP addresses that the token can be used from to the given CIDR
+	// ranges, both for logging in to the registry and for the API.
+	// If omitted, the token can be used from any address.
+	AllowedCidrs []string `json:"allowedCidrs"`
+	// Every token has to expire, so the expiration date has to be in the future.
+	// It cannot be further in the future than the maximum token lifetime configured on the server, or
+	// the maximum token lifetime of any team that the owner of the token is a member of.
+	ExpirationDate time.Time `json:"expirationDate"`
+	// The newly generated plain-text token. This needs to be stored by the caller, since it cannot be
+	// retrieved afterwards.
+	Token string `json:"token"`
+}
+
+// GetID returns the value of ID.
+func (s *PersonalAccessTokenCreationResponse) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PersonalAccessTokenCreationResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *PersonalAccessTokenCreationResponse) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetLastUsedFrom returns the value of LastUsedFrom.
+func (s *PersonalAccessTokenCreationResponse) GetLastUsedFrom() OptString {
+	return s.LastUsedFrom
+}
+
+// GetRevokedAt returns the value of RevokedAt.
+func (s *PersonalAccessTokenCreationResponse) GetRevokedAt() OptDateTime {
+	return s.RevokedAt
+}
+
+// GetDescription returns the value of Description.
+func (s *PersonalAccessTokenCreationResponse) GetDescription() string {
+	return s.Description
+}
+
+// GetPermission returns the value of Permission.
+func (s *PersonalAccessTokenCreationResponse) GetPermission() PersonalAccessTokenCreationResponsePermission {
+	return s.Permission
+}
+
+// GetRepositories returns the value of Repositories.
+func (s *PersonalAccessTokenCreationResponse) GetRepositories() []string {
+	return s.Repositories
+}
+
+// GetScopes returns the value of Scopes.
+func (s *PersonalAccessTokenCreationResponse) GetScopes() []PersonalAccessTokenCreationResponseScopesItem {
+	return s.Scopes
+}
+
+// GetAllowedCidrs returns the value of AllowedCidrs.
+func (s *PersonalAccessTokenCreationResponse) GetAllowedCidrs() []string {
+	return s.AllowedCidrs
+}
+
+// GetExpirationDate returns the value of ExpirationDate.
+func (s *PersonalAccessTokenCreationResponse) GetExpirationDate() time.Time {
+	return s.ExpirationDate
+}
+
+// GetToken returns the value of Token.
+func (s *PersonalAccessTokenCreationResponse) GetToken() string {
+	return s.Token
+}
+
+// SetID sets the value of ID.
+func (s *PersonalAccessTokenCreationResponse) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PersonalAccessTokenCreationResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *PersonalAccessTokenCreationResponse) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetLastUsedFrom sets the value of LastUsedFrom.
+func (s *PersonalAccessTokenCreationResponse) SetLastUsedFrom(val OptString) {
+	s.LastUsedFrom = val
+}
+
+// SetRevokedAt sets the value of RevokedAt.
+func (s *PersonalAccessTokenCreationResponse) SetRevokedAt(val OptDateTime) {
+	s.RevokedAt = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PersonalAccessTokenCreationResponse) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetPermission sets the value of Permission.
+func (s *PersonalAccessTokenCreationResponse) SetPermission(val PersonalAccessTokenCreationResponsePermission) {
+	s.Permission = val
+}
+
+// SetRepositories sets the value of Repositories.
+func (s *PersonalAccessTokenCreationResponse) SetRepositories(val []string) {
+	s.Repositories = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *PersonalAccessTokenCreationResponse) SetScopes(val []PersonalAccessTokenCreationResponseScopesItem) {
+	s.Scopes = val
+}
+
+// SetAllowedCidrs sets the value of AllowedCidrs.
+func (s *PersonalAccessTokenCreationResponse) SetAllowedCidrs(val []string) {
+	s.AllowedCidrs = val
+}
+
+// SetExpirationDate sets the value of ExpirationDate.
+func (s *PersonalAccessTokenCreationResponse) SetExpirationDate(val time.Time) {
+	s.ExpirationDate = val
+}
+
+// SetToken sets the value of Token.
+func (s *PersonalAccessTokenCreationResponse) SetToken(val string) {
+	s.Token = val
+}
+
+type PersonalAccessTokenCreationResponsePermission string
+
+const (
+	PersonalAccessTokenCreationResponsePermissionReadOnly        PersonalAccessTokenCreationResponsePermission = "readOnly"
+	PersonalAccessTokenCreationResponsePermissionReadWrite       PersonalAccessTokenCreationResponsePermission = "readWrite"
+	PersonalAccessTokenCreationResponsePermissionReadWriteDelete PersonalAccessTokenCreationResponsePermission = "readWriteDelete"
+)
+
+// AllValues returns all PersonalAccessTokenCreationResponsePermission values.
+func (PersonalAccessTokenCreationResponsePermission) AllValues() []PersonalAccessTokenCreationResponsePermission {
+	return []PersonalAccessTokenCreationResponsePermission{
+		PersonalAccessTokenCreationResponsePermissionReadOnly,
+		PersonalAccessTokenCreationResponsePermissionReadWrite,
+		PersonalAccessTokenCreationResponsePermissionReadWriteDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PersonalAccessTokenCreationResponsePermission) MarshalText() ([]byte, error) {
+	switch s {
+	case PersonalAccessTokenCreationResponsePermissionReadOnly:
+		return []byte(s), nil
+	case PersonalAccessTokenCreationResponsePermissionReadWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenCreationResponsePermissionReadWriteDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PersonalAccessTokenCreationResponsePermission) UnmarshalText(data []byte) error {
+	switch PersonalAccessTokenCreationResponsePermission(data) {
+	case PersonalAccessTokenCreationResponsePermissionReadOnly:
+		*s = PersonalAccessTokenCreationResponsePermissionReadOnly
+		return nil
+	case PersonalAccessTokenCreationResponsePermissionReadWrite:
+		*s = PersonalAccessTokenCreationResponsePermissionReadWrite
+		return nil
+	case PersonalAccessTokenCreationResponsePermissionReadWriteDelete:
+		*s = PersonalAccessTokenCreationResponsePermissionReadWriteDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PersonalAccessTokenCreationResponseScopesItem string
+
+const (
+	PersonalAccessTokenCreationResponseScopesItemRepositoriesRead  PersonalAccessTokenCreationResponseScopesItem = "repositories:read"
+	PersonalAccessTokenCreationResponseScopesItemRepositoriesWrite PersonalAccessTokenCreationResponseScopesItem = "repositories:write"
+	PersonalAccessTokenCreationResponseScopesItemTokensRead        PersonalAccessTokenCreationResponseScopesItem = "tokens:read"
+	PersonalAccessTokenCreationResponseScopesItemTokensWrite       PersonalAccessTokenCreationResponseScopesItem = "tokens:write"
+	PersonalAccessTokenCreationResponseScopesItemTeamsRead         PersonalAccessTokenCreationResponseScopesItem = "teams:read"
+	PersonalAccessTokenCreationResponseScopesItemTeamsAdmin        PersonalAccessTokenCreationResponseScopesItem = "teams:admin"
+	PersonalAccessTokenCreationResponseScopesItemUsersRead         PersonalAccessTokenCreationResponseScopesItem = "users:read"
+	PersonalAccessTokenCreationResponseScopesItemUsersAdmin        PersonalAccessTokenCreationResponseScopesItem = "users:admin"
+	PersonalAccessTokenCreationResponseScopesItemAuditRead         PersonalAccessTokenCreationResponseScopesItem = "audit:read"
+)
+
+// AllValues returns all PersonalAccessTokenCreationResponseScopesItem values.
+func (PersonalAccessTokenCreationResponseScopesItem) AllValues() []PersonalAccessTokenCreationResponseScopesItem {
+	return []PersonalAccessTokenCreationResponseScopesItem{
+		PersonalAccessTokenCreationResponseScopesItemRepositoriesRead,
+		PersonalAccessTokenCreationResponseScopesItemRepositoriesWrite,
+		PersonalAccessTokenCreationResponseScopesItemTokensRead,
+		PersonalAccessTokenCreationResponseScopesItemTokensWrite,
+		PersonalAccessTokenCreationResponseScopesItemTeamsRead,
+		PersonalAccessTokenCreationResponseScopesItemTeamsAdmin,
+		PersonalAccessTokenCreationResponseScopesItemUsersRead,
+		PersonalAccessTokenCreationResponseScopesItemUsersAdmin,
+		PersonalAccessTokenCreationResponseScopesItemAuditRead,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PersonalAccessTokenCreationResponseScopesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case PersonalAccessTokenCreationResponseScopesItemRepositoriesRead:
+		return []byte(s), nil
+	case PersonalAccessTokenCreationResponseScopesItemRepositoriesWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenCreationResponseScopesItemTokensRead:
+		return []byte(s), nil
+	case PersonalAccessTokenCreationResponseScopesItemTokensWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenCreationResponseScopesItemTeamsRead:
+		return []byte(s), nil
+	case PersonalAccessTokenCreationResponseScopesItemTeamsAdmin:
+		return []byte(s), nil
+	case PersonalAccessTokenCreationResponseScopesItemUsersRead:
+		return []byte(s), nil
+	case PersonalAccessTokenCreationResponseScopesItemUsersAdmin:
+		return []byte(s), nil
+	case PersonalAccessTokenCreationResponseScopesItemAuditRead:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PersonalAccessTokenCreationResponseScopesItem) UnmarshalText(data []byte) error {
+	switch PersonalAccessTokenCreationResponseScopesItem(data) {
+	case PersonalAccessTokenCreationResponseScopesItemRepositoriesRead:
+		*s = PersonalAccessTokenCreationResponseScopesItemRepositoriesRead
+		return nil
+	case PersonalAccessTokenCreationResponseScopesItemRepositoriesWrite:
+		*s = PersonalAccessTokenCreationResponseScopesItemRepositoriesWrite
+		return nil
+	case PersonalAccessTokenCreationResponseScopesItemTokensRead:
+		*s = PersonalAccessTokenCreationResponseScopesItemTokensRead
+		return nil
+	case PersonalAccessTokenCreationResponseScopesItemTokensWrite:
+		*s = PersonalAccessTokenCreationResponseScopesItemTokensWrite
+		return nil
+	case PersonalAccessTokenCreationResponseScopesItemTeamsRead:
+		*s = PersonalAccessTokenCreationResponseScopesItemTeamsRead
+		return nil
+	case PersonalAccessTokenCreationResponseScopesItemTeamsAdmin:
+		*s = PersonalAccessTokenCreationResponseScopesItemTeamsAdmin
+		return nil
+	case PersonalAccessTokenCreationResponseScopesItemUsersRead:
+		*s = PersonalAccessTokenCreationResponseScopesItemUsersRead
+		return nil
+	case PersonalAccessTokenCreationResponseScopesItemUsersAdmin:
+		*s = PersonalAccessTokenCreationResponseScopesItemUsersAdmin
+		return nil
+	case PersonalAccessTokenCreationResponseScopesItemAuditRead:
+		*s = PersonalAccessTokenCreationResponseScopesItemAuditRead
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/PersonalAccessTokenDailyUsageResponse
+type PersonalAccessTokenDailyUsageResponse struct {
+	Day      time.Time `json:"day"`
+	SourceIp string    `json:"sourceIp"`
+	Count    int64     `json:"count"`
+}
+
+// GetDay returns the value of Day.
+func (s *PersonalAccessTokenDailyUsageResponse) GetDay() time.Time {
+	return s.Day
+}
+
+// GetSourceIp returns the value of SourceIp.
+func (s *PersonalAccessTokenDailyUsageResponse) GetSourceIp() string {
+	return s.SourceIp
+}
+
+// GetCount returns the value of Count.
+func (s *PersonalAccessTokenDailyUsageResponse) GetCount() int64 {
+	return s.Count
+}
+
+// SetDay sets the value of Day.
+func (s *PersonalAccessTokenDailyUsageResponse) SetDay(val time.Time) {
+	s.Day = val
+}
+
+// SetSourceIp sets the value of SourceIp.
+func (s *PersonalAccessTokenDailyUsageResponse) SetSourceIp(val string) {
+	s.SourceIp = val
+}
+
+// SetCount sets the value of Count.
+func (s *PersonalAccessTokenDailyUsageResponse) SetCount(val int64) {
+	s.Count = val
+}
+
+// Ref: #/components/schemas/PersonalAccessTokenRequest
+type PersonalAccessTokenRequest struct {
+	Description string                               `json:"description"`
+	Permission  PersonalAccessTokenRequestPermission `json:"permission"`
+	// Optionally restricts the token to the given repositories, formatted as 'namespace/name'.
+	// Both parts may contain glob patterns, for example 'myteam/*'.
+	// If omitted, the token can be used for all repositories that the user has access to.
+	Repositories []string `json:"repositories"`
+	// The scopes that determine which operations of the API the token can be used for.
+	// If omitted, the token can only be used to log in to the registry.
+	Scopes []PersonalAccessTokenRequestScopesItem `json:"scopes"`
+	// Optionally restricts the source IP addresses that the token can be used from to the given CIDR
+	// ranges, both for logging in to the registry and for the API.
+	// If omitted, the token can be used from any address.
+	AllowedCidrs []string `json:"allowedCidrs"`
+	// Every token has to expire, so the expiration date has to be in the future.
+	// It cannot be further in the future than the maximum token lifetime configured on the server, or
+	// the maximum token lifetime of any team that the owner of the token is a member of.
+	ExpirationDate time.Time `json:"expirationDate"`
+}
+
+// GetDescription returns the value of Description.
+func (s *PersonalAccessTokenRequest) GetDescription() string {
+	return s.Description
+}
+
+// GetPermission returns the value of Permission.
+func (s *PersonalAccessTokenRequest) GetPermission() PersonalAccessTokenRequestPermission {
+	return s.Permission
+}
+
+// GetRepositories returns the value of Repositories.
+func (s *PersonalAccessTokenRequest) GetRepositories() []string {
+	return s.Repositories
+}
+
+// GetScopes returns the value of Scopes.
+func (s *PersonalAccessTokenRequest) GetScopes() []PersonalAccessTokenRequestScopesItem {
+	return s.Scopes
+}
+
+// GetAllowedCidrs returns the value of AllowedCidrs.
+func (s *PersonalAccessTokenRequest) GetAllowedCidrs() []string {
+	return s.AllowedCidrs
+}
+
+// GetExpirationDate returns the value of ExpirationDate.
+func (s *PersonalAccessTokenRequest) GetExpirationDate() time.Time {
+	return s.ExpirationDate
+}
+
+// SetDescription sets the value of Description.
+func (s *PersonalAccessTokenRequest) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetPermission sets the value of Permission.
+func (s *PersonalAccessTokenRequest) SetPermission(val PersonalAccessTokenRequestPermission) {
+	s.Permission = val
+}
+
+// SetRepositories sets the value of Repositories.
+func (s *PersonalAccessTokenRequest) SetRepositories(val []string) {
+	s.Repositories = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *PersonalAccessTokenRequest) SetScopes(val []PersonalAccessTokenRequestScopesItem) {
+	s.Scopes = val
+}
+
+// SetAllowedCidrs sets the value of AllowedCidrs.
+func (s *PersonalAccessTokenRequest) SetAllowedCidrs(val []string) {
+	s.AllowedCidrs = val
+}
+
+// SetExpirationDate sets the value of ExpirationDate.
+func (s *PersonalAccessTokenRequest) SetExpirationDate(val time.Time) {
+	s.ExpirationDate = val
+}
+
+type PersonalAccessTokenRequestPermission string
+
+const (
+	PersonalAccessTokenRequestPermissionReadOnly        PersonalAccessTokenRequestPermission = "readOnly"
+	PersonalAccessTokenRequestPermissionReadWrite       PersonalAccessTokenRequestPermission = "readWrite"
+	PersonalAccessTokenRequestPermissionReadWriteDelete PersonalAccessTokenRequestPermission = "readWriteDelete"
+)
+
+// AllValues returns all PersonalAccessTokenRequestPermission values.
+func (PersonalAccessTokenRequestPermission) AllValues() []PersonalAccessTokenRequestPermission {
+	return []PersonalAccessTokenRequestPermission{
+		PersonalAccessTokenRequestPermissionReadOnly,
+		PersonalAccessTokenRequestPermissionReadWrite,
+		PersonalAccessTokenRequestPermissionReadWriteDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PersonalAccessTokenRequestPermission) MarshalText() ([]byte, error) {
+	switch s {
+	case PersonalAccessTokenRequestPermissionReadOnly:
+		return []byte(s), nil
+	case PersonalAccessTokenRequestPermissionReadWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenRequestPermissionReadWriteDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PersonalAccessTokenRequestPermission) UnmarshalText(data []byte) error {
+	switch PersonalAccessTokenRequestPermission(data) {
+	case PersonalAccessTokenRequestPermissionReadOnly:
+		*s = PersonalAccessTokenRequestPermissionReadOnly
+		return nil
+	case PersonalAccessTokenRequestPermissionReadWrite:
+		*s = PersonalAccessTokenRequestPermissionReadWrite
+		return nil
+	case PersonalAccessTokenRequestPermissionReadWriteDelete:
+		*s = PersonalAccessTokenRequestPermissionReadWriteDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PersonalAccessTokenRequestScopesItem string
+
+const (
+	PersonalAccessTokenRequestScopesItemRepositoriesRead  PersonalAccessTokenRequestScopesItem = "repositories:read"
+	PersonalAccessTokenRequestScopesItemRepositoriesWrite PersonalAccessTokenRequestScopesItem = "repositories:write"
+	PersonalAccessTokenRequestScopesItemTokensRead        PersonalAccessTokenRequestScopesItem = "tokens:read"
+	PersonalAccessTokenRequestScopesItemTokensWrite       PersonalAccessTokenRequestScopesItem = "tokens:write"
+	PersonalAccessTokenRequestScopesItemTeamsRead         PersonalAccessTokenRequestScopesItem = "teams:read"
+	PersonalAccessTokenRequestScopesItemTeamsAdmin        PersonalAccessTokenRequestScopesItem = "teams:admin"
+	PersonalAccessTokenRequestScopesItemUsersRead         PersonalAccessTokenRequestScopesItem = "users:read"
+	PersonalAccessTokenRequestScopesItemUsersAdmin        PersonalAccessTokenRequestScopesItem = "users:admin"
+	PersonalAccessTokenRequestScopesItemAuditRead         PersonalAccessTokenRequestScopesItem = "audit:read"
+)
+
+// AllValues returns all PersonalAccessTokenRequestScopesItem values.
+func (PersonalAccessTokenRequestScopesItem) AllValues() []PersonalAccessTokenRequestScopesItem {
+	return []PersonalAccessTokenRequestScopesItem{
+		PersonalAccessTokenRequestScopesItemRepositoriesRead,
+		PersonalAccessTokenRequestScopesItemRepositoriesWrite,
+		PersonalAccessTokenRequestScopesItemTokensRead,
+		PersonalAccessTokenRequestScopesItemTokensWrite,
+		PersonalAccessTokenRequestScopesItemTeamsRead,
+		PersonalAccessTokenRequestScopesItemTeamsAdmin,
+		PersonalAccessTokenRequestScopesItemUsersRead,
+		PersonalAccessTokenRequestScopesItemUsersAdmin,
+		PersonalAccessTokenRequestScopesItemAuditRead,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PersonalAccessTokenRequestScopesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case PersonalAccessTokenRequestScopesItemRepositoriesRead:
+		return []byte(s), nil
+	case PersonalAccessTokenRequestScopesItemRepositoriesWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenRequestScopesItemTokensRead:
+		return []byte(s), nil
+	case PersonalAccessTokenRequestScopesItemTokensWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenRequestScopesItemTeamsRead:
+		return []byte(s), nil
+	case PersonalAccessTokenRequestScopesItemTeamsAdmin:
+		return []byte(s), nil
+	case PersonalAccessTokenRequestScopesItemUsersRead:
+		return []byte(s), nil
+	case PersonalAccessTokenRequestScopesItemUsersAdmin:
+		return []byte(s), nil
+	case PersonalAccessTokenRequestScopesItemAuditRead:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PersonalAccessTokenRequestScopesItem) UnmarshalText(data []byte) error {
+	switch PersonalAccessTokenRequestScopesItem(data) {
+	case PersonalAccessTokenRequestScopesItemRepositoriesRead:
+		*s = PersonalAccessTokenRequestScopesItemRepositoriesRead
+		return nil
+	case PersonalAccessTokenRequestScopesItemRepositoriesWrite:
+		*s = PersonalAccessTokenRequestScopesItemRepositoriesWrite
+		return nil
+	case PersonalAccessTokenRequestScopesItemTokensRead:
+		*s = PersonalAccessTokenRequestScopesItemTokensRead
+		return nil
+	case PersonalAccessTokenRequestScopesItemTokensWrite:
+		*s = PersonalAccessTokenRequestScopesItemTokensWrite
+		return nil
+	case PersonalAccessTokenRequestScopesItemTeamsRead:
+		*s = PersonalAccessTokenRequestScopesItemTeamsRead
+		return nil
+	case PersonalAccessTokenRequestScopesItemTeamsAdmin:
+		*s = PersonalAccessTokenRequestScopesItemTeamsAdmin
+		return nil
+	case PersonalAccessTokenRequestScopesItemUsersRead:
+		*s = PersonalAccessTokenRequestScopesItemUsersRead
+		return nil
+	case PersonalAccessTokenRequestScopesItemUsersAdmin:
+		*s = PersonalAccessTokenRequestScopesItemUsersAdmin
+		return nil
+	case PersonalAccessTokenRequestScopesItemAuditRead:
+		*s = PersonalAccessTokenRequestScopesItemAuditRead
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Merged schema.
+// Ref: #/components/schemas/PersonalAccessTokenResponse
+type PersonalAccessTokenResponse struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	// The last time that the token was used to log in to the registry. Omitted if the token has never
+	// been used.
+	LastUsedAt OptDateTime `json:"lastUsedAt"`
+	// The IP address that the token was last used from. Omitted if the token has never been used.
+	LastUsedFrom OptString `json:"lastUsedFrom"`
+	// The time that the token was revoked. Omitted if the token has not been revoked.
+	RevokedAt   OptDateTime                           `json:"revokedAt"`
+	Description string                                `json:"description"`
+	Permission  PersonalAccessTokenResponsePermission `json:"permission"`
+	// Optionally restricts the token to the given repositories, formatted as 'namespace/name'.
+	// Both parts may contain glob patterns, for example 'myteam/*'.
+	// If omitted, the token can be used for all repositories that the user has access to.
+	Repositories []string `json:"repositories"`
+	// The scopes that determine which operations of the API the token can be used for.
+	// If omitted, the token can only be used to log in to the registry.
+	Scopes []PersonalAccessTokenResponseScopesItem `json:"scopes"`
+	// Optionally restricts the source IP addresses that the token can be used from to the given CIDR
+	// ranges, both for logging in to the registry and for the API.
+	// If omitted, the token can be used from any address.
+	AllowedCidrs []string `json:"allowedCidrs"`
+	// Every token has to expire, so the expiration date has to be in the future.
+	// It cannot be further in the future than the maximum token lifetime configured on the server, or
+	// the maximum token lifetime of any team that the owner of the token is a member of.
+	ExpirationDate time.Time `json:"expirationDate"`
+}
+
+// GetID returns the value of ID.
+func (s *PersonalAccessTokenResponse) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PersonalAccessTokenResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *PersonalAccessTokenResponse) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetLastUsedFrom returns the value of LastUsedFrom.
+func (s *PersonalAccessTokenResponse) GetLastUsedFrom() OptString {
+	return s.LastUsedFrom
+}
+
+// GetRevokedAt returns the value of RevokedAt.
+func (s *PersonalAccessTokenResponse) GetRevokedAt() OptDateTime {
+	return s.RevokedAt
+}
+
+// GetDescription returns the value of Description.
 func (s *PersonalAccessTokenResponse) GetDescription() string {
 	return s.Description
 }
 
 // GetPermission returns the value of Permission.
-func (s *PersonalAccessTokenResponse) GetPermission() PersonalAccessTokenResponsePermission {
+func (s *PersonalAccessTokenResponse) GetPermission() PersonalAccessTokenResponsePermission {
+	return s.Permission
+}
+
+// GetRepositories returns the value of Repositories.
+func (s *PersonalAccessTokenResponse) GetRepositories() []string {
+	return s.Repositories
+}
+
+// GetScopes returns the value of Scopes.
+func (s *PersonalAccessTokenResponse) GetScopes() []PersonalAccessTokenResponseScopesItem {
+	return s.Scopes
+}
+
+// GetAllowedCidrs returns the value of AllowedCidrs.
+func (s *PersonalAccessTokenResponse) GetAllowedCidrs() []string {
+	return s.AllowedCidrs
+}
+
+// GetExpirationDate returns the value of ExpirationDate.
+func (s *PersonalAccessTokenResponse) GetExpirationDate() time.Time {
+	return s.ExpirationDate
+}
+
+// SetID sets the value of ID.
+func (s *PersonalAccessTokenResponse) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PersonalAccessTokenResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *PersonalAccessTokenResponse) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetLastUsedFrom sets the value of LastUsedFrom.
+func (s *PersonalAccessTokenResponse) SetLastUsedFrom(val OptString) {
+	s.LastUsedFrom = val
+}
+
+// SetRevokedAt sets the value of RevokedAt.
+func (s *PersonalAccessTokenResponse) SetRevokedAt(val OptDateTime) {
+	s.RevokedAt = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PersonalAccessTokenResponse) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetPermission sets the value of Permission.
+func (s *PersonalAccessTokenResponse) SetPermission(val PersonalAccessTokenResponsePermission) {
+	s.Permission = val
+}
+
+// SetRepositories sets the value of Repositories.
+func (s *PersonalAccessTokenResponse) SetRepositories(val []string) {
+	s.Repositories = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *PersonalAccessTokenResponse) SetScopes(val []PersonalAccessTokenResponseScopesItem) {
+	s.Scopes = val
+}
+
+// SetAllowedCidrs sets the value of AllowedCidrs.
+func (s *PersonalAccessTokenResponse) SetAllowedCidrs(val []string) {
+	s.AllowedCidrs = val
+}
+
+// SetExpirationDate sets the value of ExpirationDate.
+func (s *PersonalAccessTokenResponse) SetExpirationDate(val time.Time) {
+	s.ExpirationDate = val
+}
+
+type PersonalAccessTokenResponsePermission string
+
+const (
+	PersonalAccessTokenResponsePermissionReadOnly        PersonalAccessTokenResponsePermission = "readOnly"
+	PersonalAccessTokenResponsePermissionReadWrite       PersonalAccessTokenResponsePermission = "readWrite"
+	PersonalAccessTokenResponsePermissionReadWriteDelete PersonalAccessTokenResponsePermission = "readWriteDelete"
+)
+
+// AllValues returns all PersonalAccessTokenResponsePermission values.
+func (PersonalAccessTokenResponsePermission) AllValues() []PersonalAccessTokenResponsePermission {
+	return []PersonalAccessTokenResponsePermission{
+		PersonalAccessTokenResponsePermissionReadOnly,
+		PersonalAccessTokenResponsePermissionReadWrite,
+		PersonalAccessTokenResponsePermissionReadWriteDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PersonalAccessTokenResponsePermission) MarshalText() ([]byte, error) {
+	switch s {
+	case PersonalAccessTokenResponsePermissionReadOnly:
+		return []byte(s), nil
+	case PersonalAccessTokenResponsePermissionReadWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenResponsePermissionReadWriteDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PersonalAccessTokenResponsePermission) UnmarshalText(data []byte) error {
+	switch PersonalAccessTokenResponsePermission(data) {
+	case PersonalAccessTokenResponsePermissionReadOnly:
+		*s = PersonalAccessTokenResponsePermissionReadOnly
+		return nil
+	case PersonalAccessTokenResponsePermissionReadWrite:
+		*s = PersonalAccessTokenResponsePermissionReadWrite
+		return nil
+	case PersonalAccessTokenResponsePermissionReadWriteDelete:
+		*s = PersonalAccessTokenResponsePermissionReadWriteDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PersonalAccessTokenResponseScopesItem string
+
+const (
+	PersonalAccessTokenResponseScopesItemRepositoriesRead  PersonalAccessTokenResponseScopesItem = "repositories:read"
+	PersonalAccessTokenResponseScopesItemRepositoriesWrite PersonalAccessTokenResponseScopesItem = "repositories:write"
+	PersonalAccessTokenResponseScopesItemTokensRead        PersonalAccessTokenResponseScopesItem = "tokens:read"
+	PersonalAccessTokenResponseScopesItemTokensWrite       PersonalAccessTokenResponseScopesItem = "tokens:write"
+	PersonalAccessTokenResponseScopesItemTeamsRead         PersonalAccessTokenResponseScopesItem = "teams:read"
+	PersonalAccessTokenResponseScopesItemTeamsAdmin        PersonalAccessTokenResponseScopesItem = "teams:admin"
+	PersonalAccessTokenResponseScopesItemUsersRead         PersonalAccessTokenResponseScopesItem = "users:read"
+	PersonalAccessTokenResponseScopesItemUsersAdmin        PersonalAccessTokenResponseScopesItem = "users:admin"
+	PersonalAccessTokenResponseScopesItemAuditRead         PersonalAccessTokenResponseScopesItem = "audit:read"
+)
+
+// AllValues returns all PersonalAccessTokenResponseScopesItem values.
+func (PersonalAccessTokenResponseScopesItem) AllValues() []PersonalAccessTokenResponseScopesItem {
+	return []PersonalAccessTokenResponseScopesItem{
+		PersonalAccessTokenResponseScopesItemRepositoriesRead,
+		PersonalAccessTokenResponseScopesItemRepositoriesWrite,
+		PersonalAccessTokenResponseScopesItemTokensRead,
+		PersonalAccessTokenResponseScopesItemTokensWrite,
+		PersonalAccessTokenResponseScopesItemTeamsRead,
+		PersonalAccessTokenResponseScopesItemTeamsAdmin,
+		PersonalAccessTokenResponseScopesItemUsersRead,
+		PersonalAccessTokenResponseScopesItemUsersAdmin,
+		PersonalAccessTokenResponseScopesItemAuditRead,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PersonalAccessTokenResponseScopesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case PersonalAccessTokenResponseScopesItemRepositoriesRead:
+		return []byte(s), nil
+	case PersonalAccessTokenResponseScopesItemRepositoriesWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenResponseScopesItemTokensRead:
+		return []byte(s), nil
+	case PersonalAccessTokenResponseScopesItemTokensWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenResponseScopesItemTeamsRead:
+		return []byte(s), nil
+	case PersonalAccessTokenResponseScopesItemTeamsAdmin:
+		return []byte(s), nil
+	case PersonalAccessTokenResponseScopesItemUsersRead:
+		return []byte(s), nil
+	case PersonalAccessTokenResponseScopesItemUsersAdmin:
+		return []byte(s), nil
+	case PersonalAccessTokenResponseScopesItemAuditRead:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PersonalAccessTokenResponseScopesItem) UnmarshalText(data []byte) error {
+	switch PersonalAccessTokenResponseScopesItem(data) {
+	case PersonalAccessTokenResponseScopesItemRepositoriesRead:
+		*s = PersonalAccessTokenResponseScopesItemRepositoriesRead
+		return nil
+	case PersonalAccessTokenResponseScopesItemRepositoriesWrite:
+		*s = PersonalAccessTokenResponseScopesItemRepositoriesWrite
+		return nil
+	case PersonalAccessTokenResponseScopesItemTokensRead:
+		*s = PersonalAccessTokenResponseScopesItemTokensRead
+		return nil
+	case PersonalAccessTokenResponseScopesItemTokensWrite:
+		*s = PersonalAccessTokenResponseScopesItemTokensWrite
+		return nil
+	case PersonalAccessTokenResponseScopesItemTeamsRead:
+		*s = PersonalAccessTokenResponseScopesItemTeamsRead
+		return nil
+	case PersonalAccessTokenResponseScopesItemTeamsAdmin:
+		*s = PersonalAccessTokenResponseScopesItemTeamsAdmin
+		return nil
+	case PersonalAccessTokenResponseScopesItemUsersRead:
+		*s = PersonalAccessTokenResponseScopesItemUsersRead
+		return nil
+	case PersonalAccessTokenResponseScopesItemUsersAdmin:
+		*s = PersonalAccessTokenResponseScopesItemUsersAdmin
+		return nil
+	case PersonalAccessTokenResponseScopesItemAuditRead:
+		*s = PersonalAccessTokenResponseScopesItemAuditRead
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/PersonalAccessTokenRotationRequest
+type PersonalAccessTokenRotationRequest struct {
+	// The number of seconds that the previous plain-text token keeps working after the rotation.
+	// Defaults to the grace period configured on the server, which is also the maximum.
+	GracePeriod OptInt `json:"gracePeriod"`
+}
+
+// GetGracePeriod returns the value of GracePeriod.
+func (s *PersonalAccessTokenRotationRequest) GetGracePeriod() OptInt {
+	return s.GracePeriod
+}
+
+// SetGracePeriod sets the value of GracePeriod.
+func (s *PersonalAccessTokenRotationRequest) SetGracePeriod(val OptInt) {
+	s.GracePeriod = val
+}
+
+// Merged schema.
+// Ref: #/components/schemas/PersonalAccessTokenRotationResponse
+type PersonalAccessTokenRotationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	// The last time that the token was used to log in to the registry. Omitted if the token has never
+	// been used.
+	LastUsedAt OptDateTime `json:"lastUsedAt"`
+	// The IP address that the token was last used from. Omitted if the token has never been used.
+	LastUsedFrom OptString `json:"lastUsedFrom"`
+	// The time that the token was revoked. Omitted if the token has not been revoked.
+	RevokedAt   OptDateTime                                   `json:"revokedAt"`
+	Description string                                        `json:"description"`
+	Permission  PersonalAccessTokenRotationResponsePermission `json:"permission"`
+	// Optionally restricts the token to the given repositories, formatted as 'namespace/name'.
+	// Both parts may contain glob patterns, for example 'myteam/*'.
+	// If omitted, the token can be used for all repositories that the user has access to.
+	Repositories []string `json:"repositories"`
+	// The scopes that determine which operations of the API the token can be used for.
+	// If omitted, the token can only be used to log in to the registry.
+	Scopes []PersonalAccessTokenRotationResponseScopesItem `json:"scopes"`
+	// Optionally restricts the source IP addresses that the token can be used from to the given CIDR
+	// ranges, both for logging in to the registry and for the API.
+	// If omitted, the token can be used from any address.
+	AllowedCidrs []string `json:"allowedCidrs"`
+	// Every token has to expire, so the expiration date has to be in the future.
+	// It cannot be further in the future than the maximum token lifetime configured on the server, or
+	// the maximum token lifetime of any team that the owner of the token is a member of.
+	ExpirationDate time.Time `json:"expirationDate"`
+	// The newly generated plain-text token. This needs to be stored by the caller, since it cannot be
+	// retrieved afterwards.
+	Token string `json:"token"`
+	// The time until which the previous plain-text token keeps working.
+	PreviousTokenExpiresAt time.Time `json:"previousTokenExpiresAt"`
+}
+
+// GetID returns the value of ID.
+func (s *PersonalAccessTokenRotationResponse) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PersonalAccessTokenRotationResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *PersonalAccessTokenRotationResponse) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetLastUsedFrom returns the value of LastUsedFrom.
+func (s *PersonalAccessTokenRotationResponse) GetLastUsedFrom() OptString {
+	return s.LastUsedFrom
+}
+
+// GetRevokedAt returns the value of RevokedAt.
+func (s *PersonalAccessTokenRotationResponse) GetRevokedAt() OptDateTime {
+	return s.RevokedAt
+}
+
+// GetDescription returns the value of Description.
+func (s *PersonalAccessTokenRotationResponse) GetDescription() string {
+	return s.Description
+}
+
+// GetPermission returns the value of Permission.
+func (s *PersonalAccessTokenRotationResponse) GetPermission() PersonalAccessTokenRotationResponsePermission {
+	return s.Permission
+}
+
+// GetRepositories returns the value of Repositories.
+func (s *PersonalAccessTokenRotationResponse) GetRepositories() []string {
+	return s.Repositories
+}
+
+// GetScopes returns the value of Scopes.
+func (s *PersonalAccessTokenRotationResponse) GetScopes() []PersonalAccessTokenRotationResponseScopesItem {
+	return s.Scopes
+}
+
+// GetAllowedCidrs returns the value of AllowedCidrs.
+func (s *PersonalAccessTokenRotationResponse) GetAllowedCidrs() []string {
+	return s.AllowedCidrs
+}
+
+// GetExpirationDate returns the value of ExpirationDate.
+func (s *PersonalAccessTokenRotationResponse) GetExpirationDate() time.Time {
+	return s.ExpirationDate
+}
+
+// GetToken returns the value of Token.
+func (s *PersonalAccessTokenRotationResponse) GetToken() string {
+	return s.Token
+}
+
+// GetPreviousTokenExpiresAt returns the value of PreviousTokenExpiresAt.
+func (s *PersonalAccessTokenRotationResponse) GetPreviousTokenExpiresAt() time.Time {
+	return s.PreviousTokenExpiresAt
+}
+
+// SetID sets the value of ID.
+func (s *PersonalAccessTokenRotationResponse) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PersonalAccessTokenRotationResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *PersonalAccessTokenRotationResponse) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetLastUsedFrom sets the value of LastUsedFrom.
+func (s *PersonalAccessTokenRotationResponse) SetLastUsedFrom(val OptString) {
+	s.LastUsedFrom = val
+}
+
+// SetRevokedAt sets the value of RevokedAt.
+func (s *PersonalAccessTokenRotationResponse) SetRevokedAt(val OptDateTime) {
+	s.RevokedAt = val
+}
+
+// SetDescription sets the value of Description.
+func (s *PersonalAccessTokenRotationResponse) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetPermission sets the value of Permission.
+func (s *PersonalAccessTokenRotationResponse) SetPermission(val PersonalAccessTokenRotationResponsePermission) {
+	s.Permission = val
+}
+
+// SetRepositories sets the value of Repositories.
+func (s *PersonalAccessTokenRotationResponse) SetRepositories(val []string) {
+	s.Repositories = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *PersonalAccessTokenRotationResponse) SetScopes(val []PersonalAccessTokenRotationResponseScopesItem) {
+	s.Scopes = val
+}
+
+// SetAllowedCidrs sets the value of AllowedCidrs.
+func (s *PersonalAccessTokenRotationResponse) SetAllowedCidrs(val []string) {
+	s.AllowedCidrs = val
+}
+
+// SetExpirationDate sets the value of ExpirationDate.
+func (s *PersonalAccessTokenRotationResponse) SetExpirationDate(val time.Time) {
+	s.ExpirationDate = val
+}
+
+// SetToken sets the value of Token.
+func (s *PersonalAccessTokenRotationResponse) SetToken(val string) {
+	s.Token = val
+}
+
+// SetPreviousTokenExpiresAt sets the value of PreviousTokenExpiresAt.
+func (s *PersonalAccessTokenRotationResponse) SetPreviousTokenExpiresAt(val time.Time) {
+	s.PreviousTokenExpiresAt = val
+}
+
+type PersonalAccessTokenRotationResponsePermission string
+
+const (
+	PersonalAccessTokenRotationResponsePermissionReadOnly        PersonalAccessTokenRotationResponsePermission = "readOnly"
+	PersonalAccessTokenRotationResponsePermissionReadWrite       PersonalAccessTokenRotationResponsePermission = "readWrite"
+	PersonalAccessTokenRotationResponsePermissionReadWriteDelete PersonalAccessTokenRotationResponsePermission = "readWriteDelete"
+)
+
+// AllValues returns all PersonalAccessTokenRotationResponsePermission values.
+func (PersonalAccessTokenRotationResponsePermission) AllValues() []PersonalAccessTokenRotationResponsePermission {
+	return []PersonalAccessTokenRotationResponsePermission{
+		PersonalAccessTokenRotationResponsePermissionReadOnly,
+		PersonalAccessTokenRotationResponsePermissionReadWrite,
+		PersonalAccessTokenRotationResponsePermissionReadWriteDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PersonalAccessTokenRotationResponsePermission) MarshalText() ([]byte, error) {
+	switch s {
+	case PersonalAccessTokenRotationResponsePermissionReadOnly:
+		return []byte(s), nil
+	case PersonalAccessTokenRotationResponsePermissionReadWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenRotationResponsePermissionReadWriteDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PersonalAccessTokenRotationResponsePermission) UnmarshalText(data []byte) error {
+	switch PersonalAccessTokenRotationResponsePermission(data) {
+	case PersonalAccessTokenRotationResponsePermissionReadOnly:
+		*s = PersonalAccessTokenRotationResponsePermissionReadOnly
+		return nil
+	case PersonalAccessTokenRotationResponsePermissionReadWrite:
+		*s = PersonalAccessTokenRotationResponsePermissionReadWrite
+		return nil
+	case PersonalAccessTokenRotationResponsePermissionReadWriteDelete:
+		*s = PersonalAccessTokenRotationResponsePermissionReadWriteDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type PersonalAccessTokenRotationResponseScopesItem string
+
+const (
+	PersonalAccessTokenRotationResponseScopesItemRepositoriesRead  PersonalAccessTokenRotationResponseScopesItem = "repositories:read"
+	PersonalAccessTokenRotationResponseScopesItemRepositoriesWrite PersonalAccessTokenRotationResponseScopesItem = "repositories:write"
+	PersonalAccessTokenRotationResponseScopesItemTokensRead        PersonalAccessTokenRotationResponseScopesItem = "tokens:read"
+	PersonalAccessTokenRotationResponseScopesItemTokensWrite       PersonalAccessTokenRotationResponseScopesItem = "tokens:write"
+	PersonalAccessTokenRotationResponseScopesItemTeamsRead         PersonalAccessTokenRotationResponseScopesItem = "teams:read"
+	PersonalAccessTokenRotationResponseScopesItemTeamsAdmin        PersonalAccessTokenRotationResponseScopesItem = "teams:admin"
+	PersonalAccessTokenRotationResponseScopesItemUsersRead         PersonalAccessTokenRotationResponseScopesItem = "users:read"
+	PersonalAccessTokenRotationResponseScopesItemUsersAdmin        PersonalAccessTokenRotationResponseScopesItem = "users:admin"
+	PersonalAccessTokenRotationResponseScopesItemAuditRead         PersonalAccessTokenRotationResponseScopesItem = "audit:read"
+)
+
+// AllValues returns all PersonalAccessTokenRotationResponseScopesItem values.
+func (PersonalAccessTokenRotationResponseScopesItem) AllValues() []PersonalAccessTokenRotationResponseScopesItem {
+	return []PersonalAccessTokenRotationResponseScopesItem{
+		PersonalAccessTokenRotationResponseScopesItemRepositoriesRead,
+		PersonalAccessTokenRotationResponseScopesItemRepositoriesWrite,
+		PersonalAccessTokenRotationResponseScopesItemTokensRead,
+		PersonalAccessTokenRotationResponseScopesItemTokensWrite,
+		PersonalAccessTokenRotationResponseScopesItemTeamsRead,
+		PersonalAccessTokenRotationResponseScopesItemTeamsAdmin,
+		PersonalAccessTokenRotationResponseScopesItemUsersRead,
+		PersonalAccessTokenRotationResponseScopesItemUsersAdmin,
+		PersonalAccessTokenRotationResponseScopesItemAuditRead,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PersonalAccessTokenRotationResponseScopesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case PersonalAccessTokenRotationResponseScopesItemRepositoriesRead:
+		return []byte(s), nil
+	case PersonalAccessTokenRotationResponseScopesItemRepositoriesWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenRotationResponseScopesItemTokensRead:
+		return []byte(s), nil
+	case PersonalAccessTokenRotationResponseScopesItemTokensWrite:
+		return []byte(s), nil
+	case PersonalAccessTokenRotationResponseScopesItemTeamsRead:
+		return []byte(s), nil
+	case PersonalAccessTokenRotationResponseScopesItemTeamsAdmin:
+		return []byte(s), nil
+	case PersonalAccessTokenRotationResponseScopesItemUsersRead:
+		return []byte(s), nil
+	case PersonalAccessTokenRotationResponseScopesItemUsersAdmin:
+		return []byte(s), nil
+	case PersonalAccessTokenRotationResponseScopesItemAuditRead:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PersonalAccessTokenRotationResponseScopesItem) UnmarshalText(data []byte) error {
+	switch PersonalAccessTokenRotationResponseScopesItem(data) {
+	case PersonalAccessTokenRotationResponseScopesItemRepositoriesRead:
+		*s = PersonalAccessTokenRotationResponseScopesItemRepositoriesRead
+		return nil
+	case PersonalAccessTokenRotationResponseScopesItemRepositoriesWrite:
+		*s = PersonalAccessTokenRotationResponseScopesItemRepositoriesWrite
+		return nil
+	case PersonalAccessTokenRotationResponseScopesItemTokensRead:
+		*s = PersonalAccessTokenRotationResponseScopesItemTokensRead
+		return nil
+	case PersonalAccessTokenRotationResponseScopesItemTokensWrite:
+		*s = PersonalAccessTokenRotationResponseScopesItemTokensWrite
+		return nil
+	case PersonalAccessTokenRotationResponseScopesItemTeamsRead:
+		*s = PersonalAccessTokenRotationResponseScopesItemTeamsRead
+		return nil
+	case PersonalAccessTokenRotationResponseScopesItemTeamsAdmin:
+		*s = PersonalAccessTokenRotationResponseScopesItemTeamsAdmin
+		return nil
+	case PersonalAccessTokenRotationResponseScopesItemUsersRead:
+		*s = PersonalAccessTokenRotationResponseScopesItemUsersRead
+		return nil
+	case PersonalAccessTokenRotationResponseScopesItemUsersAdmin:
+		*s = PersonalAccessTokenRotationResponseScopesItemUsersAdmin
+		return nil
+	case PersonalAccessTokenRotationResponseScopesItemAuditRead:
+		*s = PersonalAccessTokenRotationResponseScopesItemAuditRead
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/PersonalAccessTokenUsageResponse
+type PersonalAccessTokenUsageResponse struct {
+	Timestamp time.Time `json:"timestamp"`
+	SourceIp  string    `json:"sourceIp"`
+}
+
+// GetTimestamp returns the value of Timestamp.
+func (s *PersonalAccessTokenUsageResponse) GetTimestamp() time.Time {
+	return s.Timestamp
+}
+
+// GetSourceIp returns the value of SourceIp.
+func (s *PersonalAccessTokenUsageResponse) GetSourceIp() string {
+	return s.SourceIp
+}
+
+// SetTimestamp sets the value of Timestamp.
+func (s *PersonalAccessTokenUsageResponse) SetTimestamp(val time.Time) {
+	s.Timestamp = val
+}
+
+// SetSourceIp sets the value of SourceIp.
+func (s *PersonalAccessTokenUsageResponse) SetSourceIp(val string) {
+	s.SourceIp = val
+}
+
+// Ref: #/components/schemas/ProtectedTagRequest
+type ProtectedTagRequest struct {
+	// A glob pattern matching the tags to protect, where '*' matches any sequence of characters, '?'
+	// matches a
+	// single character and '[...]' matches a range of characters.
+	Pattern string `json:"pattern"`
+}
+
+// GetPattern returns the value of Pattern.
+func (s *ProtectedTagRequest) GetPattern() string {
+	return s.Pattern
+}
+
+// SetPattern sets the value of Pattern.
+func (s *ProtectedTagRequest) SetPattern(val string) {
+	s.Pattern = val
+}
+
+// Merged schema.
+// Ref: #/components/schemas/ProtectedTagResponse
+type ProtectedTagResponse struct {
+	CreatedAt time.Time `json:"createdAt"`
+	// A glob pattern matching the tags to protect, where '*' matches any sequence of characters, '?'
+	// matches a
+	// single character and '[...]' matches a range of characters.
+	Pattern string `json:"pattern"`
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ProtectedTagResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetPattern returns the value of Pattern.
+func (s *ProtectedTagResponse) GetPattern() string {
+	return s.Pattern
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ProtectedTagResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetPattern sets the value of Pattern.
+func (s *ProtectedTagResponse) SetPattern(val string) {
+	s.Pattern = val
+}
+
+// RemoveRepositoryCollaboratorNoContent is response for RemoveRepositoryCollaborator operation.
+type RemoveRepositoryCollaboratorNoContent struct{}
+
+type RemoveRepositoryCollaboratorType string
+
+const (
+	RemoveRepositoryCollaboratorTypeUser RemoveRepositoryCollaboratorType = "user"
+	RemoveRepositoryCollaboratorTypeTeam RemoveRepositoryCollaboratorType = "team"
+)
+
+// AllValues returns all RemoveRepositoryCollaboratorType values.
+func (RemoveRepositoryCollaboratorType) AllValues() []RemoveRepositoryCollaboratorType {
+	return []RemoveRepositoryCollaboratorType{
+		RemoveRepositoryCollaboratorTypeUser,
+		RemoveRepositoryCollaboratorTypeTeam,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RemoveRepositoryCollaboratorType) MarshalText() ([]byte, error) {
+	switch s {
+	case RemoveRepositoryCollaboratorTypeUser:
+		return []byte(s), nil
+	case RemoveRepositoryCollaboratorTypeTeam:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RemoveRepositoryCollaboratorType) UnmarshalText(data []byte) error {
+	switch RemoveRepositoryCollaboratorType(data) {
+	case RemoveRepositoryCollaboratorTypeUser:
+		*s = RemoveRepositoryCollaboratorTypeUser
+		return nil
+	case RemoveRepositoryCollaboratorTypeTeam:
+		*s = RemoveRepositoryCollaboratorTypeTeam
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// RemoveRepositoryProtectedTagNoContent is response for RemoveRepositoryProtectedTag operation.
+type RemoveRepositoryProtectedTagNoContent struct{}
+
+// RemoveTeamMemberNoContent is response for RemoveTeamMember operation.
+type RemoveTeamMemberNoContent struct{}
+
+// Ref: #/components/schemas/RepositoryCollaboratorRequest
+type RepositoryCollaboratorRequest struct {
+	Type RepositoryCollaboratorRequestType `json:"type"`
+	// The username or team name of the collaborator.
+	Name string `json:"name"`
+	// The permission granted to the collaborator. Each permission includes the permissions before it.
+	Permission RepositoryCollaboratorRequestPermission `json:"permission"`
+}
+
+// GetType returns the value of Type.
+func (s *RepositoryCollaboratorRequest) GetType() RepositoryCollaboratorRequestType {
+	return s.Type
+}
+
+// GetName returns the value of Name.
+func (s *RepositoryCollaboratorRequest) GetName() string {
+	return s.Name
+}
+
+// GetPermission returns the value of Permission.
+func (s *RepositoryCollaboratorRequest) GetPermission() RepositoryCollaboratorRequestPermission {
+	return s.Permission
+}
+
+// SetType sets the value of Type.
+func (s *RepositoryCollaboratorRequest) SetType(val RepositoryCollaboratorRequestType) {
+	s.Type = val
+}
+
+// SetName sets the value of Name.
+func (s *RepositoryCollaboratorRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetPermission sets the value of Permission.
+func (s *RepositoryCollaboratorRequest) SetPermission(val RepositoryCollaboratorRequestPermission) {
+	s.Permission = val
+}
+
+// The permission granted to the collaborator. Each permission includes the permissions before it.
+type RepositoryCollaboratorRequestPermission string
+
+const (
+	RepositoryCollaboratorRequestPermissionPull   RepositoryCollaboratorRequestPermission = "pull"
+	RepositoryCollaboratorRequestPermissionPush   RepositoryCollaboratorRequestPermission = "push"
+	RepositoryCollaboratorRequestPermissionDelete RepositoryCollaboratorRequestPermission = "delete"
+)
+
+// AllValues returns all RepositoryCollaboratorRequestPermission values.
+func (RepositoryCollaboratorRequestPermission) AllValues() []RepositoryCollaboratorRequestPermission {
+	return []RepositoryCollaboratorRequestPermission{
+		RepositoryCollaboratorRequestPermissionPull,
+		RepositoryCollaboratorRequestPermissionPush,
+		RepositoryCollaboratorRequestPermissionDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RepositoryCollaboratorRequestPermission) MarshalText() ([]byte, error) {
+	switch s {
+	case RepositoryCollaboratorRequestPermissionPull:
+		return []byte(s), nil
+	case RepositoryCollaboratorRequestPermissionPush:
+		return []byte(s), nil
+	case RepositoryCollaboratorRequestPermissionDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RepositoryCollaboratorRequestPermission) UnmarshalText(data []byte) error {
+	switch RepositoryCollaboratorRequestPermission(data) {
+	case RepositoryCollaboratorRequestPermissionPull:
+		*s = RepositoryCollaboratorRequestPermissionPull
+		return nil
+	case RepositoryCollaboratorRequestPermissionPush:
+		*s = RepositoryCollaboratorRequestPermissionPush
+		return nil
+	case RepositoryCollaboratorRequestPermissionDelete:
+		*s = RepositoryCollaboratorRequestPermissionDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type RepositoryCollaboratorRequestType string
+
+const (
+	RepositoryCollaboratorRequestTypeUser RepositoryCollaboratorRequestType = "user"
+	RepositoryCollaboratorRequestTypeTeam RepositoryCollaboratorRequestType = "team"
+)
+
+// AllValues returns all RepositoryCollaboratorRequestType values.
+func (RepositoryCollaboratorRequestType) AllValues() []RepositoryCollaboratorRequestType {
+	return []RepositoryCollaboratorRequestType{
+		RepositoryCollaboratorRequestTypeUser,
+		RepositoryCollaboratorRequestTypeTeam,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RepositoryCollaboratorRequestType) MarshalText() ([]byte, error) {
+	switch s {
+	case RepositoryCollaboratorRequestTypeUser:
+		return []byte(s), nil
+	case RepositoryCollaboratorRequestTypeTeam:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RepositoryCollaboratorRequestType) UnmarshalText(data []byte) error {
+	switch RepositoryCollaboratorRequestType(data) {
+	case RepositoryCollaboratorRequestTypeUser:
+		*s = RepositoryCollaboratorRequestTypeUser
+		return nil
+	case RepositoryCollaboratorRequestTypeTeam:
+		*s = RepositoryCollaboratorRequestTypeTeam
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Merged schema.
+// Ref: #/components/schemas/RepositoryCollaboratorResponse
+type RepositoryCollaboratorResponse struct {
+	CreatedAt time.Time                          `json:"createdAt"`
+	Type      RepositoryCollaboratorResponseType `json:"type"`
+	// The username or team name of the collaborator.
+	Name string `json:"name"`
+	// The permission granted to the collaborator. Each permission includes the permissions before it.
+	Permission RepositoryCollaboratorResponsePermission `json:"permission"`
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *RepositoryCollaboratorResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetType returns the value of Type.
+func (s *RepositoryCollaboratorResponse) GetType() RepositoryCollaboratorResponseType {
+	return s.Type
+}
+
+// GetName returns the value of Name.
+func (s *RepositoryCollaboratorResponse) GetName() string {
+	return s.Name
+}
+
+// GetPermission returns the value of Permission.
+func (s *RepositoryCollaboratorResponse) GetPermission() RepositoryCollaboratorResponsePermission {
 	return s.Permission
 }
 
-// GetExpirationDate returns the value of ExpirationDate.
-func (s *PersonalAccessTokenResponse) GetExpirationDate() time.Time {
-	return s.ExpirationDate
+// SetCreatedAt sets the value of CreatedAt.
+func (s *RepositoryCollaboratorResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetType sets the value of Type.
+func (s *RepositoryCollaboratorResponse) SetType(val RepositoryCollaboratorResponseType) {
+	s.Type = val
+}
+
+// SetName sets the value of Name.
+func (s *RepositoryCollaboratorResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetPermission sets the value of Permission.
+func (s *RepositoryCollaboratorResponse) SetPermission(val RepositoryCollaboratorResponsePermission) {
+	s.Permission = val
+}
+
+// The permission granted to the collaborator. Each permission includes the permissions before it.
+type RepositoryCollaboratorResponsePermission string
+
+const (
+	RepositoryCollaboratorResponsePermissionPull   RepositoryCollaboratorResponsePermission = "pull"
+	RepositoryCollaboratorResponsePermissionPush   RepositoryCollaboratorResponsePermission = "push"
+	RepositoryCollaboratorResponsePermissionDelete RepositoryCollaboratorResponsePermission = "delete"
+)
+
+// AllValues returns all RepositoryCollaboratorResponsePermission values.
+func (RepositoryCollaboratorResponsePermission) AllValues() []RepositoryCollaboratorResponsePermission {
+	return []RepositoryCollaboratorResponsePermission{
+		RepositoryCollaboratorResponsePermissionPull,
+		RepositoryCollaboratorResponsePermissionPush,
+		RepositoryCollaboratorResponsePermissionDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RepositoryCollaboratorResponsePermission) MarshalText() ([]byte, error) {
+	switch s {
+	case RepositoryCollaboratorResponsePermissionPull:
+		return []byte(s), nil
+	case RepositoryCollaboratorResponsePermissionPush:
+		return []byte(s), nil
+	case RepositoryCollaboratorResponsePermissionDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RepositoryCollaboratorResponsePermission) UnmarshalText(data []byte) error {
+	switch RepositoryCollaboratorResponsePermission(data) {
+	case RepositoryCollaboratorResponsePermissionPull:
+		*s = RepositoryCollaboratorResponsePermissionPull
+		return nil
+	case RepositoryCollaboratorResponsePermissionPush:
+		*s = RepositoryCollaboratorResponsePermissionPush
+		return nil
+	case RepositoryCollaboratorResponsePermissionDelete:
+		*s = RepositoryCollaboratorResponsePermissionDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type RepositoryCollaboratorResponseType string
+
+const (
+	RepositoryCollaboratorResponseTypeUser RepositoryCollaboratorResponseType = "user"
+	RepositoryCollaboratorResponseTypeTeam RepositoryCollaboratorResponseType = "team"
+)
+
+// AllValues returns all RepositoryCollaboratorResponseType values.
+func (RepositoryCollaboratorResponseType) AllValues() []RepositoryCollaboratorResponseType {
+	return []RepositoryCollaboratorResponseType{
+		RepositoryCollaboratorResponseTypeUser,
+		RepositoryCollaboratorResponseTypeTeam,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RepositoryCollaboratorResponseType) MarshalText() ([]byte, error) {
+	switch s {
+	case RepositoryCollaboratorResponseTypeUser:
+		return []byte(s), nil
+	case RepositoryCollaboratorResponseTypeTeam:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RepositoryCollaboratorResponseType) UnmarshalText(data []byte) error {
+	switch RepositoryCollaboratorResponseType(data) {
+	case RepositoryCollaboratorResponseTypeUser:
+		*s = RepositoryCollaboratorResponseTypeUser
+		return nil
+	case RepositoryCollaboratorResponseTypeTeam:
+		*s = RepositoryCollaboratorResponseTypeTeam
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/RepositoryRequest
+type RepositoryRequest struct {
+	Namespace string `json:"namespace"`
+	// The name of the repository within the namespace, which can consist of multiple path components
+	// separated by
+	// slashes. Each component must consist of lowercase alphanumeric characters, optionally separated by
+	// '.', '_',
+	// '__' or one or more '-'.
+	Name        string                      `json:"name"`
+	Visibility  RepositoryRequestVisibility `json:"visibility"`
+	Description OptString                   `json:"description"`
+	// Free-form key/value pairs to categorize the repository.
+	Labels OptRepositoryRequestLabels `json:"labels"`
+	// A Markdown document describing the repository.
+	Readme OptString `json:"readme"`
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *RepositoryRequest) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetName returns the value of Name.
+func (s *RepositoryRequest) GetName() string {
+	return s.Name
+}
+
+// GetVisibility returns the value of Visibility.
+func (s *RepositoryRequest) GetVisibility() RepositoryRequestVisibility {
+	return s.Visibility
+}
+
+// GetDescription returns the value of Description.
+func (s *RepositoryRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// GetLabels returns the value of Labels.
+func (s *RepositoryRequest) GetLabels() OptRepositoryRequestLabels {
+	return s.Labels
+}
+
+// GetReadme returns the value of Readme.
+func (s *RepositoryRequest) GetReadme() OptString {
+	return s.Readme
+}
+
+// SetNamespace sets the value of Namespace.
+func (s *RepositoryRequest) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetName sets the value of Name.
+func (s *RepositoryRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetVisibility sets the value of Visibility.
+func (s *RepositoryRequest) SetVisibility(val RepositoryRequestVisibility) {
+	s.Visibility = val
+}
+
+// SetDescription sets the value of Description.
+func (s *RepositoryRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *RepositoryRequest) SetLabels(val OptRepositoryRequestLabels) {
+	s.Labels = val
+}
+
+// SetReadme sets the value of Readme.
+func (s *RepositoryRequest) SetReadme(val OptString) {
+	s.Readme = val
+}
+
+// Free-form key/value pairs to categorize the repository.
+type RepositoryRequestLabels map[string]string
+
+func (s *RepositoryRequestLabels) init() RepositoryRequestLabels {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+type RepositoryRequestVisibility string
+
+const (
+	RepositoryRequestVisibilityPrivate RepositoryRequestVisibility = "private"
+	RepositoryRequestVisibilityPublic  RepositoryRequestVisibility = "public"
+)
+
+// AllValues returns all RepositoryRequestVisibility values.
+func (RepositoryRequestVisibility) AllValues() []RepositoryRequestVisibility {
+	return []RepositoryRequestVisibility{
+		RepositoryRequestVisibilityPrivate,
+		RepositoryRequestVisibilityPublic,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RepositoryRequestVisibility) MarshalText() ([]byte, error) {
+	switch s {
+	case RepositoryRequestVisibilityPrivate:
+		return []byte(s), nil
+	case RepositoryRequestVisibilityPublic:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RepositoryRequestVisibility) UnmarshalText(data []byte) error {
+	switch RepositoryRequestVisibility(data) {
+	case RepositoryRequestVisibilityPrivate:
+		*s = RepositoryRequestVisibilityPrivate
+		return nil
+	case RepositoryRequestVisibilityPublic:
+		*s = RepositoryRequestVisibilityPublic
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Merged schema.
+// Ref: #/components/schemas/RepositoryResponse
+type RepositoryResponse struct {
+	ID uuid.UUID `json:"id"`
+	// The ID of the user that created the repository, if known. Repositories that are created
+	// automatically on
+	// their first push are created by the user of the personal access token.
+	CreatedBy OptUUID   `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+	Namespace string    `json:"namespace"`
+	// The name of the repository within the namespace, which can consist of multiple path components
+	// separated by
+	// slashes. Each component must consist of lowercase alphanumeric characters, optionally separated by
+	// '.', '_',
+	// '__' or one or more '-'.
+	Name        string                       `json:"name"`
+	Visibility  RepositoryResponseVisibility `json:"visibility"`
+	Description OptString                    `json:"description"`
+	// Free-form key/value pairs to categorize the repository.
+	Labels OptRepositoryResponseLabels `json:"labels"`
+	// A Markdown document describing the repository.
+	Readme OptString `json:"readme"`
+}
+
+// GetID returns the value of ID.
+func (s *RepositoryResponse) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *RepositoryResponse) GetCreatedBy() OptUUID {
+	return s.CreatedBy
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *RepositoryResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetNamespace returns the value of Namespace.
+func (s *RepositoryResponse) GetNamespace() string {
+	return s.Namespace
+}
+
+// GetName returns the value of Name.
+func (s *RepositoryResponse) GetName() string {
+	return s.Name
+}
+
+// GetVisibility returns the value of Visibility.
+func (s *RepositoryResponse) GetVisibility() RepositoryResponseVisibility {
+	return s.Visibility
+}
+
+// GetDescription returns the value of Description.
+func (s *RepositoryResponse) GetDescription() OptString {
+	return s.Description
+}
+
+// GetLabels returns the value of Labels.
+func (s *RepositoryResponse) GetLabels() OptRepositoryResponseLabels {
+	return s.Labels
+}
+
+// GetReadme returns the value of Readme.
+func (s *RepositoryResponse) GetReadme() OptString {
+	return s.Readme
 }
 
 // SetID sets the value of ID.
-func (s *PersonalAccessTokenResponse) SetID(val uuid.UUID) {
+func (s *RepositoryResponse) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
+// SetCreatedBy sets the value of CreatedBy.
+func (s *RepositoryResponse) SetCreatedBy(val OptUUID) {
+	s.CreatedBy = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
-func (s *PersonalAccessTokenResponse) SetCreatedAt(val time.Time) {
+func (s *RepositoryResponse) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
+// SetNamespace sets the value of Namespace.
+func (s *RepositoryResponse) SetNamespace(val string) {
+	s.Namespace = val
+}
+
+// SetName sets the value of Name.
+func (s *RepositoryResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetVisibility sets the value of Visibility.
+func (s *RepositoryResponse) SetVisibility(val RepositoryResponseVisibility) {
+	s.Visibility = val
+}
+
 // SetDescription sets the value of Description.
-func (s *PersonalAccessTokenResponse) SetDescription(val string) {
+func (s *RepositoryResponse) SetDescription(val OptString) {
 	s.Description = val
 }
 
-// SetPermission sets the value of Permission.
-func (s *PersonalAccessTokenResponse) SetPermission(val PersonalAccessTokenResponsePermission) {
-	s.Permission = val
+// SetLabels sets the value of Labels.
+func (s *RepositoryResponse) SetLabels(val OptRepositoryResponseLabels) {
+	s.Labels = val
 }
 
-// SetExpirationDate sets the value of ExpirationDate.
-func (s *PersonalAccessTokenResponse) SetExpirationDate(val time.Time) {
-	s.ExpirationDate = val
+// SetReadme sets the value of Readme.
+func (s *RepositoryResponse) SetReadme(val OptString) {
+	s.Readme = val
 }
 
-type PersonalAccessTokenResponsePermission string
+// Free-form key/value pairs to categorize the repository.
+type RepositoryResponseLabels map[string]string
 
-const (
-	PersonalAccessTokenResponsePermissionReadOnly        PersonalAccessTokenResponsePermission = "readOnly"
-	PersonalAccessTokenResponsePermissionReadWrite       PersonalAccessTokenResponsePermission = "readWrite"
-	PersonalAccessTokenResponsePermissionReadWriteDelete PersonalAccessTokenResponsePermission = "readWriteDelete"
+func (s *RepositoryResponseLabels) init() RepositoryResponseLabels {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+type RepositoryResponseVisibility string
+
+const (
+	RepositoryResponseVisibilityPrivate RepositoryResponseVisibility = "private"
+	RepositoryResponseVisibilityPublic  RepositoryResponseVisibility = "public"
 )
 
-// AllValues returns all PersonalAccessTokenResponsePermission values.
-func (PersonalAccessTokenResponsePermission) AllValues() []PersonalAccessTokenResponsePermission {
-	return []PersonalAccessTokenResponsePermission{
-		PersonalAccessTokenResponsePermissionReadOnly,
-		PersonalAccessTokenResponsePermissionReadWrite,
-		PersonalAccessTokenResponsePermissionReadWriteDelete,
+// AllValues returns all RepositoryResponseVisibility values.
+func (RepositoryResponseVisibility) AllValues() []RepositoryResponseVisibility {
+	return []RepositoryResponseVisibility{
+		RepositoryResponseVisibilityPrivate,
+		RepositoryResponseVisibilityPublic,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s PersonalAccessTokenResponsePermission) MarshalText() ([]byte, error) {
+func (s RepositoryResponseVisibility) MarshalText() ([]byte, error) {
 	switch s {
-	case PersonalAccessTokenResponsePermissionReadOnly:
-		return []byte(s), nil
-	case PersonalAccessTokenResponsePermissionReadWrite:
+	case RepositoryResponseVisibilityPrivate:
 		return []byte(s), nil
-	case PersonalAccessTokenResponsePermissionReadWriteDelete:
+	case RepositoryResponseVisibilityPublic:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -382,83 +3488,124 @@ func (s PersonalAccessTokenResponsePermission) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *PersonalAccessTokenResponsePermission) UnmarshalText(data []byte) error {
-	switch PersonalAccessTokenResponsePermission(data) {
-	case PersonalAccessTokenResponsePermissionReadOnly:
-		*s = PersonalAccessTokenResponsePermissionReadOnly
-		return nil
-	case PersonalAccessTokenResponsePermissionReadWrite:
-		*s = PersonalAccessTokenResponsePermissionReadWrite
+func (s *RepositoryResponseVisibility) UnmarshalText(data []byte) error {
+	switch RepositoryResponseVisibility(data) {
+	case RepositoryResponseVisibilityPrivate:
+		*s = RepositoryResponseVisibilityPrivate
 		return nil
-	case PersonalAccessTokenResponsePermissionReadWriteDelete:
-		*s = PersonalAccessTokenResponsePermissionReadWriteDelete
+	case RepositoryResponseVisibilityPublic:
+		*s = RepositoryResponseVisibilityPublic
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// RemoveTeamMemberNoContent is response for RemoveTeamMember operation.
-type RemoveTeamMemberNoContent struct{}
-
-// Ref: #/components/schemas/RepositoryRequest
-type RepositoryRequest struct {
-	Namespace  string                      `json:"namespace"`
-	Name       string                      `json:"name"`
-	Visibility RepositoryRequestVisibility `json:"visibility"`
+// Ref: #/components/schemas/RepositoryUpdateRequest
+type RepositoryUpdateRequest struct {
+	Namespace   OptString                            `json:"namespace"`
+	Name        OptString                            `json:"name"`
+	Visibility  OptRepositoryUpdateRequestVisibility `json:"visibility"`
+	Description OptString                            `json:"description"`
+	// Free-form key/value pairs to categorize the repository.
+	Labels OptRepositoryUpdateRequestLabels `json:"labels"`
+	// A Markdown document describing the repository.
+	Readme OptString `json:"readme"`
 }
 
 // GetNamespace returns the value of Namespace.
-func (s *RepositoryRequest) GetNamespace() string {
+func (s *RepositoryUpdateRequest) GetNamespace() OptString {
 	return s.Namespace
 }
 
 // GetName returns the value of Name.
-func (s *RepositoryRequest) GetName() string {
+func (s *RepositoryUpdateRequest) GetName() OptString {
 	return s.Name
 }
 
 // GetVisibility returns the value of Visibility.
-func (s *RepositoryRequest) GetVisibility() RepositoryRequestVisibility {
+func (s *RepositoryUpdateRequest) GetVisibility() OptRepositoryUpdateRequestVisibility {
 	return s.Visibility
 }
 
+// GetDescription returns the value of Description.
+func (s *RepositoryUpdateRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// GetLabels returns the value of Labels.
+func (s *RepositoryUpdateRequest) GetLabels() OptRepositoryUpdateRequestLabels {
+	return s.Labels
+}
+
+// GetReadme returns the value of Readme.
+func (s *RepositoryUpdateRequest) GetReadme() OptString {
+	return s.Readme
+}
+
 // SetNamespace sets the value of Namespace.
-func (s *RepositoryRequest) SetNamespace(val string) {
+func (s *RepositoryUpdateRequest) SetNamespace(val OptString) {
 	s.Namespace = val
 }
 
 // SetName sets the value of Name.
-func (s *RepositoryRequest) SetName(val string) {
+func (s *RepositoryUpdateRequest) SetName(val OptString) {
 	s.Name = val
 }
 
 // SetVisibility sets the value of Visibility.
-func (s *RepositoryRequest) SetVisibility(val RepositoryRequestVisibility) {
+func (s *RepositoryUpdateRequest) SetVisibility(val OptRepositoryUpdateRequestVisibility) {
 	s.Visibility = val
 }
 
-type RepositoryRequestVisibility string
+// SetDescription sets the value of Description.
+func (s *RepositoryUpdateRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetLabels sets the value of Labels.
+func (s *RepositoryUpdateRequest) SetLabels(val OptRepositoryUpdateRequestLabels) {
+	s.Labels = val
+}
+
+// SetReadme sets the value of Readme.
+func (s *RepositoryUpdateRequest) SetReadme(val OptString) {
+	s.Readme = val
+}
+
+// Free-form key/value pairs to categorize the repository.
+type RepositoryUpdateRequestLabels map[string]string
+
+func (s *RepositoryUpdateRequestLabels) init() RepositoryUpdateRequestLabels {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+type RepositoryUpdateRequestVisibility string
 
 const (
-	RepositoryRequestVisibilityPrivate RepositoryRequestVisibility = "private"
-	RepositoryRequestVisibilityPublic  RepositoryRequestVisibility = "public"
+	RepositoryUpdateRequestVisibilityPrivate RepositoryUpdateRequestVisibility = "private"
+	RepositoryUpdateRequestVisibilityPublic  RepositoryUpdateRequestVisibility = "public"
 )
 
-// AllValues returns all RepositoryRequestVisibility values.
-func (RepositoryRequestVisibility) AllValues() []RepositoryRequestVisibility {
-	return []RepositoryRequestVisibility{
-		RepositoryRequestVisibilityPrivate,
-		RepositoryRequestVisibilityPublic,
+// AllValues returns all RepositoryUpdateRequestVisibility values.
+func (RepositoryUpdateRequestVisibility) AllValues() []RepositoryUpdateRequestVisibility {
+	return []RepositoryUpdateRequestVisibility{
+		RepositoryUpdateRequestVisibilityPrivate,
+		RepositoryUpdateRequestVisibilityPublic,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s RepositoryRequestVisibility) MarshalText() ([]byte, error) {
+func (s RepositoryUpdateRequestVisibility) MarshalText() ([]byte, error) {
 	switch s {
-	case RepositoryRequestVisibilityPrivate:
+	case RepositoryUpdateRequestVisibilityPrivate:
 		return []byte(s), nil
-	case RepositoryRequestVisibilityPublic:
+	case RepositoryUpdateRequestVisibilityPublic:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -466,100 +3613,122 @@ func (s RepositoryRequestVisibility) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *RepositoryRequestVisibility) UnmarshalText(data []byte) error {
-	switch RepositoryRequestVisibility(data) {
-	case RepositoryRequestVisibilityPrivate:
-		*s = RepositoryRequestVisibilityPrivate
+func (s *RepositoryUpdateRequestVisibility) UnmarshalText(data []byte) error {
+	switch RepositoryUpdateRequestVisibility(data) {
+	case RepositoryUpdateRequestVisibilityPrivate:
+		*s = RepositoryUpdateRequestVisibilityPrivate
 		return nil
-	case RepositoryRequestVisibilityPublic:
-		*s = RepositoryRequestVisibilityPublic
+	case RepositoryUpdateRequestVisibilityPublic:
+		*s = RepositoryUpdateRequestVisibilityPublic
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Merged schema.
-// Ref: #/components/schemas/RepositoryResponse
-type RepositoryResponse struct {
-	ID         uuid.UUID                    `json:"id"`
-	CreatedAt  time.Time                    `json:"createdAt"`
-	Namespace  string                       `json:"namespace"`
-	Name       string                       `json:"name"`
-	Visibility RepositoryResponseVisibility `json:"visibility"`
+// SearchCatalogOKHeaders wraps []RepositoryResponse with response headers.
+type SearchCatalogOKHeaders struct {
+	Link        OptString
+	XNextCursor OptString
+	Response    []RepositoryResponse
 }
 
-// GetID returns the value of ID.
-func (s *RepositoryResponse) GetID() uuid.UUID {
-	return s.ID
+// GetLink returns the value of Link.
+func (s *SearchCatalogOKHeaders) GetLink() OptString {
+	return s.Link
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *RepositoryResponse) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// GetXNextCursor returns the value of XNextCursor.
+func (s *SearchCatalogOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
 }
 
-// GetNamespace returns the value of Namespace.
-func (s *RepositoryResponse) GetNamespace() string {
-	return s.Namespace
+// GetResponse returns the value of Response.
+func (s *SearchCatalogOKHeaders) GetResponse() []RepositoryResponse {
+	return s.Response
 }
 
-// GetName returns the value of Name.
-func (s *RepositoryResponse) GetName() string {
-	return s.Name
+// SetLink sets the value of Link.
+func (s *SearchCatalogOKHeaders) SetLink(val OptString) {
+	s.Link = val
 }
 
-// GetVisibility returns the value of Visibility.
-func (s *RepositoryResponse) GetVisibility() RepositoryResponseVisibility {
-	return s.Visibility
+// SetXNextCursor sets the value of XNextCursor.
+func (s *SearchCatalogOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
 }
 
-// SetID sets the value of ID.
-func (s *RepositoryResponse) SetID(val uuid.UUID) {
-	s.ID = val
+// SetResponse sets the value of Response.
+func (s *SearchCatalogOKHeaders) SetResponse(val []RepositoryResponse) {
+	s.Response = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *RepositoryResponse) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
-}
+type SearchCatalogOrder string
 
-// SetNamespace sets the value of Namespace.
-func (s *RepositoryResponse) SetNamespace(val string) {
-	s.Namespace = val
+const (
+	SearchCatalogOrderAsc  SearchCatalogOrder = "asc"
+	SearchCatalogOrderDesc SearchCatalogOrder = "desc"
+)
+
+// AllValues returns all SearchCatalogOrder values.
+func (SearchCatalogOrder) AllValues() []SearchCatalogOrder {
+	return []SearchCatalogOrder{
+		SearchCatalogOrderAsc,
+		SearchCatalogOrderDesc,
+	}
 }
 
-// SetName sets the value of Name.
-func (s *RepositoryResponse) SetName(val string) {
-	s.Name = val
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchCatalogOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchCatalogOrderAsc:
+		return []byte(s), nil
+	case SearchCatalogOrderDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetVisibility sets the value of Visibility.
-func (s *RepositoryResponse) SetVisibility(val RepositoryResponseVisibility) {
-	s.Visibility = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchCatalogOrder) UnmarshalText(data []byte) error {
+	switch SearchCatalogOrder(data) {
+	case SearchCatalogOrderAsc:
+		*s = SearchCatalogOrderAsc
+		return nil
+	case SearchCatalogOrderDesc:
+		*s = SearchCatalogOrderDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-type RepositoryResponseVisibility string
+type SearchCatalogSort string
 
 const (
-	RepositoryResponseVisibilityPrivate RepositoryResponseVisibility = "private"
-	RepositoryResponseVisibilityPublic  RepositoryResponseVisibility = "public"
+	SearchCatalogSortName      SearchCatalogSort = "name"
+	SearchCatalogSortNamespace SearchCatalogSort = "namespace"
+	SearchCatalogSortCreatedAt SearchCatalogSort = "createdAt"
 )
 
-// AllValues returns all RepositoryResponseVisibility values.
-func (RepositoryResponseVisibility) AllValues() []RepositoryResponseVisibility {
-	return []RepositoryResponseVisibility{
-		RepositoryResponseVisibilityPrivate,
-		RepositoryResponseVisibilityPublic,
+// AllValues returns all SearchCatalogSort values.
+func (SearchCatalogSort) AllValues() []SearchCatalogSort {
+	return []SearchCatalogSort{
+		SearchCatalogSortName,
+		SearchCatalogSortNamespace,
+		SearchCatalogSortCreatedAt,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s RepositoryResponseVisibility) MarshalText() ([]byte, error) {
+func (s SearchCatalogSort) MarshalText() ([]byte, error) {
 	switch s {
-	case RepositoryResponseVisibilityPrivate:
+	case SearchCatalogSortName:
 		return []byte(s), nil
-	case RepositoryResponseVisibilityPublic:
+	case SearchCatalogSortNamespace:
+		return []byte(s), nil
+	case SearchCatalogSortCreatedAt:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -567,19 +3736,82 @@ func (s RepositoryResponseVisibility) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *RepositoryResponseVisibility) UnmarshalText(data []byte) error {
-	switch RepositoryResponseVisibility(data) {
-	case RepositoryResponseVisibilityPrivate:
-		*s = RepositoryResponseVisibilityPrivate
+func (s *SearchCatalogSort) UnmarshalText(data []byte) error {
+	switch SearchCatalogSort(data) {
+	case SearchCatalogSortName:
+		*s = SearchCatalogSortName
 		return nil
-	case RepositoryResponseVisibilityPublic:
-		*s = RepositoryResponseVisibilityPublic
+	case SearchCatalogSortNamespace:
+		*s = SearchCatalogSortNamespace
+		return nil
+	case SearchCatalogSortCreatedAt:
+		*s = SearchCatalogSortCreatedAt
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+// Ref: #/components/schemas/TagResponse
+type TagResponse struct {
+	Name      string `json:"name"`
+	Digest    string `json:"digest"`
+	MediaType string `json:"mediaType"`
+	// The size of the manifest in bytes.
+	Size     int64     `json:"size"`
+	PushedAt time.Time `json:"pushedAt"`
+}
+
+// GetName returns the value of Name.
+func (s *TagResponse) GetName() string {
+	return s.Name
+}
+
+// GetDigest returns the value of Digest.
+func (s *TagResponse) GetDigest() string {
+	return s.Digest
+}
+
+// GetMediaType returns the value of MediaType.
+func (s *TagResponse) GetMediaType() string {
+	return s.MediaType
+}
+
+// GetSize returns the value of Size.
+func (s *TagResponse) GetSize() int64 {
+	return s.Size
+}
+
+// GetPushedAt returns the value of PushedAt.
+func (s *TagResponse) GetPushedAt() time.Time {
+	return s.PushedAt
+}
+
+// SetName sets the value of Name.
+func (s *TagResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetDigest sets the value of Digest.
+func (s *TagResponse) SetDigest(val string) {
+	s.Digest = val
+}
+
+// SetMediaType sets the value of MediaType.
+func (s *TagResponse) SetMediaType(val string) {
+	s.MediaType = val
+}
+
+// SetSize sets the value of Size.
+func (s *TagResponse) SetSize(val int64) {
+	s.Size = val
+}
+
+// SetPushedAt sets the value of PushedAt.
+func (s *TagResponse) SetPushedAt(val time.Time) {
+	s.PushedAt = val
+}
+
 // Ref: #/components/schemas/TeamMemberRequest
 type TeamMemberRequest struct {
 	Username string                `json:"username"`
@@ -755,9 +3987,13 @@ func (s *TeamRequest) SetName(val string) {
 // Merged schema.
 // Ref: #/components/schemas/TeamResponse
 type TeamResponse struct {
-	ID        uuid.UUID `json:"id"`
-	CreatedAt time.Time `json:"createdAt"`
-	Name      string    `json:"name"`
+	ID uuid.UUID `json:"id"`
+	// The maximum lifetime in seconds of the personal access tokens of the members and robots of the
+	// team, in addition to the maximum lifetime configured on the server.
+	// Zero means that the team does not limit the lifetime of tokens.
+	MaxTokenLifetime int       `json:"maxTokenLifetime"`
+	CreatedAt        time.Time `json:"createdAt"`
+	Name             string    `json:"name"`
 }
 
 // GetID returns the value of ID.
@@ -765,6 +4001,11 @@ func (s *TeamResponse) GetID() uuid.UUID {
 	return s.ID
 }
 
+// GetMaxTokenLifetime returns the value of MaxTokenLifetime.
+func (s *TeamResponse) GetMaxTokenLifetime() int {
+	return s.MaxTokenLifetime
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *TeamResponse) GetCreatedAt() time.Time {
 	return s.CreatedAt
@@ -780,6 +4021,11 @@ func (s *TeamResponse) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
+// SetMaxTokenLifetime sets the value of MaxTokenLifetime.
+func (s *TeamResponse) SetMaxTokenLifetime(val int) {
+	s.MaxTokenLifetime = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *TeamResponse) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
@@ -790,6 +4036,88 @@ func (s *TeamResponse) SetName(val string) {
 	s.Name = val
 }
 
+// Ref: #/components/schemas/TeamRobotRequest
+type TeamRobotRequest struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *TeamRobotRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *TeamRobotRequest) SetName(val string) {
+	s.Name = val
+}
+
+// Merged schema.
+// Ref: #/components/schemas/TeamRobotResponse
+type TeamRobotResponse struct {
+	ID uuid.UUID `json:"id"`
+	// The username that the robot uses to authenticate to the registry, formatted as 'team+name'.
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"createdAt"`
+	Name      string    `json:"name"`
+}
+
+// GetID returns the value of ID.
+func (s *TeamRobotResponse) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUsername returns the value of Username.
+func (s *TeamRobotResponse) GetUsername() string {
+	return s.Username
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TeamRobotResponse) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetName returns the value of Name.
+func (s *TeamRobotResponse) GetName() string {
+	return s.Name
+}
+
+// SetID sets the value of ID.
+func (s *TeamRobotResponse) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *TeamRobotResponse) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TeamRobotResponse) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetName sets the value of Name.
+func (s *TeamRobotResponse) SetName(val string) {
+	s.Name = val
+}
+
+// Ref: #/components/schemas/TeamUpdateRequest
+type TeamUpdateRequest struct {
+	// The maximum lifetime in seconds of the personal access tokens of the members and robots of the
+	// team, or zero to not limit the lifetime of tokens.
+	MaxTokenLifetime int `json:"maxTokenLifetime"`
+}
+
+// GetMaxTokenLifetime returns the value of MaxTokenLifetime.
+func (s *TeamUpdateRequest) GetMaxTokenLifetime() int {
+	return s.MaxTokenLifetime
+}
+
+// SetMaxTokenLifetime sets the value of MaxTokenLifetime.
+func (s *TeamUpdateRequest) SetMaxTokenLifetime(val int) {
+	s.MaxTokenLifetime = val
+}
+
 // Ref: #/components/schemas/UserPasswordChangeRequest
 type UserPasswordChangeRequest struct {
 	Password string `json:"password"`
@@ -875,7 +4203,9 @@ func (s *UserRequestRole) UnmarshalText(data []byte) error {
 // Merged schema.
 // Ref: #/components/schemas/UserResponse
 type UserResponse struct {
-	ID        uuid.UUID        `json:"id"`
+	ID uuid.UUID `json:"id"`
+	// Whether the user is disabled, in which case they cannot authenticate.
+	Disabled  bool             `json:"disabled"`
 	CreatedAt time.Time        `json:"createdAt"`
 	Username  string           `json:"username"`
 	Role      UserResponseRole `json:"role"`
@@ -886,6 +4216,11 @@ func (s *UserResponse) GetID() uuid.UUID {
 	return s.ID
 }
 
+// GetDisabled returns the value of Disabled.
+func (s *UserResponse) GetDisabled() bool {
+	return s.Disabled
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *UserResponse) GetCreatedAt() time.Time {
 	return s.CreatedAt
@@ -906,6 +4241,11 @@ func (s *UserResponse) SetID(val uuid.UUID) {
 	s.ID = val
 }
 
+// SetDisabled sets the value of Disabled.
+func (s *UserResponse) SetDisabled(val bool) {
+	s.Disabled = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *UserResponse) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
@@ -962,6 +4302,21 @@ func (s *UserResponseRole) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #/components/schemas/UserUpdateRequest
+type UserUpdateRequest struct {
+	Disabled bool `json:"disabled"`
+}
+
+// GetDisabled returns the value of Disabled.
+func (s *UserUpdateRequest) GetDisabled() bool {
+	return s.Disabled
+}
+
+// SetDisabled sets the value of Disabled.
+func (s *UserUpdateRequest) SetDisabled(val bool) {
+	s.Disabled = val
+}
+
 type UsernamePassword struct {
 	Username string
 	Password string
@@ -8,6 +8,10 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	AuditHandler
+	AuthHandler
+	CatalogHandler
+	NamespaceHandler
 	RepositoryHandler
 	TeamHandler
 	TokenHandler
@@ -18,10 +22,133 @@ type Handler interface {
 	NewError(ctx context.Context, err error) *ErrorStatusCode
 }
 
+// AuditHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Audit
+type AuditHandler interface {
+	// ListAuditEvents implements listAuditEvents operation.
+	//
+	// Returns the events in the audit log matching the given filters, from newest to oldest.
+	// Administrator privileges are required to query the audit log.
+	//
+	// GET /v1/audit
+	ListAuditEvents(ctx context.Context, params ListAuditEventsParams) (*ListAuditEventsOKHeaders, error)
+}
+
+// AuthHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Auth
+type AuthHandler interface {
+	// GetOIDCConfiguration implements getOIDCConfiguration operation.
+	//
+	// Returns the settings that clients need to log in through the configured OpenID Connect identity
+	// provider.
+	//
+	// GET /v1/auth/oidc
+	GetOIDCConfiguration(ctx context.Context) (*OIDCConfigurationResponse, error)
+}
+
+// CatalogHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Catalog
+type CatalogHandler interface {
+	// ListCatalogRepositories implements listCatalogRepositories operation.
+	//
+	// Lists the names of all repositories that the caller is allowed to pull, in the same format as the
+	// `/v2/_catalog` endpoint of the registry. Unlike the catalog of the registry, which requires the
+	// `registry:catalog:*` scope and contains every repository, this is available to every caller.
+	// Authentication is optional; anonymous callers only get public repositories.
+	// The repositories are sorted by their full name.
+	//
+	// GET /v1/catalog/repositories
+	ListCatalogRepositories(ctx context.Context, params ListCatalogRepositoriesParams) (*CatalogResponseHeaders, error)
+	// SearchCatalog implements searchCatalog operation.
+	//
+	// Searches all repositories that the caller is allowed to pull: public repositories, repositories in
+	// the namespaces
+	// of the caller, and repositories that the caller or one of their teams is a collaborator on.
+	// Authentication is optional; anonymous callers can only find public repositories.
+	// The README of the repositories is not included.
+	//
+	// GET /v1/catalog
+	SearchCatalog(ctx context.Context, params SearchCatalogParams) (*SearchCatalogOKHeaders, error)
+}
+
+// NamespaceHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Namespace
+type NamespaceHandler interface {
+	// DeleteNamespaceQuota implements deleteNamespaceQuota operation.
+	//
+	// Removes the quota that was set for the namespace, so that the default quota for namespaces of
+	// users or teams
+	// applies again.
+	// Only admins can delete the quota of a namespace.
+	//
+	// DELETE /v1/namespaces/{namespace}/quota
+	DeleteNamespaceQuota(ctx context.Context, params DeleteNamespaceQuotaParams) error
+	// GetNamespaceSettings implements getNamespaceSettings operation.
+	//
+	// Get namespace settings.
+	//
+	// GET /v1/namespaces/{namespace}/settings
+	GetNamespaceSettings(ctx context.Context, params GetNamespaceSettingsParams) (*NamespaceSettings, error)
+	// GetNamespaceUsage implements getNamespaceUsage operation.
+	//
+	// Gets the number of repositories in the namespace and the storage they use, together with the quota
+	// of the namespace.
+	// Push access is not granted to repositories in a namespace that has reached its storage quota, and
+	// no repositories can be created in a namespace that has reached its repository quota.
+	// Storage is tracked through the notifications sent by the registry, and is an approximation since
+	// the registry only frees storage when it is garbage collected.
+	// Admins can get the usage of every namespace.
+	//
+	// GET /v1/namespaces/{namespace}/usage
+	GetNamespaceUsage(ctx context.Context, params GetNamespaceUsageParams) (*NamespaceUsage, error)
+	// UpdateNamespaceQuota implements updateNamespaceQuota operation.
+	//
+	// Sets the quota of the namespace, which takes precedence over the default quota for namespaces of
+	// users or teams
+	// from the server configuration. A limit of zero means that there is no limit.
+	// Only admins can update the quota of a namespace.
+	//
+	// PUT /v1/namespaces/{namespace}/quota
+	UpdateNamespaceQuota(ctx context.Context, req *NamespaceQuota, params UpdateNamespaceQuotaParams) (*NamespaceUsage, error)
+	// UpdateNamespaceSettings implements updateNamespaceSettings operation.
+	//
+	// Updates the settings of a namespace that the current user can create repositories in.
+	// If automatic repository creation is enabled, requesting push access to a repository that does not
+	// exist yet in the
+	// namespace creates it, as long as the caller is allowed to push to the namespace and the personal
+	// access token allows
+	// pushing to the repository. The repository is recorded as created by the user of the token.
+	//
+	// PUT /v1/namespaces/{namespace}/settings
+	UpdateNamespaceSettings(ctx context.Context, req *NamespaceSettings, params UpdateNamespaceSettingsParams) (*NamespaceSettings, error)
+}
+
 // RepositoryHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: Repository
 type RepositoryHandler interface {
+	// AddRepositoryCollaborator implements addRepositoryCollaborator operation.
+	//
+	// Grants a user or team access to the repository. If the user or team is already a collaborator,
+	// their permission is updated.
+	//
+	// POST /v1/repositories/{namespace}/{name}/collaborators
+	AddRepositoryCollaborator(ctx context.Context, req *RepositoryCollaboratorRequest, params AddRepositoryCollaboratorParams) (*RepositoryCollaboratorResponse, error)
+	// AddRepositoryProtectedTag implements addRepositoryProtectedTag operation.
+	//
+	// Protects the tags in the repository matching the given pattern.
+	// Delete access is no longer granted for repositories with protected tags, except to the owner of a
+	// user namespace and the admins of a team namespace.
+	// Overwriting or deleting a protected tag is recorded in the audit log, which requires the registry
+	// to be configured to send notifications to regauth.
+	// Only the owner of a user namespace and the admins of a team namespace can manage protected tags.
+	//
+	// POST /v1/repositories/{namespace}/{name}/protected-tags
+	AddRepositoryProtectedTag(ctx context.Context, req *ProtectedTagRequest, params AddRepositoryProtectedTagParams) (*ProtectedTagResponse, error)
 	// CreateRepository implements createRepository operation.
 	//
 	// Create repository.
@@ -40,12 +167,64 @@ type RepositoryHandler interface {
 	//
 	// GET /v1/repositories/{namespace}/{name}
 	GetRepository(ctx context.Context, params GetRepositoryParams) (*RepositoryResponse, error)
+	// GetRepositoryTag implements getRepositoryTag operation.
+	//
+	// Get repository tag.
+	//
+	// GET /v1/repositories/{namespace}/{name}/tags/{tag}
+	GetRepositoryTag(ctx context.Context, params GetRepositoryTagParams) (*TagResponse, error)
 	// ListRepositories implements listRepositories operation.
 	//
-	// List repositories.
+	// Lists the repositories in the namespaces of the current user. The README of the repositories is
+	// not included.
 	//
 	// GET /v1/repositories
-	ListRepositories(ctx context.Context) ([]RepositoryResponse, error)
+	ListRepositories(ctx context.Context, params ListRepositoriesParams) (*ListRepositoriesOKHeaders, error)
+	// ListRepositoryCollaborators implements listRepositoryCollaborators operation.
+	//
+	// Lists the users and teams that have been granted access to the repository outside of their own
+	// namespaces.
+	//
+	// GET /v1/repositories/{namespace}/{name}/collaborators
+	ListRepositoryCollaborators(ctx context.Context, params ListRepositoryCollaboratorsParams) ([]RepositoryCollaboratorResponse, error)
+	// ListRepositoryProtectedTags implements listRepositoryProtectedTags operation.
+	//
+	// Lists the patterns of the tags that are protected in the repository.
+	//
+	// GET /v1/repositories/{namespace}/{name}/protected-tags
+	ListRepositoryProtectedTags(ctx context.Context, params ListRepositoryProtectedTagsParams) ([]ProtectedTagResponse, error)
+	// ListRepositoryTags implements listRepositoryTags operation.
+	//
+	// Lists the tags that have been pushed to the repository.
+	// Tags are tracked through the notifications sent by the registry, so this requires the registry to
+	// be configured to send notifications to regauth.
+	//
+	// GET /v1/repositories/{namespace}/{name}/tags
+	ListRepositoryTags(ctx context.Context, params ListRepositoryTagsParams) (*ListRepositoryTagsOKHeaders, error)
+	// RemoveRepositoryCollaborator implements removeRepositoryCollaborator operation.
+	//
+	// Remove repository collaborator.
+	//
+	// DELETE /v1/repositories/{namespace}/{name}/collaborators/{type}/{collaborator}
+	RemoveRepositoryCollaborator(ctx context.Context, params RemoveRepositoryCollaboratorParams) error
+	// RemoveRepositoryProtectedTag implements removeRepositoryProtectedTag operation.
+	//
+	// Only the owner of a user namespace and the admins of a team namespace can manage protected tags.
+	//
+	// DELETE /v1/repositories/{namespace}/{name}/protected-tags/{pattern}
+	RemoveRepositoryProtectedTag(ctx context.Context, params RemoveRepositoryProtectedTagParams) error
+	// UpdateRepository implements updateRepository operation.
+	//
+	// Updates the visibility, description or name of the repository. Only the properties that are given
+	// are changed.
+	// The repository can be moved to another namespace by specifying the namespace property, as long as
+	// the current user
+	// has access to the target namespace. Note that renaming or moving a repository only changes its
+	// metadata; images
+	// that have already been pushed are not moved in the storage of the registry.
+	//
+	// PATCH /v1/repositories/{namespace}/{name}
+	UpdateRepository(ctx context.Context, req *RepositoryUpdateRequest, params UpdateRepositoryParams) (*RepositoryResponse, error)
 }
 
 // TeamHandler handles operations described by OpenAPI v3 specification.
@@ -64,36 +243,95 @@ type TeamHandler interface {
 	//
 	// POST /v1/teams
 	CreateTeam(ctx context.Context, req *TeamRequest) (*TeamResponse, error)
+	// CreateTeamRobot implements createTeamRobot operation.
+	//
+	// Creates a robot account owned by the team, which can be used by automated processes like pipelines
+	// to access the repositories of the team.
+	// Robots cannot log in using a password and cannot use the API, they can only authenticate to the
+	// registry using their personal access tokens.
+	// Only team admins can manage robots.
+	//
+	// POST /v1/teams/{name}/robots
+	CreateTeamRobot(ctx context.Context, req *TeamRobotRequest, params CreateTeamRobotParams) (*TeamRobotResponse, error)
+	// CreateTeamRobotToken implements createTeamRobotToken operation.
+	//
+	// Create team robot personal access token.
+	//
+	// POST /v1/teams/{name}/robots/{robot}/tokens
+	CreateTeamRobotToken(ctx context.Context, req *PersonalAccessTokenRequest, params CreateTeamRobotTokenParams) (*PersonalAccessTokenCreationResponse, error)
 	// DeleteTeam implements deleteTeam operation.
 	//
 	// Delete team.
 	//
 	// DELETE /v1/teams/{name}
 	DeleteTeam(ctx context.Context, params DeleteTeamParams) error
+	// DeleteTeamRobot implements deleteTeamRobot operation.
+	//
+	// Deletes the robot, including all of its personal access tokens.
+	//
+	// DELETE /v1/teams/{name}/robots/{robot}
+	DeleteTeamRobot(ctx context.Context, params DeleteTeamRobotParams) error
+	// DeleteTeamRobotToken implements deleteTeamRobotToken operation.
+	//
+	// Delete team robot personal access token.
+	//
+	// DELETE /v1/teams/{name}/robots/{robot}/tokens/{id}
+	DeleteTeamRobotToken(ctx context.Context, params DeleteTeamRobotTokenParams) error
 	// GetTeam implements getTeam operation.
 	//
 	// Get team.
 	//
 	// GET /v1/teams/{name}
 	GetTeam(ctx context.Context, params GetTeamParams) (*TeamResponse, error)
+	// GetTeamRobot implements getTeamRobot operation.
+	//
+	// Get team robot.
+	//
+	// GET /v1/teams/{name}/robots/{robot}
+	GetTeamRobot(ctx context.Context, params GetTeamRobotParams) (*TeamRobotResponse, error)
 	// ListTeamMembers implements listTeamMembers operation.
 	//
 	// List team members.
 	//
 	// GET /v1/teams/{name}/members
-	ListTeamMembers(ctx context.Context, params ListTeamMembersParams) ([]TeamMemberResponse, error)
+	ListTeamMembers(ctx context.Context, params ListTeamMembersParams) (*ListTeamMembersOKHeaders, error)
+	// ListTeamRobotTokens implements listTeamRobotTokens operation.
+	//
+	// List team robot personal access tokens.
+	//
+	// GET /v1/teams/{name}/robots/{robot}/tokens
+	ListTeamRobotTokens(ctx context.Context, params ListTeamRobotTokensParams) (*ListTeamRobotTokensOKHeaders, error)
+	// ListTeamRobots implements listTeamRobots operation.
+	//
+	// List team robots.
+	//
+	// GET /v1/teams/{name}/robots
+	ListTeamRobots(ctx context.Context, params ListTeamRobotsParams) (*ListTeamRobotsOKHeaders, error)
 	// ListTeams implements listTeams operation.
 	//
 	// List teams.
 	//
 	// GET /v1/teams
-	ListTeams(ctx context.Context) ([]TeamResponse, error)
+	ListTeams(ctx context.Context, params ListTeamsParams) (*ListTeamsOKHeaders, error)
 	// RemoveTeamMember implements removeTeamMember operation.
 	//
 	// Remove team member.
 	//
 	// DELETE /v1/teams/{name}/members/{username}
 	RemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) error
+	// RevokeTeamRobotToken implements revokeTeamRobotToken operation.
+	//
+	// Revokes the personal access token of the robot, keeping the token and its usage log.
+	//
+	// POST /v1/teams/{name}/robots/{robot}/tokens/{id}/revoke
+	RevokeTeamRobotToken(ctx context.Context, params RevokeTeamRobotTokenParams) (*PersonalAccessTokenResponse, error)
+	// UpdateTeam implements updateTeam operation.
+	//
+	// Updates the maximum lifetime of the personal access tokens of the members and robots of the team.
+	// Only team admins can update the team.
+	//
+	// PATCH /v1/teams/{name}
+	UpdateTeam(ctx context.Context, req *TeamUpdateRequest, params UpdateTeamParams) (*TeamResponse, error)
 }
 
 // TokenHandler handles operations described by OpenAPI v3 specification.
@@ -102,7 +340,11 @@ type TeamHandler interface {
 type TokenHandler interface {
 	// CreatePersonalAccessToken implements createPersonalAccessToken operation.
 	//
-	// Create personal access token.
+	// Creates a new personal access token for the authenticated user.
+	// When authenticating with a personal access token, the new token cannot have more access than the
+	// token used to create it: its scopes, permission, repositories, allowed CIDR ranges and expiration
+	// date are limited to those of the current token.
+	// If the repositories or allowed CIDR ranges are omitted, those of the current token are used.
 	//
 	// POST /v1/tokens
 	CreatePersonalAccessToken(ctx context.Context, req *PersonalAccessTokenRequest) (*PersonalAccessTokenCreationResponse, error)
@@ -118,12 +360,45 @@ type TokenHandler interface {
 	//
 	// GET /v1/tokens/{id}
 	GetPersonalAccessToken(ctx context.Context, params GetPersonalAccessTokenParams) (*PersonalAccessTokenResponse, error)
+	// GetPersonalAccessTokenDailyUsage implements getPersonalAccessTokenDailyUsage operation.
+	//
+	// Returns the number of times per day and source IP address that the personal access token was used,
+	// from newest to oldest.
+	// This only includes usage that has been removed from the usage log by its retention policy.
+	//
+	// GET /v1/tokens/{id}/usage/daily
+	GetPersonalAccessTokenDailyUsage(ctx context.Context, params GetPersonalAccessTokenDailyUsageParams) ([]PersonalAccessTokenDailyUsageResponse, error)
+	// GetPersonalAccessTokenUsage implements getPersonalAccessTokenUsage operation.
+	//
+	// Returns the times that the personal access token was used to log in to the registry, from newest
+	// to oldest.
+	//
+	// GET /v1/tokens/{id}/usage
+	GetPersonalAccessTokenUsage(ctx context.Context, params GetPersonalAccessTokenUsageParams) (*GetPersonalAccessTokenUsageOKHeaders, error)
 	// ListPersonalAccessTokens implements listPersonalAccessTokens operation.
 	//
 	// List personal access tokens.
 	//
 	// GET /v1/tokens
-	ListPersonalAccessTokens(ctx context.Context) ([]PersonalAccessTokenResponse, error)
+	ListPersonalAccessTokens(ctx context.Context, params ListPersonalAccessTokensParams) (*ListPersonalAccessTokensOKHeaders, error)
+	// RevokePersonalAccessToken implements revokePersonalAccessToken operation.
+	//
+	// Revokes the personal access token, so that it cannot be used anymore.
+	// Unlike deleting the token, the token and its usage log are kept, so they can still be inspected
+	// afterward.
+	//
+	// POST /v1/tokens/{id}/revoke
+	RevokePersonalAccessToken(ctx context.Context, params RevokePersonalAccessTokenParams) (*PersonalAccessTokenResponse, error)
+	// RotatePersonalAccessToken implements rotatePersonalAccessToken operation.
+	//
+	// Generates a new plain-text token for the personal access token, keeping its description,
+	// permission, repositories, scopes and expiration date.
+	// The previous plain-text token keeps working until the grace period has passed, so that it can be
+	// replaced everywhere it is used without interruption.
+	// Rotating the token again ends the grace period of the token before it.
+	//
+	// POST /v1/tokens/{id}/rotate
+	RotatePersonalAccessToken(ctx context.Context, req *PersonalAccessTokenRotationRequest, params RotatePersonalAccessTokenParams) (*PersonalAccessTokenRotationResponse, error)
 }
 
 // UserHandler handles operations described by OpenAPI v3 specification.
@@ -159,7 +434,15 @@ type UserHandler interface {
 	// List users.
 	//
 	// GET /v1/users
-	ListUsers(ctx context.Context) ([]UserResponse, error)
+	ListUsers(ctx context.Context, params ListUsersParams) (*ListUsersOKHeaders, error)
+	// UpdateUser implements updateUser operation.
+	//
+	// Disables or enables the user. Disabled users cannot authenticate in any way, including using their
+	// personal
+	// access tokens, but their namespace, repositories and tokens are kept.
+	//
+	// PATCH /v1/users/{username}
+	UpdateUser(ctx context.Context, req *UserUpdateRequest, params UpdateUserParams) (*UserResponse, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
@@ -13,6 +13,30 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AddRepositoryCollaborator implements addRepositoryCollaborator operation.
+//
+// Grants a user or team access to the repository. If the user or team is already a collaborator,
+// their permission is updated.
+//
+// POST /v1/repositories/{namespace}/{name}/collaborators
+func (UnimplementedHandler) AddRepositoryCollaborator(ctx context.Context, req *RepositoryCollaboratorRequest, params AddRepositoryCollaboratorParams) (r *RepositoryCollaboratorResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddRepositoryProtectedTag implements addRepositoryProtectedTag operation.
+//
+// Protects the tags in the repository matching the given pattern.
+// Delete access is no longer granted for repositories with protected tags, except to the owner of a
+// user namespace and the admins of a team namespace.
+// Overwriting or deleting a protected tag is recorded in the audit log, which requires the registry
+// to be configured to send notifications to regauth.
+// Only the owner of a user namespace and the admins of a team namespace can manage protected tags.
+//
+// POST /v1/repositories/{namespace}/{name}/protected-tags
+func (UnimplementedHandler) AddRepositoryProtectedTag(ctx context.Context, req *ProtectedTagRequest, params AddRepositoryProtectedTagParams) (r *ProtectedTagResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AddTeamMember implements addTeamMember operation.
 //
 // Add team member.
@@ -33,7 +57,11 @@ func (UnimplementedHandler) ChangeUserPassword(ctx context.Context, req *UserPas
 
 // CreatePersonalAccessToken implements createPersonalAccessToken operation.
 //
-// Create personal access token.
+// Creates a new personal access token for the authenticated user.
+// When authenticating with a personal access token, the new token cannot have more access than the
+// token used to create it: its scopes, permission, repositories, allowed CIDR ranges and expiration
+// date are limited to those of the current token.
+// If the repositories or allowed CIDR ranges are omitted, those of the current token are used.
 //
 // POST /v1/tokens
 func (UnimplementedHandler) CreatePersonalAccessToken(ctx context.Context, req *PersonalAccessTokenRequest) (r *PersonalAccessTokenCreationResponse, _ error) {
@@ -58,6 +86,28 @@ func (UnimplementedHandler) CreateTeam(ctx context.Context, req *TeamRequest) (r
 	return r, ht.ErrNotImplemented
 }
 
+// CreateTeamRobot implements createTeamRobot operation.
+//
+// Creates a robot account owned by the team, which can be used by automated processes like pipelines
+// to access the repositories of the team.
+// Robots cannot log in using a password and cannot use the API, they can only authenticate to the
+// registry using their personal access tokens.
+// Only team admins can manage robots.
+//
+// POST /v1/teams/{name}/robots
+func (UnimplementedHandler) CreateTeamRobot(ctx context.Context, req *TeamRobotRequest, params CreateTeamRobotParams) (r *TeamRobotResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateTeamRobotToken implements createTeamRobotToken operation.
+//
+// Create team robot personal access token.
+//
+// POST /v1/teams/{name}/robots/{robot}/tokens
+func (UnimplementedHandler) CreateTeamRobotToken(ctx context.Context, req *PersonalAccessTokenRequest, params CreateTeamRobotTokenParams) (r *PersonalAccessTokenCreationResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateUser implements createUser operation.
 //
 // Create user.
@@ -67,6 +117,18 @@ func (UnimplementedHandler) CreateUser(ctx context.Context, req *UserRequest) (r
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteNamespaceQuota implements deleteNamespaceQuota operation.
+//
+// Removes the quota that was set for the namespace, so that the default quota for namespaces of
+// users or teams
+// applies again.
+// Only admins can delete the quota of a namespace.
+//
+// DELETE /v1/namespaces/{namespace}/quota
+func (UnimplementedHandler) DeleteNamespaceQuota(ctx context.Context, params DeleteNamespaceQuotaParams) error {
+	return ht.ErrNotImplemented
+}
+
 // DeletePersonalAccessToken implements deletePersonalAccessToken operation.
 //
 // Delete personal access token.
@@ -94,6 +156,24 @@ func (UnimplementedHandler) DeleteTeam(ctx context.Context, params DeleteTeamPar
 	return ht.ErrNotImplemented
 }
 
+// DeleteTeamRobot implements deleteTeamRobot operation.
+//
+// Deletes the robot, including all of its personal access tokens.
+//
+// DELETE /v1/teams/{name}/robots/{robot}
+func (UnimplementedHandler) DeleteTeamRobot(ctx context.Context, params DeleteTeamRobotParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DeleteTeamRobotToken implements deleteTeamRobotToken operation.
+//
+// Delete team robot personal access token.
+//
+// DELETE /v1/teams/{name}/robots/{robot}/tokens/{id}
+func (UnimplementedHandler) DeleteTeamRobotToken(ctx context.Context, params DeleteTeamRobotTokenParams) error {
+	return ht.ErrNotImplemented
+}
+
 // DeleteUser implements deleteUser operation.
 //
 // Delete user.
@@ -103,6 +183,40 @@ func (UnimplementedHandler) DeleteUser(ctx context.Context, params DeleteUserPar
 	return ht.ErrNotImplemented
 }
 
+// GetNamespaceSettings implements getNamespaceSettings operation.
+//
+// Get namespace settings.
+//
+// GET /v1/namespaces/{namespace}/settings
+func (UnimplementedHandler) GetNamespaceSettings(ctx context.Context, params GetNamespaceSettingsParams) (r *NamespaceSettings, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetNamespaceUsage implements getNamespaceUsage operation.
+//
+// Gets the number of repositories in the namespace and the storage they use, together with the quota
+// of the namespace.
+// Push access is not granted to repositories in a namespace that has reached its storage quota, and
+// no repositories can be created in a namespace that has reached its repository quota.
+// Storage is tracked through the notifications sent by the registry, and is an approximation since
+// the registry only frees storage when it is garbage collected.
+// Admins can get the usage of every namespace.
+//
+// GET /v1/namespaces/{namespace}/usage
+func (UnimplementedHandler) GetNamespaceUsage(ctx context.Context, params GetNamespaceUsageParams) (r *NamespaceUsage, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOIDCConfiguration implements getOIDCConfiguration operation.
+//
+// Returns the settings that clients need to log in through the configured OpenID Connect identity
+// provider.
+//
+// GET /v1/auth/oidc
+func (UnimplementedHandler) GetOIDCConfiguration(ctx context.Context) (r *OIDCConfigurationResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPersonalAccessToken implements getPersonalAccessToken operation.
 //
 // Get personal access token.
@@ -112,6 +226,27 @@ func (UnimplementedHandler) GetPersonalAccessToken(ctx context.Context, params G
 	return r, ht.ErrNotImplemented
 }
 
+// GetPersonalAccessTokenDailyUsage implements getPersonalAccessTokenDailyUsage operation.
+//
+// Returns the number of times per day and source IP address that the personal access token was used,
+// from newest to oldest.
+// This only includes usage that has been removed from the usage log by its retention policy.
+//
+// GET /v1/tokens/{id}/usage/daily
+func (UnimplementedHandler) GetPersonalAccessTokenDailyUsage(ctx context.Context, params GetPersonalAccessTokenDailyUsageParams) (r []PersonalAccessTokenDailyUsageResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPersonalAccessTokenUsage implements getPersonalAccessTokenUsage operation.
+//
+// Returns the times that the personal access token was used to log in to the registry, from newest
+// to oldest.
+//
+// GET /v1/tokens/{id}/usage
+func (UnimplementedHandler) GetPersonalAccessTokenUsage(ctx context.Context, params GetPersonalAccessTokenUsageParams) (r *GetPersonalAccessTokenUsageOKHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetRepository implements getRepository operation.
 //
 // Get repository.
@@ -121,6 +256,15 @@ func (UnimplementedHandler) GetRepository(ctx context.Context, params GetReposit
 	return r, ht.ErrNotImplemented
 }
 
+// GetRepositoryTag implements getRepositoryTag operation.
+//
+// Get repository tag.
+//
+// GET /v1/repositories/{namespace}/{name}/tags/{tag}
+func (UnimplementedHandler) GetRepositoryTag(ctx context.Context, params GetRepositoryTagParams) (r *TagResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetTeam implements getTeam operation.
 //
 // Get team.
@@ -130,6 +274,15 @@ func (UnimplementedHandler) GetTeam(ctx context.Context, params GetTeamParams) (
 	return r, ht.ErrNotImplemented
 }
 
+// GetTeamRobot implements getTeamRobot operation.
+//
+// Get team robot.
+//
+// GET /v1/teams/{name}/robots/{robot}
+func (UnimplementedHandler) GetTeamRobot(ctx context.Context, params GetTeamRobotParams) (r *TeamRobotResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetUser implements getUser operation.
 //
 // Get user.
@@ -139,21 +292,75 @@ func (UnimplementedHandler) GetUser(ctx context.Context, params GetUserParams) (
 	return r, ht.ErrNotImplemented
 }
 
+// ListAuditEvents implements listAuditEvents operation.
+//
+// Returns the events in the audit log matching the given filters, from newest to oldest.
+// Administrator privileges are required to query the audit log.
+//
+// GET /v1/audit
+func (UnimplementedHandler) ListAuditEvents(ctx context.Context, params ListAuditEventsParams) (r *ListAuditEventsOKHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListCatalogRepositories implements listCatalogRepositories operation.
+//
+// Lists the names of all repositories that the caller is allowed to pull, in the same format as the
+// `/v2/_catalog` endpoint of the registry. Unlike the catalog of the registry, which requires the
+// `registry:catalog:*` scope and contains every repository, this is available to every caller.
+// Authentication is optional; anonymous callers only get public repositories.
+// The repositories are sorted by their full name.
+//
+// GET /v1/catalog/repositories
+func (UnimplementedHandler) ListCatalogRepositories(ctx context.Context, params ListCatalogRepositoriesParams) (r *CatalogResponseHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListPersonalAccessTokens implements listPersonalAccessTokens operation.
 //
 // List personal access tokens.
 //
 // GET /v1/tokens
-func (UnimplementedHandler) ListPersonalAccessTokens(ctx context.Context) (r []PersonalAccessTokenResponse, _ error) {
+func (UnimplementedHandler) ListPersonalAccessTokens(ctx context.Context, params ListPersonalAccessTokensParams) (r *ListPersonalAccessTokensOKHeaders, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // ListRepositories implements listRepositories operation.
 //
-// List repositories.
+// Lists the repositories in the namespaces of the current user. The README of the repositories is
+// not included.
 //
 // GET /v1/repositories
-func (UnimplementedHandler) ListRepositories(ctx context.Context) (r []RepositoryResponse, _ error) {
+func (UnimplementedHandler) ListRepositories(ctx context.Context, params ListRepositoriesParams) (r *ListRepositoriesOKHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListRepositoryCollaborators implements listRepositoryCollaborators operation.
+//
+// Lists the users and teams that have been granted access to the repository outside of their own
+// namespaces.
+//
+// GET /v1/repositories/{namespace}/{name}/collaborators
+func (UnimplementedHandler) ListRepositoryCollaborators(ctx context.Context, params ListRepositoryCollaboratorsParams) (r []RepositoryCollaboratorResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListRepositoryProtectedTags implements listRepositoryProtectedTags operation.
+//
+// Lists the patterns of the tags that are protected in the repository.
+//
+// GET /v1/repositories/{namespace}/{name}/protected-tags
+func (UnimplementedHandler) ListRepositoryProtectedTags(ctx context.Context, params ListRepositoryProtectedTagsParams) (r []ProtectedTagResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListRepositoryTags implements listRepositoryTags operation.
+//
+// Lists the tags that have been pushed to the repository.
+// Tags are tracked through the notifications sent by the registry, so this requires the registry to
+// be configured to send notifications to regauth.
+//
+// GET /v1/repositories/{namespace}/{name}/tags
+func (UnimplementedHandler) ListRepositoryTags(ctx context.Context, params ListRepositoryTagsParams) (r *ListRepositoryTagsOKHeaders, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -162,7 +369,25 @@ func (UnimplementedHandler) ListRepositories(ctx context.Context) (r []Repositor
 // List team members.
 //
 // GET /v1/teams/{name}/members
-func (UnimplementedHandler) ListTeamMembers(ctx context.Context, params ListTeamMembersParams) (r []TeamMemberResponse, _ error) {
+func (UnimplementedHandler) ListTeamMembers(ctx context.Context, params ListTeamMembersParams) (r *ListTeamMembersOKHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTeamRobotTokens implements listTeamRobotTokens operation.
+//
+// List team robot personal access tokens.
+//
+// GET /v1/teams/{name}/robots/{robot}/tokens
+func (UnimplementedHandler) ListTeamRobotTokens(ctx context.Context, params ListTeamRobotTokensParams) (r *ListTeamRobotTokensOKHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTeamRobots implements listTeamRobots operation.
+//
+// List team robots.
+//
+// GET /v1/teams/{name}/robots
+func (UnimplementedHandler) ListTeamRobots(ctx context.Context, params ListTeamRobotsParams) (r *ListTeamRobotsOKHeaders, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -171,7 +396,7 @@ func (UnimplementedHandler) ListTeamMembers(ctx context.Context, params ListTeam
 // List teams.
 //
 // GET /v1/teams
-func (UnimplementedHandler) ListTeams(ctx context.Context) (r []TeamResponse, _ error) {
+func (UnimplementedHandler) ListTeams(ctx context.Context, params ListTeamsParams) (r *ListTeamsOKHeaders, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -180,10 +405,28 @@ func (UnimplementedHandler) ListTeams(ctx context.Context) (r []TeamResponse, _
 // List users.
 //
 // GET /v1/users
-func (UnimplementedHandler) ListUsers(ctx context.Context) (r []UserResponse, _ error) {
+func (UnimplementedHandler) ListUsers(ctx context.Context, params ListUsersParams) (r *ListUsersOKHeaders, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// RemoveRepositoryCollaborator implements removeRepositoryCollaborator operation.
+//
+// Remove repository collaborator.
+//
+// DELETE /v1/repositories/{namespace}/{name}/collaborators/{type}/{collaborator}
+func (UnimplementedHandler) RemoveRepositoryCollaborator(ctx context.Context, params RemoveRepositoryCollaboratorParams) error {
+	return ht.ErrNotImplemented
+}
+
+// RemoveRepositoryProtectedTag implements removeRepositoryProtectedTag operation.
+//
+// Only the owner of a user namespace and the admins of a team namespace can manage protected tags.
+//
+// DELETE /v1/repositories/{namespace}/{name}/protected-tags/{pattern}
+func (UnimplementedHandler) RemoveRepositoryProtectedTag(ctx context.Context, params RemoveRepositoryProtectedTagParams) error {
+	return ht.ErrNotImplemented
+}
+
 // RemoveTeamMember implements removeTeamMember operation.
 //
 // Remove team member.
@@ -193,6 +436,114 @@ func (UnimplementedHandler) RemoveTeamMember(ctx context.Context, params RemoveT
 	return ht.ErrNotImplemented
 }
 
+// RevokePersonalAccessToken implements revokePersonalAccessToken operation.
+//
+// Revokes the personal access token, so that it cannot be used anymore.
+// Unlike deleting the token, the token and its usage log are kept, so they can still be inspected
+// afterward.
+//
+// POST /v1/tokens/{id}/revoke
+func (UnimplementedHandler) RevokePersonalAccessToken(ctx context.Context, params RevokePersonalAccessTokenParams) (r *PersonalAccessTokenResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeTeamRobotToken implements revokeTeamRobotToken operation.
+//
+// Revokes the personal access token of the robot, keeping the token and its usage log.
+//
+// POST /v1/teams/{name}/robots/{robot}/tokens/{id}/revoke
+func (UnimplementedHandler) RevokeTeamRobotToken(ctx context.Context, params RevokeTeamRobotTokenParams) (r *PersonalAccessTokenResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RotatePersonalAccessToken implements rotatePersonalAccessToken operation.
+//
+// Generates a new plain-text token for the personal access token, keeping its description,
+// permission, repositories, scopes and expiration date.
+// The previous plain-text token keeps working until the grace period has passed, so that it can be
+// replaced everywhere it is used without interruption.
+// Rotating the token again ends the grace period of the token before it.
+//
+// POST /v1/tokens/{id}/rotate
+func (UnimplementedHandler) RotatePersonalAccessToken(ctx context.Context, req *PersonalAccessTokenRotationRequest, params RotatePersonalAccessTokenParams) (r *PersonalAccessTokenRotationResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchCatalog implements searchCatalog operation.
+//
+// Searches all repositories that the caller is allowed to pull: public repositories, repositories in
+// the namespaces
+// of the caller, and repositories that the caller or one of their teams is a collaborator on.
+// Authentication is optional; anonymous callers can only find public repositories.
+// The README of the repositories is not included.
+//
+// GET /v1/catalog
+func (UnimplementedHandler) SearchCatalog(ctx context.Context, params SearchCatalogParams) (r *SearchCatalogOKHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateNamespaceQuota implements updateNamespaceQuota operation.
+//
+// Sets the quota of the namespace, which takes precedence over the default quota for namespaces of
+// users or teams
+// from the server configuration. A limit of zero means that there is no limit.
+// Only admins can update the quota of a namespace.
+//
+// PUT /v1/namespaces/{namespace}/quota
+func (UnimplementedHandler) UpdateNamespaceQuota(ctx context.Context, req *NamespaceQuota, params UpdateNamespaceQuotaParams) (r *NamespaceUsage, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateNamespaceSettings implements updateNamespaceSettings operation.
+//
+// Updates the settings of a namespace that the current user can create repositories in.
+// If automatic repository creation is enabled, requesting push access to a repository that does not
+// exist yet in the
+// namespace creates it, as long as the caller is allowed to push to the namespace and the personal
+// access token allows
+// pushing to the repository. The repository is recorded as created by the user of the token.
+//
+// PUT /v1/namespaces/{namespace}/settings
+func (UnimplementedHandler) UpdateNamespaceSettings(ctx context.Context, req *NamespaceSettings, params UpdateNamespaceSettingsParams) (r *NamespaceSettings, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateRepository implements updateRepository operation.
+//
+// Updates the visibility, description or name of the repository. Only the properties that are given
+// are changed.
+// The repository can be moved to another namespace by specifying the namespace property, as long as
+// the current user
+// has access to the target namespace. Note that renaming or moving a repository only changes its
+// metadata; images
+// that have already been pushed are not moved in the storage of the registry.
+//
+// PATCH /v1/repositories/{namespace}/{name}
+func (UnimplementedHandler) UpdateRepository(ctx context.Context, req *RepositoryUpdateRequest, params UpdateRepositoryParams) (r *RepositoryResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateTeam implements updateTeam operation.
+//
+// Updates the maximum lifetime of the personal access tokens of the members and robots of the team.
+// Only team admins can update the team.
+//
+// PATCH /v1/teams/{name}
+func (UnimplementedHandler) UpdateTeam(ctx context.Context, req *TeamUpdateRequest, params UpdateTeamParams) (r *TeamResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateUser implements updateUser operation.
+//
+// Disables or enables the user. Disabled users cannot authenticate in any way, including using their
+// personal
+// access tokens, but their namespace, repositories and tokens are kept.
+//
+// PATCH /v1/users/{username}
+func (UnimplementedHandler) UpdateUser(ctx context.Context, req *UserUpdateRequest, params UpdateUserParams) (r *UserResponse, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *ErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
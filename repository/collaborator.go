@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Collaborator grants a single user or team access to a repository outside of their own namespaces.
+type Collaborator struct {
+	RepositoryID uuid.UUID
+	Type         CollaboratorType
+	// SubjectID is the ID of the user or team, depending on the Type.
+	SubjectID uuid.UUID
+	// SubjectName is the username or team name, depending on the Type.
+	SubjectName string
+	Permission  CollaboratorPermission
+	CreatedAt   time.Time
+}
+
+func (c Collaborator) IsValid() error {
+	if err := c.Type.IsValid(); err != nil {
+		return err
+	}
+
+	if err := c.Permission.IsValid(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type CollaboratorType string
+
+const (
+	CollaboratorTypeUser CollaboratorType = "user"
+	CollaboratorTypeTeam CollaboratorType = "team"
+)
+
+func (t CollaboratorType) IsValid() error {
+	if t != CollaboratorTypeUser && t != CollaboratorTypeTeam {
+		return ErrInvalidCollaboratorType
+	}
+
+	return nil
+}
+
+type CollaboratorPermission string
+
+const (
+	CollaboratorPermissionPull   CollaboratorPermission = "pull"
+	CollaboratorPermissionPush   CollaboratorPermission = "push"
+	CollaboratorPermissionDelete CollaboratorPermission = "delete"
+)
+
+func (p CollaboratorPermission) IsValid() error {
+	if p != CollaboratorPermissionPull && p != CollaboratorPermissionPush && p != CollaboratorPermissionDelete {
+		return ErrInvalidCollaboratorPermission
+	}
+
+	return nil
+}
+
+// GetAllowedActions returns the registry actions that are allowed by the permission. Each permission also includes the
+// actions of the permissions below it, since pushing to or deleting from a repository is not possible without pulling.
+func (p CollaboratorPermission) GetAllowedActions() []string {
+	m := map[CollaboratorPermission][]string{
+		CollaboratorPermissionPull:   {"pull"},
+		CollaboratorPermissionPush:   {"pull", "push"},
+		CollaboratorPermissionDelete: {"pull", "push", "delete"},
+	}
+
+	a, ok := m[p]
+	if !ok {
+		return []string{}
+	}
+	return a
+}
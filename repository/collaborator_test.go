@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestCollaborator_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc         string
+		collaborator Collaborator
+		err          error
+	}{
+		{"valid collaborator", Collaborator{Type: CollaboratorTypeUser, Permission: CollaboratorPermissionPush}, nil},
+		{"invalid type", Collaborator{Type: CollaboratorType("invalid"), Permission: CollaboratorPermissionPush}, ErrInvalidCollaboratorType},
+		{"invalid permission", Collaborator{Type: CollaboratorTypeTeam, Permission: CollaboratorPermission("invalid")}, ErrInvalidCollaboratorPermission},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.collaborator.IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestCollaboratorPermission_GetAllowedActions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc       string
+		permission CollaboratorPermission
+		expected   []string
+	}{
+		{"pull", CollaboratorPermissionPull, []string{"pull"}},
+		{"push", CollaboratorPermissionPush, []string{"pull", "push"}},
+		{"delete", CollaboratorPermissionDelete, []string{"pull", "push", "delete"}},
+		{"invalid permission", CollaboratorPermission("invalid"), []string{}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			actions := c.permission.GetAllowedActions()
+			if !slices.Equal(actions, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, actions)
+			}
+		})
+	}
+}
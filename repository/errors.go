@@ -6,6 +6,23 @@ var (
 	ErrNotFound          = errors.New("repository not found")
 	ErrAlreadyExists     = errors.New("repository already exists, cannot create it again")
 	ErrInvalidVisibility = errors.New("visibility is not valid, must be one of 'public', 'private'")
+	ErrTagNotFound       = errors.New("tag not found")
+	ErrNamespaceNotFound = errors.New("namespace not found")
+	ErrInvalidDigest     = errors.New("digest is not valid, must be in the form of '<algorithm>:<encoded>'")
+
+	ErrInvalidSearchSort  = errors.New("sort is not valid, must be one of 'name', 'namespace', 'createdAt'")
+	ErrInvalidSearchOrder = errors.New("order is not valid, must be one of 'asc', 'desc'")
+	ErrInvalidSearchLimit = errors.New("limit is not valid, must be between 1 and 1000")
+	ErrInvalidSearchAfter = errors.New("repository name to start after is not valid, must be in the form of 'namespace/name'")
+
+	ErrCollaboratorNotFound          = errors.New("collaborator not found")
+	ErrInvalidCollaboratorType       = errors.New("collaborator type is not valid, must be one of 'user', 'team'")
+	ErrInvalidCollaboratorPermission = errors.New("collaborator permission is not valid, must be one of 'pull', 'push', 'delete'")
+
+	ErrProtectedTagNotFound = errors.New("protected tag pattern not found")
+
+	ErrQuotaOverrideNotFound = errors.New("quota override not found")
+	ErrInvalidQuota          = errors.New("quota is not valid, limits cannot be negative")
 )
 
 type InvalidNameError string
@@ -13,3 +30,33 @@ type InvalidNameError string
 func (e InvalidNameError) Error() string {
 	return "invalid repository name: " + string(e)
 }
+
+type InvalidDescriptionError string
+
+func (e InvalidDescriptionError) Error() string {
+	return "invalid repository description: " + string(e)
+}
+
+type InvalidLabelError string
+
+func (e InvalidLabelError) Error() string {
+	return "invalid repository label: " + string(e)
+}
+
+type InvalidReadmeError string
+
+func (e InvalidReadmeError) Error() string {
+	return "invalid repository README: " + string(e)
+}
+
+type InvalidTagNameError string
+
+func (e InvalidTagNameError) Error() string {
+	return "invalid tag name: " + string(e)
+}
+
+type InvalidTagPatternError string
+
+func (e InvalidTagPatternError) Error() string {
+	return "invalid tag pattern: " + string(e)
+}
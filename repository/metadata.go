@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// MaxLabels is the maximum amount of labels that can be set on a single repository.
+	MaxLabels = 32
+	// MaxReadmeSize is the maximum size of a repository README in bytes.
+	MaxReadmeSize = 64 * 1024
+)
+
+// Labels are free-form key/value pairs attached to a repository.
+type Labels map[string]string
+
+var validLabelKey = regexp.MustCompile("^[a-z0-9]([a-z0-9-_./]*[a-z0-9])?$")
+
+func (l Labels) IsValid() error {
+	if len(l) > MaxLabels {
+		return InvalidLabelError(fmt.Sprintf("cannot set more than %d labels", MaxLabels))
+	}
+
+	for key, value := range l {
+		if err := isValidLabel(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isValidLabel(key, value string) error {
+	if len(key) > 63 {
+		return InvalidLabelError("key cannot be longer than 63 characters")
+	}
+
+	if !validLabelKey.MatchString(key) {
+		return InvalidLabelError(fmt.Sprintf(`key %q must consist of lowercase alphanumeric characters, "-", "_", "." and "/", and start and end with an alphanumeric character`, key))
+	}
+
+	if len([]rune(value)) > 255 {
+		return InvalidLabelError(fmt.Sprintf("value of %q cannot be longer than 255 characters", key))
+	}
+
+	return nil
+}
+
+// LabelSelector selects repositories that have all the given labels set to the given values.
+// An empty selector matches all repositories.
+type LabelSelector map[string]string
+
+// ParseLabelSelector parses a list of 'key=value' strings into a LabelSelector.
+func ParseLabelSelector(labels []string) (LabelSelector, error) {
+	selector := make(LabelSelector, len(labels))
+
+	for _, label := range labels {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok {
+			return nil, InvalidLabelError(fmt.Sprintf("%q is not in the form of 'key=value'", label))
+		}
+
+		if err := isValidLabel(key, value); err != nil {
+			return nil, err
+		}
+
+		selector[key] = value
+	}
+
+	return selector, nil
+}
+
+// Matches returns whether the given labels contain all labels of the selector.
+func (s LabelSelector) Matches(l Labels) bool {
+	for key, value := range s {
+		if v, ok := l[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Readme is a Markdown document describing a repository.
+type Readme string
+
+func (r Readme) IsValid() error {
+	if len(r) > MaxReadmeSize {
+		return InvalidReadmeError(fmt.Sprintf("README cannot be larger than %d bytes", MaxReadmeSize))
+	}
+
+	return nil
+}
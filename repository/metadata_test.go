@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLabels_IsValid(t *testing.T) {
+	t.Parallel()
+
+	tooMany := make(Labels)
+	for i := 0; i <= MaxLabels; i++ {
+		tooMany[fmt.Sprintf("key-%d", i)] = "value"
+	}
+
+	testCases := []struct {
+		desc   string
+		labels Labels
+		err    error
+	}{
+		{"no labels", nil, nil},
+		{"valid labels", Labels{"team": "platform", "example.com/tier": "backend", "empty": ""}, nil},
+		{"too many labels", tooMany, InvalidLabelError(fmt.Sprintf("cannot set more than %d labels", MaxLabels))},
+		{"key too long", Labels{strings.Repeat("a", 64): "value"}, InvalidLabelError("key cannot be longer than 63 characters")},
+		{"uppercase key", Labels{"Team": "value"}, InvalidLabelError(`key "Team" must consist of lowercase alphanumeric characters, "-", "_", "." and "/", and start and end with an alphanumeric character`)},
+		{"key ending with special character", Labels{"team-": "value"}, InvalidLabelError(`key "team-" must consist of lowercase alphanumeric characters, "-", "_", "." and "/", and start and end with an alphanumeric character`)},
+		{"empty key", Labels{"": "value"}, InvalidLabelError(`key "" must consist of lowercase alphanumeric characters, "-", "_", "." and "/", and start and end with an alphanumeric character`)},
+		{"value too long", Labels{"team": strings.Repeat("a", 256)}, InvalidLabelError(`value of "team" cannot be longer than 255 characters`)},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.labels.IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		labels   []string
+		expected LabelSelector
+		err      error
+	}{
+		{"no labels", nil, LabelSelector{}, nil},
+		{"single label", []string{"team=platform"}, LabelSelector{"team": "platform"}, nil},
+		{"multiple labels", []string{"team=platform", "tier="}, LabelSelector{"team": "platform", "tier": ""}, nil},
+		{"value containing separator", []string{"query=a=b"}, LabelSelector{"query": "a=b"}, nil},
+		{"missing separator", []string{"team"}, nil, InvalidLabelError(`"team" is not in the form of 'key=value'`)},
+		{"invalid key", []string{"Team=platform"}, nil, InvalidLabelError(`key "Team" must consist of lowercase alphanumeric characters, "-", "_", "." and "/", and start and end with an alphanumeric character`)},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			selector, err := ParseLabelSelector(c.labels)
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+
+			if len(selector) != len(c.expected) {
+				t.Fatalf("expected %v, got %v", c.expected, selector)
+			}
+
+			for key, value := range c.expected {
+				if selector[key] != value {
+					t.Errorf("expected %v, got %v", c.expected, selector)
+				}
+			}
+		})
+	}
+}
+
+func TestLabelSelector_Matches(t *testing.T) {
+	t.Parallel()
+
+	labels := Labels{"team": "platform", "tier": "backend"}
+
+	testCases := []struct {
+		desc     string
+		selector LabelSelector
+		expected bool
+	}{
+		{"empty selector", nil, true},
+		{"single matching label", LabelSelector{"team": "platform"}, true},
+		{"all labels matching", LabelSelector{"team": "platform", "tier": "backend"}, true},
+		{"different value", LabelSelector{"team": "security"}, false},
+		{"missing label", LabelSelector{"environment": "production"}, false},
+		{"partially matching", LabelSelector{"team": "platform", "tier": "frontend"}, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			if actual := c.selector.Matches(labels); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestReadme_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc   string
+		readme string
+		err    error
+	}{
+		{"empty README", "", nil},
+		{"valid README", "# My image\n\nUsed for things.", nil},
+		{"maximum size", strings.Repeat("a", MaxReadmeSize), nil},
+		{"README too large", strings.Repeat("a", MaxReadmeSize+1), InvalidReadmeError(fmt.Sprintf("README cannot be larger than %d bytes", MaxReadmeSize))},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := Readme(c.readme).IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package repository
+
+// NamespaceSettings are the settings of a namespace, which is owned by either a user or a team.
+type NamespaceSettings struct {
+	Namespace string
+	// AutoCreateRepositories allows repositories to be created automatically when they are first pushed to, by a
+	// caller that is allowed to push to the namespace.
+	AutoCreateRepositories bool
+	// AutoCreateVisibility is the visibility of automatically created repositories.
+	AutoCreateVisibility Visibility
+}
+
+// DefaultNamespaceSettings returns the settings of a namespace that has not been configured.
+func DefaultNamespaceSettings(namespace string) NamespaceSettings {
+	return NamespaceSettings{Namespace: namespace, AutoCreateVisibility: VisibilityPrivate}
+}
+
+func (s NamespaceSettings) IsValid() error {
+	return s.AutoCreateVisibility.IsValid()
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"path"
+	"regexp"
+	"time"
+)
+
+// ProtectedTag protects the tags in a repository that match its pattern from being overwritten or deleted.
+type ProtectedTag struct {
+	RepositoryID uuid.UUID
+	Pattern      TagPattern
+	CreatedAt    time.Time
+}
+
+func (p ProtectedTag) IsValid() error {
+	return p.Pattern.IsValid()
+}
+
+// TagPattern is a glob pattern matching tag names, using the syntax of path.Match. For example, 'v*' matches all tags
+// starting with 'v'.
+type TagPattern string
+
+// validTagPattern allows the characters of the tag grammar, together with the special characters of glob patterns.
+var validTagPattern = regexp.MustCompile(`^[a-zA-Z0-9_.*?\[\]^-]{1,128}$`)
+
+func (p TagPattern) IsValid() error {
+	if !validTagPattern.MatchString(string(p)) {
+		return InvalidTagPatternError(`tag pattern can only contain alphanumeric characters, "-", "_", "." and the glob characters "*", "?", "[", "]" and "^", and must be between 1 and 128 characters`)
+	}
+
+	if _, err := path.Match(string(p), ""); err != nil {
+		return InvalidTagPatternError("tag pattern is malformed: " + err.Error())
+	}
+
+	return nil
+}
+
+// Matches returns whether the given tag name matches the pattern.
+func (p TagPattern) Matches(name string) bool {
+	matched, _ := path.Match(string(p), name)
+	return matched
+}
+
+// IsTagProtected returns whether the tag with the given name matches any of the protected tag patterns.
+func IsTagProtected(protectedTags []ProtectedTag, name string) bool {
+	for _, p := range protectedTags {
+		if p.Pattern.Matches(name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTagPattern_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc    string
+		pattern string
+		valid   bool
+	}{
+		{"exact tag name", "latest", true},
+		{"prefix", "v*", true},
+		{"single character wildcard", "v?.0", true},
+		{"character range", "release-[0-9]*", true},
+		{"negated character range", "[^a-z]*", true},
+		{"empty pattern", "", false},
+		{"disallowed characters", "v*/foo", false},
+		{"unterminated character range", "v[0-9", false},
+		{"pattern too long", strings.Repeat("a", 129), false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := TagPattern(c.pattern).IsValid()
+			if c.valid && err != nil {
+				t.Errorf("expected nil, got %q", err)
+			}
+
+			var invalidErr InvalidTagPatternError
+			if !c.valid && !errors.As(err, &invalidErr) {
+				t.Errorf("expected InvalidTagPatternError, got %q", err)
+			}
+		})
+	}
+}
+
+func TestIsTagProtected(t *testing.T) {
+	t.Parallel()
+
+	protectedTags := []ProtectedTag{
+		{Pattern: "latest"},
+		{Pattern: "v*"},
+	}
+
+	testCases := []struct {
+		tag       string
+		protected bool
+	}{
+		{"latest", true},
+		{"v1.2.3", true},
+		{"v", true},
+		{"latest-alpine", false},
+		{"dev", false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.tag, func(t *testing.T) {
+			if actual := IsTagProtected(protectedTags, c.tag); actual != c.protected {
+				t.Errorf("expected %t, got %t", c.protected, actual)
+			}
+		})
+	}
+
+	if IsTagProtected(nil, "latest") {
+		t.Errorf("expected tag not to be protected without protected tags")
+	}
+}
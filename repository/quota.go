@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Quota limits the number of repositories in a namespace and the storage they use. A limit of zero means that there is
+// no limit.
+type Quota struct {
+	Repositories int
+	Bytes        int64
+}
+
+func (q Quota) IsValid() error {
+	if q.Repositories < 0 || q.Bytes < 0 {
+		return ErrInvalidQuota
+	}
+
+	return nil
+}
+
+// AllowsRepository returns whether another repository can be created in a namespace with the given usage.
+func (q Quota) AllowsRepository(u Usage) bool {
+	return q.Repositories == 0 || u.Repositories < q.Repositories
+}
+
+// AllowsPush returns whether pushing to the repositories of a namespace with the given usage is allowed. Pushing is
+// refused once the storage limit has been reached, or when there are more repositories than allowed, for example
+// because the quota was lowered.
+func (q Quota) AllowsPush(u Usage) bool {
+	if q.Bytes > 0 && u.Bytes >= q.Bytes {
+		return false
+	}
+
+	return q.Repositories == 0 || u.Repositories <= q.Repositories
+}
+
+// Quotas are the quotas that apply to the namespaces of users and teams.
+type Quotas struct {
+	User Quota
+	Team Quota
+}
+
+// ForNamespace returns the quota of a namespace, which is owned by either a team or a user.
+func (q Quotas) ForNamespace(ownedByTeam bool) Quota {
+	if ownedByTeam {
+		return q.Team
+	}
+
+	return q.User
+}
+
+// QuotaOverride is a quota that an admin has set for a single namespace, which takes precedence over the default quota
+// for namespaces of users or teams in Quotas.
+type QuotaOverride struct {
+	Namespace string
+	Quota
+}
+
+// NamespaceQuota returns the quota of the given namespace along with its current usage. This is the quota override of
+// the namespace if it has one, and otherwise the default quota depending on whether it is owned by a team or a user.
+func NamespaceQuota(ctx context.Context, repoStore Store, teamStore user.TeamStore, quotas Quotas, namespace string) (Quota, Usage, error) {
+	var quota Quota
+
+	override, err := repoStore.GetQuotaOverride(ctx, namespace)
+	switch {
+	case err == nil:
+		quota = override.Quota
+	case errors.Is(err, ErrQuotaOverrideNotFound):
+		_, err := teamStore.GetByName(ctx, namespace)
+		if err != nil && !errors.Is(err, user.ErrTeamNotFound) {
+			return Quota{}, Usage{}, fmt.Errorf("failed to get team: %w", err)
+		}
+
+		quota = quotas.ForNamespace(err == nil)
+	default:
+		return Quota{}, Usage{}, fmt.Errorf("failed to get quota override: %w", err)
+	}
+
+	usage, err := repoStore.GetNamespaceUsage(ctx, namespace)
+	if err != nil {
+		return Quota{}, Usage{}, err
+	}
+
+	return quota, usage, nil
+}
+
+// Usage is the number of repositories in a namespace and the storage they use.
+type Usage struct {
+	Namespace    string
+	Repositories int
+	// Bytes is the total size of the distinct blobs and manifests that were pushed to the repositories in the namespace.
+	// Since the registry only frees storage when it is garbage collected, this is an approximation.
+	Bytes int64
+}
+
+// Blob is a blob or manifest that was pushed to or mounted into a repository, which is tracked to determine the storage
+// used by a namespace.
+type Blob struct {
+	RepositoryID uuid.UUID
+	Digest       Digest
+	Size         int64
+	PushedAt     time.Time
+}
+
+func (b Blob) IsValid() error {
+	return b.Digest.IsValid()
+}
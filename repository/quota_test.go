@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestQuota_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc  string
+		quota Quota
+		err   error
+	}{
+		{"no limit", Quota{}, nil},
+		{"limits", Quota{Repositories: 10, Bytes: 100}, nil},
+		{"negative repository limit", Quota{Repositories: -1}, ErrInvalidQuota},
+		{"negative storage limit", Quota{Bytes: -1}, ErrInvalidQuota},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.quota.IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestQuota_AllowsRepository(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		quota    Quota
+		usage    Usage
+		expected bool
+	}{
+		{"no limit", Quota{}, Usage{Repositories: 1000}, true},
+		{"below limit", Quota{Repositories: 10}, Usage{Repositories: 9}, true},
+		{"at limit", Quota{Repositories: 10}, Usage{Repositories: 10}, false},
+		{"storage limit is ignored", Quota{Repositories: 10, Bytes: 100}, Usage{Repositories: 1, Bytes: 200}, true},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			if actual := c.quota.AllowsRepository(c.usage); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestQuota_AllowsPush(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		quota    Quota
+		usage    Usage
+		expected bool
+	}{
+		{"no limit", Quota{}, Usage{Repositories: 1000, Bytes: 1 << 40}, true},
+		{"below storage limit", Quota{Bytes: 100}, Usage{Bytes: 99}, true},
+		{"at storage limit", Quota{Bytes: 100}, Usage{Bytes: 100}, false},
+		{"at repository limit", Quota{Repositories: 10}, Usage{Repositories: 10}, true},
+		{"over repository limit", Quota{Repositories: 10}, Usage{Repositories: 11}, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			if actual := c.quota.AllowsPush(c.usage); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestQuotas_ForNamespace(t *testing.T) {
+	t.Parallel()
+
+	q := Quotas{User: Quota{Repositories: 5}, Team: Quota{Repositories: 50}}
+
+	if actual := q.ForNamespace(false); actual != q.User {
+		t.Errorf("expected %+v, got %+v", q.User, actual)
+	}
+
+	if actual := q.ForNamespace(true); actual != q.Team {
+		t.Errorf("expected %+v, got %+v", q.Team, actual)
+	}
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"github.com/evanebb/regauth/store"
 	"github.com/google/uuid"
 	"regexp"
 	"strings"
@@ -12,7 +13,17 @@ type Repository struct {
 	Namespace  string
 	Name       Name
 	Visibility Visibility
-	CreatedAt  time.Time
+	// Description is an optional description of the contents of the repository.
+	Description Description
+	// Labels are free-form key/value pairs that can be used to categorize and filter repositories.
+	Labels Labels
+	// Readme is an optional Markdown document describing the repository.
+	// It is not loaded when listing repositories.
+	Readme Readme
+	// CreatedBy is the ID of the user that created the repository. It is uuid.Nil if the user is unknown, for
+	// repositories created before this was tracked or if the user has since been deleted.
+	CreatedBy uuid.UUID
+	CreatedAt time.Time
 }
 
 func (r Repository) IsValid() error {
@@ -20,17 +31,46 @@ func (r Repository) IsValid() error {
 		return err
 	}
 
+	if len(r.FullName()) > 255 {
+		return InvalidNameError("full repository name cannot be longer than 255 characters")
+	}
+
 	if err := r.Visibility.IsValid(); err != nil {
 		return err
 	}
 
+	if err := r.Description.IsValid(); err != nil {
+		return err
+	}
+
+	if err := r.Labels.IsValid(); err != nil {
+		return err
+	}
+
+	if err := r.Readme.IsValid(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// FullName returns the name of the repository including its namespace, as used by the registry.
+func (r Repository) FullName() string {
+	return r.Namespace + "/" + string(r.Name)
+}
+
+// Position returns the position of the repository in a listing, which is sorted on the repository name, or on the
+// namespace and name when searching the catalog.
+func (r Repository) Position() store.Position {
+	return store.Position{Namespace: r.Namespace, Name: string(r.Name), CreatedAt: r.CreatedAt, ID: r.ID}
+}
+
+// Name is the name of a repository within its namespace. It can consist of multiple path components separated by
+// slashes, such as 'payments/api/worker', following the repository name grammar of the registry.
 type Name string
 
-var validName = regexp.MustCompile("^[a-zA-Z0-9-_.]+$")
-var nameNoRepeatingSpecialChars = regexp.MustCompile("[-_.]{2,}")
+// validNameComponent matches a single path component of a repository name, as defined by the registry.
+var validNameComponent = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*$`)
 
 func (n Name) IsValid() error {
 	l := len([]rune(n))
@@ -42,20 +82,18 @@ func (n Name) IsValid() error {
 		return InvalidNameError("name cannot be longer than 255 characters")
 	}
 
-	str := string(n)
-	disallowedStartEndChars := []string{"-", "_", "."}
-	for _, disallowed := range disallowedStartEndChars {
-		if strings.HasPrefix(str, disallowed) || strings.HasSuffix(str, disallowed) {
-			return InvalidNameError(`name cannot start or end with "-", "_" or "."`)
+	for _, component := range strings.Split(string(n), "/") {
+		if component == "" {
+			return InvalidNameError("name cannot start or end with a slash, or contain repeating slashes")
 		}
-	}
 
-	if !validName.MatchString(str) {
-		return InvalidNameError(`name can only contain alphanumeric characters, "-", "_" and "." (non-repeating)`)
-	}
+		if strings.IndexAny(component[:1], "-_.") == 0 || strings.IndexAny(component[len(component)-1:], "-_.") == 0 {
+			return InvalidNameError(`name cannot start or end with "-", "_" or "."`)
+		}
 
-	if nameNoRepeatingSpecialChars.MatchString(str) {
-		return InvalidNameError(`name cannot contain repeating "-", "_" and "." characters`)
+		if !validNameComponent.MatchString(component) {
+			return InvalidNameError(`name components can only contain lowercase alphanumeric characters, separated by ".", "_", "__" or one or more "-"`)
+		}
 	}
 
 	return nil
@@ -75,3 +113,13 @@ func (v Visibility) IsValid() error {
 
 	return nil
 }
+
+type Description string
+
+func (d Description) IsValid() error {
+	if len([]rune(d)) > 1024 {
+		return InvalidDescriptionError("description cannot be longer than 1024 characters")
+	}
+
+	return nil
+}
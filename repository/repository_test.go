@@ -17,7 +17,9 @@ func TestRepository_IsValid(t *testing.T) {
 	}{
 		{"valid repository", Repository{Namespace: "namespace", Name: Name("name"), Visibility: VisibilityPrivate}, nil},
 		{"invalid name", Repository{Namespace: "namespace", Name: Name("a"), Visibility: VisibilityPrivate}, InvalidNameError("name cannot be shorter than 2 characters")},
+		{"full name too long", Repository{Namespace: "namespace", Name: Name(strings.Repeat("a", 250)), Visibility: VisibilityPrivate}, InvalidNameError("full repository name cannot be longer than 255 characters")},
 		{"invalid visibility", Repository{Namespace: "namespace", Name: Name("name"), Visibility: Visibility("invalid")}, ErrInvalidVisibility},
+		{"invalid description", Repository{Namespace: "namespace", Name: Name("name"), Visibility: VisibilityPrivate, Description: Description(strings.Repeat("a", 1025))}, InvalidDescriptionError("description cannot be longer than 1024 characters")},
 	}
 
 	for _, c := range testCases {
@@ -58,13 +60,29 @@ func TestName_IsValid(t *testing.T) {
 				err:  InvalidNameError(`name cannot start or end with "-", "_" or "."`),
 			},
 			nameTestCase{
-				desc: fmt.Sprintf(`disallowed repeating character "%s"`, char),
-				name: "foo" + strings.Repeat(char, 2) + "bar",
-				err:  InvalidNameError(`name cannot contain repeating "-", "_" and "." characters`),
+				desc: fmt.Sprintf(`disallowed start character "%s" in nested component`, char),
+				name: "valid/" + char + "invalid",
+				err:  InvalidNameError(`name cannot start or end with "-", "_" or "."`),
 			},
 		)
 	}
 
+	invalidComponent := InvalidNameError(`name components can only contain lowercase alphanumeric characters, separated by ".", "_", "__" or one or more "-"`)
+	testCases = append(testCases,
+		nameTestCase{"double underscore", "foo__bar", nil},
+		nameTestCase{"repeating dashes", "foo---bar", nil},
+		nameTestCase{"repeating dots", "foo..bar", invalidComponent},
+		nameTestCase{"triple underscore", "foo___bar", invalidComponent},
+		nameTestCase{"mixed separators", "foo.-bar", invalidComponent},
+		nameTestCase{"uppercase characters", "Foo", invalidComponent},
+		nameTestCase{"disallowed characters", "foo@bar", invalidComponent},
+		nameTestCase{"nested name", "payments/api/worker", nil},
+		nameTestCase{"single character components", "a/b", nil},
+		nameTestCase{"leading slash", "/payments", InvalidNameError("name cannot start or end with a slash, or contain repeating slashes")},
+		nameTestCase{"trailing slash", "payments/", InvalidNameError("name cannot start or end with a slash, or contain repeating slashes")},
+		nameTestCase{"repeating slashes", "payments//api", InvalidNameError("name cannot start or end with a slash, or contain repeating slashes")},
+	)
+
 	for _, c := range testCases {
 		t.Run(c.desc, func(t *testing.T) {
 			err := Name(c.name).IsValid()
@@ -97,3 +115,27 @@ func TestVisibility_IsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestDescription_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc        string
+		description string
+		err         error
+	}{
+		{"empty description", "", nil},
+		{"valid description", "Base image for internal services", nil},
+		{"maximum length", strings.Repeat("é", 1024), nil},
+		{"description too long", strings.Repeat("a", 1025), InvalidDescriptionError("description cannot be longer than 1024 characters")},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := Description(c.description).IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"cmp"
+	"github.com/evanebb/regauth/store"
+	"github.com/google/uuid"
+	"path"
+	"slices"
+	"strings"
+)
+
+const (
+	DefaultSearchLimit = 100
+	MaxSearchLimit     = 1000
+)
+
+type SearchSort string
+
+const (
+	SearchSortName      = SearchSort(store.SortByName)
+	SearchSortNamespace = SearchSort(store.SortByNamespace)
+	SearchSortCreatedAt = SearchSort(store.SortByCreatedAt)
+)
+
+func (s SearchSort) IsValid() error {
+	if s != SearchSortName && s != SearchSortNamespace && s != SearchSortCreatedAt {
+		return ErrInvalidSearchSort
+	}
+
+	return nil
+}
+
+type SearchOrder string
+
+const (
+	SearchOrderAscending  = SearchOrder(store.SortAscending)
+	SearchOrderDescending = SearchOrder(store.SortDescending)
+)
+
+func (o SearchOrder) IsValid() error {
+	if o != SearchOrderAscending && o != SearchOrderDescending {
+		return ErrInvalidSearchOrder
+	}
+
+	return nil
+}
+
+// SearchFilter selects the repositories that are returned when searching the catalog. Empty fields are not filtered on.
+type SearchFilter struct {
+	// Query is matched case-insensitively against any part of the repository name.
+	Query     string
+	Namespace string
+	Labels    LabelSelector
+	// After only matches repositories that come after the repository with this full name, in the form of
+	// 'namespace/name', when sorted by namespace and name. This is used to paginate the catalog in the registry format.
+	After string
+	Sort  SearchSort
+	Order SearchOrder
+	Limit int
+	// Cursor continues a previous search after the last repository that it returned. It is empty for the first page.
+	Cursor store.Cursor
+}
+
+func (f SearchFilter) IsValid() error {
+	if err := f.Sort.IsValid(); err != nil {
+		return err
+	}
+
+	if err := f.Order.IsValid(); err != nil {
+		return err
+	}
+
+	if f.Limit < 1 || f.Limit > MaxSearchLimit {
+		return ErrInvalidSearchLimit
+	}
+
+	if f.After != "" && !strings.Contains(f.After, "/") {
+		return ErrInvalidSearchAfter
+	}
+
+	_, _, err := f.ListOptions().After()
+	return err
+}
+
+// ListOptions returns the pagination and sorting of the filter as store.ListOptions, so that the search results are
+// paginated like any other listing. The query is not included, since it is matched by Matches instead.
+func (f SearchFilter) ListOptions() store.ListOptions {
+	return store.ListOptions{
+		Limit:  f.Limit,
+		Cursor: f.Cursor,
+		Sort:   store.SortField(f.Sort),
+		Order:  store.SortOrder(f.Order),
+	}
+}
+
+// Matches checks whether the given repository matches the filter. Access, sorting and pagination are not taken into
+// account.
+func (f SearchFilter) Matches(r Repository) bool {
+	if f.Query != "" && !strings.Contains(strings.ToLower(string(r.Name)), strings.ToLower(f.Query)) {
+		return false
+	}
+
+	if f.Namespace != "" && r.Namespace != f.Namespace {
+		return false
+	}
+
+	if f.After != "" {
+		namespace, name, _ := strings.Cut(f.After, "/")
+		if cmp.Or(strings.Compare(r.Namespace, namespace), strings.Compare(string(r.Name), name)) <= 0 {
+			return false
+		}
+	}
+
+	return f.Labels.Matches(r.Labels)
+}
+
+// Compare compares two repositories according to the sort field and order of the filter, for use with slices.SortFunc.
+// Repositories that are equal on the sort field are ordered by their ID.
+func (f SearchFilter) Compare(a, b Repository) int {
+	return f.ListOptions().Compare(a.Position(), b.Position())
+}
+
+// SearchAccess describes which non-public repositories a caller is allowed to pull, and may therefore see when searching
+// the catalog. The zero value only gives access to public repositories, for anonymous callers.
+type SearchAccess struct {
+	// Namespaces are the namespaces owned by the caller, either directly or through their teams.
+	Namespaces []string
+	// UserID is the ID of the caller, used to find repositories that they are a direct collaborator on.
+	UserID uuid.UUID
+	// TeamIDs are the IDs of the teams of the caller, used to find repositories that their teams are a collaborator on.
+	TeamIDs []uuid.UUID
+	// Repositories restricts the non-public repositories to the ones matching one of these patterns, for callers that
+	// authenticated with a personal access token that is restricted to certain repositories. See MatchesPatterns.
+	Repositories []string
+}
+
+// CanPull checks whether the given repository is visible with this access, given its collaborators.
+func (a SearchAccess) CanPull(r Repository, collaborators []Collaborator) bool {
+	if r.Visibility == VisibilityPublic {
+		return true
+	}
+
+	return a.canPullPrivate(r, collaborators) && MatchesPatterns(r, a.Repositories)
+}
+
+func (a SearchAccess) canPullPrivate(r Repository, collaborators []Collaborator) bool {
+	if slices.Contains(a.Namespaces, r.Namespace) {
+		return true
+	}
+
+	// every collaborator permission includes pull access
+	for _, c := range collaborators {
+		switch c.Type {
+		case CollaboratorTypeUser:
+			if a.UserID != uuid.Nil && c.SubjectID == a.UserID {
+				return true
+			}
+		case CollaboratorTypeTeam:
+			if slices.Contains(a.TeamIDs, c.SubjectID) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// MatchesPatterns checks whether the full name of the given repository matches one of the patterns, which use the
+// syntax of path.Match. Every repository matches if there are no patterns.
+func MatchesPatterns(r Repository, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, p := range patterns {
+		if matched, err := path.Match(p, r.FullName()); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
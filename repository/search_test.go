@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/store"
+	"github.com/google/uuid"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSearchFilter_IsValid(t *testing.T) {
+	t.Parallel()
+
+	valid := SearchFilter{Sort: SearchSortName, Order: SearchOrderAscending, Limit: DefaultSearchLimit}
+
+	withFilter := func(modify func(f *SearchFilter)) SearchFilter {
+		f := valid
+		modify(&f)
+		return f
+	}
+
+	testCases := []struct {
+		desc   string
+		filter SearchFilter
+		err    error
+	}{
+		{"valid filter", valid, nil},
+		{"invalid sort", withFilter(func(f *SearchFilter) { f.Sort = "size" }), ErrInvalidSearchSort},
+		{"missing sort", withFilter(func(f *SearchFilter) { f.Sort = "" }), ErrInvalidSearchSort},
+		{"invalid order", withFilter(func(f *SearchFilter) { f.Order = "random" }), ErrInvalidSearchOrder},
+		{"limit too low", withFilter(func(f *SearchFilter) { f.Limit = 0 }), ErrInvalidSearchLimit},
+		{"limit too high", withFilter(func(f *SearchFilter) { f.Limit = MaxSearchLimit + 1 }), ErrInvalidSearchLimit},
+		{"malformed cursor", withFilter(func(f *SearchFilter) { f.Cursor = "not a cursor" }), store.ErrInvalidCursor},
+		{"valid after", withFilter(func(f *SearchFilter) { f.After = "platform/base-image" }), nil},
+		{"after without namespace", withFilter(func(f *SearchFilter) { f.After = "base-image" }), ErrInvalidSearchAfter},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.filter.IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestSearchFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	repo := Repository{Namespace: "platform", Name: "Base-Image", Labels: Labels{"tier": "base"}}
+
+	testCases := []struct {
+		desc     string
+		filter   SearchFilter
+		expected bool
+	}{
+		{"empty filter", SearchFilter{}, true},
+		{"matching query", SearchFilter{Query: "image"}, true},
+		{"query with different case", SearchFilter{Query: "BASE"}, true},
+		{"non-matching query", SearchFilter{Query: "runtime"}, false},
+		{"matching namespace", SearchFilter{Namespace: "platform"}, true},
+		{"non-matching namespace", SearchFilter{Namespace: "security"}, false},
+		{"matching labels", SearchFilter{Labels: LabelSelector{"tier": "base"}}, true},
+		{"non-matching labels", SearchFilter{Labels: LabelSelector{"tier": "app"}}, false},
+		{"after earlier namespace", SearchFilter{After: "other/zzz"}, true},
+		{"after earlier name", SearchFilter{After: "platform/Alpha"}, true},
+		{"after itself", SearchFilter{After: "platform/Base-Image"}, false},
+		{"after later namespace", SearchFilter{After: "security/aaa"}, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			if actual := c.filter.Matches(repo); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSearchFilter_Compare(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	repos := []Repository{
+		{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001"), Namespace: "b", Name: "alpha", CreatedAt: now},
+		{ID: uuid.MustParse("00000000-0000-0000-0000-000000000002"), Namespace: "a", Name: "gamma", CreatedAt: now.Add(-time.Hour)},
+		{ID: uuid.MustParse("00000000-0000-0000-0000-000000000003"), Namespace: "a", Name: "beta", CreatedAt: now.Add(time.Hour)},
+		{ID: uuid.MustParse("00000000-0000-0000-0000-000000000004"), Namespace: "c", Name: "beta", CreatedAt: now},
+	}
+
+	testCases := []struct {
+		desc     string
+		filter   SearchFilter
+		expected []string
+	}{
+		{"name ascending", SearchFilter{Sort: SearchSortName, Order: SearchOrderAscending}, []string{"b/alpha", "a/beta", "c/beta", "a/gamma"}},
+		{"name descending", SearchFilter{Sort: SearchSortName, Order: SearchOrderDescending}, []string{"a/gamma", "c/beta", "a/beta", "b/alpha"}},
+		{"namespace ascending", SearchFilter{Sort: SearchSortNamespace, Order: SearchOrderAscending}, []string{"a/beta", "a/gamma", "b/alpha", "c/beta"}},
+		{"creation date descending", SearchFilter{Sort: SearchSortCreatedAt, Order: SearchOrderDescending}, []string{"a/beta", "c/beta", "b/alpha", "a/gamma"}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			sorted := slices.Clone(repos)
+			slices.SortFunc(sorted, c.filter.Compare)
+
+			var actual []string
+			for _, r := range sorted {
+				actual = append(actual, r.Namespace+"/"+string(r.Name))
+			}
+
+			if !slices.Equal(c.expected, actual) {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSearchAccess_CanPull(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	teamID := uuid.New()
+
+	public := Repository{Namespace: "other", Name: "app", Visibility: VisibilityPublic}
+	private := Repository{Namespace: "other", Name: "app", Visibility: VisibilityPrivate}
+
+	testCases := []struct {
+		desc          string
+		access        SearchAccess
+		repo          Repository
+		collaborators []Collaborator
+		expected      bool
+	}{
+		{"anonymous on public repository", SearchAccess{}, public, nil, true},
+		{"anonymous on private repository", SearchAccess{}, private, nil, false},
+		{"owned namespace", SearchAccess{Namespaces: []string{"other"}}, private, nil, true},
+		{"other namespace", SearchAccess{Namespaces: []string{"mine"}}, private, nil, false},
+		{"user collaborator", SearchAccess{UserID: userID}, private, []Collaborator{{Type: CollaboratorTypeUser, SubjectID: userID}}, true},
+		{"team collaborator", SearchAccess{UserID: userID, TeamIDs: []uuid.UUID{teamID}}, private, []Collaborator{{Type: CollaboratorTypeTeam, SubjectID: teamID}}, true},
+		{"team ID as user collaborator", SearchAccess{TeamIDs: []uuid.UUID{teamID}}, private, []Collaborator{{Type: CollaboratorTypeUser, SubjectID: teamID}}, false},
+		{"other collaborator", SearchAccess{UserID: userID}, private, []Collaborator{{Type: CollaboratorTypeUser, SubjectID: uuid.New()}}, false},
+		{"owned namespace matching repository pattern", SearchAccess{Namespaces: []string{"other"}, Repositories: []string{"other/*"}}, private, nil, true},
+		{"owned namespace not matching repository pattern", SearchAccess{Namespaces: []string{"other"}, Repositories: []string{"other/web"}}, private, nil, false},
+		{"collaborator not matching repository pattern", SearchAccess{UserID: userID, Repositories: []string{"mine/*"}}, private, []Collaborator{{Type: CollaboratorTypeUser, SubjectID: userID}}, false},
+		{"public repository not matching repository pattern", SearchAccess{Repositories: []string{"mine/*"}}, public, nil, true},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			if actual := c.access.CanPull(c.repo, c.collaborators); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
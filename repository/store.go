@@ -8,9 +8,59 @@ import (
 
 type Store interface {
 	store.TransactionStore
-	GetAllByNamespace(ctx context.Context, namespaces ...string) ([]Repository, error)
+	// ListByNamespace returns a page of the repositories in the given namespaces that match the label selector and one
+	// of the patterns, see MatchesPatterns. The README of the repositories is not loaded.
+	ListByNamespace(ctx context.Context, o store.ListOptions, selector LabelSelector, patterns []string, namespaces ...string) (store.Page[Repository], error)
+	// Search returns a page of the repositories matching the filter that can be pulled with the given access, sorted
+	// and paginated according to the filter. The README of the repositories is not loaded.
+	Search(ctx context.Context, f SearchFilter, a SearchAccess) (store.Page[Repository], error)
 	GetByNamespaceAndName(ctx context.Context, namespace string, name string) (Repository, error)
 	GetByID(ctx context.Context, id uuid.UUID) (Repository, error)
 	Create(ctx context.Context, r Repository) error
+	// Update will update the namespace, name, visibility, description, labels and README of the repository with the same ID.
+	// It returns ErrAlreadyExists if another repository with the new namespace and name already exists.
+	Update(ctx context.Context, r Repository) error
 	DeleteByID(ctx context.Context, id uuid.UUID) error
+	GetTags(ctx context.Context, repositoryID uuid.UUID) ([]Tag, error)
+	// ListTags returns a page of the tags in the given repository, sorted and filtered on their name.
+	ListTags(ctx context.Context, repositoryID uuid.UUID, o store.ListOptions) (store.Page[Tag], error)
+	GetTag(ctx context.Context, repositoryID uuid.UUID, name string) (Tag, error)
+	// SaveTag will create the given tag, or update it if a tag with the same name already exists in the repository.
+	SaveTag(ctx context.Context, t Tag) error
+	DeleteTag(ctx context.Context, repositoryID uuid.UUID, name string) error
+	// DeleteTagsByDigest will delete all tags in the repository that point to the manifest with the given digest.
+	DeleteTagsByDigest(ctx context.Context, repositoryID uuid.UUID, digest string) error
+	GetCollaborators(ctx context.Context, repositoryID uuid.UUID) ([]Collaborator, error)
+	GetCollaborator(ctx context.Context, repositoryID uuid.UUID, t CollaboratorType, subjectID uuid.UUID) (Collaborator, error)
+	// SaveCollaborator will add the given collaborator to the repository, or update its permission if the user or team
+	// already is a collaborator.
+	SaveCollaborator(ctx context.Context, c Collaborator) error
+	RemoveCollaborator(ctx context.Context, repositoryID uuid.UUID, t CollaboratorType, subjectID uuid.UUID) error
+	GetProtectedTags(ctx context.Context, repositoryID uuid.UUID) ([]ProtectedTag, error)
+	// SaveProtectedTag will add the given protected tag pattern to the repository, if it does not exist yet.
+	SaveProtectedTag(ctx context.Context, p ProtectedTag) error
+	// RemoveProtectedTag will remove the given protected tag pattern from the repository. It returns
+	// ErrProtectedTagNotFound if the pattern does not exist.
+	RemoveProtectedTag(ctx context.Context, repositoryID uuid.UUID, pattern string) error
+	// SaveBlob will record the given blob or manifest as pushed to the repository, if it has not been recorded yet.
+	SaveBlob(ctx context.Context, b Blob) error
+	DeleteBlob(ctx context.Context, repositoryID uuid.UUID, digest string) error
+	// GetNamespaceUsage returns the number of repositories in the given namespace and the storage they use. It returns
+	// ErrNamespaceNotFound if the namespace does not exist.
+	GetNamespaceUsage(ctx context.Context, namespace string) (Usage, error)
+	// GetNamespaceSettings returns the settings of the given namespace. It returns ErrNamespaceNotFound if the namespace
+	// does not exist.
+	GetNamespaceSettings(ctx context.Context, namespace string) (NamespaceSettings, error)
+	// SaveNamespaceSettings will update the settings of the namespace. It returns ErrNamespaceNotFound if the namespace
+	// does not exist.
+	SaveNamespaceSettings(ctx context.Context, s NamespaceSettings) error
+	// GetQuotaOverride returns the quota override of the given namespace. It returns ErrQuotaOverrideNotFound if the
+	// namespace uses the default quota, and ErrNamespaceNotFound if the namespace does not exist.
+	GetQuotaOverride(ctx context.Context, namespace string) (QuotaOverride, error)
+	// SaveQuotaOverride will set the quota override of the namespace. It returns ErrNamespaceNotFound if the namespace
+	// does not exist.
+	SaveQuotaOverride(ctx context.Context, o QuotaOverride) error
+	// DeleteQuotaOverride will remove the quota override of the namespace, so that it uses the default quota again. It
+	// returns ErrNamespaceNotFound if the namespace does not exist.
+	DeleteQuotaOverride(ctx context.Context, namespace string) error
 }
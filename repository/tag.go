@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"github.com/evanebb/regauth/store"
+	"github.com/google/uuid"
+	"regexp"
+	"time"
+)
+
+// Tag is a tag within a repository, pointing to the manifest that was last pushed under that tag.
+// Tags are tracked through the notifications that the registry sends after each push and delete.
+type Tag struct {
+	RepositoryID uuid.UUID
+	Name         TagName
+	Digest       Digest
+	MediaType    string
+	Size         int64
+	PushedAt     time.Time
+}
+
+func (t Tag) IsValid() error {
+	if err := t.Name.IsValid(); err != nil {
+		return err
+	}
+
+	if err := t.Digest.IsValid(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Position returns the position of the tag in a listing, which is sorted on the tag name, or on the time that it was
+// last pushed. Tag names are unique within a repository, so they are used instead of an ID.
+func (t Tag) Position() store.Position {
+	return store.Position{Name: string(t.Name), CreatedAt: t.PushedAt}
+}
+
+type TagName string
+
+// validTagName follows the tag grammar of the Distribution specification.
+var validTagName = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127}$`)
+
+func (n TagName) IsValid() error {
+	if !validTagName.MatchString(string(n)) {
+		return InvalidTagNameError(`tag name must start with an alphanumeric character or "_", can only contain alphanumeric characters, "-", "_" and ".", and cannot be longer than 128 characters`)
+	}
+
+	return nil
+}
+
+// Digest is a content-addressable identifier of a manifest, in the form of '<algorithm>:<encoded>'.
+type Digest string
+
+var validDigest = regexp.MustCompile(`^[a-z0-9]+(?:[.+_-][a-z0-9]+)*:[a-zA-Z0-9=_-]+$`)
+
+func (d Digest) IsValid() error {
+	if !validDigest.MatchString(string(d)) {
+		return ErrInvalidDigest
+	}
+
+	return nil
+}
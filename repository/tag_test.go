@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTag_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc string
+		tag  Tag
+		err  error
+	}{
+		{"valid tag", Tag{Name: TagName("latest"), Digest: Digest("sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b")}, nil},
+		{"invalid name", Tag{Name: TagName("-latest"), Digest: Digest("sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b")}, InvalidTagNameError(`tag name must start with an alphanumeric character or "_", can only contain alphanumeric characters, "-", "_" and ".", and cannot be longer than 128 characters`)},
+		{"invalid digest", Tag{Name: TagName("latest"), Digest: Digest("6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b")}, ErrInvalidDigest},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.tag.IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestTagName_IsValid(t *testing.T) {
+	t.Parallel()
+
+	invalidErr := InvalidTagNameError(`tag name must start with an alphanumeric character or "_", can only contain alphanumeric characters, "-", "_" and ".", and cannot be longer than 128 characters`)
+
+	testCases := []struct {
+		desc string
+		name string
+		err  error
+	}{
+		{"valid tag name", "v1.2.3-alpine_amd64", nil},
+		{"single character", "a", nil},
+		{"leading underscore", "_internal", nil},
+		{"empty tag name", "", invalidErr},
+		{"leading period", ".latest", invalidErr},
+		{"leading dash", "-latest", invalidErr},
+		{"disallowed characters", "latest/foo", invalidErr},
+		{"tag name too long", strings.Repeat("a", 129), invalidErr},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := TagName(c.name).IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestDigest_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc   string
+		digest string
+		err    error
+	}{
+		{"sha256 digest", "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b", nil},
+		{"digest with algorithm separator", "multihash+base58:QmRZxt2b1FVZPNqd8hsiykDL3TdBDeTSPX9Kv46HmX4Gx8", nil},
+		{"missing algorithm", "6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b", ErrInvalidDigest},
+		{"missing encoded part", "sha256:", ErrInvalidDigest},
+		{"empty digest", "", ErrInvalidDigest},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := Digest(c.digest).IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
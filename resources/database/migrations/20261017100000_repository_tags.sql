@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE repository_tags
+(
+    id            bigint PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    repository_id uuid REFERENCES repositories ON DELETE CASCADE NOT NULL,
+    name          varchar(128)                                    NOT NULL,
+    digest        varchar(255)                                    NOT NULL,
+    media_type    varchar(255)                                    NOT NULL,
+    size          bigint                                          NOT NULL,
+    pushed_at     timestamptz                                     NOT NULL,
+    UNIQUE (repository_id, name)
+);
+CREATE INDEX ON repository_tags (repository_id, digest);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE repository_tags;
+-- +goose StatementEnd
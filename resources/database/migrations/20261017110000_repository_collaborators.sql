@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE repository_collaborator_permission AS ENUM ('pull', 'push', 'delete');
+
+CREATE TABLE repository_collaborators
+(
+    id            bigint PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    repository_id uuid REFERENCES repositories ON DELETE CASCADE NOT NULL,
+    user_id       uuid REFERENCES users ON DELETE CASCADE,
+    team_id       uuid REFERENCES teams ON DELETE CASCADE,
+    permission    repository_collaborator_permission              NOT NULL,
+    created_at    timestamptz                                     NOT NULL DEFAULT now(),
+    -- a collaborator is either a user or a team, never both
+    CHECK ((user_id IS NULL) <> (team_id IS NULL)),
+    UNIQUE (repository_id, user_id),
+    UNIQUE (repository_id, team_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE repository_collaborators;
+DROP TYPE repository_collaborator_permission;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE personal_access_tokens ADD COLUMN repositories text[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE personal_access_tokens DROP COLUMN repositories;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE user_role ADD VALUE 'robot';
+
+CREATE TABLE team_robots
+(
+    user_id    uuid PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+    team_id    uuid REFERENCES teams ON DELETE CASCADE NOT NULL,
+    name       varchar(64)                             NOT NULL,
+    created_at timestamptz                             NOT NULL DEFAULT now(),
+    UNIQUE (team_id, name)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM users WHERE id IN (SELECT user_id FROM team_robots);
+DROP TABLE team_robots;
+-- enum values cannot be dropped, so the 'robot' user role is left in place
+-- +goose StatementEnd
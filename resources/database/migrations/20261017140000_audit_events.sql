@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_events
+(
+    id             uuid PRIMARY KEY,
+    timestamp      timestamptz  NOT NULL,
+    action         varchar(64)  NOT NULL,
+    -- the actor is not a foreign key, so that events are kept after the user has been deleted
+    actor_id       uuid         NOT NULL,
+    actor_username varchar(255) NOT NULL,
+    source_ip      inet,
+    target_type    varchar(32)  NOT NULL,
+    target         varchar(255) NOT NULL,
+    details        jsonb        NOT NULL DEFAULT '{}'
+);
+CREATE INDEX ON audit_events (timestamp);
+CREATE INDEX ON audit_events (actor_username);
+CREATE INDEX ON audit_events (target_type, target);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_events;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX personal_access_tokens_usage_log_token_id_timestamp_idx ON personal_access_tokens_usage_log (token_id, timestamp);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX personal_access_tokens_usage_log_token_id_timestamp_idx;
+-- +goose StatementEnd
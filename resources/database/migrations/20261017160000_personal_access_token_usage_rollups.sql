@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE personal_access_tokens_usage_rollups
+(
+    token_id  uuid REFERENCES personal_access_tokens ON DELETE CASCADE NOT NULL,
+    day       date                                                     NOT NULL,
+    source_ip inet                                                     NOT NULL,
+    count     bigint                                                   NOT NULL,
+    PRIMARY KEY (token_id, day, source_ip)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE personal_access_tokens_usage_rollups;
+-- +goose StatementEnd
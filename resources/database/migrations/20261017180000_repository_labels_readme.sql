@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE repositories ADD COLUMN labels jsonb NOT NULL DEFAULT '{}';
+ALTER TABLE repositories ADD COLUMN readme text NOT NULL DEFAULT '';
+CREATE INDEX repositories_labels_idx ON repositories USING gin (labels jsonb_path_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX repositories_labels_idx;
+ALTER TABLE repositories DROP COLUMN readme;
+ALTER TABLE repositories DROP COLUMN labels;
+-- +goose StatementEnd
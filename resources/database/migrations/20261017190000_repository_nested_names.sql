@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Repository names can consist of multiple path components, following the repository name grammar of the registry.
+-- Existing repositories are not validated, so they can still be renamed to a valid name afterward.
+ALTER TABLE repositories ADD CONSTRAINT repositories_name_format CHECK (
+    name ~ '^[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*(/[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*)*$'
+) NOT VALID;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE repositories DROP CONSTRAINT repositories_name_format;
+-- +goose StatementEnd
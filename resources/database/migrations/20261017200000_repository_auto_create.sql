@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE namespaces ADD COLUMN auto_create_repositories boolean NOT NULL DEFAULT false;
+ALTER TABLE namespaces ADD COLUMN auto_create_visibility repository_visibility NOT NULL DEFAULT 'private';
+ALTER TABLE repositories ADD COLUMN created_by uuid REFERENCES users ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE repositories DROP COLUMN created_by;
+ALTER TABLE namespaces DROP COLUMN auto_create_visibility;
+ALTER TABLE namespaces DROP COLUMN auto_create_repositories;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE repository_protected_tags
+(
+    id            bigint PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    repository_id uuid REFERENCES repositories ON DELETE CASCADE NOT NULL,
+    pattern       varchar(128)                                    NOT NULL,
+    created_at    timestamptz                                     NOT NULL DEFAULT now(),
+    UNIQUE (repository_id, pattern)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE repository_protected_tags;
+-- +goose StatementEnd
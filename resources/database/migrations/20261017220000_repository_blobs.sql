@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE repository_blobs
+(
+    id            bigint PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    repository_id uuid REFERENCES repositories ON DELETE CASCADE NOT NULL,
+    digest        varchar(255)                                    NOT NULL,
+    size          bigint                                          NOT NULL,
+    pushed_at     timestamptz                                     NOT NULL DEFAULT now(),
+    UNIQUE (repository_id, digest)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE repository_blobs;
+-- +goose StatementEnd
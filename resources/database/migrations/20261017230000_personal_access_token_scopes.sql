@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE personal_access_tokens ADD COLUMN scopes text[] NOT NULL DEFAULT '{}';
+-- Existing tokens could be used for the entire API, so they keep all scopes to avoid breaking existing clients.
+UPDATE personal_access_tokens
+SET scopes = '{repositories:read,repositories:write,tokens:read,tokens:write,teams:read,teams:admin,users:read,users:admin,audit:read}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE personal_access_tokens DROP COLUMN scopes;
+-- +goose StatementEnd
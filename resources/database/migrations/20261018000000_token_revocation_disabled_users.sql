@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE personal_access_tokens ADD COLUMN revoked_at timestamptz;
+ALTER TABLE users ADD COLUMN disabled boolean NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN disabled;
+ALTER TABLE personal_access_tokens DROP COLUMN revoked_at;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE personal_access_tokens ADD COLUMN previous_hash varchar;
+ALTER TABLE personal_access_tokens ADD COLUMN previous_last_eight varchar(8);
+ALTER TABLE personal_access_tokens ADD COLUMN previous_expires_at timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE personal_access_tokens DROP COLUMN previous_expires_at;
+ALTER TABLE personal_access_tokens DROP COLUMN previous_last_eight;
+ALTER TABLE personal_access_tokens DROP COLUMN previous_hash;
+-- +goose StatementEnd
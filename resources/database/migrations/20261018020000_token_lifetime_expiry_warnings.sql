@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams ADD COLUMN max_token_lifetime_seconds bigint NOT NULL DEFAULT 0 CHECK (max_token_lifetime_seconds >= 0);
+ALTER TABLE personal_access_tokens ADD COLUMN expiry_warned_at timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE personal_access_tokens DROP COLUMN expiry_warned_at;
+ALTER TABLE teams DROP COLUMN max_token_lifetime_seconds;
+-- +goose StatementEnd
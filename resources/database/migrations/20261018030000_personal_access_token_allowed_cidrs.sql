@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE personal_access_tokens ADD COLUMN allowed_cidrs text[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE personal_access_tokens DROP COLUMN allowed_cidrs;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Users provisioned from an external identity source are linked to their account in it, instead of on their username.
+-- Users that were provisioned before this migration are not linked, and cannot log in through the identity source
+-- anymore until an administrator removes them, so that they are provisioned again on their next login.
+ALTER TABLE users ADD COLUMN identity_source varchar(255);
+ALTER TABLE users ADD COLUMN identity_subject varchar(1024);
+ALTER TABLE users ADD CONSTRAINT users_identity_complete CHECK ((identity_source IS NULL) = (identity_subject IS NULL));
+ALTER TABLE users ADD CONSTRAINT users_identity_unique UNIQUE (identity_source, identity_subject);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP CONSTRAINT users_identity_unique;
+ALTER TABLE users DROP CONSTRAINT users_identity_complete;
+ALTER TABLE users DROP COLUMN identity_subject;
+ALTER TABLE users DROP COLUMN identity_source;
+-- +goose StatementEnd
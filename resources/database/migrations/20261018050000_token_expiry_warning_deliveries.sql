@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE personal_access_tokens_expiry_warning_deliveries
+(
+    token_id     uuid REFERENCES personal_access_tokens ON DELETE CASCADE NOT NULL,
+    notifier     varchar(255)                                             NOT NULL,
+    delivered_at timestamptz                                              NOT NULL,
+    PRIMARY KEY (token_id, notifier)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE personal_access_tokens_expiry_warning_deliveries;
+-- +goose StatementEnd
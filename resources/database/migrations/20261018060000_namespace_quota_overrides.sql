@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE namespaces ADD COLUMN quota_repositories integer;
+ALTER TABLE namespaces ADD COLUMN quota_bytes bigint;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE namespaces DROP COLUMN quota_bytes;
+ALTER TABLE namespaces DROP COLUMN quota_repositories;
+-- +goose StatementEnd
@@ -1,19 +1,25 @@
-CREATE TYPE user_role AS ENUM ('admin', 'user');
+CREATE TYPE user_role AS ENUM ('admin', 'user', 'robot');
 
 CREATE TABLE users
 (
-    id            uuid PRIMARY KEY,
-    username      varchar(255) UNIQUE NOT NULL,
-    password_hash varchar(255),
-    role          user_role           NOT NULL,
-    created_at    timestamptz         NOT NULL DEFAULT now()
+    id               uuid PRIMARY KEY,
+    username         varchar(255) UNIQUE NOT NULL,
+    password_hash    varchar(255),
+    role             user_role           NOT NULL,
+    disabled         boolean             NOT NULL DEFAULT false,
+    identity_source  varchar(255),
+    identity_subject varchar(1024),
+    created_at       timestamptz         NOT NULL DEFAULT now(),
+    CONSTRAINT users_identity_complete CHECK ((identity_source IS NULL) = (identity_subject IS NULL)),
+    CONSTRAINT users_identity_unique UNIQUE (identity_source, identity_subject)
 );
 
 CREATE TABLE teams
 (
-    id         uuid PRIMARY KEY,
-    name       varchar(255) UNIQUE NOT NULL,
-    created_at timestamptz         NOT NULL DEFAULT now()
+    id                         uuid PRIMARY KEY,
+    name                       varchar(255) UNIQUE NOT NULL,
+    max_token_lifetime_seconds bigint              NOT NULL DEFAULT 0 CHECK (max_token_lifetime_seconds >= 0),
+    created_at                 timestamptz         NOT NULL DEFAULT now()
 );
 
 CREATE TYPE team_member_role AS ENUM ('admin', 'user');
@@ -28,39 +34,78 @@ CREATE TABLE team_members
     UNIQUE (user_id, team_id)
 );
 
-CREATE TABLE namespaces
+CREATE TABLE team_robots
 (
-    id         bigint PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
-    name       varchar(255) UNIQUE NOT NULL,
-    user_id    uuid REFERENCES users ON DELETE CASCADE,
-    team_id    uuid REFERENCES teams ON DELETE CASCADE,
-    created_at timestamptz         NOT NULL DEFAULT now()
+    user_id    uuid PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+    team_id    uuid REFERENCES teams ON DELETE CASCADE NOT NULL,
+    name       varchar(64)                             NOT NULL,
+    created_at timestamptz                             NOT NULL DEFAULT now(),
+    UNIQUE (team_id, name)
 );
 
 CREATE TYPE repository_visibility AS ENUM ('public', 'private');
 
+CREATE TABLE namespaces
+(
+    id                       bigint PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    name                     varchar(255) UNIQUE   NOT NULL,
+    user_id                  uuid REFERENCES users ON DELETE CASCADE,
+    team_id                  uuid REFERENCES teams ON DELETE CASCADE,
+    auto_create_repositories boolean               NOT NULL DEFAULT false,
+    auto_create_visibility   repository_visibility NOT NULL DEFAULT 'private',
+    -- the quota overrides the default quota of user or team namespaces if it is set
+    quota_repositories       integer,
+    quota_bytes              bigint,
+    created_at               timestamptz           NOT NULL DEFAULT now()
+);
+
 CREATE TABLE repositories
 (
     id           uuid PRIMARY KEY,
     namespace_id bigint REFERENCES namespaces ON DELETE CASCADE NOT NULL,
     name         varchar(255)                                   NOT NULL,
     visibility   repository_visibility                          NOT NULL,
+    description  varchar(1024)                                  NOT NULL DEFAULT '',
+    labels       jsonb                                          NOT NULL DEFAULT '{}',
+    readme       text                                           NOT NULL DEFAULT '',
+    created_by   uuid REFERENCES users ON DELETE SET NULL,
     created_at   timestamptz                                    NOT NULL DEFAULT now(),
-    UNIQUE (namespace_id, name)
+    UNIQUE (namespace_id, name),
+    CONSTRAINT repositories_name_format CHECK (
+        name ~ '^[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*(/[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*)*$'
+    )
 );
 
+CREATE INDEX repositories_labels_idx ON repositories USING gin (labels jsonb_path_ops);
+
 CREATE TYPE token_permission AS ENUM ('read_only', 'read_write', 'read_write_delete');
 
 CREATE TABLE personal_access_tokens
 (
-    id              uuid PRIMARY KEY,
-    hash            varchar                                 NOT NULL,
-    last_eight      varchar(8)                              NOT NULL,
-    description     varchar(255)                            NOT NULL,
-    permission      token_permission                        NOT NULL,
-    expiration_date timestamp,
-    user_id         uuid REFERENCES users ON DELETE CASCADE NOT NULL,
-    created_at      timestamptz                             NOT NULL DEFAULT now()
+    id                  uuid PRIMARY KEY,
+    hash                varchar                                 NOT NULL,
+    last_eight          varchar(8)                              NOT NULL,
+    previous_hash       varchar,
+    previous_last_eight varchar(8),
+    previous_expires_at timestamptz,
+    description         varchar(255)                            NOT NULL,
+    permission          token_permission                        NOT NULL,
+    repositories        text[]                                  NOT NULL DEFAULT '{}',
+    scopes              text[]                                  NOT NULL DEFAULT '{}',
+    allowed_cidrs       text[]                                  NOT NULL DEFAULT '{}',
+    expiration_date     timestamp,
+    revoked_at          timestamptz,
+    expiry_warned_at    timestamptz,
+    user_id             uuid REFERENCES users ON DELETE CASCADE NOT NULL,
+    created_at          timestamptz                             NOT NULL DEFAULT now()
+);
+
+CREATE TABLE personal_access_tokens_expiry_warning_deliveries
+(
+    token_id     uuid REFERENCES personal_access_tokens ON DELETE CASCADE NOT NULL,
+    notifier     varchar(255)                                             NOT NULL,
+    delivered_at timestamptz                                              NOT NULL,
+    PRIMARY KEY (token_id, notifier)
 );
 
 CREATE TABLE personal_access_tokens_usage_log
@@ -71,3 +116,78 @@ CREATE TABLE personal_access_tokens_usage_log
     timestamp timestamptz                                              NOT NULL
 );
 CREATE INDEX ON personal_access_tokens_usage_log (token_id);
+CREATE INDEX personal_access_tokens_usage_log_token_id_timestamp_idx ON personal_access_tokens_usage_log (token_id, timestamp);
+
+CREATE TABLE personal_access_tokens_usage_rollups
+(
+    token_id  uuid REFERENCES personal_access_tokens ON DELETE CASCADE NOT NULL,
+    day       date                                                     NOT NULL,
+    source_ip inet                                                     NOT NULL,
+    count     bigint                                                   NOT NULL,
+    PRIMARY KEY (token_id, day, source_ip)
+);
+
+CREATE TABLE repository_tags
+(
+    id            bigint PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    repository_id uuid REFERENCES repositories ON DELETE CASCADE NOT NULL,
+    name          varchar(128)                                    NOT NULL,
+    digest        varchar(255)                                    NOT NULL,
+    media_type    varchar(255)                                    NOT NULL,
+    size          bigint                                          NOT NULL,
+    pushed_at     timestamptz                                     NOT NULL,
+    UNIQUE (repository_id, name)
+);
+CREATE INDEX ON repository_tags (repository_id, digest);
+
+CREATE TABLE repository_protected_tags
+(
+    id            bigint PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    repository_id uuid REFERENCES repositories ON DELETE CASCADE NOT NULL,
+    pattern       varchar(128)                                    NOT NULL,
+    created_at    timestamptz                                     NOT NULL DEFAULT now(),
+    UNIQUE (repository_id, pattern)
+);
+
+CREATE TABLE repository_blobs
+(
+    id            bigint PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    repository_id uuid REFERENCES repositories ON DELETE CASCADE NOT NULL,
+    digest        varchar(255)                                    NOT NULL,
+    size          bigint                                          NOT NULL,
+    pushed_at     timestamptz                                     NOT NULL DEFAULT now(),
+    UNIQUE (repository_id, digest)
+);
+
+CREATE TYPE repository_collaborator_permission AS ENUM ('pull', 'push', 'delete');
+
+CREATE TABLE repository_collaborators
+(
+    id            bigint PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    repository_id uuid REFERENCES repositories ON DELETE CASCADE NOT NULL,
+    user_id       uuid REFERENCES users ON DELETE CASCADE,
+    team_id       uuid REFERENCES teams ON DELETE CASCADE,
+    permission    repository_collaborator_permission              NOT NULL,
+    created_at    timestamptz                                     NOT NULL DEFAULT now(),
+    -- a collaborator is either a user or a team, never both
+    CHECK ((user_id IS NULL) <> (team_id IS NULL)),
+    UNIQUE (repository_id, user_id),
+    UNIQUE (repository_id, team_id)
+);
+
+CREATE TABLE audit_events
+(
+    id             uuid PRIMARY KEY,
+    timestamp      timestamptz  NOT NULL,
+    action         varchar(64)  NOT NULL,
+    -- the actor is not a foreign key, so that events are kept after the user has been deleted
+    actor_id       uuid         NOT NULL,
+    actor_username varchar(255) NOT NULL,
+    source_ip      inet,
+    target_type    varchar(32)  NOT NULL,
+    target         varchar(255) NOT NULL,
+    details        jsonb        NOT NULL DEFAULT '{}'
+);
+CREATE INDEX ON audit_events (timestamp);
+CREATE INDEX ON audit_events (actor_username);
+CREATE INDEX ON audit_events (target_type, target);
@@ -1,9 +1,9 @@
 -- +goose Up
 -- +goose StatementBegin
-TRUNCATE users, teams, team_members, namespaces, repositories, personal_access_tokens, personal_access_tokens_usage_log RESTART IDENTITY;
+TRUNCATE users, teams, team_members, team_robots, namespaces, repositories, personal_access_tokens, personal_access_tokens_usage_log, personal_access_tokens_usage_rollups, repository_tags, repository_collaborators, repository_protected_tags, repository_blobs, audit_events RESTART IDENTITY;
 -- +goose StatementEnd
 
 -- +goose Down
 -- +goose StatementBegin
-TRUNCATE users, teams, team_members, namespaces, repositories, personal_access_tokens, personal_access_tokens_usage_log RESTART IDENTITY;
+TRUNCATE users, teams, team_members, team_robots, namespaces, repositories, personal_access_tokens, personal_access_tokens_usage_log, personal_access_tokens_usage_rollups, repository_tags, repository_collaborators, repository_protected_tags, repository_blobs, audit_events RESTART IDENTITY;
 -- +goose StatementEnd
@@ -1,12 +1,12 @@
 -- +goose Up
 -- +goose StatementBegin
-INSERT INTO repositories (id, namespace_id, name, visibility, created_at)
-SELECT '0195cd13-ba14-76fd-b43e-55f190e566bd', id, 'public-image', 'public', '2025-01-01 00:00:00+00'
+INSERT INTO repositories (id, namespace_id, name, visibility, labels, created_at)
+SELECT '0195cd13-ba14-76fd-b43e-55f190e566bd', id, 'public-image', 'public', '{"team": "platform", "tier": "base"}', '2025-01-01 00:00:00+00'
 FROM namespaces
 WHERE name = 'adminuser';
 
-INSERT INTO repositories (id, namespace_id, name, visibility, created_at)
-SELECT '0195cd13-ba14-7728-9e48-d51b8578ea53', id, 'private-image', 'private', '2025-01-01 00:00:00+00'
+INSERT INTO repositories (id, namespace_id, name, visibility, labels, created_at)
+SELECT '0195cd13-ba14-7728-9e48-d51b8578ea53', id, 'private-image', 'private', '{"team": "platform"}', '2025-01-01 00:00:00+00'
 FROM namespaces
 WHERE name = 'adminuser';
 
@@ -1,12 +1,13 @@
 -- +goose Up
 -- +goose StatementBegin
-INSERT INTO personal_access_tokens (id, hash, last_eight, description, permission, expiration_date, user_id, created_at)
+INSERT INTO personal_access_tokens (id, hash, last_eight, description, permission, scopes, expiration_date, user_id, created_at)
 VALUES ('0195cd16-2142-78e5-8425-a8db7acbc8f8',
            -- registry_pat_SVV_otfQNmSjo7viDiCrC0AKe6Qa_iFhxXJBZE1vMOByC9nbUtBPsz3r
         'f18c6dd73fb5831deae4dc5dfd8b6d347dd32b173e75e3f2b224fc8437c4422ec0881e000d282faf546ba05d6b449ba1',
         'UtBPsz3r',
         'Read-only token',
         'read_only',
+        '{repositories:read,tokens:read}',
         '2045-03-25 12:16:33.110405+00',
         '0195cd11-2863-71d4-a3c4-032bc264cf81',
         '2025-01-01 00:00:00+00'),
@@ -16,6 +17,7 @@ VALUES ('0195cd16-2142-78e5-8425-a8db7acbc8f8',
         'B04GMpjm',
         'Read-write token',
         'read_write',
+        '{}',
         '2045-03-25 12:16:33.110405+00',
         '0195cd11-2863-71d4-a3c4-032bc264cf81',
         '2025-01-01 00:00:00+00'),
@@ -25,6 +27,7 @@ VALUES ('0195cd16-2142-78e5-8425-a8db7acbc8f8',
         '26EXKtC2',
         'Read-write-delete token',
         'read_write_delete',
+        '{}',
         '2045-03-25 12:16:33.110405+00',
         '0195cd11-2863-71d4-a3c4-032bc264cf81',
         '2025-01-01 00:00:00+00');
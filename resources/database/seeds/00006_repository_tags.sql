@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO repository_tags (repository_id, name, digest, media_type, size, pushed_at)
+VALUES ('0195cd13-ba14-76fd-b43e-55f190e566bd',
+        'latest',
+        'sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b',
+        'application/vnd.oci.image.index.v1+json',
+        10240,
+        '2025-01-01 00:00:00+00'),
+       ('0195cd13-ba14-76fd-b43e-55f190e566bd',
+        'v1.0.0',
+        'sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b',
+        'application/vnd.oci.image.index.v1+json',
+        10240,
+        '2025-01-01 00:00:00+00');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- +goose StatementEnd
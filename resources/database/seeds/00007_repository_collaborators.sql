@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO repository_collaborators (repository_id, user_id, permission, created_at)
+VALUES ('0195cd13-ba14-7728-9e48-d51b8578ea53', '0195cd11-2863-721e-a75c-86522539d0ee', 'push', '2025-01-01 00:00:00+00');
+
+INSERT INTO repository_collaborators (repository_id, team_id, permission, created_at)
+VALUES ('0195cd13-ba14-77a5-bec6-46a26a17ad2d', '0195d46f-fde4-7b27-b542-e41ed0917ace', 'pull', '2025-01-01 00:00:00+00');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- +goose StatementEnd
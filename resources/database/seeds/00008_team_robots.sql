@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO users (id, username, role, created_at)
+VALUES ('0199f1c2-4a10-7b3e-9d5c-1f2e3a4b5c6d', 'team-1+ci', 'robot', '2025-01-01 00:00:00+00');
+
+INSERT INTO team_robots (user_id, team_id, name, created_at)
+VALUES ('0199f1c2-4a10-7b3e-9d5c-1f2e3a4b5c6d', '0195d46e-cfbf-7324-b9aa-4c9c78d3b722', 'ci', '2025-01-01 00:00:00+00');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- +goose StatementEnd
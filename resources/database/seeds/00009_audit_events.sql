@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO audit_events (id, timestamp, action, actor_id, actor_username, source_ip, target_type, target, details)
+VALUES ('0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e01', '2025-01-01 00:00:00+00', 'repository.create',
+        '0195cd11-2863-71d4-a3c4-032bc264cf81', 'adminuser', '192.168.1.10', 'repository', 'adminuser/public-image',
+        '{"visibility": "public"}'),
+       ('0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e02', '2025-01-02 00:00:00+00', 'team.create',
+        '0195cd11-2863-71d4-a3c4-032bc264cf81', 'adminuser', '192.168.1.10', 'team', 'team-1', '{}'),
+       ('0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e03', '2025-01-03 00:00:00+00', 'registry.token.issue',
+        '0195cd11-2863-721e-a75c-86522539d0ee', 'normaluser', NULL, 'registry', 'registry',
+        '{"scopes": "repository:adminuser/public-image:pull"}');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- +goose StatementEnd
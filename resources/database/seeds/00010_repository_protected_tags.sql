@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO repository_protected_tags (repository_id, pattern, created_at)
+VALUES ('0195cd13-ba14-76fd-b43e-55f190e566bd', 'v*', '2025-01-01 00:00:00+00'),
+       ('0195cd13-ba14-76fd-b43e-55f190e566bd', 'latest', '2025-01-01 00:00:00+00');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- +goose StatementEnd
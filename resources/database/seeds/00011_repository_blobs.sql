@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+INSERT INTO repository_blobs (repository_id, digest, size, pushed_at)
+VALUES ('0195cd13-ba14-76fd-b43e-55f190e566bd', 'sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b', 10240, '2025-01-01 00:00:00+00'),
+       ('0195cd13-ba14-76fd-b43e-55f190e566bd', 'sha256:a3ed95caeb02ffe68cdd9fd84406680ae93d633cb16422d00e8a7c22955b46d4', 1000, '2025-01-01 00:00:00+00'),
+       ('0195cd13-ba14-7728-9e48-d51b8578ea53', 'sha256:a3ed95caeb02ffe68cdd9fd84406680ae93d633cb16422d00e8a7c22955b46d4', 1000, '2025-01-01 00:00:00+00'),
+       ('0195cd13-ba14-7728-9e48-d51b8578ea53', 'sha256:4f4fb700ef54461cfa02571ae0db9a0dc1e0cdb5577484a6d75e68dc38e8acc1', 500, '2025-01-01 00:00:00+00');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- +goose StatementEnd
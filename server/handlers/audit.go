@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"github.com/evanebb/regauth/audit"
+	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/server/middleware"
+	"github.com/evanebb/regauth/store"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type AuditHandler struct {
+	logger     *slog.Logger
+	auditStore audit.Store
+}
+
+func (h AuditHandler) ListAuditEvents(ctx context.Context, params oas.ListAuditEventsParams) (*oas.ListAuditEventsOKHeaders, error) {
+	u, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		h.logger.ErrorContext(ctx, "could not parse user from request context")
+		return nil, newInternalServerErrorResponse()
+	}
+
+	if u.Role != user.RoleAdmin {
+		return nil, newErrorResponse(http.StatusForbidden, "insufficient permission")
+	}
+
+	f := audit.Filter{
+		ActorUsername: params.Actor.Or(""),
+		Action:        audit.Action(params.Action.Or("")),
+		TargetType:    audit.TargetType(params.TargetType.Or("")),
+		Target:        params.Target.Or(""),
+		Since:         params.Since.Or(time.Time{}),
+		Until:         params.Until.Or(time.Time{}),
+		Limit:         params.Limit.Or(audit.DefaultLimit),
+		Cursor:        store.Cursor(params.Cursor.Or("")),
+	}
+
+	if err := f.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	page, err := h.auditStore.List(ctx, f)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get audit events", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	query := make(url.Values)
+	for key, value := range map[string]string{"actor": f.ActorUsername, "action": string(f.Action), "targetType": string(f.TargetType), "target": f.Target} {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	if !f.Since.IsZero() {
+		query.Set("since", f.Since.Format(time.RFC3339Nano))
+	}
+	if !f.Until.IsZero() {
+		query.Set("until", f.Until.Format(time.RFC3339Nano))
+	}
+
+	resp := &oas.ListAuditEventsOKHeaders{Response: convertSlice(page.Items, convertToAuditEventResponse)}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/audit", query, "", store.ListOptions{Limit: f.Limit}, page)
+	return resp, nil
+}
+
+// auditRecorder records the actions performed through the handlers in the audit log.
+type auditRecorder struct {
+	logger     *slog.Logger
+	auditStore audit.Store
+}
+
+// record records an event for the given action in the audit log, performed by the authenticated user in the context.
+func (r auditRecorder) record(ctx context.Context, action audit.Action, targetType audit.TargetType, target string, details map[string]string) {
+	actor, _ := AuthenticatedUserFromContext(ctx)
+	r.recordAs(ctx, actor, action, targetType, target, details)
+}
+
+// recordAs records an event for the given action in the audit log, performed by the given actor. The actor is the zero
+// value for anonymous actors.
+// Failing to record the event is only logged, since the action has already been performed at this point.
+func (r auditRecorder) recordAs(ctx context.Context, actor user.User, action audit.Action, targetType audit.TargetType, target string, details map[string]string) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		r.logger.ErrorContext(ctx, "could not generate UUID", slog.Any("error", err))
+		return
+	}
+
+	e := audit.Event{
+		ID:            id,
+		Timestamp:     time.Now(),
+		Action:        action,
+		ActorID:       actor.ID,
+		ActorUsername: string(actor.Username),
+		TargetType:    targetType,
+		Target:        target,
+		Details:       details,
+	}
+
+	if ip, ok := middleware.SourceIPFromContext(ctx); ok {
+		e.SourceIP = ip
+	}
+
+	if err := r.auditStore.Record(ctx, e); err != nil {
+		r.logger.ErrorContext(ctx, "could not record audit event", slog.String("action", string(action)), slog.Any("error", err))
+	}
+}
+
+func convertToAuditEventResponse(e audit.Event) oas.AuditEventResponse {
+	resp := oas.AuditEventResponse{
+		ID:         e.ID,
+		Timestamp:  e.Timestamp,
+		Action:     string(e.Action),
+		Actor:      e.ActorUsername,
+		TargetType: oas.AuditEventResponseTargetType(e.TargetType),
+		Target:     e.Target,
+		Details:    e.Details,
+	}
+
+	if resp.Details == nil {
+		resp.Details = make(map[string]string)
+	}
+
+	if e.SourceIP != nil {
+		resp.SourceIp = oas.NewOptString(e.SourceIP.String())
+	}
+
+	return resp
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"context"
+	"github.com/evanebb/regauth/auth/oidc"
+	"github.com/evanebb/regauth/oas"
+	"log/slog"
+	"net/http"
+)
+
+type AuthHandler struct {
+	logger *slog.Logger
+	// oidcProvider is nil if OpenID Connect authentication is disabled
+	oidcProvider *oidc.Provider
+}
+
+func (h AuthHandler) GetOIDCConfiguration(ctx context.Context) (*oas.OIDCConfigurationResponse, error) {
+	if h.oidcProvider == nil {
+		return nil, newErrorResponse(http.StatusNotFound, "OpenID Connect authentication is not enabled")
+	}
+
+	conf := h.oidcProvider.Config()
+	return &oas.OIDCConfigurationResponse{
+		Issuer:   conf.Issuer,
+		ClientId: conf.ClientID,
+		Scopes:   conf.Scopes,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/store"
+	"github.com/evanebb/regauth/user"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type CatalogHandler struct {
+	logger    *slog.Logger
+	repoStore repository.Store
+	teamStore user.TeamStore
+}
+
+func (h CatalogHandler) SearchCatalog(ctx context.Context, params oas.SearchCatalogParams) (*oas.SearchCatalogOKHeaders, error) {
+	selector, err := repository.ParseLabelSelector(params.Label)
+	if err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	f := repository.SearchFilter{
+		Query:     params.Q.Or(""),
+		Namespace: params.Namespace.Or(""),
+		Labels:    selector,
+		Sort:      repository.SearchSort(params.Sort.Or(oas.SearchCatalogSortName)),
+		Order:     repository.SearchOrder(params.Order.Or(oas.SearchCatalogOrderAsc)),
+		Limit:     params.Limit.Or(repository.DefaultSearchLimit),
+		Cursor:    store.Cursor(params.Cursor.Or("")),
+	}
+
+	if err := f.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	var access repository.SearchAccess
+	if u, ok := AuthenticatedUserFromContext(ctx); ok {
+		access, err = h.getSearchAccess(ctx, u)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "could not determine repository access for user", slog.Any("error", err))
+			return nil, newInternalServerErrorResponse()
+		}
+	}
+
+	page, err := h.repoStore.Search(ctx, f, access)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not search repositories", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	query := url.Values{"label": params.Label}
+	if f.Namespace != "" {
+		query.Set("namespace", f.Namespace)
+	}
+
+	o := f.ListOptions()
+	o.Name = f.Query
+
+	resp := &oas.SearchCatalogOKHeaders{Response: convertSlice(page.Items, convertToRepositoryResponse)}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/catalog", query, "q", o, page)
+	return resp, nil
+}
+
+// ListCatalogRepositories lists the repositories that the caller is allowed to pull in the format of the catalog of the
+// registry, for callers that are not allowed to list the catalog of the registry itself.
+func (h CatalogHandler) ListCatalogRepositories(ctx context.Context, params oas.ListCatalogRepositoriesParams) (*oas.CatalogResponseHeaders, error) {
+	n := params.N.Or(repository.DefaultSearchLimit)
+	f := repository.SearchFilter{
+		After: params.Last.Or(""),
+		Sort:  repository.SearchSortNamespace,
+		Order: repository.SearchOrderAscending,
+		Limit: n,
+	}
+
+	if err := f.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	var access repository.SearchAccess
+	if u, ok := AuthenticatedUserFromContext(ctx); ok {
+		var err error
+		access, err = h.getSearchAccess(ctx, u)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "could not determine repository access for user", slog.Any("error", err))
+			return nil, newInternalServerErrorResponse()
+		}
+	}
+
+	page, err := h.repoStore.Search(ctx, f, access)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not search repositories", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	resp := &oas.CatalogResponseHeaders{Response: oas.CatalogResponse{Repositories: []string{}}}
+	for _, r := range page.Items {
+		resp.Response.Repositories = append(resp.Response.Repositories, r.FullName())
+	}
+
+	// the registry format paginates on the name of the last repository instead of a cursor
+	if page.Next != "" {
+		last := resp.Response.Repositories[len(resp.Response.Repositories)-1]
+		u := url.URL{Path: "/v1/catalog/repositories", RawQuery: url.Values{"n": {strconv.Itoa(n)}, "last": {last}}.Encode()}
+		resp.Link = oas.NewOptString("<" + u.String() + `>; rel="next"`)
+	}
+
+	return resp, nil
+}
+
+// getSearchAccess determines which non-public repositories the user is allowed to see in the catalog, through their own
+// namespace, their teams, and the repositories they or their teams are a collaborator on, limited to the repositories
+// of the personal access token that they authenticated with.
+func (h CatalogHandler) getSearchAccess(ctx context.Context, u user.User) (repository.SearchAccess, error) {
+	teams, err := h.teamStore.GetAllByUser(ctx, u.ID)
+	if err != nil {
+		return repository.SearchAccess{}, fmt.Errorf("failed to get teams for user: %w", err)
+	}
+
+	access := repository.SearchAccess{
+		Namespaces:   []string{string(u.Username)},
+		UserID:       u.ID,
+		Repositories: tokenRepositoryPatterns(ctx),
+	}
+
+	for _, team := range teams {
+		access.Namespaces = append(access.Namespaces, string(team.Name))
+		access.TeamIDs = append(access.TeamIDs, team.ID)
+	}
+
+	return access, nil
+}
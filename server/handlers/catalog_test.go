@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+)
+
+func TestCatalogHandler_RestrictedToken(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	u := user.User{ID: uuid.New(), Username: "alice", Role: user.RoleUser}
+
+	repoStore := memory.NewRepositoryStore()
+	createTestRepository(t, repoStore, "alice", "app", repository.VisibilityPrivate)
+	createTestRepository(t, repoStore, "alice", "other", repository.VisibilityPrivate)
+	createTestRepository(t, repoStore, "bob", "public", repository.VisibilityPublic)
+
+	h := CatalogHandler{
+		logger:    logger,
+		repoStore: repoStore,
+		teamStore: memory.NewTeamStore(),
+	}
+
+	current := token.PersonalAccessToken{
+		ID:           uuid.New(),
+		Description:  "current",
+		Permission:   token.PermissionReadOnly,
+		Repositories: []token.RepositoryPattern{"alice/app"},
+		UserID:       u.ID,
+	}
+
+	// public repositories can be pulled with any token, so they stay visible
+	expected := []string{"alice/app", "bob/public"}
+
+	t.Run("search", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+		resp, err := h.SearchCatalog(ctx, oas.SearchCatalogParams{})
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		var names []string
+		for _, r := range resp.Response {
+			names = append(names, r.Namespace+"/"+r.Name)
+		}
+
+		if !slices.Equal(names, expected) {
+			t.Errorf("expected repositories %v, got %v", expected, names)
+		}
+	})
+
+	t.Run("registry catalog", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+		resp, err := h.ListCatalogRepositories(ctx, oas.ListCatalogRepositoriesParams{})
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if !slices.Equal(resp.Response.Repositories, expected) {
+			t.Errorf("expected repositories %v, got %v", expected, resp.Response.Repositories)
+		}
+	})
+}
@@ -3,7 +3,9 @@ package handlers
 import (
 	"context"
 	"errors"
+	"github.com/evanebb/regauth/audit"
 	"github.com/evanebb/regauth/auth/local"
+	"github.com/evanebb/regauth/auth/oidc"
 	"github.com/evanebb/regauth/oas"
 	"github.com/evanebb/regauth/repository"
 	"github.com/evanebb/regauth/server/response"
@@ -20,6 +22,10 @@ func NotFound(w http.ResponseWriter, r *http.Request) {
 
 type Handler struct {
 	logger *slog.Logger
+	AuditHandler
+	AuthHandler
+	CatalogHandler
+	NamespaceHandler
 	RepositoryHandler
 	TeamHandler
 	TokenHandler
@@ -33,29 +39,68 @@ func NewHandler(
 	teamStore user.TeamStore,
 	tokenStore token.Store,
 	credentialsStore local.UserCredentialsStore,
+	auditStore audit.Store,
 	tokenPrefix string,
+	lifetimePolicy token.LifetimePolicy,
+	rotationPolicy token.RotationPolicy,
+	quotas repository.Quotas,
+	oidcProvider *oidc.Provider,
 ) Handler {
+	auditLog := auditRecorder{logger: logger, auditStore: auditStore}
+
 	return Handler{
 		logger: logger,
-		RepositoryHandler: RepositoryHandler{
+		AuditHandler: AuditHandler{
+			logger:     logger,
+			auditStore: auditStore,
+		},
+		AuthHandler: AuthHandler{
+			logger:       logger,
+			oidcProvider: oidcProvider,
+		},
+		CatalogHandler: CatalogHandler{
 			logger:    logger,
 			repoStore: repoStore,
 			teamStore: teamStore,
 		},
-		TeamHandler: TeamHandler{
+		NamespaceHandler: NamespaceHandler{
 			logger:    logger,
+			repoStore: repoStore,
 			teamStore: teamStore,
+			quotas:    quotas,
+			auditLog:  auditLog,
+		},
+		RepositoryHandler: RepositoryHandler{
+			logger:    logger,
+			repoStore: repoStore,
 			userStore: userStore,
+			teamStore: teamStore,
+			quotas:    quotas,
+			auditLog:  auditLog,
+		},
+		TeamHandler: TeamHandler{
+			logger:         logger,
+			teamStore:      teamStore,
+			userStore:      userStore,
+			tokenStore:     tokenStore,
+			tokenPrefix:    tokenPrefix,
+			lifetimePolicy: lifetimePolicy,
+			auditLog:       auditLog,
 		},
 		TokenHandler: TokenHandler{
-			logger:      logger,
-			tokenStore:  tokenStore,
-			tokenPrefix: tokenPrefix,
+			logger:         logger,
+			tokenStore:     tokenStore,
+			teamStore:      teamStore,
+			tokenPrefix:    tokenPrefix,
+			lifetimePolicy: lifetimePolicy,
+			rotationPolicy: rotationPolicy,
+			auditLog:       auditLog,
 		},
 		UserHandler: UserHandler{
 			logger:           logger,
 			userStore:        userStore,
 			credentialsStore: credentialsStore,
+			auditLog:         auditLog,
 		},
 	}
 }
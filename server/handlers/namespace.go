@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/evanebb/regauth/audit"
+	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/user"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+)
+
+type NamespaceHandler struct {
+	logger    *slog.Logger
+	repoStore repository.Store
+	teamStore user.TeamStore
+	quotas    repository.Quotas
+	auditLog  auditRecorder
+}
+
+func (h NamespaceHandler) GetNamespaceSettings(ctx context.Context, params oas.GetNamespaceSettingsParams) (*oas.NamespaceSettings, error) {
+	if err := h.authorizeNamespace(ctx, params.Namespace); err != nil {
+		return nil, err
+	}
+
+	s, err := h.repoStore.GetNamespaceSettings(ctx, params.Namespace)
+	if err != nil {
+		if errors.Is(err, repository.ErrNamespaceNotFound) {
+			return nil, newErrorResponse(http.StatusNotFound, "namespace not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not get namespace settings", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	resp := convertToNamespaceSettingsResponse(s)
+	return &resp, nil
+}
+
+func (h NamespaceHandler) UpdateNamespaceSettings(ctx context.Context, req *oas.NamespaceSettings, params oas.UpdateNamespaceSettingsParams) (*oas.NamespaceSettings, error) {
+	if err := h.authorizeNamespace(ctx, params.Namespace); err != nil {
+		return nil, err
+	}
+
+	s := repository.NamespaceSettings{
+		Namespace:              params.Namespace,
+		AutoCreateRepositories: req.AutoCreateRepositories,
+		AutoCreateVisibility:   repository.Visibility(req.AutoCreateVisibility),
+	}
+
+	if err := s.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.repoStore.SaveNamespaceSettings(ctx, s); err != nil {
+		if errors.Is(err, repository.ErrNamespaceNotFound) {
+			return nil, newErrorResponse(http.StatusNotFound, "namespace not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not save namespace settings", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionNamespaceSettingsUpdate, audit.TargetTypeNamespace, s.Namespace, map[string]string{
+		"autoCreateRepositories": strconv.FormatBool(s.AutoCreateRepositories),
+		"autoCreateVisibility":   string(s.AutoCreateVisibility),
+	})
+
+	resp := convertToNamespaceSettingsResponse(s)
+	return &resp, nil
+}
+
+func (h NamespaceHandler) GetNamespaceUsage(ctx context.Context, params oas.GetNamespaceUsageParams) (*oas.NamespaceUsage, error) {
+	u, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		h.logger.ErrorContext(ctx, "could not parse user from request context")
+		return nil, newInternalServerErrorResponse()
+	}
+
+	if u.Role != user.RoleAdmin {
+		if err := h.authorizeNamespace(ctx, params.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	return h.getNamespaceUsage(ctx, params.Namespace)
+}
+
+func (h NamespaceHandler) UpdateNamespaceQuota(ctx context.Context, req *oas.NamespaceQuota, params oas.UpdateNamespaceQuotaParams) (*oas.NamespaceUsage, error) {
+	if err := h.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	o := repository.QuotaOverride{
+		Namespace: params.Namespace,
+		Quota:     repository.Quota{Repositories: req.Repositories, Bytes: req.Bytes},
+	}
+
+	if err := o.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.repoStore.SaveQuotaOverride(ctx, o); err != nil {
+		if errors.Is(err, repository.ErrNamespaceNotFound) {
+			return nil, newErrorResponse(http.StatusNotFound, "namespace not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not save quota override", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionNamespaceQuotaUpdate, audit.TargetTypeNamespace, o.Namespace, map[string]string{
+		"repositories": strconv.Itoa(o.Repositories),
+		"bytes":        strconv.FormatInt(o.Bytes, 10),
+	})
+
+	return h.getNamespaceUsage(ctx, o.Namespace)
+}
+
+func (h NamespaceHandler) DeleteNamespaceQuota(ctx context.Context, params oas.DeleteNamespaceQuotaParams) error {
+	if err := h.requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	if err := h.repoStore.DeleteQuotaOverride(ctx, params.Namespace); err != nil {
+		if errors.Is(err, repository.ErrNamespaceNotFound) {
+			return newErrorResponse(http.StatusNotFound, "namespace not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not delete quota override", slog.Any("error", err))
+		return newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionNamespaceQuotaDelete, audit.TargetTypeNamespace, params.Namespace, nil)
+
+	return nil
+}
+
+// getNamespaceUsage gets the usage of the given namespace together with its quota, which is either its quota override
+// or the default quota.
+func (h NamespaceHandler) getNamespaceUsage(ctx context.Context, namespace string) (*oas.NamespaceUsage, error) {
+	quota, usage, err := repository.NamespaceQuota(ctx, h.repoStore, h.teamStore, h.quotas, namespace)
+	if err != nil {
+		if errors.Is(err, repository.ErrNamespaceNotFound) {
+			return nil, newErrorResponse(http.StatusNotFound, "namespace not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not get namespace usage", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	resp := oas.NamespaceUsage{
+		Repositories: usage.Repositories,
+		Bytes:        usage.Bytes,
+	}
+
+	if quota.Repositories > 0 {
+		resp.RepositoryQuota = oas.NewOptInt(quota.Repositories)
+	}
+
+	if quota.Bytes > 0 {
+		resp.BytesQuota = oas.NewOptInt64(quota.Bytes)
+	}
+
+	return &resp, nil
+}
+
+// requireAdmin returns an error response if the authenticated user is not an admin.
+func (h NamespaceHandler) requireAdmin(ctx context.Context) error {
+	u, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		h.logger.ErrorContext(ctx, "could not parse user from request context")
+		return newInternalServerErrorResponse()
+	}
+
+	if u.Role != user.RoleAdmin {
+		return newErrorResponse(http.StatusForbidden, "insufficient permission")
+	}
+
+	return nil
+}
+
+// authorizeNamespace checks whether the authenticated user owns the given namespace, either as their own namespace or
+// through one of their teams. A personal access token that the user authenticated with must have access to every
+// repository in the namespace.
+func (h NamespaceHandler) authorizeNamespace(ctx context.Context, namespace string) error {
+	u, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		h.logger.ErrorContext(ctx, "could not parse user from request context")
+		return newInternalServerErrorResponse()
+	}
+
+	authorizedNamespaces, err := userNamespaces(ctx, h.teamStore, u)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to get namespaces for user", slog.Any("error", err))
+		return newInternalServerErrorResponse()
+	}
+
+	if !slices.Contains(authorizedNamespaces, namespace) {
+		return newErrorResponse(http.StatusForbidden, "not authorized for given namespace")
+	}
+
+	if tok, ok := AuthenticatedTokenFromContext(ctx); ok && !tok.AllowsNamespace(namespace) {
+		return newErrorResponse(http.StatusForbidden, "personal access token does not have access to every repository in this namespace")
+	}
+
+	return nil
+}
+
+func convertToNamespaceSettingsResponse(s repository.NamespaceSettings) oas.NamespaceSettings {
+	return oas.NamespaceSettings{
+		AutoCreateRepositories: s.AutoCreateRepositories,
+		AutoCreateVisibility:   oas.NamespaceSettingsAutoCreateVisibility(s.AutoCreateVisibility),
+	}
+}
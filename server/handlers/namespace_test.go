@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+)
+
+func TestNamespaceHandler_UpdateNamespaceSettings_RestrictedToken(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	u := user.User{ID: uuid.New(), Username: "alice", Role: user.RoleUser}
+
+	cases := []struct {
+		name         string
+		repositories []token.RepositoryPattern
+		status       int
+	}{
+		{name: "unrestricted"},
+		{name: "every repository in namespace", repositories: []token.RepositoryPattern{"alice/*"}},
+		{name: "every repository in every namespace", repositories: []token.RepositoryPattern{"*/*"}},
+		{name: "single repository", repositories: []token.RepositoryPattern{"alice/app"}, status: http.StatusForbidden},
+		{name: "repository prefix", repositories: []token.RepositoryPattern{"alice/app-*"}, status: http.StatusForbidden},
+		{name: "other namespace", repositories: []token.RepositoryPattern{"bob/*"}, status: http.StatusForbidden},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NamespaceHandler{
+				logger:    logger,
+				repoStore: memory.NewRepositoryStore(),
+				teamStore: memory.NewTeamStore(),
+				auditLog:  auditRecorder{logger: logger, auditStore: memory.NewAuditStore()},
+			}
+
+			current := token.PersonalAccessToken{
+				ID:           uuid.New(),
+				Description:  "current",
+				Permission:   token.PermissionReadWriteDelete,
+				Repositories: c.repositories,
+				UserID:       u.ID,
+			}
+
+			req := &oas.NamespaceSettings{AutoCreateRepositories: true, AutoCreateVisibility: oas.NamespaceSettingsAutoCreateVisibilityPrivate}
+
+			ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+			_, err := h.UpdateNamespaceSettings(ctx, req, oas.UpdateNamespaceSettingsParams{Namespace: "alice"})
+			if c.status == 0 {
+				if err != nil {
+					t.Fatalf("expected err to be nil, got %q", err)
+				}
+				return
+			}
+
+			var e *oas.ErrorStatusCode
+			if !errors.As(err, &e) || e.StatusCode != c.status {
+				t.Errorf("expected status code %d, got %v", c.status, err)
+			}
+
+			settings, err := h.repoStore.GetNamespaceSettings(t.Context(), "alice")
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			if settings.AutoCreateRepositories {
+				t.Errorf("expected namespace settings not to be updated")
+			}
+		})
+	}
+}
+
+func TestNamespaceHandler_QuotaOverride(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	admin := user.User{ID: uuid.New(), Username: "admin", Role: user.RoleAdmin}
+	u := user.User{ID: uuid.New(), Username: "alice", Role: user.RoleUser}
+	quotas := repository.Quotas{User: repository.Quota{Repositories: 5}}
+
+	repoStore := memory.NewRepositoryStore()
+	teamStore := memory.NewTeamStore()
+	auditLog := auditRecorder{logger: logger, auditStore: memory.NewAuditStore()}
+	createTestRepository(t, repoStore, "alice", "app", repository.VisibilityPrivate)
+
+	h := NamespaceHandler{logger: logger, repoStore: repoStore, teamStore: teamStore, quotas: quotas, auditLog: auditLog}
+	repoHandler := RepositoryHandler{logger: logger, repoStore: repoStore, teamStore: teamStore, quotas: quotas, auditLog: auditLog}
+
+	adminCtx := WithAuthenticatedUser(t.Context(), admin)
+	userCtx := WithAuthenticatedUser(t.Context(), u)
+	params := oas.UpdateNamespaceQuotaParams{Namespace: "alice"}
+
+	createRepository := func() error {
+		req := &oas.RepositoryRequest{Namespace: "alice", Name: "new", Visibility: oas.RepositoryRequestVisibilityPrivate}
+		_, err := repoHandler.CreateRepository(userCtx, req)
+		return err
+	}
+
+	var e *oas.ErrorStatusCode
+	if _, err := h.UpdateNamespaceQuota(userCtx, &oas.NamespaceQuota{Repositories: 100}, params); !errors.As(err, &e) || e.StatusCode != http.StatusForbidden {
+		t.Errorf("expected status code %d when updating quota as non-admin, got %v", http.StatusForbidden, err)
+	}
+
+	if err := h.DeleteNamespaceQuota(userCtx, oas.DeleteNamespaceQuotaParams{Namespace: "alice"}); !errors.As(err, &e) || e.StatusCode != http.StatusForbidden {
+		t.Errorf("expected status code %d when deleting quota as non-admin, got %v", http.StatusForbidden, err)
+	}
+
+	if _, err := h.UpdateNamespaceQuota(adminCtx, &oas.NamespaceQuota{Repositories: -1}, params); !errors.As(err, &e) || e.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status code %d for negative quota, got %v", http.StatusBadRequest, err)
+	}
+
+	resp, err := h.UpdateNamespaceQuota(adminCtx, &oas.NamespaceQuota{Repositories: 1, Bytes: 1000}, params)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if resp.RepositoryQuota.Or(0) != 1 || resp.BytesQuota.Or(0) != 1000 {
+		t.Errorf("expected quota override to be returned, got repository quota %v and bytes quota %v", resp.RepositoryQuota, resp.BytesQuota)
+	}
+
+	usage, err := h.GetNamespaceUsage(userCtx, oas.GetNamespaceUsageParams{Namespace: "alice"})
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if usage.RepositoryQuota.Or(0) != 1 {
+		t.Errorf("expected quota override of 1 repository to take precedence, got %v", usage.RepositoryQuota)
+	}
+
+	if err := createRepository(); !errors.As(err, &e) || e.StatusCode != http.StatusForbidden {
+		t.Errorf("expected status code %d when creating repository over quota override, got %v", http.StatusForbidden, err)
+	}
+
+	if err := h.DeleteNamespaceQuota(adminCtx, oas.DeleteNamespaceQuotaParams{Namespace: "alice"}); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	usage, err = h.GetNamespaceUsage(userCtx, oas.GetNamespaceUsageParams{Namespace: "alice"})
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if usage.RepositoryQuota.Or(0) != 5 || usage.BytesQuota.IsSet() {
+		t.Errorf("expected default quota after deleting quota override, got repository quota %v and bytes quota %v", usage.RepositoryQuota, usage.BytesQuota)
+	}
+
+	if err := createRepository(); err != nil {
+		t.Errorf("expected err to be nil when creating repository within default quota, got %q", err)
+	}
+}
@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"github.com/evanebb/regauth/audit"
+	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/server/response"
+	"github.com/evanebb/regauth/user"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxNotificationEnvelopeSize is the maximum size of a notification envelope that will be accepted from the registry.
+const maxNotificationEnvelopeSize = 10 << 20
+
+// HandleRegistryNotifications receives the notification envelopes sent by the registry, and keeps track of the tags that
+// are pushed to and deleted from the repositories, as well as the blobs that count towards the storage quota of their
+// namespace. Protected tags that are overwritten or deleted are flagged in the audit log.
+func HandleRegistryNotifications(l *slog.Logger, repoStore repository.Store, auditStore audit.Store, secret string) http.Handler {
+	auditLog := auditRecorder{logger: l, auditStore: auditStore}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			response.WriteJSONError(w, http.StatusMethodNotAllowed, "unsupported operation")
+			return
+		}
+
+		given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(given), []byte(secret)) != 1 {
+			l.InfoContext(r.Context(), "registry notification authentication failed")
+			response.WriteJSONError(w, http.StatusUnauthorized, "authentication failed")
+			return
+		}
+
+		var envelope registryNotificationEnvelope
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxNotificationEnvelopeSize)).Decode(&envelope); err != nil {
+			l.InfoContext(r.Context(), "could not decode registry notification envelope", slog.Any("error", err))
+			response.WriteJSONError(w, http.StatusBadRequest, "invalid notification envelope")
+			return
+		}
+
+		for _, event := range envelope.Events {
+			if err := handleRegistryEvent(r.Context(), l, repoStore, auditLog, event); err != nil {
+				// the registry will retry delivering the envelope if we return an error
+				l.ErrorContext(r.Context(), "could not handle registry event",
+					slog.Any("error", err),
+					slog.String("id", event.ID),
+					slog.String("action", event.Action),
+					slog.String("repository", event.Target.Repository))
+				response.WriteJSONError(w, http.StatusInternalServerError, "internal server error")
+				return
+			}
+
+			l.DebugContext(r.Context(), "handled registry event",
+				slog.String("id", event.ID),
+				slog.String("action", event.Action),
+				slog.String("repository", event.Target.Repository))
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func handleRegistryEvent(ctx context.Context, l *slog.Logger, repoStore repository.Store, auditLog auditRecorder, event registryEvent) error {
+	if event.Action != "push" && event.Action != "mount" && event.Action != "delete" {
+		// pulls do not change what is stored in the repository
+		return nil
+	}
+
+	namespace, name, ok := strings.Cut(event.Target.Repository, "/")
+	if !ok {
+		return nil
+	}
+
+	repo, err := repoStore.GetByNamespaceAndName(ctx, namespace, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			// the repository was deleted or never existed in the first place, so there is nothing to keep track of
+			return nil
+		}
+
+		return err
+	}
+
+	pushedAt := event.Timestamp
+	if pushedAt.IsZero() {
+		pushedAt = time.Now()
+	}
+
+	switch event.Action {
+	case "push", "mount":
+		// both pushed and mounted blobs count towards the storage used by the namespace
+		blob := repository.Blob{
+			RepositoryID: repo.ID,
+			Digest:       repository.Digest(event.Target.Digest),
+			Size:         event.Target.Size,
+			PushedAt:     pushedAt,
+		}
+
+		if blob.IsValid() == nil && blob.Size > 0 {
+			if err := repoStore.SaveBlob(ctx, blob); err != nil {
+				return err
+			}
+		}
+
+		if event.Action == "mount" || event.Target.Tag == "" {
+			// only tags are tracked, not the blobs and manifests that are pushed by digest
+			return nil
+		}
+
+		tag := repository.Tag{
+			RepositoryID: repo.ID,
+			Name:         repository.TagName(event.Target.Tag),
+			Digest:       repository.Digest(event.Target.Digest),
+			MediaType:    event.Target.MediaType,
+			Size:         event.Target.Size,
+			PushedAt:     pushedAt,
+		}
+
+		if err := tag.IsValid(); err != nil {
+			// there is no point in letting the registry retry an invalid event, so just skip it
+			return nil
+		}
+
+		previous, err := repoStore.GetTag(ctx, repo.ID, event.Target.Tag)
+		if err != nil && !errors.Is(err, repository.ErrTagNotFound) {
+			return err
+		}
+
+		if err == nil && previous.Digest != tag.Digest {
+			if err := flagProtectedTags(ctx, l, repoStore, auditLog, repo, event, audit.ActionRepositoryProtectedTagOverwrite, previous); err != nil {
+				return err
+			}
+		}
+
+		return repoStore.SaveTag(ctx, tag)
+	case "delete":
+		if event.Target.Tag != "" {
+			previous, err := repoStore.GetTag(ctx, repo.ID, event.Target.Tag)
+			if err != nil && !errors.Is(err, repository.ErrTagNotFound) {
+				return err
+			}
+
+			if err == nil {
+				if err := flagProtectedTags(ctx, l, repoStore, auditLog, repo, event, audit.ActionRepositoryProtectedTagDelete, previous); err != nil {
+					return err
+				}
+			}
+
+			return repoStore.DeleteTag(ctx, repo.ID, event.Target.Tag)
+		}
+
+		if event.Target.Digest != "" {
+			// deleting a manifest by digest implicitly removes all tags pointing to it
+			tags, err := repoStore.GetTags(ctx, repo.ID)
+			if err != nil {
+				return err
+			}
+
+			deleted := slices.DeleteFunc(tags, func(t repository.Tag) bool {
+				return string(t.Digest) != event.Target.Digest
+			})
+
+			if err := flagProtectedTags(ctx, l, repoStore, auditLog, repo, event, audit.ActionRepositoryProtectedTagDelete, deleted...); err != nil {
+				return err
+			}
+
+			// the deleted manifest no longer counts towards the storage used by the namespace
+			if err := repoStore.DeleteBlob(ctx, repo.ID, event.Target.Digest); err != nil {
+				return err
+			}
+
+			return repoStore.DeleteTagsByDigest(ctx, repo.ID, event.Target.Digest)
+		}
+	}
+
+	return nil
+}
+
+// flagProtectedTags records the given tags that match a protected tag pattern of the repository in the audit log, since
+// they have been overwritten or deleted in the registry. The registry has already performed the change at this point, so
+// it can only be flagged.
+func flagProtectedTags(
+	ctx context.Context,
+	l *slog.Logger,
+	repoStore repository.Store,
+	auditLog auditRecorder,
+	repo repository.Repository,
+	event registryEvent,
+	action audit.Action,
+	tags ...repository.Tag,
+) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	protectedTags, err := repoStore.GetProtectedTags(ctx, repo.ID)
+	if err != nil {
+		return err
+	}
+
+	// the actor is only known by the username that the registry reports
+	actor := user.User{Username: user.Username(event.Actor.Name)}
+
+	for _, t := range tags {
+		if !repository.IsTagProtected(protectedTags, string(t.Name)) {
+			continue
+		}
+
+		l.WarnContext(ctx, "protected tag changed in registry",
+			slog.String("action", string(action)),
+			slog.String("repository", repo.FullName()),
+			slog.String("tag", string(t.Name)),
+			slog.String("actor", event.Actor.Name))
+
+		details := map[string]string{
+			"tag":            string(t.Name),
+			"previousDigest": string(t.Digest),
+			"clientAddress":  event.Request.Addr,
+		}
+		if action == audit.ActionRepositoryProtectedTagOverwrite {
+			details["digest"] = event.Target.Digest
+		}
+
+		auditLog.recordAs(ctx, actor, action, audit.TargetTypeRepository, repositoryTarget(repo), details)
+	}
+
+	return nil
+}
+
+// registryNotificationEnvelope is the envelope that the registry uses to deliver one or more events.
+// Only the fields that are relevant to regauth are decoded.
+type registryNotificationEnvelope struct {
+	Events []registryEvent `json:"events"`
+}
+
+type registryEvent struct {
+	ID        string               `json:"id"`
+	Timestamp time.Time            `json:"timestamp"`
+	Action    string               `json:"action"`
+	Target    registryEventTarget  `json:"target"`
+	Request   registryEventRequest `json:"request"`
+	Actor     registryEventActor   `json:"actor"`
+}
+
+type registryEventTarget struct {
+	MediaType  string `json:"mediaType"`
+	Size       int64  `json:"size"`
+	Digest     string `json:"digest"`
+	Repository string `json:"repository"`
+	Tag        string `json:"tag"`
+}
+
+type registryEventRequest struct {
+	Addr string `json:"addr"`
+}
+
+type registryEventActor struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/evanebb/regauth/audit"
+	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandleRegistryNotifications_ProtectedTags(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	const (
+		oldDigest = "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b"
+		newDigest = "sha256:a3ed95caeb02ffe68cdd9fd84406680ae93d633cb16422d00e8a7c22955b46d4"
+	)
+
+	testCases := []struct {
+		desc           string
+		event          registryEvent
+		expectedAction audit.Action
+		expectedTags   []string
+	}{
+		{
+			"overwriting protected tag",
+			registryEvent{Action: "push", Target: registryEventTarget{Repository: "user/app", Tag: "v1", Digest: newDigest}},
+			audit.ActionRepositoryProtectedTagOverwrite,
+			[]string{"v1"},
+		},
+		{
+			"pushing protected tag with same digest",
+			registryEvent{Action: "push", Target: registryEventTarget{Repository: "user/app", Tag: "v1", Digest: oldDigest}},
+			"",
+			nil,
+		},
+		{
+			"pushing new protected tag",
+			registryEvent{Action: "push", Target: registryEventTarget{Repository: "user/app", Tag: "v2", Digest: newDigest}},
+			"",
+			nil,
+		},
+		{
+			"overwriting unprotected tag",
+			registryEvent{Action: "push", Target: registryEventTarget{Repository: "user/app", Tag: "dev", Digest: newDigest}},
+			"",
+			nil,
+		},
+		{
+			"deleting protected tag",
+			registryEvent{Action: "delete", Target: registryEventTarget{Repository: "user/app", Tag: "v1"}},
+			audit.ActionRepositoryProtectedTagDelete,
+			[]string{"v1"},
+		},
+		{
+			"deleting manifest of protected tag",
+			registryEvent{Action: "delete", Target: registryEventTarget{Repository: "user/app", Digest: oldDigest}},
+			audit.ActionRepositoryProtectedTagDelete,
+			[]string{"v1"},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+			repoStore := memory.NewRepositoryStore()
+			auditStore := memory.NewAuditStore()
+
+			repo := repository.Repository{ID: uuid.New(), Namespace: "user", Name: "app", Visibility: repository.VisibilityPrivate}
+			if err := repoStore.Create(t.Context(), repo); err != nil {
+				t.Fatalf("could not create repository: %q", err)
+			}
+
+			if err := repoStore.SaveProtectedTag(t.Context(), repository.ProtectedTag{RepositoryID: repo.ID, Pattern: "v*"}); err != nil {
+				t.Fatalf("could not save protected tag: %q", err)
+			}
+
+			for _, name := range []string{"v1", "dev"} {
+				tag := repository.Tag{RepositoryID: repo.ID, Name: repository.TagName(name), Digest: oldDigest, PushedAt: time.Now()}
+				if err := repoStore.SaveTag(t.Context(), tag); err != nil {
+					t.Fatalf("could not save tag: %q", err)
+				}
+			}
+
+			c.event.Actor.Name = "user"
+			body, err := json.Marshal(registryNotificationEnvelope{Events: []registryEvent{c.event}})
+			if err != nil {
+				t.Fatalf("could not encode envelope: %q", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/notifications", bytes.NewReader(body))
+			req.Header.Set("Authorization", "Bearer secret")
+			rec := httptest.NewRecorder()
+			HandleRegistryNotifications(logger, repoStore, auditStore, "secret").ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+			}
+
+			page, err := auditStore.List(t.Context(), audit.Filter{Limit: audit.DefaultLimit})
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			if len(page.Items) != len(c.expectedTags) {
+				t.Fatalf("expected %d audit events, got %d", len(c.expectedTags), len(page.Items))
+			}
+
+			for i, e := range page.Items {
+				if e.Action != c.expectedAction || e.Target != "user/app" || e.ActorUsername != "user" || e.Details["tag"] != c.expectedTags[i] {
+					t.Errorf("expected %q of tag %q by user, got %+v", c.expectedAction, c.expectedTags[i], e)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleRegistryNotifications_Blobs(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	repoStore := memory.NewRepositoryStore()
+
+	for _, name := range []string{"app", "worker"} {
+		repo := repository.Repository{ID: uuid.New(), Namespace: "user", Name: repository.Name(name), Visibility: repository.VisibilityPrivate}
+		if err := repoStore.Create(t.Context(), repo); err != nil {
+			t.Fatalf("could not create repository: %q", err)
+		}
+	}
+
+	const (
+		layerDigest    = "sha256:a3ed95caeb02ffe68cdd9fd84406680ae93d633cb16422d00e8a7c22955b46d4"
+		manifestDigest = "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b"
+	)
+
+	testCases := []struct {
+		desc          string
+		event         registryEvent
+		expectedBytes int64
+	}{
+		{
+			"pushing blob",
+			registryEvent{Action: "push", Target: registryEventTarget{Repository: "user/app", Digest: layerDigest, Size: 1000}},
+			1000,
+		},
+		{
+			"pushing tagged manifest",
+			registryEvent{Action: "push", Target: registryEventTarget{Repository: "user/app", Tag: "latest", Digest: manifestDigest, Size: 500}},
+			1500,
+		},
+		{
+			"pushing same blob again",
+			registryEvent{Action: "push", Target: registryEventTarget{Repository: "user/app", Digest: layerDigest, Size: 1000}},
+			1500,
+		},
+		{
+			"mounting blob into repository in same namespace",
+			registryEvent{Action: "mount", Target: registryEventTarget{Repository: "user/worker", Digest: layerDigest, Size: 1000}},
+			1500,
+		},
+		{
+			"deleting manifest",
+			registryEvent{Action: "delete", Target: registryEventTarget{Repository: "user/app", Digest: manifestDigest}},
+			1000,
+		},
+		{
+			"pulling blob",
+			registryEvent{Action: "pull", Target: registryEventTarget{Repository: "user/app", Digest: manifestDigest, Size: 500}},
+			1000,
+		},
+	}
+
+	// the events are handled in order, since each of them builds on the usage of the previous ones
+	for _, c := range testCases {
+		body, err := json.Marshal(registryNotificationEnvelope{Events: []registryEvent{c.event}})
+		if err != nil {
+			t.Fatalf("could not encode envelope: %q", err)
+		}
+
+		req := httptest.NewRequest(http.MethodPost, "/notifications", bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer secret")
+		rec := httptest.NewRecorder()
+		HandleRegistryNotifications(logger, repoStore, memory.NewAuditStore(), "secret").ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: expected status %d, got %d", c.desc, http.StatusOK, rec.Code)
+		}
+
+		usage, err := repoStore.GetNamespaceUsage(t.Context(), "user")
+		if err != nil {
+			t.Fatalf("%s: expected err to be nil, got %q", c.desc, err)
+		}
+
+		if usage.Bytes != c.expectedBytes {
+			t.Errorf("%s: expected %d bytes, got %d", c.desc, c.expectedBytes, usage.Bytes)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/store"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// newListOptions builds the store.ListOptions from the pagination, sorting and filtering parameters that are shared by
+// all list operations. It returns a bad request error response if they are invalid.
+func newListOptions(limit oas.OptInt, cursor oas.OptString, sort oas.OptListSort, order oas.OptListOrder, name oas.OptString) (store.ListOptions, error) {
+	o := store.ListOptions{
+		Limit:  limit.Or(store.DefaultListLimit),
+		Cursor: store.Cursor(cursor.Or("")),
+		Sort:   store.SortField(sort.Or(oas.ListSortName)),
+		Order:  store.SortOrder(order.Or(oas.ListOrderAsc)),
+		Name:   name.Or(""),
+	}
+
+	if err := o.IsValid(); err != nil {
+		return o, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	return o, nil
+}
+
+// nextPageHeaders returns the values of the Link and X-Next-Cursor headers pointing to the next page of a listing at
+// the given path, which are unset if there is no next page. The query contains the parameters of the request that are
+// specific to the operation, and is carried over to the next page together with the list options. The sort and order
+// are left out for listings that cannot be sorted, which have no sort in the given list options.
+func nextPageHeaders[T any](path string, query url.Values, nameParam string, o store.ListOptions, page store.Page[T]) (link oas.OptString, nextCursor oas.OptString) {
+	if page.Next == "" {
+		return link, nextCursor
+	}
+
+	if query == nil {
+		query = make(url.Values)
+	}
+
+	query.Set("limit", strconv.Itoa(o.Limit))
+	query.Set("cursor", string(page.Next))
+	if o.Sort != "" {
+		query.Set("sort", string(o.Sort))
+		query.Set("order", string(o.Order))
+	}
+	if o.Name != "" {
+		query.Set(nameParam, o.Name)
+	}
+
+	u := url.URL{Path: path, RawQuery: query.Encode()}
+	return oas.NewOptString("<" + u.String() + `>; rel="next"`), oas.NewOptString(string(page.Next))
+}
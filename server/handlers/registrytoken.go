@@ -3,7 +3,10 @@ package handlers
 import (
 	"encoding/base64"
 	"errors"
+	"github.com/evanebb/regauth/audit"
 	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/auth/federation"
+	"github.com/evanebb/regauth/server/middleware"
 	"github.com/evanebb/regauth/server/response"
 	"github.com/evanebb/regauth/token"
 	"github.com/evanebb/regauth/user"
@@ -11,8 +14,8 @@ import (
 	"github.com/lestrrat-go/jwx/v2/jws"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 	"log/slog"
-	"net"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -23,27 +26,58 @@ func GenerateRegistryToken(
 	authenticator auth.Authenticator,
 	authorizer auth.Authorizer,
 	tokenConfig auth.AccessTokenConfiguration,
+	federatedAuthenticator *federation.Authenticator,
+	auditStore audit.Store,
 ) http.Handler {
+	auditLog := auditRecorder{logger: l, auditStore: auditStore}
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != "GET" {
 			writeRegistryErrorResponse(w, "UNSUPPORTED", "unsupported operation", http.StatusMethodNotAllowed)
 			return
 		}
 
+		// the service is checked before authorizing, since authorizing can create repositories automatically
+		requestedService := r.URL.Query().Get("service")
+		if requestedService != tokenConfig.Service {
+			l.Info("authorization requested for unknown service")
+			writeRegistryErrorResponse(w, "DENIED", "authorization requested for unknown service", http.StatusForbidden)
+			return
+		}
+
 		var p *token.PersonalAccessToken
 		var u *user.User
 		var subject string
+		details := make(map[string]string)
 
 		username, password, ok := r.BasicAuth()
-		if ok {
-			sourceIPRaw, _, err := net.SplitHostPort(r.RemoteAddr)
+		rawFederatedToken, federated := federatedTokenFromRequest(r, federatedAuthenticator)
+
+		if federated {
+			// the workload presents a token from a trusted identity provider, instead of a personal access token
+			fp, fu, err := federatedAuthenticator.Authenticate(r.Context(), rawFederatedToken)
 			if err != nil {
-				l.ErrorContext(r.Context(), "failed to parse source IP address", slog.Any("error", err))
+				if errors.Is(err, auth.ErrAuthenticationFailed) {
+					l.Info("federated authentication failed", "error", err)
+					writeRegistryErrorResponse(w, "UNAUTHORIZED", "authentication failed", http.StatusUnauthorized)
+					return
+				}
+				l.Error("unknown error occurred during federated authentication", "error", err)
 				writeRegistryErrorResponse(w, "UNKNOWN", "unknown error", http.StatusInternalServerError)
 				return
 			}
 
-			sourceIP := net.ParseIP(sourceIPRaw)
+			p = &fp
+			u = &fu
+			subject = string(u.Username)
+			details["federationPolicy"] = string(fp.Description)
+		} else if ok {
+			sourceIP, ok := middleware.SourceIPFromContext(r.Context())
+			if !ok {
+				l.ErrorContext(r.Context(), "failed to parse source IP address")
+				writeRegistryErrorResponse(w, "UNKNOWN", "unknown error", http.StatusInternalServerError)
+				return
+			}
 
 			lp, lu, err := authenticator.Authenticate(r.Context(), username, password, sourceIP)
 			if err != nil {
@@ -60,6 +94,7 @@ func GenerateRegistryToken(
 			p = &lp
 			u = &lu
 			subject = string(u.Username)
+			details["token"] = lp.ID.String()
 		}
 
 		requestedAccess := parseScopes(r)
@@ -70,13 +105,6 @@ func GenerateRegistryToken(
 			return
 		}
 
-		requestedService := r.URL.Query().Get("service")
-		if requestedService != tokenConfig.Service {
-			l.Info("authorization requested for unknown service")
-			writeRegistryErrorResponse(w, "DENIED", "authorization requested for unknown service", http.StatusForbidden)
-			return
-		}
-
 		now := time.Now()
 		expiry := now.Add(30 * time.Minute)
 
@@ -121,42 +149,103 @@ func GenerateRegistryToken(
 			IssuedAt:    now.Format(time.RFC3339),
 		}
 
+		var actor user.User
+		if u != nil {
+			actor = *u
+		}
+
+		details["service"] = requestedService
+		details["scopes"] = formatScopes(grantedAccess)
+		auditLog.recordAs(r.Context(), actor, audit.ActionRegistryTokenIssue, audit.TargetTypeRegistry, requestedService, details)
+
 		response.WriteJSONResponse(w, http.StatusOK, resp)
 	})
 }
 
+// federatedTokenFromRequest returns the token from a trusted identity provider that the request authenticates with, if
+// any. It can either be sent as a bearer token, or as the password using basic authentication, since that is all that
+// most registry clients support. The username is ignored in that case.
+func federatedTokenFromRequest(r *http.Request, federatedAuthenticator *federation.Authenticator) (string, bool) {
+	if federatedAuthenticator == nil {
+		return "", false
+	}
+
+	if rawToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return rawToken, true
+	}
+
+	if _, password, ok := r.BasicAuth(); ok && federation.LooksLikeToken(password) {
+		return password, true
+	}
+
+	return "", false
+}
+
+// resourceTypePattern matches the resource type of a scope, with an optional resource class, such as 'repository' or
+// 'repository(plugin)'.
+var resourceTypePattern = regexp.MustCompile(`^([a-z0-9]+)(?:\(([a-z0-9]+)\))?$`)
+
+// parseScopes parses the requested scopes, in the form of 'type:name:action[,action...]'. Scopes can be given as
+// multiple scope parameters, or as a space-separated list in a single parameter. Invalid scopes are skipped.
 func parseScopes(r *http.Request) auth.Access {
 	var requestedAccess auth.Access
-	scopes := r.URL.Query()["scope"]
-	for _, scope := range scopes {
-		var ra auth.ResourceActions
-
-		parts := strings.Split(scope, ":")
-		// FIXME: add regex validation to parts
-		switch len(parts) {
-		case 3:
-			ra = auth.ResourceActions{
-				Type:    parts[0],
-				Name:    parts[1],
-				Actions: strings.Split(parts[2], ","),
-			}
-		case 4:
-			ra = auth.ResourceActions{
-				Type:    parts[0],
-				Name:    parts[1] + ":" + parts[2],
-				Actions: strings.Split(parts[3], ","),
+	for _, param := range r.URL.Query()["scope"] {
+		for _, scope := range strings.Fields(param) {
+			ra, ok := parseScope(scope)
+			if !ok {
+				continue
 			}
-		default:
-			// Invalid scope, just skip it
-			continue
-		}
 
-		requestedAccess = append(requestedAccess, ra)
+			requestedAccess = append(requestedAccess, ra)
+		}
 	}
 
 	return requestedAccess
 }
 
+// parseScope parses a single scope. The resource name can contain colons itself, for example when it includes the
+// hostname and port of the registry, so the type is everything up to the first colon and the actions are everything
+// after the last colon.
+func parseScope(scope string) (auth.ResourceActions, bool) {
+	resourceType, rest, ok := strings.Cut(scope, ":")
+	if !ok {
+		return auth.ResourceActions{}, false
+	}
+
+	i := strings.LastIndex(rest, ":")
+	if i < 1 {
+		return auth.ResourceActions{}, false
+	}
+
+	m := resourceTypePattern.FindStringSubmatch(resourceType)
+	if m == nil {
+		return auth.ResourceActions{}, false
+	}
+
+	return auth.ResourceActions{
+		Type:    m[1],
+		Class:   m[2],
+		Name:    rest[:i],
+		Actions: strings.Split(rest[i+1:], ","),
+	}, true
+}
+
+// formatScopes formats the given access as a space-separated list of scopes, in the same format that they are requested
+// in, for example 'repository:namespace/name:pull,push'.
+func formatScopes(access auth.Access) string {
+	scopes := make([]string, 0, len(access))
+	for _, ra := range access {
+		resourceType := ra.Type
+		if ra.Class != "" {
+			resourceType += "(" + ra.Class + ")"
+		}
+
+		scopes = append(scopes, resourceType+":"+ra.Name+":"+strings.Join(ra.Actions, ","))
+	}
+
+	return strings.Join(scopes, " ")
+}
+
 type registryTokenResponse struct {
 	Token       string `json:"token"`
 	AccessToken string `json:"access_token"`
@@ -0,0 +1,444 @@
+package handlers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/server/middleware"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseScopes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		query    string
+		expected auth.Access
+	}{
+		{"no scopes", "", nil},
+		{
+			"single scope",
+			"scope=repository:user/repo:pull,push",
+			auth.Access{{Type: "repository", Name: "user/repo", Actions: []string{"pull", "push"}}},
+		},
+		{
+			"multiple scope parameters",
+			"scope=repository:user/target:pull,push&scope=repository:user/source:pull",
+			auth.Access{
+				{Type: "repository", Name: "user/target", Actions: []string{"pull", "push"}},
+				{Type: "repository", Name: "user/source", Actions: []string{"pull"}},
+			},
+		},
+		{
+			"space-separated scopes",
+			"scope=" + url.QueryEscape("repository:user/target:pull,push repository:user/source:pull"),
+			auth.Access{
+				{Type: "repository", Name: "user/target", Actions: []string{"pull", "push"}},
+				{Type: "repository", Name: "user/source", Actions: []string{"pull"}},
+			},
+		},
+		{
+			"resource class",
+			"scope=repository(plugin):user/plugin:pull",
+			auth.Access{{Type: "repository", Class: "plugin", Name: "user/plugin", Actions: []string{"pull"}}},
+		},
+		{
+			"name containing a colon",
+			"scope=repository:localhost:5000/user/repo:pull",
+			auth.Access{{Type: "repository", Name: "localhost:5000/user/repo", Actions: []string{"pull"}}},
+		},
+		{
+			"registry catalog",
+			"scope=registry:catalog:*",
+			auth.Access{{Type: "registry", Name: "catalog", Actions: []string{"*"}}},
+		},
+		{"missing actions", "scope=repository:user/repo", nil},
+		{"missing name", "scope=repository::pull", nil},
+		{"invalid resource type", "scope=Repository(plugin:user/repo:pull", nil},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequest(http.MethodGet, "/token?"+c.query, nil)
+			if actual := parseScopes(r); !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("expected %+v, got %+v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFormatScopes(t *testing.T) {
+	t.Parallel()
+
+	access := auth.Access{
+		{Type: "repository", Name: "user/repo", Actions: []string{"pull", "push"}},
+		{Type: "repository", Class: "plugin", Name: "user/plugin", Actions: []string{"pull"}},
+	}
+
+	expected := "repository:user/repo:pull,push repository(plugin):user/plugin:pull"
+	if actual := formatScopes(access); actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+// TestGenerateRegistryToken_BlobMount runs the token flow of a cross-repository blob mount end-to-end: a fake registry
+// challenges the client in the same way as Distribution, by listing the pull scope on the source repository next to the
+// scope on the target repository, and only mounts the blob if the issued token grants both.
+func TestGenerateRegistryToken_BlobMount(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+
+	testCases := []struct {
+		desc            string
+		fromNamespace   string
+		fromVisibility  repository.Visibility
+		repositories    []token.RepositoryPattern
+		expectedMounted bool
+	}{
+		{"from owned repository", "user", repository.VisibilityPrivate, nil, true},
+		{"from public repository of another user", "other", repository.VisibilityPublic, nil, true},
+		{"from private repository of another user", "other", repository.VisibilityPrivate, nil, false},
+		{"with token restricted to target repository", "user", repository.VisibilityPrivate, []token.RepositoryPattern{"user/target"}, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+
+			repoStore := memory.NewRepositoryStore()
+			teamStore := memory.NewTeamStore()
+			userStore := memory.NewUserStore()
+			tokenStore := memory.NewPersonalAccessTokenStore()
+
+			repos := []repository.Repository{
+				{ID: uuid.New(), Namespace: "user", Name: "target", Visibility: repository.VisibilityPrivate},
+				{ID: uuid.New(), Namespace: c.fromNamespace, Name: "source", Visibility: c.fromVisibility},
+			}
+			for _, repo := range repos {
+				if err := repoStore.Create(t.Context(), repo); err != nil {
+					t.Fatalf("could not create repository: %q", err)
+				}
+			}
+
+			u := user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}
+			if err := userStore.Create(t.Context(), u); err != nil {
+				t.Fatalf("could not create user: %q", err)
+			}
+
+			pat := token.PersonalAccessToken{
+				ID:             uuid.New(),
+				Description:    "push",
+				Permission:     token.PermissionReadWrite,
+				Repositories:   c.repositories,
+				ExpirationDate: time.Now().Add(time.Hour),
+				UserID:         u.ID,
+			}
+			if err := tokenStore.Create(t.Context(), pat, "registry_pat_push"); err != nil {
+				t.Fatalf("could not create personal access token: %q", err)
+			}
+
+			tokenConfig := newTestAccessTokenConfiguration(t)
+			tokenServer := httptest.NewServer(middleware.SourceIP(nil)(GenerateRegistryToken(
+				logger,
+				auth.NewAuthenticator(tokenStore, userStore, "registry_pat_"),
+				auth.NewAuthorizer(logger, repoStore, teamStore, memory.NewAuditStore(), nil, repository.Quotas{}),
+				tokenConfig,
+				nil,
+				memory.NewAuditStore(),
+			)))
+			t.Cleanup(tokenServer.Close)
+
+			registry := httptest.NewServer(fakeRegistry(t, tokenServer.URL, tokenConfig))
+			t.Cleanup(registry.Close)
+
+			from := c.fromNamespace + "/source"
+			mountURL := registry.URL + "/v2/user/target/blobs/uploads/?mount=sha256:abc&from=" + url.QueryEscape(from)
+			resp := doWithChallenge(t, mountURL, "user", "registry_pat_push")
+
+			expectedStatus := http.StatusAccepted
+			if c.expectedMounted {
+				expectedStatus = http.StatusCreated
+			}
+			if resp.StatusCode != expectedStatus {
+				t.Errorf("expected status %d, got %d", expectedStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestGenerateRegistryToken_UnknownService(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+
+	testCases := []struct {
+		desc            string
+		service         string
+		expectedStatus  int
+		expectedCreated bool
+	}{
+		{"known service", "registry", http.StatusOK, true},
+		{"unknown service", "other", http.StatusForbidden, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+
+			repoStore := memory.NewRepositoryStore()
+			userStore := memory.NewUserStore()
+			tokenStore := memory.NewPersonalAccessTokenStore()
+
+			u := user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}
+			if err := userStore.Create(t.Context(), u); err != nil {
+				t.Fatalf("could not create user: %q", err)
+			}
+
+			pat := token.PersonalAccessToken{
+				ID:             uuid.New(),
+				Description:    "push",
+				Permission:     token.PermissionReadWrite,
+				ExpirationDate: time.Now().Add(time.Hour),
+				UserID:         u.ID,
+			}
+			if err := tokenStore.Create(t.Context(), pat, "registry_pat_push"); err != nil {
+				t.Fatalf("could not create personal access token: %q", err)
+			}
+
+			s := repository.NamespaceSettings{Namespace: "user", AutoCreateRepositories: true, AutoCreateVisibility: repository.VisibilityPrivate}
+			if err := repoStore.SaveNamespaceSettings(t.Context(), s); err != nil {
+				t.Fatalf("could not save namespace settings: %q", err)
+			}
+
+			tokenServer := httptest.NewServer(middleware.SourceIP(nil)(GenerateRegistryToken(
+				logger,
+				auth.NewAuthenticator(tokenStore, userStore, "registry_pat_"),
+				auth.NewAuthorizer(logger, repoStore, memory.NewTeamStore(), memory.NewAuditStore(), nil, repository.Quotas{}),
+				newTestAccessTokenConfiguration(t),
+				nil,
+				memory.NewAuditStore(),
+			)))
+			t.Cleanup(tokenServer.Close)
+
+			query := url.Values{"service": {c.service}, "scope": {"repository:user/new:pull,push"}}
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, tokenServer.URL+"?"+query.Encode(), nil)
+			if err != nil {
+				t.Fatalf("could not create request: %q", err)
+			}
+			req.SetBasicAuth("user", "registry_pat_push")
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("could not request token: %q", err)
+			}
+			_ = resp.Body.Close()
+
+			if resp.StatusCode != c.expectedStatus {
+				t.Errorf("expected status %d, got %d", c.expectedStatus, resp.StatusCode)
+			}
+
+			_, err = repoStore.GetByNamespaceAndName(t.Context(), "user", "new")
+			if created := err == nil; created != c.expectedCreated {
+				t.Errorf("expected repository to be created to be %t, got %t", c.expectedCreated, created)
+			}
+		})
+	}
+}
+
+// fakeRegistry returns a handler that only implements starting a blob upload, including mounting blobs from other
+// repositories, using the token authentication of Distribution.
+func fakeRegistry(t *testing.T, tokenServerURL string, tokenConfig auth.AccessTokenConfiguration) http.Handler {
+	uploadPath := regexp.MustCompile(`^/v2/(.+)/blobs/uploads/$`)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m := uploadPath.FindStringSubmatch(r.URL.Path)
+		if r.Method != http.MethodPost || m == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		name := m[1]
+		from := r.URL.Query().Get("from")
+
+		scopes := []string{"repository:" + name + ":pull,push"}
+		if from != "" {
+			scopes = append(scopes, "repository:"+from+":pull")
+		}
+
+		var access testAccess
+		if rawToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+			access = parseAccessClaim(t, rawToken, tokenConfig)
+		}
+
+		if !access.allows(name, "push") {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+				`Bearer realm=%q,service=%q,scope=%q`, tokenServerURL+"/token", tokenConfig.Service, strings.Join(scopes, " "),
+			))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if from != "" && access.allows(from, "pull") {
+			// the blob is mounted from the other repository, and does not have to be uploaded
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// doWithChallenge performs a POST request in the same way as registry clients do: if the registry responds with a
+// bearer challenge, a token is requested for the challenged scopes and the request is retried using that token.
+func doWithChallenge(t *testing.T, rawURL, username, password string) *http.Response {
+	resp, err := http.Post(rawURL, "", nil)
+	if err != nil {
+		t.Fatalf("could not perform request: %q", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resp
+	}
+
+	challenge := parseBearerChallenge(resp.Header.Get("WWW-Authenticate"))
+	tokenURL, err := url.Parse(challenge["realm"])
+	if err != nil {
+		t.Fatalf("could not parse realm: %q", err)
+	}
+
+	tokenURL.RawQuery = url.Values{"service": {challenge["service"]}, "scope": {challenge["scope"]}}.Encode()
+	tokenReq, err := http.NewRequest(http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		t.Fatalf("could not create token request: %q", err)
+	}
+	tokenReq.SetBasicAuth(username, password)
+
+	tokenResp, err := http.DefaultClient.Do(tokenReq)
+	if err != nil {
+		t.Fatalf("could not request token: %q", err)
+	}
+	defer func() { _ = tokenResp.Body.Close() }()
+
+	if tokenResp.StatusCode != http.StatusOK {
+		t.Fatalf("expected token response status %d, got %d", http.StatusOK, tokenResp.StatusCode)
+	}
+
+	var tr registryTokenResponse
+	if err := json.NewDecoder(tokenResp.Body).Decode(&tr); err != nil {
+		t.Fatalf("could not decode token response: %q", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, rawURL, nil)
+	if err != nil {
+		t.Fatalf("could not create request: %q", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+tr.Token)
+
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("could not perform request: %q", err)
+	}
+	_ = resp.Body.Close()
+
+	return resp
+}
+
+var challengeParamPattern = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+func parseBearerChallenge(header string) map[string]string {
+	params := make(map[string]string)
+	for _, m := range challengeParamPattern.FindAllStringSubmatch(strings.TrimPrefix(header, "Bearer "), -1) {
+		params[m[1]] = m[2]
+	}
+
+	return params
+}
+
+// parseAccessClaim verifies the given registry token and returns its access claim, like the registry does.
+func parseAccessClaim(t *testing.T, rawToken string, tokenConfig auth.AccessTokenConfiguration) testAccess {
+	tok, err := jwt.ParseString(rawToken, jwt.WithKey(tokenConfig.SigningAlg, tokenConfig.VerificationKey), jwt.WithAudience(tokenConfig.Service))
+	if err != nil {
+		t.Errorf("could not verify token: %q", err)
+		return nil
+	}
+
+	claim, _ := tok.Get("access")
+	raw, err := json.Marshal(claim)
+	if err != nil {
+		t.Fatalf("could not marshal access claim: %q", err)
+	}
+
+	var access testAccess
+	if err := json.Unmarshal(raw, &access); err != nil {
+		t.Fatalf("could not unmarshal access claim: %q", err)
+	}
+
+	return access
+}
+
+type testAccess auth.Access
+
+func (a testAccess) allows(name, action string) bool {
+	return slices.ContainsFunc(a, func(ra auth.ResourceActions) bool {
+		return ra.Type == "repository" && ra.Name == name && slices.Contains(ra.Actions, action)
+	})
+}
+
+func newTestAccessTokenConfiguration(t *testing.T) auth.AccessTokenConfiguration {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key: %q", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "regauth"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("could not create certificate: %q", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("could not parse certificate: %q", err)
+	}
+
+	return auth.AccessTokenConfiguration{
+		Issuer:          "regauth",
+		Service:         "registry",
+		SigningAlg:      jwa.ES256,
+		SigningKey:      key,
+		VerificationKey: &key.PublicKey,
+		SigningCert:     cert,
+	}
+}
@@ -4,20 +4,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/evanebb/regauth/audit"
 	"github.com/evanebb/regauth/oas"
 	"github.com/evanebb/regauth/repository"
 	"github.com/evanebb/regauth/user"
 	"github.com/google/uuid"
 	"log/slog"
+	"maps"
 	"net/http"
+	"net/url"
 	"slices"
+	"strings"
 	"time"
 )
 
 type RepositoryHandler struct {
 	logger    *slog.Logger
 	repoStore repository.Store
+	userStore user.Store
 	teamStore user.TeamStore
+	quotas    repository.Quotas
+	auditLog  auditRecorder
 }
 
 func (h RepositoryHandler) CreateRepository(ctx context.Context, req *oas.RepositoryRequest) (*oas.RepositoryResponse, error) {
@@ -37,6 +44,10 @@ func (h RepositoryHandler) CreateRepository(ctx context.Context, req *oas.Reposi
 		return nil, newErrorResponse(http.StatusForbidden, "not authorized for given namespace")
 	}
 
+	if !tokenAllowsRepository(ctx, req.Namespace, req.Name) {
+		return nil, newErrorResponse(http.StatusForbidden, "personal access token does not have access to this repository")
+	}
+
 	id, err := uuid.NewV7()
 	if err != nil {
 		h.logger.ErrorContext(ctx, "could not generate UUID", "error", err)
@@ -44,46 +55,70 @@ func (h RepositoryHandler) CreateRepository(ctx context.Context, req *oas.Reposi
 	}
 
 	repo := repository.Repository{
-		ID:         id,
-		Namespace:  req.Namespace,
-		Name:       repository.Name(req.Name),
-		Visibility: repository.Visibility(req.Visibility),
-		CreatedAt:  time.Now(),
+		ID:          id,
+		Namespace:   req.Namespace,
+		Name:        repository.Name(req.Name),
+		Visibility:  repository.Visibility(req.Visibility),
+		Description: repository.Description(req.Description.Or("")),
+		Labels:      repository.Labels(req.Labels.Or(nil)),
+		Readme:      repository.Readme(req.Readme.Or("")),
+		CreatedBy:   u.ID,
+		CreatedAt:   time.Now(),
 	}
 
 	if err := repo.IsValid(); err != nil {
 		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
 	}
 
+	if err := h.checkRepositoryQuota(ctx, repo.Namespace); err != nil {
+		return nil, err
+	}
+
 	if err := h.repoStore.Create(ctx, repo); err != nil {
 		h.logger.ErrorContext(ctx, "could not create repository", "error", err)
 		return nil, newInternalServerErrorResponse()
 	}
 
+	h.auditLog.record(ctx, audit.ActionRepositoryCreate, audit.TargetTypeRepository, repositoryTarget(repo), map[string]string{
+		"visibility": string(repo.Visibility),
+	})
+
 	resp := convertToRepositoryResponse(repo)
 	return &resp, nil
 }
 
-func (h RepositoryHandler) ListRepositories(ctx context.Context) ([]oas.RepositoryResponse, error) {
+func (h RepositoryHandler) ListRepositories(ctx context.Context, params oas.ListRepositoriesParams) (*oas.ListRepositoriesOKHeaders, error) {
 	u, ok := AuthenticatedUserFromContext(ctx)
 	if !ok {
 		h.logger.ErrorContext(ctx, "could not parse user from request context")
 		return nil, newInternalServerErrorResponse()
 	}
 
+	o, err := newListOptions(params.Limit, params.Cursor, params.Sort, params.Order, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := repository.ParseLabelSelector(params.Label)
+	if err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
 	namespaces, err := h.getUserNamespaces(ctx, u)
 	if err != nil {
 		h.logger.ErrorContext(ctx, "failed to get namespaces for user", slog.Any("error", err))
 		return nil, newInternalServerErrorResponse()
 	}
 
-	repos, err := h.repoStore.GetAllByNamespace(ctx, namespaces...)
+	page, err := h.repoStore.ListByNamespace(ctx, o, selector, tokenRepositoryPatterns(ctx), namespaces...)
 	if err != nil {
 		h.logger.ErrorContext(ctx, "failed to get repositories for user", slog.Any("error", err))
 		return nil, newInternalServerErrorResponse()
 	}
 
-	return convertSlice(repos, convertToRepositoryResponse), nil
+	resp := &oas.ListRepositoriesOKHeaders{Response: convertSlice(page.Items, convertToRepositoryResponse)}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/repositories", url.Values{"label": params.Label}, "name", o, page)
+	return resp, nil
 }
 
 func (h RepositoryHandler) GetRepository(ctx context.Context, params oas.GetRepositoryParams) (*oas.RepositoryResponse, error) {
@@ -96,22 +131,363 @@ func (h RepositoryHandler) GetRepository(ctx context.Context, params oas.GetRepo
 	return &resp, nil
 }
 
+func (h RepositoryHandler) UpdateRepository(ctx context.Context, req *oas.RepositoryUpdateRequest, params oas.UpdateRepositoryParams) (*oas.RepositoryResponse, error) {
+	repo, err := h.getRepositoryFromRequest(ctx, params.Namespace, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := repo
+
+	if req.Namespace.Or(repo.Namespace) != repo.Namespace || repository.Name(req.Name.Or(string(repo.Name))) != repo.Name {
+		if err := h.checkProtectedTagsAdmin(ctx, repo); err != nil {
+			return nil, err
+		}
+	}
+
+	if namespace, ok := req.Namespace.Get(); ok && namespace != repo.Namespace {
+		u, ok := AuthenticatedUserFromContext(ctx)
+		if !ok {
+			h.logger.ErrorContext(ctx, "could not parse user from request context")
+			return nil, newInternalServerErrorResponse()
+		}
+
+		authorizedNamespaces, err := h.getUserNamespaces(ctx, u)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "failed to get namespaces for user", slog.Any("error", err))
+			return nil, newInternalServerErrorResponse()
+		}
+
+		if !slices.Contains(authorizedNamespaces, namespace) {
+			return nil, newErrorResponse(http.StatusForbidden, "not authorized for given namespace")
+		}
+
+		if err := h.checkRepositoryQuota(ctx, namespace); err != nil {
+			return nil, err
+		}
+
+		repo.Namespace = namespace
+	}
+
+	if name, ok := req.Name.Get(); ok {
+		repo.Name = repository.Name(name)
+	}
+
+	if visibility, ok := req.Visibility.Get(); ok {
+		repo.Visibility = repository.Visibility(visibility)
+	}
+
+	if description, ok := req.Description.Get(); ok {
+		repo.Description = repository.Description(description)
+	}
+
+	if labels, ok := req.Labels.Get(); ok {
+		repo.Labels = repository.Labels(labels)
+	}
+
+	if readme, ok := req.Readme.Get(); ok {
+		repo.Readme = repository.Readme(readme)
+	}
+
+	if err := repo.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	// a renamed or moved repository must still be accessible with the token, like a newly created one
+	if !tokenAllowsRepository(ctx, repo.Namespace, string(repo.Name)) {
+		return nil, newErrorResponse(http.StatusForbidden, "personal access token does not have access to the new repository name")
+	}
+
+	if err := h.repoStore.Update(ctx, repo); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, newErrorResponse(http.StatusBadRequest, "repository already exists")
+		}
+
+		h.logger.ErrorContext(ctx, "could not update repository", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionRepositoryUpdate, audit.TargetTypeRepository, repositoryTarget(repo), repositoryChanges(previous, repo))
+
+	resp := convertToRepositoryResponse(repo)
+	return &resp, nil
+}
+
 func (h RepositoryHandler) DeleteRepository(ctx context.Context, params oas.DeleteRepositoryParams) error {
 	repo, err := h.getRepositoryFromRequest(ctx, params.Namespace, params.Name)
 	if err != nil {
 		return err
 	}
 
+	if err := h.checkProtectedTagsAdmin(ctx, repo); err != nil {
+		return err
+	}
+
 	if err := h.repoStore.DeleteByID(ctx, repo.ID); err != nil {
 		h.logger.ErrorContext(ctx, "could not delete repository", slog.Any("error", err))
 		return newInternalServerErrorResponse()
 	}
 
+	h.auditLog.record(ctx, audit.ActionRepositoryDelete, audit.TargetTypeRepository, repositoryTarget(repo), nil)
+
 	return nil
 }
 
+func (h RepositoryHandler) ListRepositoryTags(ctx context.Context, params oas.ListRepositoryTagsParams) (*oas.ListRepositoryTagsOKHeaders, error) {
+	repo, err := h.getRepositoryFromRequest(ctx, params.Namespace, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := newListOptions(params.Limit, params.Cursor, params.Sort, params.Order, params.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.repoStore.ListTags(ctx, repo.ID, o)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get tags for repository", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	resp := &oas.ListRepositoryTagsOKHeaders{Response: convertSlice(page.Items, convertToTagResponse)}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/repositories/"+url.PathEscape(repo.Namespace)+"/"+url.PathEscape(string(repo.Name))+"/tags", nil, "tag", o, page)
+	return resp, nil
+}
+
+func (h RepositoryHandler) GetRepositoryTag(ctx context.Context, params oas.GetRepositoryTagParams) (*oas.TagResponse, error) {
+	repo, err := h.getRepositoryFromRequest(ctx, params.Namespace, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := h.repoStore.GetTag(ctx, repo.ID, params.Tag)
+	if err != nil {
+		if errors.Is(err, repository.ErrTagNotFound) {
+			return nil, newErrorResponse(http.StatusNotFound, "tag not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not get tag", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	resp := convertToTagResponse(tag)
+	return &resp, nil
+}
+
+func (h RepositoryHandler) ListRepositoryCollaborators(ctx context.Context, params oas.ListRepositoryCollaboratorsParams) ([]oas.RepositoryCollaboratorResponse, error) {
+	repo, err := h.getRepositoryFromRequest(ctx, params.Namespace, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators, err := h.repoStore.GetCollaborators(ctx, repo.ID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get collaborators for repository", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	return convertSlice(collaborators, convertToRepositoryCollaboratorResponse), nil
+}
+
+func (h RepositoryHandler) AddRepositoryCollaborator(ctx context.Context, req *oas.RepositoryCollaboratorRequest, params oas.AddRepositoryCollaboratorParams) (*oas.RepositoryCollaboratorResponse, error) {
+	repo, err := h.getRepositoryFromRequest(ctx, params.Namespace, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	collaboratorType := repository.CollaboratorType(req.Type)
+	subjectID, subjectName, err := h.getCollaboratorSubject(ctx, collaboratorType, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	c := repository.Collaborator{
+		RepositoryID: repo.ID,
+		Type:         collaboratorType,
+		SubjectID:    subjectID,
+		SubjectName:  subjectName,
+		Permission:   repository.CollaboratorPermission(req.Permission),
+		CreatedAt:    time.Now(),
+	}
+
+	if err := c.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.repoStore.SaveCollaborator(ctx, c); err != nil {
+		h.logger.ErrorContext(ctx, "could not save collaborator", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionRepositoryCollaboratorAdd, audit.TargetTypeRepository, repositoryTarget(repo), map[string]string{
+		"collaboratorType": string(c.Type),
+		"collaborator":     c.SubjectName,
+		"permission":       string(c.Permission),
+	})
+
+	// read the collaborator back, since an existing collaborator keeps its original creation date
+	c, err = h.repoStore.GetCollaborator(ctx, repo.ID, c.Type, c.SubjectID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get collaborator", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	resp := convertToRepositoryCollaboratorResponse(c)
+	return &resp, nil
+}
+
+func (h RepositoryHandler) RemoveRepositoryCollaborator(ctx context.Context, params oas.RemoveRepositoryCollaboratorParams) error {
+	repo, err := h.getRepositoryFromRequest(ctx, params.Namespace, params.Name)
+	if err != nil {
+		return err
+	}
+
+	collaboratorType := repository.CollaboratorType(params.Type)
+	subjectID, subjectName, err := h.getCollaboratorSubject(ctx, collaboratorType, params.Collaborator)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.repoStore.GetCollaborator(ctx, repo.ID, collaboratorType, subjectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrCollaboratorNotFound) {
+			return newErrorResponse(http.StatusNotFound, "collaborator not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not get collaborator", slog.Any("error", err))
+		return newInternalServerErrorResponse()
+	}
+
+	if err := h.repoStore.RemoveCollaborator(ctx, repo.ID, collaboratorType, subjectID); err != nil {
+		h.logger.ErrorContext(ctx, "could not remove collaborator", slog.Any("error", err))
+		return newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionRepositoryCollaboratorRemove, audit.TargetTypeRepository, repositoryTarget(repo), map[string]string{
+		"collaboratorType": string(collaboratorType),
+		"collaborator":     subjectName,
+	})
+
+	return nil
+}
+
+func (h RepositoryHandler) ListRepositoryProtectedTags(ctx context.Context, params oas.ListRepositoryProtectedTagsParams) ([]oas.ProtectedTagResponse, error) {
+	repo, err := h.getRepositoryFromRequest(ctx, params.Namespace, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	protectedTags, err := h.repoStore.GetProtectedTags(ctx, repo.ID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get protected tags for repository", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	return convertSlice(protectedTags, convertToProtectedTagResponse), nil
+}
+
+func (h RepositoryHandler) AddRepositoryProtectedTag(ctx context.Context, req *oas.ProtectedTagRequest, params oas.AddRepositoryProtectedTagParams) (*oas.ProtectedTagResponse, error) {
+	repo, err := h.getRepositoryFromRequestAsNamespaceAdmin(ctx, params.Namespace, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	p := repository.ProtectedTag{
+		RepositoryID: repo.ID,
+		Pattern:      repository.TagPattern(req.Pattern),
+		CreatedAt:    time.Now(),
+	}
+
+	if err := p.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.repoStore.SaveProtectedTag(ctx, p); err != nil {
+		h.logger.ErrorContext(ctx, "could not save protected tag", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionRepositoryProtectedTagAdd, audit.TargetTypeRepository, repositoryTarget(repo), map[string]string{
+		"pattern": string(p.Pattern),
+	})
+
+	// read the protected tag back, since an existing protected tag keeps its original creation date
+	protectedTags, err := h.repoStore.GetProtectedTags(ctx, repo.ID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get protected tags for repository", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	if i := slices.IndexFunc(protectedTags, func(saved repository.ProtectedTag) bool { return saved.Pattern == p.Pattern }); i != -1 {
+		p = protectedTags[i]
+	}
+
+	resp := convertToProtectedTagResponse(p)
+	return &resp, nil
+}
+
+func (h RepositoryHandler) RemoveRepositoryProtectedTag(ctx context.Context, params oas.RemoveRepositoryProtectedTagParams) error {
+	repo, err := h.getRepositoryFromRequestAsNamespaceAdmin(ctx, params.Namespace, params.Name)
+	if err != nil {
+		return err
+	}
+
+	if err := h.repoStore.RemoveProtectedTag(ctx, repo.ID, params.Pattern); err != nil {
+		if errors.Is(err, repository.ErrProtectedTagNotFound) {
+			return newErrorResponse(http.StatusNotFound, "protected tag pattern not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not remove protected tag", slog.Any("error", err))
+		return newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionRepositoryProtectedTagRemove, audit.TargetTypeRepository, repositoryTarget(repo), map[string]string{
+		"pattern": params.Pattern,
+	})
+
+	return nil
+}
+
+// getCollaboratorSubject looks up the user or team with the given name, and returns its ID and name.
+func (h RepositoryHandler) getCollaboratorSubject(ctx context.Context, t repository.CollaboratorType, name string) (uuid.UUID, string, error) {
+	switch t {
+	case repository.CollaboratorTypeUser:
+		u, err := h.userStore.GetByUsername(ctx, name)
+		if err != nil {
+			if errors.Is(err, user.ErrNotFound) {
+				return uuid.Nil, "", newErrorResponse(http.StatusNotFound, "user not found")
+			}
+
+			h.logger.ErrorContext(ctx, "could not get user", slog.Any("error", err))
+			return uuid.Nil, "", newInternalServerErrorResponse()
+		}
+
+		return u.ID, string(u.Username), nil
+	case repository.CollaboratorTypeTeam:
+		team, err := h.teamStore.GetByName(ctx, name)
+		if err != nil {
+			if errors.Is(err, user.ErrTeamNotFound) {
+				return uuid.Nil, "", newErrorResponse(http.StatusNotFound, "team not found")
+			}
+
+			h.logger.ErrorContext(ctx, "could not get team", slog.Any("error", err))
+			return uuid.Nil, "", newInternalServerErrorResponse()
+		}
+
+		return team.ID, string(team.Name), nil
+	default:
+		return uuid.Nil, "", newErrorResponse(http.StatusBadRequest, repository.ErrInvalidCollaboratorType.Error())
+	}
+}
+
 func (h RepositoryHandler) getUserNamespaces(ctx context.Context, u user.User) ([]string, error) {
-	teams, err := h.teamStore.GetAllByUser(ctx, u.ID)
+	return userNamespaces(ctx, h.teamStore, u)
+}
+
+// userNamespaces returns the namespaces the given user owns: their own namespace and the namespaces of their teams.
+func userNamespaces(ctx context.Context, teamStore user.TeamStore, u user.User) ([]string, error) {
+	teams, err := teamStore.GetAllByUser(ctx, u.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get teams for user: %w", err)
 	}
@@ -144,6 +520,10 @@ func (h RepositoryHandler) getRepositoryFromRequest(ctx context.Context, namespa
 		return repository.Repository{}, newErrorResponse(http.StatusForbidden, "not authorized for given namespace")
 	}
 
+	if !tokenAllowsRepository(ctx, namespace, name) {
+		return repository.Repository{}, newErrorResponse(http.StatusForbidden, "personal access token does not have access to this repository")
+	}
+
 	repo, err := h.repoStore.GetByNamespaceAndName(ctx, namespace, name)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
@@ -160,12 +540,196 @@ func (h RepositoryHandler) getRepositoryFromRequest(ctx context.Context, namespa
 	return repo, nil
 }
 
+// tokenAllowsRepository checks whether the personal access token that the user authenticated with, if any, may be used
+// to access the given repository.
+func tokenAllowsRepository(ctx context.Context, namespace, name string) bool {
+	tok, ok := AuthenticatedTokenFromContext(ctx)
+	return !ok || tok.AllowsRepository(namespace+"/"+name)
+}
+
+// tokenRepositoryPatterns returns the repository patterns that the personal access token that the user authenticated
+// with is restricted to. It is empty if the user did not authenticate with a token, or if the token is not restricted.
+func tokenRepositoryPatterns(ctx context.Context) []string {
+	tok, _ := AuthenticatedTokenFromContext(ctx)
+	return convertRepositoryPatterns(tok.Repositories)
+}
+
+// checkRepositoryQuota returns an error response if no more repositories can be created in the given namespace.
+func (h RepositoryHandler) checkRepositoryQuota(ctx context.Context, namespace string) error {
+	quota, usage, err := repository.NamespaceQuota(ctx, h.repoStore, h.teamStore, h.quotas, namespace)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get namespace usage", slog.Any("error", err))
+		return newInternalServerErrorResponse()
+	}
+
+	if !quota.AllowsRepository(usage) {
+		return newErrorResponse(http.StatusForbidden, fmt.Sprintf("namespace has reached its quota of %d repositories", quota.Repositories))
+	}
+
+	return nil
+}
+
+// getRepositoryFromRequestAsNamespaceAdmin gets the requested repository, like getRepositoryFromRequest, but only if the
+// authenticated user is the owner of the user namespace or an admin of the team namespace that the repository is in.
+func (h RepositoryHandler) getRepositoryFromRequestAsNamespaceAdmin(ctx context.Context, namespace, name string) (repository.Repository, error) {
+	repo, err := h.getRepositoryFromRequest(ctx, namespace, name)
+	if err != nil {
+		return repository.Repository{}, err
+	}
+
+	if err := h.checkNamespaceAdmin(ctx, repo.Namespace); err != nil {
+		return repository.Repository{}, err
+	}
+
+	return repo, nil
+}
+
+// checkNamespaceAdmin returns an error response if the authenticated user is not the owner of the given user namespace
+// or an admin of the given team namespace.
+func (h RepositoryHandler) checkNamespaceAdmin(ctx context.Context, namespace string) error {
+	u, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		h.logger.ErrorContext(ctx, "could not parse user from request context")
+		return newInternalServerErrorResponse()
+	}
+
+	if u.Role == user.RoleRobot {
+		return newErrorResponse(http.StatusForbidden, "insufficient permission")
+	}
+
+	if string(u.Username) == namespace {
+		return nil
+	}
+
+	team, err := h.teamStore.GetByName(ctx, namespace)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get team", slog.Any("error", err), slog.String("name", namespace))
+		return newInternalServerErrorResponse()
+	}
+
+	member, err := h.teamStore.GetTeamMember(ctx, team.ID, u.ID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get team member", slog.Any("error", err), slog.String("team", namespace))
+		return newInternalServerErrorResponse()
+	}
+
+	if member.Role != user.TeamMemberRoleAdmin {
+		return newErrorResponse(http.StatusForbidden, "insufficient permission")
+	}
+
+	return nil
+}
+
+// checkProtectedTagsAdmin returns an error response if the repository has protected tags and the authenticated user is
+// not an admin of its namespace. Deleting, renaming or moving such a repository would otherwise get rid of its protected
+// tags, which only namespace admins may remove.
+func (h RepositoryHandler) checkProtectedTagsAdmin(ctx context.Context, repo repository.Repository) error {
+	protectedTags, err := h.repoStore.GetProtectedTags(ctx, repo.ID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get protected tags for repository", slog.Any("error", err))
+		return newInternalServerErrorResponse()
+	}
+
+	if len(protectedTags) == 0 {
+		return nil
+	}
+
+	return h.checkNamespaceAdmin(ctx, repo.Namespace)
+}
+
+// repositoryTarget returns the name of the repository formatted as 'namespace/name', to identify it in the audit log.
+func repositoryTarget(r repository.Repository) string {
+	return r.FullName()
+}
+
+// repositoryChanges returns the properties that differ between the old and new state of a repository, to include
+// in the audit log.
+func repositoryChanges(old, updated repository.Repository) map[string]string {
+	changes := make(map[string]string)
+
+	if old.Namespace != updated.Namespace || old.Name != updated.Name {
+		changes["previousName"] = repositoryTarget(old)
+	}
+
+	if old.Visibility != updated.Visibility {
+		changes["visibility"] = string(updated.Visibility)
+	}
+
+	if old.Description != updated.Description {
+		changes["description"] = string(updated.Description)
+	}
+
+	if !maps.Equal(old.Labels, updated.Labels) {
+		changes["labels"] = formatLabels(updated.Labels)
+	}
+
+	if old.Readme != updated.Readme {
+		// the README itself can be large, so only record that it has changed
+		changes["readme"] = "updated"
+	}
+
+	return changes
+}
+
+// formatLabels formats the labels as a sorted, comma-separated list of 'key=value' pairs.
+func formatLabels(l repository.Labels) string {
+	pairs := make([]string, 0, len(l))
+	for key, value := range l {
+		pairs = append(pairs, key+"="+value)
+	}
+
+	slices.Sort(pairs)
+	return strings.Join(pairs, ",")
+}
+
 func convertToRepositoryResponse(r repository.Repository) oas.RepositoryResponse {
-	return oas.RepositoryResponse{
-		ID:         r.ID,
-		Namespace:  r.Namespace,
-		Name:       string(r.Name),
-		Visibility: oas.RepositoryResponseVisibility(r.Visibility),
-		CreatedAt:  r.CreatedAt,
+	labels := make(oas.RepositoryResponseLabels, len(r.Labels))
+	maps.Copy(labels, r.Labels)
+
+	resp := oas.RepositoryResponse{
+		ID:          r.ID,
+		Namespace:   r.Namespace,
+		Name:        string(r.Name),
+		Visibility:  oas.RepositoryResponseVisibility(r.Visibility),
+		Description: oas.NewOptString(string(r.Description)),
+		Labels:      oas.NewOptRepositoryResponseLabels(labels),
+		CreatedAt:   r.CreatedAt,
+	}
+
+	if r.CreatedBy != uuid.Nil {
+		resp.CreatedBy = oas.NewOptUUID(r.CreatedBy)
+	}
+
+	// the README is not loaded when listing repositories, so only include it when it is set
+	if r.Readme != "" {
+		resp.Readme = oas.NewOptString(string(r.Readme))
+	}
+
+	return resp
+}
+
+func convertToTagResponse(t repository.Tag) oas.TagResponse {
+	return oas.TagResponse{
+		Name:      string(t.Name),
+		Digest:    string(t.Digest),
+		MediaType: t.MediaType,
+		Size:      t.Size,
+		PushedAt:  t.PushedAt,
+	}
+}
+
+func convertToRepositoryCollaboratorResponse(c repository.Collaborator) oas.RepositoryCollaboratorResponse {
+	return oas.RepositoryCollaboratorResponse{
+		Type:       oas.RepositoryCollaboratorResponseType(c.Type),
+		Name:       c.SubjectName,
+		Permission: oas.RepositoryCollaboratorResponsePermission(c.Permission),
+		CreatedAt:  c.CreatedAt,
+	}
+}
+
+func convertToProtectedTagResponse(p repository.ProtectedTag) oas.ProtectedTagResponse {
+	return oas.ProtectedTagResponse{
+		Pattern:   string(p.Pattern),
+		CreatedAt: p.CreatedAt,
 	}
 }
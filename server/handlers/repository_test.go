@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRepositoryHandler_RestrictedToken(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	u := user.User{ID: uuid.New(), Username: "alice", Role: user.RoleUser}
+
+	current := token.PersonalAccessToken{
+		ID:           uuid.New(),
+		Description:  "current",
+		Permission:   token.PermissionReadWriteDelete,
+		Repositories: []token.RepositoryPattern{"alice/app"},
+		UserID:       u.ID,
+	}
+
+	newHandler := func(t *testing.T) RepositoryHandler {
+		repoStore := memory.NewRepositoryStore()
+		for _, name := range []string{"app", "other"} {
+			createTestRepository(t, repoStore, "alice", name, repository.VisibilityPrivate)
+		}
+
+		return RepositoryHandler{
+			logger:    logger,
+			repoStore: repoStore,
+			userStore: memory.NewUserStore(),
+			teamStore: memory.NewTeamStore(),
+			auditLog:  auditRecorder{logger: logger, auditStore: memory.NewAuditStore()},
+		}
+	}
+
+	operations := map[string]func(h RepositoryHandler, ctx context.Context, name string) error{
+		"get": func(h RepositoryHandler, ctx context.Context, name string) error {
+			_, err := h.GetRepository(ctx, oas.GetRepositoryParams{Namespace: "alice", Name: name})
+			return err
+		},
+		"update": func(h RepositoryHandler, ctx context.Context, name string) error {
+			req := &oas.RepositoryUpdateRequest{Description: oas.NewOptString("updated")}
+			_, err := h.UpdateRepository(ctx, req, oas.UpdateRepositoryParams{Namespace: "alice", Name: name})
+			return err
+		},
+		"delete": func(h RepositoryHandler, ctx context.Context, name string) error {
+			return h.DeleteRepository(ctx, oas.DeleteRepositoryParams{Namespace: "alice", Name: name})
+		},
+		"list collaborators": func(h RepositoryHandler, ctx context.Context, name string) error {
+			_, err := h.ListRepositoryCollaborators(ctx, oas.ListRepositoryCollaboratorsParams{Namespace: "alice", Name: name})
+			return err
+		},
+		"add protected tag": func(h RepositoryHandler, ctx context.Context, name string) error {
+			req := &oas.ProtectedTagRequest{Pattern: "v*"}
+			_, err := h.AddRepositoryProtectedTag(ctx, req, oas.AddRepositoryProtectedTagParams{Namespace: "alice", Name: name})
+			return err
+		},
+	}
+
+	for operation, do := range operations {
+		t.Run(operation, func(t *testing.T) {
+			t.Parallel()
+
+			h := newHandler(t)
+			ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+
+			var e *oas.ErrorStatusCode
+			if err := do(h, ctx, "other"); !errors.As(err, &e) || e.StatusCode != http.StatusForbidden {
+				t.Errorf("expected status code %d for repository outside of token, got %v", http.StatusForbidden, err)
+			}
+
+			if err := do(h, ctx, "app"); err != nil {
+				t.Errorf("expected err to be nil for repository within token, got %q", err)
+			}
+
+			if err := do(h, WithAuthenticatedUser(t.Context(), u), "other"); err != nil {
+				t.Errorf("expected err to be nil without token, got %q", err)
+			}
+		})
+	}
+
+	t.Run("create", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHandler(t)
+		ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+
+		req := &oas.RepositoryRequest{Namespace: "alice", Name: "new", Visibility: oas.RepositoryRequestVisibilityPrivate}
+		_, err := h.CreateRepository(ctx, req)
+
+		var e *oas.ErrorStatusCode
+		if !errors.As(err, &e) || e.StatusCode != http.StatusForbidden {
+			t.Errorf("expected status code %d, got %v", http.StatusForbidden, err)
+		}
+
+		if _, err := h.repoStore.GetByNamespaceAndName(t.Context(), "alice", "new"); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("expected repository not to be created, got %v", err)
+		}
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHandler(t)
+		ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+
+		req := &oas.RepositoryUpdateRequest{Name: oas.NewOptString("renamed")}
+		_, err := h.UpdateRepository(ctx, req, oas.UpdateRepositoryParams{Namespace: "alice", Name: "app"})
+
+		var e *oas.ErrorStatusCode
+		if !errors.As(err, &e) || e.StatusCode != http.StatusForbidden {
+			t.Errorf("expected status code %d, got %v", http.StatusForbidden, err)
+		}
+
+		if _, err := h.repoStore.GetByNamespaceAndName(t.Context(), "alice", "app"); err != nil {
+			t.Errorf("expected repository not to be renamed, got %v", err)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHandler(t)
+		ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+
+		resp, err := h.ListRepositories(ctx, oas.ListRepositoriesParams{})
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		var names []string
+		for _, r := range resp.Response {
+			names = append(names, r.Namespace+"/"+r.Name)
+		}
+
+		if !slices.Equal(names, []string{"alice/app"}) {
+			t.Errorf("expected repositories %v, got %v", []string{"alice/app"}, names)
+		}
+	})
+}
+
+func TestRepositoryHandler_ProtectedTagsRequireNamespaceAdmin(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	member := user.User{ID: uuid.New(), Username: "alice", Role: user.RoleUser}
+	admin := user.User{ID: uuid.New(), Username: "bob", Role: user.RoleUser}
+
+	newHandler := func(t *testing.T) RepositoryHandler {
+		teamStore := memory.NewTeamStore()
+		team := user.Team{ID: uuid.New(), Name: "acme", CreatedAt: time.Now()}
+		if err := teamStore.Create(t.Context(), team); err != nil {
+			t.Fatalf("could not create team: %q", err)
+		}
+
+		for _, m := range []user.TeamMember{
+			{UserID: member.ID, TeamID: team.ID, Username: member.Username, Role: user.TeamMemberRoleUser, CreatedAt: time.Now()},
+			{UserID: admin.ID, TeamID: team.ID, Username: admin.Username, Role: user.TeamMemberRoleAdmin, CreatedAt: time.Now()},
+		} {
+			if err := teamStore.AddTeamMember(t.Context(), m); err != nil {
+				t.Fatalf("could not add team member: %q", err)
+			}
+		}
+
+		repoStore := memory.NewRepositoryStore()
+		protected := createTestRepository(t, repoStore, "acme", "protected", repository.VisibilityPrivate)
+		createTestRepository(t, repoStore, "acme", "plain", repository.VisibilityPrivate)
+
+		p := repository.ProtectedTag{RepositoryID: protected.ID, Pattern: "v*", CreatedAt: time.Now()}
+		if err := repoStore.SaveProtectedTag(t.Context(), p); err != nil {
+			t.Fatalf("could not save protected tag: %q", err)
+		}
+
+		return RepositoryHandler{
+			logger:    logger,
+			repoStore: repoStore,
+			userStore: memory.NewUserStore(),
+			teamStore: teamStore,
+			auditLog:  auditRecorder{logger: logger, auditStore: memory.NewAuditStore()},
+		}
+	}
+
+	update := func(req *oas.RepositoryUpdateRequest) func(h RepositoryHandler, ctx context.Context, name string) error {
+		return func(h RepositoryHandler, ctx context.Context, name string) error {
+			_, err := h.UpdateRepository(ctx, req, oas.UpdateRepositoryParams{Namespace: "acme", Name: name})
+			return err
+		}
+	}
+
+	cases := []struct {
+		name   string
+		user   user.User
+		repo   string
+		do     func(h RepositoryHandler, ctx context.Context, name string) error
+		status int
+	}{
+		{
+			name: "member deletes repository with protected tags",
+			user: member,
+			repo: "protected",
+			do: func(h RepositoryHandler, ctx context.Context, name string) error {
+				return h.DeleteRepository(ctx, oas.DeleteRepositoryParams{Namespace: "acme", Name: name})
+			},
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "member renames repository with protected tags",
+			user:   member,
+			repo:   "protected",
+			do:     update(&oas.RepositoryUpdateRequest{Name: oas.NewOptString("renamed")}),
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "member moves repository with protected tags",
+			user:   member,
+			repo:   "protected",
+			do:     update(&oas.RepositoryUpdateRequest{Namespace: oas.NewOptString("alice")}),
+			status: http.StatusForbidden,
+		},
+		{
+			name: "member updates description of repository with protected tags",
+			user: member,
+			repo: "protected",
+			do:   update(&oas.RepositoryUpdateRequest{Name: oas.NewOptString("protected"), Description: oas.NewOptString("updated")}),
+		},
+		{
+			name: "member deletes repository without protected tags",
+			user: member,
+			repo: "plain",
+			do: func(h RepositoryHandler, ctx context.Context, name string) error {
+				return h.DeleteRepository(ctx, oas.DeleteRepositoryParams{Namespace: "acme", Name: name})
+			},
+		},
+		{
+			name: "member renames repository without protected tags",
+			user: member,
+			repo: "plain",
+			do:   update(&oas.RepositoryUpdateRequest{Name: oas.NewOptString("renamed")}),
+		},
+		{
+			name: "admin deletes repository with protected tags",
+			user: admin,
+			repo: "protected",
+			do: func(h RepositoryHandler, ctx context.Context, name string) error {
+				return h.DeleteRepository(ctx, oas.DeleteRepositoryParams{Namespace: "acme", Name: name})
+			},
+		},
+		{
+			name: "admin renames repository with protected tags",
+			user: admin,
+			repo: "protected",
+			do:   update(&oas.RepositoryUpdateRequest{Name: oas.NewOptString("renamed")}),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := newHandler(t)
+			err := c.do(h, WithAuthenticatedUser(t.Context(), c.user), c.repo)
+			if c.status == 0 {
+				if err != nil {
+					t.Fatalf("expected err to be nil, got %q", err)
+				}
+				return
+			}
+
+			var e *oas.ErrorStatusCode
+			if !errors.As(err, &e) || e.StatusCode != c.status {
+				t.Errorf("expected status code %d, got %v", c.status, err)
+			}
+
+			if _, err := h.repoStore.GetByNamespaceAndName(t.Context(), "acme", c.repo); err != nil {
+				t.Errorf("expected repository to be unchanged, got %v", err)
+			}
+		})
+	}
+}
+
+func createTestRepository(t *testing.T, repoStore repository.Store, namespace, name string, visibility repository.Visibility) repository.Repository {
+	t.Helper()
+
+	r := repository.Repository{
+		ID:         uuid.New(),
+		Namespace:  namespace,
+		Name:       repository.Name(name),
+		Visibility: visibility,
+		CreatedAt:  time.Now(),
+	}
+
+	if err := repoStore.Create(t.Context(), r); err != nil {
+		t.Fatalf("could not create repository: %q", err)
+	}
+
+	return r
+}
@@ -3,36 +3,52 @@ package handlers
 import (
 	"context"
 	"errors"
-	"github.com/evanebb/regauth/auth/local"
+	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/auth/oidc"
 	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/server/middleware"
 	"github.com/evanebb/regauth/token"
 	"github.com/evanebb/regauth/user"
 	"log/slog"
 	"net/http"
+	"strings"
+	"time"
 )
 
 type SecurityHandler struct {
-	logger           *slog.Logger
-	tokenStore       token.Store
-	userStore        user.Store
-	credentialsStore local.UserCredentialsStore
+	logger                *slog.Logger
+	tokenStore            token.Store
+	userStore             user.Store
+	passwordAuthenticator auth.PasswordAuthenticator
+	tokenPrefix           string
+	// oidcAuthenticator is nil if OpenID Connect authentication is disabled
+	oidcAuthenticator *oidc.Authenticator
 }
 
 func NewSecurityHandler(
 	logger *slog.Logger,
 	tokenStore token.Store,
 	userStore user.Store,
-	credentialsStore local.UserCredentialsStore,
+	passwordAuthenticator auth.PasswordAuthenticator,
+	tokenPrefix string,
+	oidcAuthenticator *oidc.Authenticator,
 ) SecurityHandler {
 	return SecurityHandler{
-		logger:           logger,
-		tokenStore:       tokenStore,
-		userStore:        userStore,
-		credentialsStore: credentialsStore,
+		logger:                logger,
+		tokenStore:            tokenStore,
+		userStore:             userStore,
+		passwordAuthenticator: passwordAuthenticator,
+		tokenPrefix:           tokenPrefix,
+		oidcAuthenticator:     oidcAuthenticator,
 	}
 }
 
 func (s SecurityHandler) HandlePersonalAccessToken(ctx context.Context, operationName oas.OperationName, t oas.PersonalAccessToken) (context.Context, error) {
+	if s.oidcAuthenticator != nil && !strings.HasPrefix(t.GetToken(), s.tokenPrefix) {
+		// anything that does not look like a personal access token is treated as an ID token from the identity provider
+		return s.handleIDToken(ctx, t.GetToken())
+	}
+
 	tok, err := s.tokenStore.GetByPlainTextToken(ctx, t.GetToken())
 	if err != nil {
 		if errors.Is(err, token.ErrNotFound) {
@@ -49,42 +65,141 @@ func (s SecurityHandler) HandlePersonalAccessToken(ctx context.Context, operatio
 		return ctx, newInternalServerErrorResponse()
 	}
 
+	if err := auth.ValidateToken(tok, u, time.Now()); err != nil {
+		s.logger.DebugContext(ctx, "token authentication failed", slog.Any("error", err))
+		return ctx, newErrorResponse(http.StatusUnauthorized, "authentication failed")
+	}
+
+	sourceIP, _ := middleware.SourceIPFromContext(ctx)
+	if err := auth.ValidateSourceIP(tok, sourceIP); err != nil {
+		s.logger.DebugContext(ctx, "token authentication failed", slog.String("sourceIp", sourceIP.String()), slog.Any("error", err))
+		return ctx, newErrorResponse(http.StatusUnauthorized, "authentication failed")
+	}
+
+	if u.Role == user.RoleRobot {
+		s.logger.DebugContext(ctx, "robots cannot use the API", slog.String("username", string(u.Username)))
+		return ctx, newErrorResponse(http.StatusForbidden, "robots cannot use the API")
+	}
+
+	// operations without a scope are denied, so that new operations cannot be used with any token by accident
+	scope, ok := operationScopes[operationName]
+	if !ok || !tok.HasScope(scope) {
+		s.logger.DebugContext(ctx, "token does not have the required scope", slog.String("operation", operationName), slog.String("scope", string(scope)))
+		return ctx, newErrorResponse(http.StatusForbidden, "token does not have the required scope "+string(scope))
+	}
+
 	s.logger.DebugContext(ctx, "token authentication successful")
-	return WithAuthenticatedUser(ctx, u), nil
+	return WithAuthenticatedToken(WithAuthenticatedUser(ctx, u), tok), nil
 }
 
 func (s SecurityHandler) HandleUsernamePassword(ctx context.Context, operationName oas.OperationName, t oas.UsernamePassword) (context.Context, error) {
-	u, err := s.userStore.GetByUsername(ctx, t.GetUsername())
+	u, err := s.passwordAuthenticator.AuthenticatePassword(ctx, t.GetUsername(), t.GetPassword())
 	if err != nil {
-		if errors.Is(err, user.ErrNotFound) {
-			s.logger.DebugContext(ctx, "user not found", slog.String("username", t.GetUsername()))
+		if errors.Is(err, auth.ErrAuthenticationFailed) {
+			s.logger.DebugContext(ctx, "password authentication failed", slog.String("username", t.GetUsername()), slog.Any("error", err))
 			return ctx, newErrorResponse(http.StatusUnauthorized, "authentication failed")
 		}
 
-		s.logger.ErrorContext(ctx, "could not get user", slog.Any("error", err))
+		s.logger.ErrorContext(ctx, "could not authenticate using password", slog.Any("error", err))
 		return ctx, newInternalServerErrorResponse()
 	}
 
-	credentials, err := s.credentialsStore.GetByUserID(ctx, u.ID)
+	if err := auth.ValidateUser(u); err != nil {
+		s.logger.DebugContext(ctx, "password authentication failed", slog.String("username", t.GetUsername()), slog.Any("error", err))
+		return ctx, newErrorResponse(http.StatusUnauthorized, "authentication failed")
+	}
+
+	s.logger.DebugContext(ctx, "password authentication successful")
+	return WithAuthenticatedUser(ctx, u), nil
+}
+
+func (s SecurityHandler) handleIDToken(ctx context.Context, rawIDToken string) (context.Context, error) {
+	u, err := s.oidcAuthenticator.Authenticate(ctx, rawIDToken)
 	if err != nil {
-		if errors.Is(err, local.ErrNoCredentials) {
-			s.logger.DebugContext(ctx, "no credentials set for user", slog.String("username", t.GetUsername()))
+		var invalidUsername user.InvalidUsernameError
+		if errors.Is(err, oidc.ErrInvalidIDToken) || errors.Is(err, oidc.ErrMissingClaim) ||
+			errors.Is(err, auth.ErrAuthenticationFailed) || errors.As(err, &invalidUsername) {
+			s.logger.DebugContext(ctx, "ID token authentication failed", slog.Any("error", err))
 			return ctx, newErrorResponse(http.StatusUnauthorized, "authentication failed")
 		}
 
-		s.logger.ErrorContext(ctx, "could not get credentials", slog.Any("error", err))
+		s.logger.ErrorContext(ctx, "could not authenticate using ID token", slog.Any("error", err))
 		return ctx, newInternalServerErrorResponse()
 	}
 
-	if err := credentials.CheckPassword(t.GetPassword()); err != nil {
-		s.logger.DebugContext(ctx, "password does not match", slog.String("username", t.GetUsername()))
+	if err := auth.ValidateUser(u); err != nil {
+		s.logger.DebugContext(ctx, "ID token authentication failed", slog.Any("error", err))
 		return ctx, newErrorResponse(http.StatusUnauthorized, "authentication failed")
 	}
 
-	s.logger.DebugContext(ctx, "token authentication successful")
+	s.logger.DebugContext(ctx, "ID token authentication successful", slog.String("username", string(u.Username)))
 	return WithAuthenticatedUser(ctx, u), nil
 }
 
+// operationScopes contains the scope that a personal access token needs for each operation, which corresponds to the
+// security requirements of the operations in the OpenAPI specification.
+var operationScopes = map[oas.OperationName]token.Scope{
+	oas.ListRepositoriesOperation:            token.ScopeRepositoriesRead,
+	oas.GetRepositoryOperation:               token.ScopeRepositoriesRead,
+	oas.ListRepositoryTagsOperation:          token.ScopeRepositoriesRead,
+	oas.GetRepositoryTagOperation:            token.ScopeRepositoriesRead,
+	oas.ListRepositoryCollaboratorsOperation: token.ScopeRepositoriesRead,
+	oas.ListRepositoryProtectedTagsOperation: token.ScopeRepositoriesRead,
+	oas.GetNamespaceSettingsOperation:        token.ScopeRepositoriesRead,
+	oas.GetNamespaceUsageOperation:           token.ScopeRepositoriesRead,
+	oas.SearchCatalogOperation:               token.ScopeRepositoriesRead,
+	oas.ListCatalogRepositoriesOperation:     token.ScopeRepositoriesRead,
+
+	oas.CreateRepositoryOperation:             token.ScopeRepositoriesWrite,
+	oas.UpdateRepositoryOperation:             token.ScopeRepositoriesWrite,
+	oas.DeleteRepositoryOperation:             token.ScopeRepositoriesWrite,
+	oas.AddRepositoryCollaboratorOperation:    token.ScopeRepositoriesWrite,
+	oas.RemoveRepositoryCollaboratorOperation: token.ScopeRepositoriesWrite,
+	oas.AddRepositoryProtectedTagOperation:    token.ScopeRepositoriesWrite,
+	oas.RemoveRepositoryProtectedTagOperation: token.ScopeRepositoriesWrite,
+	oas.UpdateNamespaceSettingsOperation:      token.ScopeRepositoriesWrite,
+
+	oas.ListPersonalAccessTokensOperation:         token.ScopeTokensRead,
+	oas.GetPersonalAccessTokenOperation:           token.ScopeTokensRead,
+	oas.GetPersonalAccessTokenUsageOperation:      token.ScopeTokensRead,
+	oas.GetPersonalAccessTokenDailyUsageOperation: token.ScopeTokensRead,
+
+	oas.CreatePersonalAccessTokenOperation: token.ScopeTokensWrite,
+	oas.DeletePersonalAccessTokenOperation: token.ScopeTokensWrite,
+	oas.RevokePersonalAccessTokenOperation: token.ScopeTokensWrite,
+	oas.RotatePersonalAccessTokenOperation: token.ScopeTokensWrite,
+
+	oas.ListTeamsOperation:           token.ScopeTeamsRead,
+	oas.GetTeamOperation:             token.ScopeTeamsRead,
+	oas.ListTeamMembersOperation:     token.ScopeTeamsRead,
+	oas.ListTeamRobotsOperation:      token.ScopeTeamsRead,
+	oas.GetTeamRobotOperation:        token.ScopeTeamsRead,
+	oas.ListTeamRobotTokensOperation: token.ScopeTeamsRead,
+
+	oas.CreateTeamOperation:           token.ScopeTeamsAdmin,
+	oas.UpdateTeamOperation:           token.ScopeTeamsAdmin,
+	oas.DeleteTeamOperation:           token.ScopeTeamsAdmin,
+	oas.AddTeamMemberOperation:        token.ScopeTeamsAdmin,
+	oas.RemoveTeamMemberOperation:     token.ScopeTeamsAdmin,
+	oas.CreateTeamRobotOperation:      token.ScopeTeamsAdmin,
+	oas.DeleteTeamRobotOperation:      token.ScopeTeamsAdmin,
+	oas.CreateTeamRobotTokenOperation: token.ScopeTeamsAdmin,
+	oas.DeleteTeamRobotTokenOperation: token.ScopeTeamsAdmin,
+	oas.RevokeTeamRobotTokenOperation: token.ScopeTeamsAdmin,
+
+	oas.ListUsersOperation: token.ScopeUsersRead,
+	oas.GetUserOperation:   token.ScopeUsersRead,
+
+	oas.CreateUserOperation:           token.ScopeUsersAdmin,
+	oas.UpdateUserOperation:           token.ScopeUsersAdmin,
+	oas.DeleteUserOperation:           token.ScopeUsersAdmin,
+	oas.ChangeUserPasswordOperation:   token.ScopeUsersAdmin,
+	oas.UpdateNamespaceQuotaOperation: token.ScopeUsersAdmin,
+	oas.DeleteNamespaceQuotaOperation: token.ScopeUsersAdmin,
+
+	oas.ListAuditEventsOperation: token.ScopeAuditRead,
+}
+
 type authenticatedUserCtxKey struct{}
 
 // WithAuthenticatedUser sets the authenticated user.User in the context.
@@ -99,3 +214,18 @@ func AuthenticatedUserFromContext(ctx context.Context) (user.User, bool) {
 	val, ok := ctx.Value(authenticatedUserCtxKey{}).(user.User)
 	return val, ok
 }
+
+type authenticatedTokenCtxKey struct{}
+
+// WithAuthenticatedToken sets the token.PersonalAccessToken that the user authenticated with in the context.
+// Use AuthenticatedTokenFromContext to retrieve the token.
+func WithAuthenticatedToken(ctx context.Context, t token.PersonalAccessToken) context.Context {
+	return context.WithValue(ctx, authenticatedTokenCtxKey{}, t)
+}
+
+// AuthenticatedTokenFromContext parses the token.PersonalAccessToken that the user authenticated with from the given
+// request context. This is only set if the user authenticated using a personal access token.
+func AuthenticatedTokenFromContext(ctx context.Context) (token.PersonalAccessToken, bool) {
+	val, ok := ctx.Value(authenticatedTokenCtxKey{}).(token.PersonalAccessToken)
+	return val, ok
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/server/middleware"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOperationScopes(t *testing.T) {
+	t.Parallel()
+
+	b, err := os.ReadFile("../../api/openapi.yaml")
+	if err != nil {
+		t.Fatalf("could not read OpenAPI specification: %q", err)
+	}
+
+	var spec struct {
+		Paths map[string]map[string]yaml.Node `yaml:"paths"`
+	}
+	if err := yaml.Unmarshal(b, &spec); err != nil {
+		t.Fatalf("could not parse OpenAPI specification: %q", err)
+	}
+
+	seen := make(map[oas.OperationName]bool)
+	operations := make(map[oas.OperationName]bool)
+
+	for path, pathItem := range spec.Paths {
+		for method, node := range pathItem {
+			if strings.HasPrefix(method, "x-") || method == "parameters" {
+				// extensions such as x-ogen-operation-group and shared parameters are not operations
+				continue
+			}
+
+			var op struct {
+				OperationID string                 `yaml:"operationId"`
+				Security    *[]map[string][]string `yaml:"security"`
+			}
+			if err := node.Decode(&op); err != nil {
+				t.Fatalf("could not parse operation: %q", err)
+			}
+
+			if op.OperationID == "" {
+				t.Errorf("expected operation %s %s to have an operation ID", strings.ToUpper(method), path)
+				continue
+			}
+
+			name := oas.OperationName(strings.ToUpper(op.OperationID[:1]) + op.OperationID[1:])
+			if seen[name] {
+				t.Errorf("expected operation ID of %s to be unique", name)
+			}
+			seen[name] = true
+
+			if op.Security == nil {
+				t.Errorf("expected operation %s to explicitly list its security requirements", name)
+				continue
+			}
+
+			acceptsToken := false
+			var scopes []string
+			for _, requirement := range *op.Security {
+				if s, ok := requirement["personalAccessToken"]; ok {
+					acceptsToken = true
+					scopes = s
+				}
+			}
+
+			scope, ok := operationScopes[name]
+			if !acceptsToken {
+				if ok {
+					t.Errorf("expected operation %s not to have a scope, since it does not accept personal access tokens, got %q", name, scope)
+				}
+				continue
+			}
+
+			operations[name] = true
+
+			if len(scopes) != 1 {
+				t.Errorf("expected operation %s to require exactly one scope, got %v", name, scopes)
+				continue
+			}
+
+			if !ok {
+				t.Errorf("expected operation %s to have scope %q, got none", name, scopes[0])
+				continue
+			}
+
+			if err := scope.IsValid(); err != nil {
+				t.Errorf("expected scope of operation %s to be valid, got %q", name, err)
+			}
+
+			if string(scope) != scopes[0] {
+				t.Errorf("expected scope of operation %s to be %q, got %q", name, scopes[0], scope)
+			}
+		}
+	}
+
+	if len(operations) == 0 {
+		t.Fatal("expected OpenAPI specification to contain operations accepting personal access tokens, got none")
+	}
+
+	for name := range operationScopes {
+		if !operations[name] {
+			t.Errorf("expected operation %s to accept personal access tokens in the OpenAPI specification, since it has a scope", name)
+		}
+	}
+}
+
+func TestSecurityHandler_HandlePersonalAccessToken(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tokenStore := memory.NewPersonalAccessTokenStore()
+	userStore := memory.NewUserStore()
+	h := NewSecurityHandler(logger, tokenStore, userStore, nil, "registry_pat_", nil)
+
+	u := user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}
+	if err := userStore.Create(t.Context(), u); err != nil {
+		t.Fatalf("could not create user: %q", err)
+	}
+
+	disabled := user.User{ID: uuid.New(), Username: "disabled", Role: user.RoleUser, Disabled: true}
+	if err := userStore.Create(t.Context(), disabled); err != nil {
+		t.Fatalf("could not create user: %q", err)
+	}
+
+	scopes := []token.Scope{token.ScopeRepositoriesRead}
+	tokens := map[string]token.PersonalAccessToken{
+		"registry_pat_unscoped": {UserID: u.ID, ExpirationDate: time.Now().Add(time.Hour)},
+		"registry_pat_readonly": {UserID: u.ID, ExpirationDate: time.Now().Add(time.Hour), Scopes: []token.Scope{token.ScopeRepositoriesRead, token.ScopeTokensRead}},
+		"registry_pat_expired":  {UserID: u.ID, ExpirationDate: time.Now().Add(-time.Hour), Scopes: scopes},
+		"registry_pat_revoked":  {UserID: u.ID, ExpirationDate: time.Now().Add(time.Hour), RevokedAt: time.Now().Add(-time.Minute), Scopes: scopes},
+		"registry_pat_disabled": {UserID: disabled.ID, ExpirationDate: time.Now().Add(time.Hour), Scopes: scopes},
+		"registry_pat_allowed":  {UserID: u.ID, ExpirationDate: time.Now().Add(time.Hour), Scopes: scopes, AllowedCIDRs: []token.CIDR{"192.168.1.0/24"}},
+		"registry_pat_blocked":  {UserID: u.ID, ExpirationDate: time.Now().Add(time.Hour), Scopes: scopes, AllowedCIDRs: []token.CIDR{"10.0.0.0/8"}},
+	}
+	for plainTextToken, pat := range tokens {
+		pat.ID = uuid.New()
+		pat.Description = "token"
+		pat.Permission = token.PermissionReadOnly
+		if err := tokenStore.Create(t.Context(), pat, plainTextToken); err != nil {
+			t.Fatalf("could not create token: %q", err)
+		}
+	}
+
+	testCases := []struct {
+		desc               string
+		token              string
+		operation          oas.OperationName
+		expectedStatusCode int
+	}{
+		{"token without scopes", "registry_pat_unscoped", oas.ListRepositoriesOperation, http.StatusForbidden},
+		{"token with required scope", "registry_pat_readonly", oas.ListRepositoriesOperation, 0},
+		{"token with other scopes", "registry_pat_readonly", oas.CreateUserOperation, http.StatusForbidden},
+		{"operation without scope", "registry_pat_readonly", oas.GetOIDCConfigurationOperation, http.StatusForbidden},
+		{"unknown token", "registry_pat_unknown", oas.ListRepositoriesOperation, http.StatusUnauthorized},
+		{"expired token", "registry_pat_expired", oas.ListRepositoriesOperation, http.StatusUnauthorized},
+		{"revoked token", "registry_pat_revoked", oas.ListRepositoriesOperation, http.StatusUnauthorized},
+		{"token of disabled user", "registry_pat_disabled", oas.ListRepositoriesOperation, http.StatusUnauthorized},
+		{"token used from allowed source IP address", "registry_pat_allowed", oas.ListRepositoriesOperation, 0},
+		{"token used from other source IP address", "registry_pat_blocked", oas.ListRepositoriesOperation, http.StatusUnauthorized},
+	}
+
+	// all requests are made from the same address
+	ctx := middleware.WithSourceIP(t.Context(), net.ParseIP("192.168.1.10"))
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			ctx, err := h.HandlePersonalAccessToken(ctx, c.operation, oas.PersonalAccessToken{Token: c.token})
+			if c.expectedStatusCode != 0 {
+				var errResp *oas.ErrorStatusCode
+				if !errors.As(err, &errResp) || errResp.StatusCode != c.expectedStatusCode {
+					t.Fatalf("expected status code %d, got %q", c.expectedStatusCode, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			if authenticated, ok := AuthenticatedUserFromContext(ctx); !ok || authenticated.ID != u.ID {
+				t.Errorf("expected user %s in context, got %+v", u.ID, authenticated)
+			}
+
+			if _, ok := AuthenticatedTokenFromContext(ctx); !ok {
+				t.Error("expected token in context")
+			}
+		})
+	}
+}
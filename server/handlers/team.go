@@ -3,18 +3,25 @@ package handlers
 import (
 	"context"
 	"errors"
+	"github.com/evanebb/regauth/audit"
 	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/token"
 	"github.com/evanebb/regauth/user"
 	"github.com/google/uuid"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"time"
 )
 
 type TeamHandler struct {
-	logger    *slog.Logger
-	teamStore user.TeamStore
-	userStore user.Store
+	logger         *slog.Logger
+	teamStore      user.TeamStore
+	userStore      user.Store
+	tokenStore     token.Store
+	tokenPrefix    string
+	lifetimePolicy token.LifetimePolicy
+	auditLog       auditRecorder
 }
 
 func (h TeamHandler) CreateTeam(ctx context.Context, req *oas.TeamRequest) (*oas.TeamResponse, error) {
@@ -80,24 +87,33 @@ func (h TeamHandler) CreateTeam(ctx context.Context, req *oas.TeamRequest) (*oas
 		return nil, newInternalServerErrorResponse()
 	}
 
+	h.auditLog.record(ctx, audit.ActionTeamCreate, audit.TargetTypeTeam, string(team.Name), nil)
+
 	resp := convertToTeamResponse(team)
 	return &resp, nil
 }
 
-func (h TeamHandler) ListTeams(ctx context.Context) ([]oas.TeamResponse, error) {
+func (h TeamHandler) ListTeams(ctx context.Context, params oas.ListTeamsParams) (*oas.ListTeamsOKHeaders, error) {
 	u, ok := AuthenticatedUserFromContext(ctx)
 	if !ok {
 		h.logger.ErrorContext(ctx, "could not parse user from request context")
 		return nil, newInternalServerErrorResponse()
 	}
 
-	teams, err := h.teamStore.GetAllByUser(ctx, u.ID)
+	o, err := newListOptions(params.Limit, params.Cursor, params.Sort, params.Order, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.teamStore.ListByUser(ctx, u.ID, o)
 	if err != nil {
 		h.logger.ErrorContext(ctx, "could not get teams for user", slog.Any("error", err))
 		return nil, newInternalServerErrorResponse()
 	}
 
-	return convertSlice(teams, convertToTeamResponse), nil
+	resp := &oas.ListTeamsOKHeaders{Response: convertSlice(page.Items, convertToTeamResponse)}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/teams", nil, "name", o, page)
+	return resp, nil
 }
 
 func (h TeamHandler) GetTeam(ctx context.Context, params oas.GetTeamParams) (*oas.TeamResponse, error) {
@@ -110,6 +126,34 @@ func (h TeamHandler) GetTeam(ctx context.Context, params oas.GetTeamParams) (*oa
 	return &resp, nil
 }
 
+func (h TeamHandler) UpdateTeam(ctx context.Context, req *oas.TeamUpdateRequest, params oas.UpdateTeamParams) (*oas.TeamResponse, error) {
+	team, member, err := h.getTeamAndCurrentMemberFromRequest(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if member.Role != user.TeamMemberRoleAdmin {
+		return nil, newErrorResponse(http.StatusForbidden, "insufficient permission")
+	}
+
+	team.MaxTokenLifetime = time.Duration(req.MaxTokenLifetime) * time.Second
+	if err := team.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.teamStore.SetMaxTokenLifetime(ctx, team.ID, team.MaxTokenLifetime); err != nil {
+		h.logger.ErrorContext(ctx, "could not update team", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionTeamUpdate, audit.TargetTypeTeam, string(team.Name), map[string]string{
+		"maxTokenLifetime": team.MaxTokenLifetime.String(),
+	})
+
+	resp := convertToTeamResponse(team)
+	return &resp, nil
+}
+
 func (h TeamHandler) DeleteTeam(ctx context.Context, params oas.DeleteTeamParams) error {
 	team, member, err := h.getTeamAndCurrentMemberFromRequest(ctx, params.Name)
 	if err != nil {
@@ -125,6 +169,8 @@ func (h TeamHandler) DeleteTeam(ctx context.Context, params oas.DeleteTeamParams
 		return newInternalServerErrorResponse()
 	}
 
+	h.auditLog.record(ctx, audit.ActionTeamDelete, audit.TargetTypeTeam, string(team.Name), nil)
+
 	return nil
 }
 
@@ -148,6 +194,10 @@ func (h TeamHandler) AddTeamMember(ctx context.Context, req *oas.TeamMemberReque
 		return nil, newInternalServerErrorResponse()
 	}
 
+	if userToAdd.Role == user.RoleRobot {
+		return nil, newErrorResponse(http.StatusBadRequest, "robots cannot be added to teams")
+	}
+
 	newMember := user.TeamMember{
 		UserID:    userToAdd.ID,
 		TeamID:    team.ID,
@@ -165,23 +215,35 @@ func (h TeamHandler) AddTeamMember(ctx context.Context, req *oas.TeamMemberReque
 		return nil, newInternalServerErrorResponse()
 	}
 
+	h.auditLog.record(ctx, audit.ActionTeamMemberAdd, audit.TargetTypeTeam, string(team.Name), map[string]string{
+		"member": string(newMember.Username),
+		"role":   string(newMember.Role),
+	})
+
 	resp := convertToTeamMemberResponse(newMember)
 	return &resp, nil
 }
 
-func (h TeamHandler) ListTeamMembers(ctx context.Context, params oas.ListTeamMembersParams) ([]oas.TeamMemberResponse, error) {
+func (h TeamHandler) ListTeamMembers(ctx context.Context, params oas.ListTeamMembersParams) (*oas.ListTeamMembersOKHeaders, error) {
 	team, _, err := h.getTeamAndCurrentMemberFromRequest(ctx, params.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	members, err := h.teamStore.GetTeamMembers(ctx, team.ID)
+	o, err := newListOptions(params.Limit, params.Cursor, params.Sort, params.Order, params.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.teamStore.ListTeamMembers(ctx, team.ID, o)
 	if err != nil {
 		h.logger.ErrorContext(ctx, "could not get team members", slog.Any("error", err))
 		return nil, newInternalServerErrorResponse()
 	}
 
-	return convertSlice(members, convertToTeamMemberResponse), nil
+	resp := &oas.ListTeamMembersOKHeaders{Response: convertSlice(page.Items, convertToTeamMemberResponse)}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/teams/"+url.PathEscape(string(team.Name))+"/members", nil, "username", o, page)
+	return resp, nil
 }
 
 func (h TeamHandler) RemoveTeamMember(ctx context.Context, params oas.RemoveTeamMemberParams) error {
@@ -213,9 +275,294 @@ func (h TeamHandler) RemoveTeamMember(ctx context.Context, params oas.RemoveTeam
 		return newInternalServerErrorResponse()
 	}
 
+	h.auditLog.record(ctx, audit.ActionTeamMemberRemove, audit.TargetTypeTeam, string(team.Name), map[string]string{
+		"member": string(userToRemove.Username),
+	})
+
 	return nil
 }
 
+func (h TeamHandler) ListTeamRobots(ctx context.Context, params oas.ListTeamRobotsParams) (*oas.ListTeamRobotsOKHeaders, error) {
+	team, _, err := h.getTeamAndCurrentMemberFromRequest(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := newListOptions(params.Limit, params.Cursor, params.Sort, params.Order, params.Robot)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.teamStore.ListRobots(ctx, team.ID, o)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get team robots", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	resp := &oas.ListTeamRobotsOKHeaders{Response: convertSlice(page.Items, convertToTeamRobotResponse)}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/teams/"+url.PathEscape(string(team.Name))+"/robots", nil, "robot", o, page)
+	return resp, nil
+}
+
+func (h TeamHandler) CreateTeamRobot(ctx context.Context, req *oas.TeamRobotRequest, params oas.CreateTeamRobotParams) (*oas.TeamRobotResponse, error) {
+	team, currentMember, err := h.getTeamAndCurrentMemberFromRequest(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if currentMember.Role != user.TeamMemberRoleAdmin {
+		return nil, newErrorResponse(http.StatusForbidden, "insufficient permission")
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not generate UUID", "error", err)
+		return nil, newInternalServerErrorResponse()
+	}
+
+	robot := user.Robot{
+		UserID:    id,
+		TeamID:    team.ID,
+		Name:      user.RobotName(req.Name),
+		Username:  user.NewRobotUsername(team.Name, user.RobotName(req.Name)),
+		CreatedAt: time.Now(),
+	}
+
+	if err := robot.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	_, err = h.teamStore.GetRobot(ctx, team.ID, req.Name)
+	if err == nil {
+		return nil, newErrorResponse(http.StatusBadRequest, "robot already exists")
+	}
+
+	if !errors.Is(err, user.ErrRobotNotFound) {
+		h.logger.ErrorContext(ctx, "could not get robot", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	robotUser := user.User{
+		ID:        robot.UserID,
+		Username:  robot.Username,
+		Role:      user.RoleRobot,
+		CreatedAt: robot.CreatedAt,
+	}
+
+	err = h.teamStore.Tx(ctx, func(txCtx context.Context) error {
+		if err := h.userStore.Create(txCtx, robotUser); err != nil {
+			return err
+		}
+
+		return h.teamStore.AddRobot(txCtx, robot)
+	})
+
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not create robot", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionTeamRobotCreate, audit.TargetTypeTeam, string(team.Name), map[string]string{
+		"robot": string(robot.Username),
+	})
+
+	resp := convertToTeamRobotResponse(robot)
+	return &resp, nil
+}
+
+func (h TeamHandler) GetTeamRobot(ctx context.Context, params oas.GetTeamRobotParams) (*oas.TeamRobotResponse, error) {
+	team, _, err := h.getTeamAndCurrentMemberFromRequest(ctx, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	robot, err := h.getRobot(ctx, team, params.Robot)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := convertToTeamRobotResponse(robot)
+	return &resp, nil
+}
+
+func (h TeamHandler) DeleteTeamRobot(ctx context.Context, params oas.DeleteTeamRobotParams) error {
+	robot, err := h.getRobotAsTeamAdmin(ctx, params.Name, params.Robot)
+	if err != nil {
+		return err
+	}
+
+	err = h.teamStore.Tx(ctx, func(txCtx context.Context) error {
+		if err := h.teamStore.RemoveRobot(txCtx, robot.TeamID, robot.UserID); err != nil {
+			return err
+		}
+
+		// deleting the user belonging to the robot also deletes its personal access tokens
+		return h.userStore.DeleteByID(txCtx, robot.UserID)
+	})
+
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not delete robot", slog.Any("error", err))
+		return newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionTeamRobotDelete, audit.TargetTypeTeam, params.Name, map[string]string{
+		"robot": string(robot.Username),
+	})
+
+	return nil
+}
+
+func (h TeamHandler) ListTeamRobotTokens(ctx context.Context, params oas.ListTeamRobotTokensParams) (*oas.ListTeamRobotTokensOKHeaders, error) {
+	robot, err := h.getRobotAsTeamAdmin(ctx, params.Name, params.Robot)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := newListOptions(params.Limit, params.Cursor, params.Sort, params.Order, params.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.tokenStore.ListByUser(ctx, robot.UserID, o)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get personal access tokens for robot", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	tokens, err := convertToPersonalAccessTokenResponses(ctx, h.logger, h.tokenStore, page.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &oas.ListTeamRobotTokensOKHeaders{Response: tokens}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/teams/"+url.PathEscape(params.Name)+"/robots/"+url.PathEscape(params.Robot)+"/tokens", nil, "description", o, page)
+	return resp, nil
+}
+
+func (h TeamHandler) CreateTeamRobotToken(ctx context.Context, req *oas.PersonalAccessTokenRequest, params oas.CreateTeamRobotTokenParams) (*oas.PersonalAccessTokenCreationResponse, error) {
+	robot, err := h.getRobotAsTeamAdmin(ctx, params.Name, params.Robot)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Scopes) > 0 {
+		return nil, newErrorResponse(http.StatusBadRequest, "robot tokens cannot have scopes, since robots cannot use the API")
+	}
+
+	if current, ok := AuthenticatedTokenFromContext(ctx); ok {
+		if err := restrictToToken(current, req); err != nil {
+			return nil, err
+		}
+	}
+
+	team, err := h.teamStore.GetByID(ctx, robot.TeamID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get team of robot", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	policy := h.lifetimePolicy.Restrict(team.MaxTokenLifetime)
+	resp, err := createPersonalAccessToken(ctx, h.logger, h.tokenStore, h.tokenPrefix, policy, robot.UserID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	h.auditLog.record(ctx, audit.ActionTokenCreate, audit.TargetTypeToken, resp.ID.String(), tokenAuditDetails(robot.Username, resp.Description, string(resp.Permission)))
+	return resp, nil
+}
+
+func (h TeamHandler) DeleteTeamRobotToken(ctx context.Context, params oas.DeleteTeamRobotTokenParams) error {
+	robot, err := h.getRobotAsTeamAdmin(ctx, params.Name, params.Robot)
+	if err != nil {
+		return err
+	}
+
+	pat, err := h.tokenStore.GetByID(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, token.ErrNotFound) {
+			return newErrorResponse(http.StatusNotFound, "personal access token not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not get personal access token", slog.Any("error", err))
+		return newInternalServerErrorResponse()
+	}
+
+	if pat.UserID != robot.UserID {
+		return newErrorResponse(http.StatusNotFound, "personal access token not found")
+	}
+
+	if err := h.tokenStore.DeleteByID(ctx, pat.ID); err != nil {
+		h.logger.ErrorContext(ctx, "could not delete personal access token", slog.Any("error", err))
+		return newInternalServerErrorResponse()
+	}
+
+	h.auditLog.record(ctx, audit.ActionTokenDelete, audit.TargetTypeToken, pat.ID.String(), tokenAuditDetails(robot.Username, string(pat.Description), string(pat.Permission)))
+
+	return nil
+}
+
+func (h TeamHandler) RevokeTeamRobotToken(ctx context.Context, params oas.RevokeTeamRobotTokenParams) (*oas.PersonalAccessTokenResponse, error) {
+	robot, err := h.getRobotAsTeamAdmin(ctx, params.Name, params.Robot)
+	if err != nil {
+		return nil, err
+	}
+
+	pat, err := h.tokenStore.GetByID(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, token.ErrNotFound) {
+			return nil, newErrorResponse(http.StatusNotFound, "personal access token not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not get personal access token", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	if pat.UserID != robot.UserID {
+		return nil, newErrorResponse(http.StatusNotFound, "personal access token not found")
+	}
+
+	resp, err := revokePersonalAccessToken(ctx, h.logger, h.tokenStore, pat)
+	if err != nil {
+		return nil, err
+	}
+
+	h.auditLog.record(ctx, audit.ActionTokenRevoke, audit.TargetTypeToken, pat.ID.String(), tokenAuditDetails(robot.Username, string(pat.Description), string(pat.Permission)))
+
+	return resp, nil
+}
+
+// getRobotAsTeamAdmin gets the robot with the given name from the given team, and ensures that the current user is an
+// admin of the team.
+func (h TeamHandler) getRobotAsTeamAdmin(ctx context.Context, teamName, robotName string) (user.Robot, error) {
+	team, currentMember, err := h.getTeamAndCurrentMemberFromRequest(ctx, teamName)
+	if err != nil {
+		return user.Robot{}, err
+	}
+
+	if currentMember.Role != user.TeamMemberRoleAdmin {
+		return user.Robot{}, newErrorResponse(http.StatusForbidden, "insufficient permission")
+	}
+
+	return h.getRobot(ctx, team, robotName)
+}
+
+func (h TeamHandler) getRobot(ctx context.Context, team user.Team, name string) (user.Robot, error) {
+	robot, err := h.teamStore.GetRobot(ctx, team.ID, name)
+	if err != nil {
+		if errors.Is(err, user.ErrRobotNotFound) {
+			return user.Robot{}, newErrorResponse(http.StatusNotFound, "robot not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not get robot",
+			slog.Any("error", err),
+			slog.String("team", string(team.Name)),
+			slog.String("robot", name))
+		return user.Robot{}, newInternalServerErrorResponse()
+	}
+
+	return robot, nil
+}
+
 func (h TeamHandler) getTeamAndCurrentMemberFromRequest(ctx context.Context, name string) (user.Team, user.TeamMember, error) {
 	u, ok := AuthenticatedUserFromContext(ctx)
 	if !ok {
@@ -253,9 +600,10 @@ func (h TeamHandler) getTeamAndCurrentMemberFromRequest(ctx context.Context, nam
 
 func convertToTeamResponse(t user.Team) oas.TeamResponse {
 	return oas.TeamResponse{
-		ID:        t.ID,
-		Name:      string(t.Name),
-		CreatedAt: t.CreatedAt,
+		ID:               t.ID,
+		Name:             string(t.Name),
+		MaxTokenLifetime: int(t.MaxTokenLifetime / time.Second),
+		CreatedAt:        t.CreatedAt,
 	}
 }
 
@@ -267,3 +615,12 @@ func convertToTeamMemberResponse(tm user.TeamMember) oas.TeamMemberResponse {
 		CreatedAt: tm.CreatedAt,
 	}
 }
+
+func convertToTeamRobotResponse(r user.Robot) oas.TeamRobotResponse {
+	return oas.TeamRobotResponse{
+		ID:        r.UserID,
+		Name:      string(r.Name),
+		Username:  string(r.Username),
+		CreatedAt: r.CreatedAt,
+	}
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTeamHandler_CreateTeamRobotToken_RestrictedToCurrentToken(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	u := user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}
+
+	current := token.PersonalAccessToken{
+		ID:             uuid.New(),
+		Description:    "current",
+		Permission:     token.PermissionReadWrite,
+		Repositories:   []token.RepositoryPattern{"acme/*"},
+		Scopes:         []token.Scope{token.ScopeTeamsAdmin},
+		AllowedCIDRs:   []token.CIDR{"10.0.0.0/8"},
+		ExpirationDate: time.Now().Add(24 * time.Hour),
+		UserID:         u.ID,
+	}
+
+	newHandler := func(t *testing.T) TeamHandler {
+		teamStore := memory.NewTeamStore()
+		team := user.Team{ID: uuid.New(), Name: "acme", CreatedAt: time.Now()}
+		if err := teamStore.Create(t.Context(), team); err != nil {
+			t.Fatalf("could not create team: %q", err)
+		}
+
+		m := user.TeamMember{UserID: u.ID, TeamID: team.ID, Username: u.Username, Role: user.TeamMemberRoleAdmin, CreatedAt: time.Now()}
+		if err := teamStore.AddTeamMember(t.Context(), m); err != nil {
+			t.Fatalf("could not add team member: %q", err)
+		}
+
+		r := user.Robot{UserID: uuid.New(), TeamID: team.ID, Name: "ci", Username: user.NewRobotUsername(team.Name, "ci"), CreatedAt: time.Now()}
+		if err := teamStore.AddRobot(t.Context(), r); err != nil {
+			t.Fatalf("could not add robot: %q", err)
+		}
+
+		return TeamHandler{
+			logger:     logger,
+			teamStore:  teamStore,
+			userStore:  memory.NewUserStore(),
+			tokenStore: memory.NewPersonalAccessTokenStore(),
+			auditLog:   auditRecorder{logger: logger, auditStore: memory.NewAuditStore()},
+		}
+	}
+
+	validRequest := func() *oas.PersonalAccessTokenRequest {
+		return &oas.PersonalAccessTokenRequest{
+			Description:    "robot token",
+			Permission:     oas.PersonalAccessTokenRequestPermissionReadOnly,
+			Repositories:   []string{"acme/app"},
+			AllowedCidrs:   []string{"10.1.0.0/16"},
+			ExpirationDate: time.Now().Add(time.Hour),
+		}
+	}
+
+	params := oas.CreateTeamRobotTokenParams{Name: "acme", Robot: "ci"}
+
+	testCases := []struct {
+		desc   string
+		modify func(req *oas.PersonalAccessTokenRequest)
+		status int
+	}{
+		{"within current token", func(req *oas.PersonalAccessTokenRequest) {}, 0},
+		{"broader permission", func(req *oas.PersonalAccessTokenRequest) {
+			req.Permission = oas.PersonalAccessTokenRequestPermissionReadWriteDelete
+		}, http.StatusForbidden},
+		{"repository outside current token", func(req *oas.PersonalAccessTokenRequest) {
+			req.Repositories = []string{"other/app"}
+		}, http.StatusForbidden},
+		{"broader CIDR range", func(req *oas.PersonalAccessTokenRequest) {
+			req.AllowedCidrs = []string{"0.0.0.0/0"}
+		}, http.StatusForbidden},
+		{"expiration date after current token", func(req *oas.PersonalAccessTokenRequest) {
+			req.ExpirationDate = current.ExpirationDate.Add(time.Hour)
+		}, http.StatusForbidden},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+
+			h := newHandler(t)
+
+			req := validRequest()
+			c.modify(req)
+
+			ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+			_, err := h.CreateTeamRobotToken(ctx, req, params)
+			if c.status == 0 {
+				if err != nil {
+					t.Fatalf("expected err to be nil, got %q", err)
+				}
+				return
+			}
+
+			var e *oas.ErrorStatusCode
+			if !errors.As(err, &e) || e.StatusCode != c.status {
+				t.Errorf("expected status code %d, got %v", c.status, err)
+			}
+		})
+	}
+
+	t.Run("inherits restrictions of current token", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHandler(t)
+
+		req := validRequest()
+		req.Repositories = nil
+		req.AllowedCidrs = nil
+
+		ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+		resp, err := h.CreateTeamRobotToken(ctx, req, params)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if !slices.Equal(resp.Repositories, []string{"acme/*"}) {
+			t.Errorf("expected repositories %v, got %v", current.Repositories, resp.Repositories)
+		}
+
+		if !slices.Equal(resp.AllowedCidrs, []string{"10.0.0.0/8"}) {
+			t.Errorf("expected allowed CIDRs %v, got %v", current.AllowedCIDRs, resp.AllowedCidrs)
+		}
+	})
+}
@@ -5,18 +5,26 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"errors"
+	"github.com/evanebb/regauth/audit"
 	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/store"
 	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
 	"github.com/google/uuid"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"time"
 )
 
 type TokenHandler struct {
-	logger      *slog.Logger
-	tokenStore  token.Store
-	tokenPrefix string
+	logger         *slog.Logger
+	tokenStore     token.Store
+	teamStore      user.TeamStore
+	tokenPrefix    string
+	lifetimePolicy token.LifetimePolicy
+	rotationPolicy token.RotationPolicy
+	auditLog       auditRecorder
 }
 
 func (h TokenHandler) CreatePersonalAccessToken(ctx context.Context, req *oas.PersonalAccessTokenRequest) (*oas.PersonalAccessTokenCreationResponse, error) {
@@ -26,58 +34,59 @@ func (h TokenHandler) CreatePersonalAccessToken(ctx context.Context, req *oas.Pe
 		return nil, newInternalServerErrorResponse()
 	}
 
-	id, err := uuid.NewV7()
-	if err != nil {
-		h.logger.ErrorContext(ctx, "could not generate UUID", "error", err)
-		return nil, newInternalServerErrorResponse()
+	if current, ok := AuthenticatedTokenFromContext(ctx); ok {
+		if err := restrictToToken(current, req); err != nil {
+			return nil, err
+		}
 	}
 
-	pat := token.PersonalAccessToken{
-		ID:             id,
-		Description:    token.Description(req.Description),
-		Permission:     token.Permission(req.Permission),
-		ExpirationDate: req.ExpirationDate,
-		UserID:         u.ID,
-		CreatedAt:      time.Now(),
+	// the lifetime of the token is limited by every team that the user is a member of
+	teams, err := h.teamStore.GetAllByUser(ctx, u.ID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get teams for user", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
 	}
 
-	if err := pat.IsValid(); err != nil {
-		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	policy := h.lifetimePolicy
+	for _, t := range teams {
+		policy = policy.Restrict(t.MaxTokenLifetime)
 	}
 
-	randBytes := make([]byte, 42)
-	_, _ = rand.Read(randBytes)
-	plainTextToken := h.tokenPrefix + base64.URLEncoding.EncodeToString(randBytes)
-
-	if err := h.tokenStore.Create(ctx, pat, plainTextToken); err != nil {
-		h.logger.ErrorContext(ctx, "could not create personal access token", slog.Any("error", err))
-		return nil, newInternalServerErrorResponse()
+	resp, err := createPersonalAccessToken(ctx, h.logger, h.tokenStore, h.tokenPrefix, policy, u.ID, req)
+	if err != nil {
+		return nil, err
 	}
 
-	return &oas.PersonalAccessTokenCreationResponse{
-		ID:             pat.ID,
-		Description:    string(pat.Description),
-		Permission:     oas.PersonalAccessTokenCreationResponsePermission(pat.Permission),
-		ExpirationDate: pat.ExpirationDate,
-		Token:          plainTextToken,
-		CreatedAt:      time.Now(),
-	}, nil
+	h.auditLog.record(ctx, audit.ActionTokenCreate, audit.TargetTypeToken, resp.ID.String(), tokenAuditDetails(u.Username, resp.Description, string(resp.Permission)))
+	return resp, nil
 }
 
-func (h TokenHandler) ListPersonalAccessTokens(ctx context.Context) ([]oas.PersonalAccessTokenResponse, error) {
+func (h TokenHandler) ListPersonalAccessTokens(ctx context.Context, params oas.ListPersonalAccessTokensParams) (*oas.ListPersonalAccessTokensOKHeaders, error) {
 	u, ok := AuthenticatedUserFromContext(ctx)
 	if !ok {
 		h.logger.ErrorContext(ctx, "could not parse user from request context")
 		return nil, newInternalServerErrorResponse()
 	}
 
-	tokens, err := h.tokenStore.GetAllByUser(ctx, u.ID)
+	o, err := newListOptions(params.Limit, params.Cursor, params.Sort, params.Order, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.tokenStore.ListByUser(ctx, u.ID, o)
 	if err != nil {
 		h.logger.ErrorContext(ctx, "could not get personal access tokens for user", slog.Any("error", err))
 		return nil, newInternalServerErrorResponse()
 	}
 
-	return convertSlice(tokens, convertToPersonalAccessTokenResponse), nil
+	tokens, err := convertToPersonalAccessTokenResponses(ctx, h.logger, h.tokenStore, page.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &oas.ListPersonalAccessTokensOKHeaders{Response: tokens}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/tokens", nil, "name", o, page)
+	return resp, nil
 }
 
 func (h TokenHandler) GetPersonalAccessToken(ctx context.Context, params oas.GetPersonalAccessTokenParams) (*oas.PersonalAccessTokenResponse, error) {
@@ -86,8 +95,48 @@ func (h TokenHandler) GetPersonalAccessToken(ctx context.Context, params oas.Get
 		return nil, err
 	}
 
-	resp := convertToPersonalAccessTokenResponse(pat)
-	return &resp, nil
+	responses, err := convertToPersonalAccessTokenResponses(ctx, h.logger, h.tokenStore, []token.PersonalAccessToken{pat})
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses[0], nil
+}
+
+func (h TokenHandler) GetPersonalAccessTokenUsage(ctx context.Context, params oas.GetPersonalAccessTokenUsageParams) (*oas.GetPersonalAccessTokenUsageOKHeaders, error) {
+	pat, err := h.getPersonalAccessTokenFromRequest(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	f := token.UsageLogFilter{
+		Since:  params.Since.Or(time.Time{}),
+		Until:  params.Until.Or(time.Time{}),
+		Limit:  params.Limit.Or(token.DefaultUsageLogLimit),
+		Cursor: store.Cursor(params.Cursor.Or("")),
+	}
+
+	if err := f.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	page, err := h.tokenStore.GetUsageLog(ctx, pat.ID, f)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get personal access token usage log", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	query := make(url.Values)
+	if !f.Since.IsZero() {
+		query.Set("since", f.Since.Format(time.RFC3339Nano))
+	}
+	if !f.Until.IsZero() {
+		query.Set("until", f.Until.Format(time.RFC3339Nano))
+	}
+
+	resp := &oas.GetPersonalAccessTokenUsageOKHeaders{Response: convertSlice(page.Items, convertToPersonalAccessTokenUsageResponse)}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/tokens/"+pat.ID.String()+"/usage", query, "", store.ListOptions{Limit: f.Limit}, page)
+	return resp, nil
 }
 
 func (h TokenHandler) DeletePersonalAccessToken(ctx context.Context, params oas.DeletePersonalAccessTokenParams) error {
@@ -101,9 +150,88 @@ func (h TokenHandler) DeletePersonalAccessToken(ctx context.Context, params oas.
 		return newInternalServerErrorResponse()
 	}
 
+	u, _ := AuthenticatedUserFromContext(ctx)
+	h.auditLog.record(ctx, audit.ActionTokenDelete, audit.TargetTypeToken, pat.ID.String(), tokenAuditDetails(u.Username, string(pat.Description), string(pat.Permission)))
+
 	return nil
 }
 
+func (h TokenHandler) RevokePersonalAccessToken(ctx context.Context, params oas.RevokePersonalAccessTokenParams) (*oas.PersonalAccessTokenResponse, error) {
+	pat, err := h.getPersonalAccessTokenFromRequest(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := revokePersonalAccessToken(ctx, h.logger, h.tokenStore, pat)
+	if err != nil {
+		return nil, err
+	}
+
+	u, _ := AuthenticatedUserFromContext(ctx)
+	h.auditLog.record(ctx, audit.ActionTokenRevoke, audit.TargetTypeToken, pat.ID.String(), tokenAuditDetails(u.Username, string(pat.Description), string(pat.Permission)))
+
+	return resp, nil
+}
+
+func (h TokenHandler) RotatePersonalAccessToken(ctx context.Context, req *oas.PersonalAccessTokenRotationRequest, params oas.RotatePersonalAccessTokenParams) (*oas.PersonalAccessTokenRotationResponse, error) {
+	pat, err := h.getPersonalAccessTokenFromRequest(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if pat.IsRevoked() {
+		return nil, newErrorResponse(http.StatusConflict, "personal access token has been revoked")
+	}
+
+	if pat.IsExpired(now) {
+		return nil, newErrorResponse(http.StatusConflict, "personal access token has expired")
+	}
+
+	// rotating a token returns its new plain-text token, so a token cannot rotate a token with more access than itself
+	if current, ok := AuthenticatedTokenFromContext(ctx); ok {
+		if err := checkWithinToken(current, pat); err != nil {
+			return nil, err
+		}
+	}
+
+	requested, ok := req.GracePeriod.Get()
+	gracePeriod, err := h.rotationPolicy.GracePeriodFor(time.Duration(requested)*time.Second, ok)
+	if err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	plainTextToken := generatePlainTextToken(h.tokenPrefix)
+	previousExpiresAt := now.Add(gracePeriod)
+
+	if err := h.tokenStore.Rotate(ctx, pat.ID, plainTextToken, previousExpiresAt); err != nil {
+		if errors.Is(err, token.ErrNotFound) {
+			return nil, newErrorResponse(http.StatusNotFound, "personal access token not found")
+		}
+
+		h.logger.ErrorContext(ctx, "could not rotate personal access token", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	u, _ := AuthenticatedUserFromContext(ctx)
+	details := tokenAuditDetails(u.Username, string(pat.Description), string(pat.Permission))
+	details["gracePeriod"] = gracePeriod.String()
+	h.auditLog.record(ctx, audit.ActionTokenRotate, audit.TargetTypeToken, pat.ID.String(), details)
+
+	return &oas.PersonalAccessTokenRotationResponse{
+		ID:                     pat.ID,
+		Description:            string(pat.Description),
+		Permission:             oas.PersonalAccessTokenRotationResponsePermission(pat.Permission),
+		Repositories:           convertRepositoryPatterns(pat.Repositories),
+		Scopes:                 convertScopes[oas.PersonalAccessTokenRotationResponseScopesItem](pat.Scopes),
+		AllowedCidrs:           convertCIDRs(pat.AllowedCIDRs),
+		ExpirationDate:         pat.ExpirationDate,
+		Token:                  plainTextToken,
+		PreviousTokenExpiresAt: previousExpiresAt,
+		CreatedAt:              pat.CreatedAt,
+	}, nil
+}
+
 func (h TokenHandler) getPersonalAccessTokenFromRequest(ctx context.Context, id uuid.UUID) (token.PersonalAccessToken, error) {
 	u, ok := AuthenticatedUserFromContext(ctx)
 	if !ok {
@@ -128,12 +256,243 @@ func (h TokenHandler) getPersonalAccessTokenFromRequest(ctx context.Context, id
 	return pat, nil
 }
 
+// createPersonalAccessToken creates a new personal access token for the given user if its expiration date is allowed by
+// the given policy, and returns the newly generated plain-text token in the response.
+func createPersonalAccessToken(
+	ctx context.Context,
+	logger *slog.Logger,
+	tokenStore token.Store,
+	tokenPrefix string,
+	policy token.LifetimePolicy,
+	userID uuid.UUID,
+	req *oas.PersonalAccessTokenRequest,
+) (*oas.PersonalAccessTokenCreationResponse, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		logger.ErrorContext(ctx, "could not generate UUID", "error", err)
+		return nil, newInternalServerErrorResponse()
+	}
+
+	pat := token.PersonalAccessToken{
+		ID:             id,
+		Description:    token.Description(req.Description),
+		Permission:     token.Permission(req.Permission),
+		Repositories:   convertSlice(req.Repositories, func(r string) token.RepositoryPattern { return token.RepositoryPattern(r) }),
+		Scopes:         convertSlice(req.Scopes, func(s oas.PersonalAccessTokenRequestScopesItem) token.Scope { return token.Scope(s) }),
+		AllowedCIDRs:   convertSlice(req.AllowedCidrs, func(c string) token.CIDR { return token.CIDR(c) }),
+		ExpirationDate: req.ExpirationDate,
+		UserID:         userID,
+		CreatedAt:      time.Now(),
+	}
+
+	if err := pat.IsValid(); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	if err := policy.Allows(pat); err != nil {
+		return nil, newErrorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	plainTextToken := generatePlainTextToken(tokenPrefix)
+
+	if err := tokenStore.Create(ctx, pat, plainTextToken); err != nil {
+		logger.ErrorContext(ctx, "could not create personal access token", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	return &oas.PersonalAccessTokenCreationResponse{
+		ID:             pat.ID,
+		Description:    string(pat.Description),
+		Permission:     oas.PersonalAccessTokenCreationResponsePermission(pat.Permission),
+		Repositories:   convertRepositoryPatterns(pat.Repositories),
+		Scopes:         convertScopes[oas.PersonalAccessTokenCreationResponseScopesItem](pat.Scopes),
+		AllowedCidrs:   convertCIDRs(pat.AllowedCIDRs),
+		ExpirationDate: pat.ExpirationDate,
+		Token:          plainTextToken,
+		CreatedAt:      pat.CreatedAt,
+	}, nil
+}
+
+// restrictToToken limits the requested token to the access of the given token, which is used to create it, since a
+// token cannot be used to create another token with more access than itself. If the request does not restrict the
+// repositories or source addresses, the restrictions of the given token are inherited.
+func restrictToToken(current token.PersonalAccessToken, req *oas.PersonalAccessTokenRequest) error {
+	if len(req.Repositories) == 0 {
+		req.Repositories = convertRepositoryPatterns(current.Repositories)
+	}
+
+	if len(req.AllowedCidrs) == 0 {
+		req.AllowedCidrs = convertCIDRs(current.AllowedCIDRs)
+	}
+
+	return checkWithinToken(current, token.PersonalAccessToken{
+		Permission:     token.Permission(req.Permission),
+		Repositories:   convertSlice(req.Repositories, func(r string) token.RepositoryPattern { return token.RepositoryPattern(r) }),
+		Scopes:         convertSlice(req.Scopes, func(s oas.PersonalAccessTokenRequestScopesItem) token.Scope { return token.Scope(s) }),
+		AllowedCIDRs:   convertSlice(req.AllowedCidrs, func(c string) token.CIDR { return token.CIDR(c) }),
+		ExpirationDate: req.ExpirationDate,
+	})
+}
+
+// checkWithinToken checks that the other token does not have more access than the given token, which is used to obtain
+// a plain-text token for it. A token without repository or source address restrictions is only within a token that
+// does not have them either.
+func checkWithinToken(current, other token.PersonalAccessToken) error {
+	for _, s := range other.Scopes {
+		if !current.HasScope(s) {
+			return newErrorResponse(http.StatusForbidden, "cannot grant scope "+string(s)+" that the current token does not have")
+		}
+	}
+
+	if !current.Permission.Includes(other.Permission) {
+		return newErrorResponse(http.StatusForbidden, "cannot grant permission "+string(other.Permission)+" that exceeds the permission of the current token")
+	}
+
+	if len(other.Repositories) == 0 && len(current.Repositories) > 0 {
+		return newErrorResponse(http.StatusForbidden, "cannot grant access to all repositories, since the current token is restricted to certain repositories")
+	}
+
+	for _, r := range other.Repositories {
+		if !current.CoversRepositoryPattern(r) {
+			return newErrorResponse(http.StatusForbidden, "cannot grant access to repositories "+string(r)+" that the current token does not have access to")
+		}
+	}
+
+	if len(other.AllowedCIDRs) == 0 && len(current.AllowedCIDRs) > 0 {
+		return newErrorResponse(http.StatusForbidden, "cannot allow all source addresses, since the current token is restricted to certain source addresses")
+	}
+
+	for _, c := range other.AllowedCIDRs {
+		if !current.CoversCIDR(c) {
+			return newErrorResponse(http.StatusForbidden, "cannot allow source addresses "+string(c)+" that the current token cannot be used from")
+		}
+	}
+
+	if other.ExpirationDate.After(current.ExpirationDate) {
+		return newErrorResponse(http.StatusForbidden, "expiration date cannot be after the expiration date of the current token")
+	}
+
+	return nil
+}
+
+// generatePlainTextToken generates a new random plain-text token with the given prefix.
+func generatePlainTextToken(tokenPrefix string) string {
+	randBytes := make([]byte, 42)
+	_, _ = rand.Read(randBytes)
+	return tokenPrefix + base64.URLEncoding.EncodeToString(randBytes)
+}
+
+// revokePersonalAccessToken revokes the given token, and returns the revoked token in the response.
+func revokePersonalAccessToken(ctx context.Context, logger *slog.Logger, tokenStore token.Store, pat token.PersonalAccessToken) (*oas.PersonalAccessTokenResponse, error) {
+	if pat.IsRevoked() {
+		return nil, newErrorResponse(http.StatusConflict, "personal access token has already been revoked")
+	}
+
+	if err := tokenStore.Revoke(ctx, pat.ID, time.Now()); err != nil {
+		logger.ErrorContext(ctx, "could not revoke personal access token", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	revoked, err := tokenStore.GetByID(ctx, pat.ID)
+	if err != nil {
+		logger.ErrorContext(ctx, "could not get revoked personal access token", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	responses, err := convertToPersonalAccessTokenResponses(ctx, logger, tokenStore, []token.PersonalAccessToken{revoked})
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses[0], nil
+}
+
+// tokenAuditDetails returns the details that are recorded in the audit log for actions on a personal access token.
+func tokenAuditDetails(owner user.Username, description, permission string) map[string]string {
+	return map[string]string{
+		"owner":       string(owner),
+		"description": description,
+		"permission":  permission,
+	}
+}
+
+func (h TokenHandler) GetPersonalAccessTokenDailyUsage(ctx context.Context, params oas.GetPersonalAccessTokenDailyUsageParams) ([]oas.PersonalAccessTokenDailyUsageResponse, error) {
+	pat, err := h.getPersonalAccessTokenFromRequest(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rollups, err := h.tokenStore.GetUsageRollups(ctx, pat.ID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "could not get personal access token usage rollups", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	return convertSlice(rollups, convertToPersonalAccessTokenDailyUsageResponse), nil
+}
+
+// convertToPersonalAccessTokenResponses converts the given tokens to responses, including when each token was last used.
+func convertToPersonalAccessTokenResponses(ctx context.Context, logger *slog.Logger, tokenStore token.Store, tokens []token.PersonalAccessToken) ([]oas.PersonalAccessTokenResponse, error) {
+	ids := convertSlice(tokens, func(t token.PersonalAccessToken) uuid.UUID { return t.ID })
+	lastUsage, err := tokenStore.GetLastUsage(ctx, ids...)
+	if err != nil {
+		logger.ErrorContext(ctx, "could not get last usage of personal access tokens", slog.Any("error", err))
+		return nil, newInternalServerErrorResponse()
+	}
+
+	responses := convertSlice(tokens, convertToPersonalAccessTokenResponse)
+	for i := range responses {
+		if e, ok := lastUsage[responses[i].ID]; ok {
+			responses[i].LastUsedAt = oas.NewOptDateTime(e.Timestamp)
+			responses[i].LastUsedFrom = oas.NewOptString(e.SourceIP.String())
+		}
+	}
+
+	return responses, nil
+}
+
+func convertToPersonalAccessTokenUsageResponse(e token.UsageLogEntry) oas.PersonalAccessTokenUsageResponse {
+	return oas.PersonalAccessTokenUsageResponse{
+		Timestamp: e.Timestamp,
+		SourceIp:  e.SourceIP.String(),
+	}
+}
+
+func convertToPersonalAccessTokenDailyUsageResponse(r token.UsageRollup) oas.PersonalAccessTokenDailyUsageResponse {
+	return oas.PersonalAccessTokenDailyUsageResponse{
+		Day:      r.Day,
+		SourceIp: r.SourceIP.String(),
+		Count:    r.Count,
+	}
+}
+
 func convertToPersonalAccessTokenResponse(t token.PersonalAccessToken) oas.PersonalAccessTokenResponse {
-	return oas.PersonalAccessTokenResponse{
+	resp := oas.PersonalAccessTokenResponse{
 		ID:             t.ID,
 		Description:    string(t.Description),
 		Permission:     oas.PersonalAccessTokenResponsePermission(t.Permission),
+		Repositories:   convertRepositoryPatterns(t.Repositories),
+		Scopes:         convertScopes[oas.PersonalAccessTokenResponseScopesItem](t.Scopes),
+		AllowedCidrs:   convertCIDRs(t.AllowedCIDRs),
 		ExpirationDate: t.ExpirationDate,
 		CreatedAt:      t.CreatedAt,
 	}
+
+	if t.IsRevoked() {
+		resp.RevokedAt = oas.NewOptDateTime(t.RevokedAt)
+	}
+
+	return resp
+}
+
+func convertRepositoryPatterns(patterns []token.RepositoryPattern) []string {
+	return convertSlice(patterns, func(r token.RepositoryPattern) string { return string(r) })
+}
+
+func convertCIDRs(cidrs []token.CIDR) []string {
+	return convertSlice(cidrs, func(c token.CIDR) string { return string(c) })
+}
+
+func convertScopes[T ~string](scopes []token.Scope) []T {
+	return convertSlice(scopes, func(s token.Scope) T { return T(s) })
 }
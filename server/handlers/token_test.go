@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/oas"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTokenHandler_CreatePersonalAccessToken_RestrictedToCurrentToken(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	u := user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}
+
+	current := token.PersonalAccessToken{
+		ID:             uuid.New(),
+		Description:    "current",
+		Permission:     token.PermissionReadWrite,
+		Repositories:   []token.RepositoryPattern{"user/*"},
+		Scopes:         []token.Scope{token.ScopeTokensWrite},
+		AllowedCIDRs:   []token.CIDR{"10.0.0.0/8"},
+		ExpirationDate: time.Now().Add(24 * time.Hour),
+		UserID:         u.ID,
+	}
+
+	validRequest := func() *oas.PersonalAccessTokenRequest {
+		return &oas.PersonalAccessTokenRequest{
+			Description:    "new token",
+			Permission:     oas.PersonalAccessTokenRequestPermissionReadOnly,
+			Repositories:   []string{"user/app"},
+			AllowedCidrs:   []string{"10.1.0.0/16"},
+			ExpirationDate: time.Now().Add(time.Hour),
+		}
+	}
+
+	testCases := []struct {
+		desc   string
+		modify func(req *oas.PersonalAccessTokenRequest)
+		status int
+	}{
+		{"within current token", func(req *oas.PersonalAccessTokenRequest) {}, 0},
+		{"same access as current token", func(req *oas.PersonalAccessTokenRequest) {
+			req.Permission = oas.PersonalAccessTokenRequestPermissionReadWrite
+			req.Repositories = []string{"user/*"}
+			req.Scopes = []oas.PersonalAccessTokenRequestScopesItem{oas.PersonalAccessTokenRequestScopesItemTokensWrite}
+			req.AllowedCidrs = []string{"10.0.0.0/8"}
+			req.ExpirationDate = current.ExpirationDate
+		}, 0},
+		{"scope not held by current token", func(req *oas.PersonalAccessTokenRequest) {
+			req.Scopes = []oas.PersonalAccessTokenRequestScopesItem{oas.PersonalAccessTokenRequestScopesItemUsersAdmin}
+		}, http.StatusForbidden},
+		{"broader permission", func(req *oas.PersonalAccessTokenRequest) {
+			req.Permission = oas.PersonalAccessTokenRequestPermissionReadWriteDelete
+		}, http.StatusForbidden},
+		{"repository outside current token", func(req *oas.PersonalAccessTokenRequest) {
+			req.Repositories = []string{"other/app"}
+		}, http.StatusForbidden},
+		{"broader repository pattern", func(req *oas.PersonalAccessTokenRequest) {
+			req.Repositories = []string{"*/*"}
+		}, http.StatusForbidden},
+		{"broader CIDR range", func(req *oas.PersonalAccessTokenRequest) {
+			req.AllowedCidrs = []string{"0.0.0.0/0"}
+		}, http.StatusForbidden},
+		{"CIDR range outside current token", func(req *oas.PersonalAccessTokenRequest) {
+			req.AllowedCidrs = []string{"192.168.0.0/16"}
+		}, http.StatusForbidden},
+		{"expiration date after current token", func(req *oas.PersonalAccessTokenRequest) {
+			req.ExpirationDate = current.ExpirationDate.Add(time.Hour)
+		}, http.StatusForbidden},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+
+			h := TokenHandler{
+				logger:     logger,
+				tokenStore: memory.NewPersonalAccessTokenStore(),
+				teamStore:  memory.NewTeamStore(),
+				auditLog:   auditRecorder{logger: logger, auditStore: memory.NewAuditStore()},
+			}
+
+			req := validRequest()
+			c.modify(req)
+
+			ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+			_, err := h.CreatePersonalAccessToken(ctx, req)
+			if c.status == 0 {
+				if err != nil {
+					t.Fatalf("expected err to be nil, got %q", err)
+				}
+				return
+			}
+
+			var e *oas.ErrorStatusCode
+			if !errors.As(err, &e) || e.StatusCode != c.status {
+				t.Errorf("expected status code %d, got %v", c.status, err)
+			}
+		})
+	}
+
+	t.Run("inherits restrictions of current token", func(t *testing.T) {
+		t.Parallel()
+
+		h := TokenHandler{
+			logger:     logger,
+			tokenStore: memory.NewPersonalAccessTokenStore(),
+			teamStore:  memory.NewTeamStore(),
+			auditLog:   auditRecorder{logger: logger, auditStore: memory.NewAuditStore()},
+		}
+
+		req := validRequest()
+		req.Repositories = nil
+		req.AllowedCidrs = nil
+
+		ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+		resp, err := h.CreatePersonalAccessToken(ctx, req)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if !slices.Equal(resp.Repositories, []string{"user/*"}) {
+			t.Errorf("expected repositories %v, got %v", current.Repositories, resp.Repositories)
+		}
+
+		if !slices.Equal(resp.AllowedCidrs, []string{"10.0.0.0/8"}) {
+			t.Errorf("expected allowed CIDRs %v, got %v", current.AllowedCIDRs, resp.AllowedCidrs)
+		}
+	})
+
+	t.Run("not restricted when authenticated with password", func(t *testing.T) {
+		t.Parallel()
+
+		h := TokenHandler{
+			logger:     logger,
+			tokenStore: memory.NewPersonalAccessTokenStore(),
+			teamStore:  memory.NewTeamStore(),
+			auditLog:   auditRecorder{logger: logger, auditStore: memory.NewAuditStore()},
+		}
+
+		req := validRequest()
+		req.Permission = oas.PersonalAccessTokenRequestPermissionReadWriteDelete
+		req.Repositories = nil
+		req.AllowedCidrs = nil
+		req.ExpirationDate = current.ExpirationDate.Add(time.Hour)
+
+		resp, err := h.CreatePersonalAccessToken(WithAuthenticatedUser(t.Context(), u), req)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if len(resp.Repositories) != 0 || len(resp.AllowedCidrs) != 0 {
+			t.Errorf("expected token to be unrestricted, got repositories %v and allowed CIDRs %v", resp.Repositories, resp.AllowedCidrs)
+		}
+	})
+}
+
+func TestTokenHandler_RotatePersonalAccessToken_RestrictedToCurrentToken(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	u := user.User{ID: uuid.New(), Username: "user", Role: user.RoleUser}
+
+	current := token.PersonalAccessToken{
+		ID:             uuid.New(),
+		Description:    "current",
+		Permission:     token.PermissionReadWrite,
+		Repositories:   []token.RepositoryPattern{"user/*"},
+		Scopes:         []token.Scope{token.ScopeTokensWrite},
+		AllowedCIDRs:   []token.CIDR{"10.0.0.0/8"},
+		ExpirationDate: time.Now().Add(24 * time.Hour),
+		UserID:         u.ID,
+		CreatedAt:      time.Now(),
+	}
+
+	testCases := []struct {
+		desc   string
+		modify func(sibling *token.PersonalAccessToken)
+		status int
+	}{
+		{"within current token", func(sibling *token.PersonalAccessToken) {}, 0},
+		{"more scopes", func(sibling *token.PersonalAccessToken) {
+			sibling.Scopes = []token.Scope{token.ScopeTokensWrite, token.ScopeUsersAdmin}
+		}, http.StatusForbidden},
+		{"broader permission", func(sibling *token.PersonalAccessToken) {
+			sibling.Permission = token.PermissionReadWriteDelete
+		}, http.StatusForbidden},
+		{"all repositories", func(sibling *token.PersonalAccessToken) {
+			sibling.Repositories = nil
+		}, http.StatusForbidden},
+		{"wider repository pattern", func(sibling *token.PersonalAccessToken) {
+			sibling.Repositories = []token.RepositoryPattern{"*/*"}
+		}, http.StatusForbidden},
+		{"all source addresses", func(sibling *token.PersonalAccessToken) {
+			sibling.AllowedCIDRs = nil
+		}, http.StatusForbidden},
+		{"wider CIDR range", func(sibling *token.PersonalAccessToken) {
+			sibling.AllowedCIDRs = []token.CIDR{"0.0.0.0/0"}
+		}, http.StatusForbidden},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			t.Parallel()
+
+			tokenStore := memory.NewPersonalAccessTokenStore()
+			h := TokenHandler{
+				logger:     logger,
+				tokenStore: tokenStore,
+				teamStore:  memory.NewTeamStore(),
+				auditLog:   auditRecorder{logger: logger, auditStore: memory.NewAuditStore()},
+			}
+
+			sibling := current
+			sibling.ID = uuid.New()
+			sibling.Description = "sibling"
+			sibling.ExpirationDate = time.Now().Add(time.Hour)
+			c.modify(&sibling)
+
+			if err := tokenStore.Create(t.Context(), sibling, "registry_pat_sibling"); err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			ctx := WithAuthenticatedToken(WithAuthenticatedUser(t.Context(), u), current)
+			_, err := h.RotatePersonalAccessToken(ctx, &oas.PersonalAccessTokenRotationRequest{}, oas.RotatePersonalAccessTokenParams{ID: sibling.ID})
+			if c.status == 0 {
+				if err != nil {
+					t.Fatalf("expected err to be nil, got %q", err)
+				}
+				return
+			}
+
+			var e *oas.ErrorStatusCode
+			if !errors.As(err, &e) || e.StatusCode != c.status {
+				t.Errorf("expected status code %d, got %v", c.status, err)
+			}
+
+			if _, err := tokenStore.GetByPlainTextToken(t.Context(), "registry_pat_sibling"); err != nil {
+				t.Errorf("expected sibling token to not be rotated, got %q", err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"github.com/evanebb/regauth/audit"
 	"github.com/evanebb/regauth/auth/local"
 	"github.com/evanebb/regauth/oas"
 	"github.com/evanebb/regauth/user"
@@ -16,6 +17,7 @@ type UserHandler struct {
 	logger           *slog.Logger
 	userStore        user.Store
 	credentialsStore local.UserCredentialsStore
+	auditLog         auditRecorder
 }
 
 func (h UserHandler) CreateUser(ctx context.Context, req *oas.UserRequest) (*oas.UserResponse, error) {
@@ -55,22 +57,33 @@ func (h UserHandler) CreateUser(ctx context.Context, req *oas.UserRequest) (*oas
 		return nil, newInternalServerErrorResponse()
 	}
 
+	h.auditLog.record(ctx, audit.ActionUserCreate, audit.TargetTypeUser, string(newUser.Username), map[string]string{
+		"role": string(newUser.Role),
+	})
+
 	resp := convertToUserResponse(newUser)
 	return &resp, nil
 }
 
-func (h UserHandler) ListUsers(ctx context.Context) ([]oas.UserResponse, error) {
+func (h UserHandler) ListUsers(ctx context.Context, params oas.ListUsersParams) (*oas.ListUsersOKHeaders, error) {
 	if err := h.requireRole(ctx, user.RoleAdmin); err != nil {
 		return nil, err
 	}
 
-	users, err := h.userStore.GetAll(ctx)
+	o, err := newListOptions(params.Limit, params.Cursor, params.Sort, params.Order, params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.userStore.List(ctx, o)
 	if err != nil {
 		h.logger.ErrorContext(ctx, "could not get users", slog.Any("error", err))
 		return nil, newInternalServerErrorResponse()
 	}
 
-	return convertSlice(users, convertToUserResponse), nil
+	resp := &oas.ListUsersOKHeaders{Response: convertSlice(page.Items, convertToUserResponse)}
+	resp.Link, resp.XNextCursor = nextPageHeaders("/v1/users", nil, "name", o, page)
+	return resp, nil
 }
 
 func (h UserHandler) GetUser(ctx context.Context, params oas.GetUserParams) (*oas.UserResponse, error) {
@@ -112,9 +125,50 @@ func (h UserHandler) DeleteUser(ctx context.Context, params oas.DeleteUserParams
 		return newInternalServerErrorResponse()
 	}
 
+	h.auditLog.record(ctx, audit.ActionUserDelete, audit.TargetTypeUser, string(u.Username), nil)
+
 	return nil
 }
 
+func (h UserHandler) UpdateUser(ctx context.Context, req *oas.UserUpdateRequest, params oas.UpdateUserParams) (*oas.UserResponse, error) {
+	if err := h.requireRole(ctx, user.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	currentUser, ok := AuthenticatedUserFromContext(ctx)
+	if !ok {
+		h.logger.ErrorContext(ctx, "could not parse user from request context")
+		return nil, newInternalServerErrorResponse()
+	}
+
+	u, err := h.getUserFromRequest(ctx, params.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if currentUser.ID == u.ID && req.Disabled {
+		return nil, newErrorResponse(http.StatusBadRequest, "cannot disable current user")
+	}
+
+	if u.Disabled != req.Disabled {
+		if err := h.userStore.SetDisabled(ctx, u.ID, req.Disabled); err != nil {
+			h.logger.ErrorContext(ctx, "could not update user", slog.Any("error", err))
+			return nil, newInternalServerErrorResponse()
+		}
+
+		action := audit.ActionUserEnable
+		if req.Disabled {
+			action = audit.ActionUserDisable
+		}
+		h.auditLog.record(ctx, action, audit.TargetTypeUser, string(u.Username), nil)
+
+		u.Disabled = req.Disabled
+	}
+
+	resp := convertToUserResponse(u)
+	return &resp, nil
+}
+
 func (h UserHandler) ChangeUserPassword(ctx context.Context, req *oas.UserPasswordChangeRequest, params oas.ChangeUserPasswordParams) error {
 	if err := h.requireRole(ctx, user.RoleAdmin); err != nil {
 		return err
@@ -140,6 +194,8 @@ func (h UserHandler) ChangeUserPassword(ctx context.Context, req *oas.UserPasswo
 		return newInternalServerErrorResponse()
 	}
 
+	h.auditLog.record(ctx, audit.ActionUserPasswordChange, audit.TargetTypeUser, string(u.Username), nil)
+
 	return nil
 }
 
@@ -154,6 +210,11 @@ func (h UserHandler) getUserFromRequest(ctx context.Context, username string) (u
 		return user.User{}, newInternalServerErrorResponse()
 	}
 
+	if u.Role == user.RoleRobot {
+		// robots are managed through their team, and are not regular users
+		return user.User{}, newErrorResponse(http.StatusNotFound, "user not found")
+	}
+
 	return u, nil
 }
 
@@ -176,6 +237,7 @@ func convertToUserResponse(u user.User) oas.UserResponse {
 		ID:        u.ID,
 		Username:  string(u.Username),
 		Role:      oas.UserResponseRole(u.Role),
+		Disabled:  u.Disabled,
 		CreatedAt: u.CreatedAt,
 	}
 }
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type sourceIPCtxKey struct{}
+
+// SourceIP is a middleware that parses the IP address of the client from the request, and stores it in the request
+// context. Use SourceIPFromContext to retrieve it.
+//
+// If the request comes from one of the given trusted proxies, the X-Forwarded-For header is used instead. It is read
+// from right to left, skipping the addresses of trusted proxies, so the first untrusted address is the client. Any
+// address before it could have been set by the client itself, and is ignored.
+func SourceIP(trustedProxies []*net.IPNet) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			host, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				host = r.RemoteAddr
+			}
+
+			if ip := net.ParseIP(host); ip != nil {
+				ip = forwardedIP(ip, r.Header.Values("X-Forwarded-For"), trustedProxies)
+				r = r.WithContext(WithSourceIP(r.Context(), ip))
+			}
+
+			next.ServeHTTP(w, r)
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// forwardedIP returns the IP address of the client that connected to the first trusted proxy, based on the given
+// X-Forwarded-For header values. If the remote address is not a trusted proxy, it is returned as-is.
+func forwardedIP(remote net.IP, headers []string, trustedProxies []*net.IPNet) net.IP {
+	var hops []string
+	for _, h := range headers {
+		hops = append(hops, strings.Split(h, ",")...)
+	}
+
+	ip := remote
+	for i := len(hops) - 1; i >= 0 && isTrustedProxy(ip, trustedProxies); i-- {
+		hop := net.ParseIP(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			// the header is malformed, so the last address that could be parsed is used
+			break
+		}
+
+		ip = hop
+	}
+
+	return ip
+}
+
+func isTrustedProxy(ip net.IP, trustedProxies []*net.IPNet) bool {
+	for _, n := range trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithSourceIP returns a copy of the given context containing the IP address of the client.
+func WithSourceIP(ctx context.Context, ip net.IP) context.Context {
+	return context.WithValue(ctx, sourceIPCtxKey{}, ip)
+}
+
+// SourceIPFromContext returns the IP address of the client that was set in the context by the SourceIP middleware.
+func SourceIPFromContext(ctx context.Context) (net.IP, bool) {
+	ip, ok := ctx.Value(sourceIPCtxKey{}).(net.IP)
+	return ip, ok
+}
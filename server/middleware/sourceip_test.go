@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSourceIP(t *testing.T) {
+	t.Parallel()
+
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+	trustedProxies := []*net.IPNet{proxies}
+
+	testCases := []struct {
+		desc           string
+		trustedProxies []*net.IPNet
+		remoteAddr     string
+		forwardedFor   []string
+		expected       string
+	}{
+		{"no trusted proxies", nil, "192.168.1.10:1234", nil, "192.168.1.10"},
+		{"forwarded header without trusted proxies", nil, "192.168.1.10:1234", []string{"203.0.113.1"}, "192.168.1.10"},
+		{"forwarded header from untrusted address", trustedProxies, "192.168.1.10:1234", []string{"203.0.113.1"}, "192.168.1.10"},
+		{"forwarded header from trusted proxy", trustedProxies, "10.0.0.1:1234", []string{"203.0.113.1"}, "203.0.113.1"},
+		{"trusted proxy without forwarded header", trustedProxies, "10.0.0.1:1234", nil, "10.0.0.1"},
+		{"chain of trusted proxies", trustedProxies, "10.0.0.1:1234", []string{"203.0.113.1, 10.0.0.2"}, "203.0.113.1"},
+		{"spoofed address before client address", trustedProxies, "10.0.0.1:1234", []string{"198.51.100.1, 203.0.113.1"}, "203.0.113.1"},
+		{"multiple forwarded headers", trustedProxies, "10.0.0.1:1234", []string{"198.51.100.1", "203.0.113.1, 10.0.0.2"}, "203.0.113.1"},
+		{"only trusted proxies", trustedProxies, "10.0.0.1:1234", []string{"10.0.0.3, 10.0.0.2"}, "10.0.0.3"},
+		{"malformed forwarded header", trustedProxies, "10.0.0.1:1234", []string{"203.0.113.1, unknown"}, "10.0.0.1"},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			var actual net.IP
+			h := SourceIP(c.trustedProxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actual, _ = SourceIPFromContext(r.Context())
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = c.remoteAddr
+			for _, v := range c.forwardedFor {
+				r.Header.Add("X-Forwarded-For", v)
+			}
+
+			h.ServeHTTP(httptest.NewRecorder(), r)
+
+			if actual.String() != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
@@ -2,8 +2,11 @@ package server
 
 import (
 	"github.com/evanebb/regauth/api"
+	"github.com/evanebb/regauth/audit"
 	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/auth/federation"
 	"github.com/evanebb/regauth/auth/local"
+	"github.com/evanebb/regauth/auth/oidc"
 	"github.com/evanebb/regauth/oas"
 	"github.com/evanebb/regauth/repository"
 	"github.com/evanebb/regauth/server/handlers"
@@ -13,6 +16,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 	"log/slog"
+	"net"
 	"net/http"
 )
 
@@ -23,15 +27,24 @@ func baseRouter(
 	teamStore user.TeamStore,
 	tokenStore token.Store,
 	credentialsStore local.UserCredentialsStore,
+	auditStore audit.Store,
 	authenticator auth.Authenticator,
 	authorizer auth.Authorizer,
+	passwordAuthenticator auth.PasswordAuthenticator,
 	accessTokenConfig auth.AccessTokenConfiguration,
 	tokenPrefix string,
+	lifetimePolicy token.LifetimePolicy,
+	rotationPolicy token.RotationPolicy,
+	notificationSecret string,
+	quotas repository.Quotas,
+	oidcProvider *oidc.Provider,
+	federatedAuthenticator *federation.Authenticator,
+	trustedProxies []*net.IPNet,
 ) chi.Router {
 	r := chi.NewRouter()
 
 	loggerMiddleware := middleware.Logger(logger)
-	r.Use(chiMiddleware.RequestID, loggerMiddleware, chiMiddleware.Recoverer)
+	r.Use(chiMiddleware.RequestID, loggerMiddleware, chiMiddleware.Recoverer, middleware.SourceIP(trustedProxies))
 
 	// Note: if more extensive (and sensitive) information is ever added to the /health endpoint, it should listen on a
 	// separate port from the main server, so that clients cannot directly access it!
@@ -41,10 +54,20 @@ func baseRouter(
 	r.Handle("/reference", http.RedirectHandler("/reference/", http.StatusMovedPermanently))
 	r.Handle("/reference/*", http.StripPrefix("/reference/", http.FileServer(http.FS(api.Files))))
 
-	r.Handle("/token", handlers.GenerateRegistryToken(logger, authenticator, authorizer, accessTokenConfig))
+	r.Handle("/token", handlers.GenerateRegistryToken(logger, authenticator, authorizer, accessTokenConfig, federatedAuthenticator, auditStore))
 
-	handler := handlers.NewHandler(logger, repoStore, userStore, teamStore, tokenStore, credentialsStore, tokenPrefix)
-	securityHandler := handlers.NewSecurityHandler(logger, tokenStore, userStore, credentialsStore)
+	if notificationSecret != "" {
+		r.Handle("/notifications", handlers.HandleRegistryNotifications(logger, repoStore, auditStore, notificationSecret))
+	}
+
+	var oidcAuthenticator *oidc.Authenticator
+	if oidcProvider != nil {
+		a := oidc.NewAuthenticator(oidcProvider, userStore)
+		oidcAuthenticator = &a
+	}
+
+	handler := handlers.NewHandler(logger, repoStore, userStore, teamStore, tokenStore, credentialsStore, auditStore, tokenPrefix, lifetimePolicy, rotationPolicy, quotas, oidcProvider)
+	securityHandler := handlers.NewSecurityHandler(logger, tokenStore, userStore, passwordAuthenticator, tokenPrefix, oidcAuthenticator)
 	apiServer, err := oas.NewServer(handler, securityHandler, oas.WithNotFound(handlers.NotFound))
 	if err != nil {
 		panic(err)
@@ -7,17 +7,28 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"github.com/evanebb/regauth/audit"
 	"github.com/evanebb/regauth/auth"
+	"github.com/evanebb/regauth/auth/federation"
+	"github.com/evanebb/regauth/auth/ldap"
+	"github.com/evanebb/regauth/auth/local"
+	"github.com/evanebb/regauth/auth/oidc"
 	"github.com/evanebb/regauth/configuration"
+	"github.com/evanebb/regauth/repository"
 	"github.com/evanebb/regauth/resources/database"
 	"github.com/evanebb/regauth/resources/database/migrations"
 	"github.com/evanebb/regauth/store/postgres"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/token/expiry"
+	"github.com/evanebb/regauth/token/retention"
+	"github.com/evanebb/regauth/user"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/lestrrat-go/jwx/v2/jwa"
 	"github.com/pressly/goose/v3"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -82,15 +93,114 @@ func Run(ctx context.Context, conf *configuration.Configuration) error {
 	tokenStore := postgres.NewPersonalAccessTokenStore(db)
 	credentialsStore := postgres.NewUserCredentialsStore(db)
 
+	var auditStore audit.Store = postgres.NewAuditStore(db)
+	if conf.Audit.ExportFile != "" {
+		f, err := os.OpenFile(conf.Audit.ExportFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to open audit export file: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+
+		auditStore = audit.NewJSONLinesExporter(auditStore, f)
+	}
+
 	authenticator := auth.NewAuthenticator(tokenStore, userStore, conf.Pat.Prefix)
-	authorizer := auth.NewAuthorizer(logger, repoStore, teamStore)
+	catalogRoles := make([]user.Role, 0, len(conf.Token.CatalogRoles))
+	for _, r := range conf.Token.CatalogRoles {
+		catalogRoles = append(catalogRoles, user.Role(r))
+	}
+
+	quotas := repository.Quotas{
+		User: repository.Quota{Repositories: conf.Quota.User.Repositories, Bytes: conf.Quota.User.Bytes},
+		Team: repository.Quota{Repositories: conf.Quota.Team.Repositories, Bytes: conf.Quota.Team.Bytes},
+	}
+
+	authorizer := auth.NewAuthorizer(logger, repoStore, teamStore, auditStore, catalogRoles, quotas)
+
+	retentionPolicy := retention.Policy{
+		MaxAge:             conf.Pat.UsageLog.MaxAge,
+		MaxEntriesPerToken: conf.Pat.UsageLog.MaxEntriesPerToken,
+		RollUp:             conf.Pat.UsageLog.RollUp,
+	}
+
+	if retentionPolicy.Enabled() {
+		pruneCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		pruner := retention.NewPruner(logger, retentionPolicy, tokenStore)
+		go pruner.Run(pruneCtx, conf.Pat.UsageLog.PruneInterval)
+	}
+
+	if conf.Pat.ExpiryWarnings.Enabled() {
+		warnCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		warner := expiry.NewWarner(logger, tokenStore, userStore, conf.Pat.ExpiryWarnings.Before, buildExpiryNotifiers(conf)...)
+		go warner.Run(warnCtx, conf.Pat.ExpiryWarnings.Interval)
+	}
+
+	var passwordAuthenticators []auth.PasswordAuthenticator
+	if conf.LDAP.Enabled() {
+		ldapConfig := buildLDAPConfiguration(conf)
+		passwordAuthenticators = append(passwordAuthenticators, ldap.NewPasswordAuthenticator(ldapConfig, userStore))
+
+		if ldapConfig.AdminGroup != "" || len(ldapConfig.Teams) > 0 {
+			syncCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			syncer := ldap.NewSyncer(logger, ldapConfig, userStore, teamStore)
+			go syncer.Run(syncCtx, conf.LDAP.SyncInterval)
+		}
+
+		logger.InfoContext(ctx, "LDAP authentication enabled", slog.String("url", conf.LDAP.URL))
+	}
+
+	if !conf.LDAP.Enabled() || !conf.LDAP.DisableLocalAuthentication {
+		passwordAuthenticators = append(passwordAuthenticators, local.NewPasswordAuthenticator(userStore, credentialsStore))
+	}
+
+	passwordAuthenticator := auth.NewChainPasswordAuthenticator(passwordAuthenticators...)
 
 	accessTokenConfig, err := buildAccessTokenConfiguration(conf)
 	if err != nil {
 		return err
 	}
 
-	router := baseRouter(logger, repoStore, userStore, teamStore, tokenStore, credentialsStore, authenticator, authorizer, accessTokenConfig, conf.Pat.Prefix)
+	var oidcProvider *oidc.Provider
+	if conf.OIDC.Enabled() {
+		oidcProvider, err = oidc.NewProvider(ctx, buildOIDCConfiguration(conf))
+		if err != nil {
+			return err
+		}
+
+		logger.InfoContext(ctx, "OpenID Connect authentication enabled", slog.String("issuer", conf.OIDC.Issuer))
+	}
+
+	var federatedAuthenticator *federation.Authenticator
+	if len(conf.Federation.Policies) > 0 {
+		policies, err := buildFederationPolicies(conf)
+		if err != nil {
+			return err
+		}
+
+		a, err := federation.NewAuthenticator(ctx, policies, userStore)
+		if err != nil {
+			return fmt.Errorf("invalid federation policy: %w", err)
+		}
+
+		federatedAuthenticator = &a
+		logger.InfoContext(ctx, fmt.Sprintf("workload identity federation enabled with %d trust policies", len(policies)))
+	}
+
+	lifetimePolicy := token.LifetimePolicy{MaxLifetime: conf.Pat.MaxLifetime}
+	rotationPolicy := token.RotationPolicy{GracePeriod: conf.Pat.Rotation.GracePeriod}
+
+	trustedProxies, err := buildTrustedProxies(conf)
+	if err != nil {
+		return err
+	}
+
+	router := baseRouter(logger, repoStore, userStore, teamStore, tokenStore, credentialsStore, auditStore, authenticator, authorizer, passwordAuthenticator, accessTokenConfig, conf.Pat.Prefix, lifetimePolicy, rotationPolicy, conf.Notifications.Secret, quotas, oidcProvider, federatedAuthenticator, trustedProxies)
 
 	server := &http.Server{
 		Addr:    conf.HTTP.Addr,
@@ -177,6 +287,100 @@ func buildAccessTokenConfiguration(conf *configuration.Configuration) (auth.Acce
 	return a, nil
 }
 
+func buildOIDCConfiguration(conf *configuration.Configuration) oidc.Config {
+	return oidc.Config{
+		Issuer:        conf.OIDC.Issuer,
+		ClientID:      conf.OIDC.ClientID,
+		Scopes:        conf.OIDC.Scopes,
+		UsernameClaim: conf.OIDC.UsernameClaim,
+		RoleClaim:     conf.OIDC.RoleClaim,
+		AdminRoles:    conf.OIDC.AdminRoles,
+	}
+}
+
+func buildLDAPConfiguration(conf *configuration.Configuration) ldap.Config {
+	teams := make([]ldap.TeamMapping, 0, len(conf.LDAP.Teams))
+	for _, t := range conf.LDAP.Teams {
+		teams = append(teams, ldap.TeamMapping{
+			Group: t.Group,
+			Team:  user.TeamName(t.Team),
+			Role:  user.TeamMemberRole(t.Role),
+		})
+	}
+
+	return ldap.Config{
+		URL:                  conf.LDAP.URL,
+		StartTLS:             conf.LDAP.StartTLS,
+		InsecureSkipVerify:   conf.LDAP.InsecureSkipVerify,
+		BindDN:               conf.LDAP.BindDN,
+		BindPassword:         conf.LDAP.BindPassword,
+		UserBaseDN:           conf.LDAP.UserBaseDN,
+		UserFilter:           conf.LDAP.UserFilter,
+		UsernameAttribute:    conf.LDAP.UsernameAttribute,
+		GroupMemberAttribute: conf.LDAP.GroupMemberAttribute,
+		AdminGroup:           conf.LDAP.AdminGroup,
+		Teams:                teams,
+	}
+}
+
+func buildFederationPolicies(conf *configuration.Configuration) ([]federation.Policy, error) {
+	policies := make([]federation.Policy, 0, len(conf.Federation.Policies))
+	for _, p := range conf.Federation.Policies {
+		policy := federation.Policy{
+			Name:         p.Name,
+			Issuer:       p.Issuer,
+			JWKSURL:      p.JWKSURL,
+			Audience:     p.Audience,
+			Claims:       p.Claims,
+			Username:     p.Username,
+			Permission:   token.Permission(p.Permission),
+			Repositories: make([]token.RepositoryPattern, 0, len(p.Repositories)),
+		}
+
+		for _, r := range p.Repositories {
+			policy.Repositories = append(policy.Repositories, token.RepositoryPattern(r))
+		}
+
+		if err := policy.IsValid(); err != nil {
+			return nil, fmt.Errorf("invalid federation policy: %w", err)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+// buildExpiryNotifiers creates the notifiers that warn about expiring personal access tokens from the configuration.
+func buildExpiryNotifiers(conf *configuration.Configuration) []expiry.Notifier {
+	var notifiers []expiry.Notifier
+
+	if c := conf.Pat.ExpiryWarnings.SMTP; c.Host != "" {
+		notifiers = append(notifiers, expiry.NewSMTPNotifier(c.Host, c.Port, c.Username, c.Password, c.From, c.To))
+	}
+
+	if c := conf.Pat.ExpiryWarnings.Webhook; c.URL != "" {
+		notifiers = append(notifiers, expiry.NewWebhookNotifier(c.URL, c.Secret))
+	}
+
+	return notifiers
+}
+
+// buildTrustedProxies parses the CIDR ranges of the proxies that are trusted to set the X-Forwarded-For header.
+func buildTrustedProxies(conf *configuration.Configuration) ([]*net.IPNet, error) {
+	trustedProxies := make([]*net.IPNet, len(conf.HTTP.TrustedProxies))
+	for i, p := range conf.HTTP.TrustedProxies {
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse trusted proxy %q: %w", p, err)
+		}
+
+		trustedProxies[i] = n
+	}
+
+	return trustedProxies, nil
+}
+
 func loadCertificate(path string) (*x509.Certificate, error) {
 	certFile, err := os.Open(path)
 	if err != nil {
@@ -0,0 +1,211 @@
+package store
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 1000
+)
+
+var (
+	ErrInvalidListLimit = errors.New("limit is not valid, must be between 1 and 1000")
+	ErrInvalidSortField = errors.New("sort is not valid, must be one of 'name', 'createdAt'")
+	ErrInvalidSortOrder = errors.New("order is not valid, must be one of 'asc', 'desc'")
+	ErrInvalidCursor    = errors.New("cursor is not valid, it must be taken from a previous response with the same sort and order")
+)
+
+type SortField string
+
+const (
+	SortByName      SortField = "name"
+	SortByCreatedAt SortField = "createdAt"
+	// SortByNamespace sorts items on their namespace and then their name. It is only supported when searching the
+	// repository catalog, and is therefore not accepted by ListOptions.IsValid.
+	SortByNamespace SortField = "namespace"
+)
+
+func (f SortField) IsValid() error {
+	if f != SortByName && f != SortByCreatedAt {
+		return ErrInvalidSortField
+	}
+
+	return nil
+}
+
+type SortOrder string
+
+const (
+	SortAscending  SortOrder = "asc"
+	SortDescending SortOrder = "desc"
+)
+
+func (o SortOrder) IsValid() error {
+	if o != SortAscending && o != SortDescending {
+		return ErrInvalidSortOrder
+	}
+
+	return nil
+}
+
+// ListOptions controls the pagination, sorting and filtering of list operations.
+// Items with an equal sort key are ordered by their ID, so that every item has a stable position in the listing.
+type ListOptions struct {
+	// Limit is the maximum number of items to return.
+	Limit int
+	// Cursor continues a previous listing after the last item that it returned. It is empty for the first page.
+	Cursor Cursor
+	Sort   SortField
+	Order  SortOrder
+	// Name only returns the items whose name contains this value, ignoring case.
+	Name string
+}
+
+// DefaultListOptions returns the options for the first page of a listing sorted by name.
+func DefaultListOptions() ListOptions {
+	return ListOptions{Limit: DefaultListLimit, Sort: SortByName, Order: SortAscending}
+}
+
+func (o ListOptions) IsValid() error {
+	if o.Limit < 1 || o.Limit > MaxListLimit {
+		return ErrInvalidListLimit
+	}
+
+	if err := o.Sort.IsValid(); err != nil {
+		return err
+	}
+
+	if err := o.Order.IsValid(); err != nil {
+		return err
+	}
+
+	_, _, err := o.After()
+	return err
+}
+
+// After returns the position of the last item of the previous page, as encoded in the cursor. The boolean is false if
+// no cursor is set.
+func (o ListOptions) After() (Position, bool, error) {
+	if o.Cursor == "" {
+		return Position{}, false, nil
+	}
+
+	p, err := o.Cursor.decode(o.Sort, o.Order)
+	if err != nil {
+		return Position{}, false, err
+	}
+
+	return p, true, nil
+}
+
+// MatchesName checks whether the given name matches the name filter of the options.
+func (o ListOptions) MatchesName(name string) bool {
+	return o.Name == "" || strings.Contains(strings.ToLower(name), strings.ToLower(o.Name))
+}
+
+// Compare compares two positions according to the sort field and order of the options, for use with slices.SortFunc.
+func (o ListOptions) Compare(a, b Position) int {
+	var c int
+	switch o.Sort {
+	case SortByCreatedAt:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	case SortByNamespace:
+		c = cmp.Or(strings.Compare(a.Namespace, b.Namespace), strings.Compare(a.Name, b.Name))
+	default:
+		c = strings.Compare(a.Name, b.Name)
+	}
+
+	c = cmp.Or(c, strings.Compare(a.ID.String(), b.ID.String()), strings.Compare(a.Name, b.Name))
+	if o.Order == SortDescending {
+		return -c
+	}
+
+	return c
+}
+
+// Position identifies the position of an item in a sorted listing. Items without an ID, such as tags, are identified by
+// their name instead, which must then be unique within the listing.
+type Position struct {
+	// Namespace is set for items in a namespace, which can also be sorted on their namespace and name.
+	Namespace string
+	Name      string
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// Cursor is an opaque token that points to a position in a sorted listing.
+type Cursor string
+
+type cursorData struct {
+	Sort      SortField `json:"s"`
+	Order     SortOrder `json:"o"`
+	Namespace string    `json:"ns,omitempty"`
+	Name      string    `json:"n,omitempty"`
+	CreatedAt time.Time `json:"c,omitzero"`
+	ID        uuid.UUID `json:"i"`
+}
+
+func newCursor(sort SortField, order SortOrder, p Position) Cursor {
+	data := cursorData{Sort: sort, Order: order, ID: p.ID}
+	if sort == SortByCreatedAt {
+		data.CreatedAt = p.CreatedAt
+		if p.ID == uuid.Nil {
+			// items without an ID that were created at the same time are ordered by their name
+			data.Name = p.Name
+		}
+	} else {
+		data.Namespace = p.Namespace
+		data.Name = p.Name
+	}
+
+	// marshalling this struct cannot fail
+	b, _ := json.Marshal(data)
+	return Cursor(base64.RawURLEncoding.EncodeToString(b))
+}
+
+func (c Cursor) decode(sort SortField, order SortOrder) (Position, error) {
+	b, err := base64.RawURLEncoding.DecodeString(string(c))
+	if err != nil {
+		return Position{}, ErrInvalidCursor
+	}
+
+	var data cursorData
+	if err := json.Unmarshal(b, &data); err != nil {
+		return Position{}, ErrInvalidCursor
+	}
+
+	if data.Sort != sort || data.Order != order || (data.ID == uuid.Nil && data.Name == "") {
+		return Position{}, ErrInvalidCursor
+	}
+
+	return Position{Namespace: data.Namespace, Name: data.Name, CreatedAt: data.CreatedAt, ID: data.ID}, nil
+}
+
+// Page is a single page of a listing.
+type Page[T any] struct {
+	Items []T
+	// Next is the cursor pointing to the next page, or empty if this is the last page.
+	Next Cursor
+}
+
+// NewPage builds a page out of the items following the cursor of the options, which should be fetched with a limit of
+// one more than the limit of the options. If there are more items than the limit, the excess items are dropped and the
+// cursor for the next page is set.
+func NewPage[T any](o ListOptions, items []T, position func(T) Position) Page[T] {
+	if len(items) <= o.Limit {
+		return Page[T]{Items: items}
+	}
+
+	items = items[:o.Limit]
+	return Page[T]{
+		Items: items,
+		Next:  newCursor(o.Sort, o.Order, position(items[len(items)-1])),
+	}
+}
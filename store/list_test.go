@@ -0,0 +1,251 @@
+package store
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestListOptions_IsValid(t *testing.T) {
+	t.Parallel()
+
+	valid := DefaultListOptions()
+
+	withOptions := func(modify func(o *ListOptions)) ListOptions {
+		o := valid
+		modify(&o)
+		return o
+	}
+
+	cursor := newCursor(SortByName, SortAscending, Position{Name: "name", ID: uuid.New()})
+
+	testCases := []struct {
+		desc    string
+		options ListOptions
+		err     error
+	}{
+		{"default options", valid, nil},
+		{"valid cursor", withOptions(func(o *ListOptions) { o.Cursor = cursor }), nil},
+		{"limit too low", withOptions(func(o *ListOptions) { o.Limit = 0 }), ErrInvalidListLimit},
+		{"limit too high", withOptions(func(o *ListOptions) { o.Limit = MaxListLimit + 1 }), ErrInvalidListLimit},
+		{"invalid sort", withOptions(func(o *ListOptions) { o.Sort = "size" }), ErrInvalidSortField},
+		{"invalid order", withOptions(func(o *ListOptions) { o.Order = "random" }), ErrInvalidSortOrder},
+		{"malformed cursor", withOptions(func(o *ListOptions) { o.Cursor = "not a cursor" }), ErrInvalidCursor},
+		{"cursor with different sort", withOptions(func(o *ListOptions) { o.Cursor = cursor; o.Sort = SortByCreatedAt }), ErrInvalidCursor},
+		{"cursor with different order", withOptions(func(o *ListOptions) { o.Cursor = cursor; o.Order = SortDescending }), ErrInvalidCursor},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.options.IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestListOptions_After(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no cursor", func(t *testing.T) {
+		_, ok, err := DefaultListOptions().After()
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if ok {
+			t.Errorf("expected no position")
+		}
+	})
+
+	t.Run("sorted by name", func(t *testing.T) {
+		expected := Position{Name: "name", ID: uuid.New()}
+
+		o := DefaultListOptions()
+		o.Cursor = newCursor(o.Sort, o.Order, Position{Name: expected.Name, CreatedAt: time.Now(), ID: expected.ID})
+
+		p, ok, err := o.After()
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !ok || p != expected {
+			t.Errorf("expected %+v, got %+v", expected, p)
+		}
+	})
+
+	t.Run("sorted by creation date", func(t *testing.T) {
+		expected := Position{CreatedAt: time.Date(2025, 1, 1, 12, 30, 0, 123456000, time.UTC), ID: uuid.New()}
+
+		o := DefaultListOptions()
+		o.Sort = SortByCreatedAt
+		o.Order = SortDescending
+		o.Cursor = newCursor(o.Sort, o.Order, Position{Name: "name", CreatedAt: expected.CreatedAt, ID: expected.ID})
+
+		p, ok, err := o.After()
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !ok || !p.CreatedAt.Equal(expected.CreatedAt) || p.ID != expected.ID || p.Name != "" {
+			t.Errorf("expected %+v, got %+v", expected, p)
+		}
+	})
+}
+
+func TestListOptions_After_WithoutID(t *testing.T) {
+	t.Parallel()
+
+	expected := Position{Name: "v1.0.0", CreatedAt: time.Date(2025, 1, 1, 12, 30, 0, 0, time.UTC)}
+
+	o := DefaultListOptions()
+	o.Sort = SortByCreatedAt
+	o.Cursor = newCursor(o.Sort, o.Order, expected)
+
+	p, ok, err := o.After()
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if !ok || !p.CreatedAt.Equal(expected.CreatedAt) || p.Name != expected.Name || p.ID != uuid.Nil {
+		t.Errorf("expected %+v, got %+v", expected, p)
+	}
+
+	o.Cursor = newCursor(o.Sort, o.Order, Position{CreatedAt: expected.CreatedAt})
+	if _, _, err := o.After(); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("expected %q, got %q", ErrInvalidCursor, err)
+	}
+}
+
+func TestListOptions_After_SortedByNamespace(t *testing.T) {
+	t.Parallel()
+
+	expected := Position{Namespace: "team", Name: "app", ID: uuid.New()}
+
+	o := ListOptions{Limit: DefaultListLimit, Sort: SortByNamespace, Order: SortAscending}
+	o.Cursor = newCursor(o.Sort, o.Order, Position{Namespace: expected.Namespace, Name: expected.Name, CreatedAt: time.Now(), ID: expected.ID})
+
+	p, ok, err := o.After()
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if !ok || p != expected {
+		t.Errorf("expected %+v, got %+v", expected, p)
+	}
+}
+
+func TestListOptions_MatchesName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		filter   string
+		name     string
+		expected bool
+	}{
+		{"no filter", "", "name", true},
+		{"exact match", "name", "name", true},
+		{"substring", "am", "name", true},
+		{"different case", "NAME", "name", true},
+		{"no match", "other", "name", false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			o := ListOptions{Name: c.filter}
+			if actual := o.MatchesName(c.name); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestListOptions_Compare(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	positions := []Position{
+		{Namespace: "x", Name: "b", CreatedAt: now, ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")},
+		{Namespace: "y", Name: "a", CreatedAt: now.Add(time.Hour), ID: uuid.MustParse("00000000-0000-0000-0000-000000000003")},
+		{Namespace: "x", Name: "a", CreatedAt: now.Add(-time.Hour), ID: uuid.MustParse("00000000-0000-0000-0000-000000000002")},
+	}
+
+	testCases := []struct {
+		desc     string
+		sort     SortField
+		order    SortOrder
+		expected []int
+	}{
+		{"name ascending", SortByName, SortAscending, []int{2, 3, 1}},
+		{"name descending", SortByName, SortDescending, []int{1, 3, 2}},
+		{"creation date ascending", SortByCreatedAt, SortAscending, []int{2, 1, 3}},
+		{"creation date descending", SortByCreatedAt, SortDescending, []int{3, 1, 2}},
+		{"namespace ascending", SortByNamespace, SortAscending, []int{2, 1, 3}},
+		{"namespace descending", SortByNamespace, SortDescending, []int{3, 1, 2}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			o := ListOptions{Sort: c.sort, Order: c.order}
+
+			sorted := slices.Clone(positions)
+			slices.SortFunc(sorted, o.Compare)
+
+			var actual []int
+			for _, p := range sorted {
+				actual = append(actual, int(p.ID[15]))
+			}
+
+			if !slices.Equal(c.expected, actual) {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	t.Parallel()
+
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	position := func(id uuid.UUID) Position {
+		return Position{Name: id.String(), ID: id}
+	}
+
+	t.Run("last page", func(t *testing.T) {
+		o := DefaultListOptions()
+		o.Limit = 3
+
+		page := NewPage(o, ids, position)
+		if len(page.Items) != 3 {
+			t.Errorf("expected three items, got %d", len(page.Items))
+		}
+
+		if page.Next != "" {
+			t.Errorf("expected no next page, got cursor %q", page.Next)
+		}
+	})
+
+	t.Run("more items available", func(t *testing.T) {
+		o := DefaultListOptions()
+		o.Limit = 2
+
+		page := NewPage(o, ids, position)
+		if len(page.Items) != 2 {
+			t.Errorf("expected two items, got %d", len(page.Items))
+		}
+
+		o.Cursor = page.Next
+		after, ok, err := o.After()
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if !ok || after != position(ids[1]) {
+			t.Errorf("expected cursor to point to %+v, got %+v", position(ids[1]), after)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"github.com/evanebb/regauth/audit"
+	"github.com/evanebb/regauth/store"
+	"sync"
+)
+
+type AuditStore struct {
+	TransactionStore
+	mu sync.RWMutex
+	// events are stored in the order they were recorded
+	events []audit.Event
+}
+
+func NewAuditStore() *AuditStore {
+	return &AuditStore{}
+}
+
+func (s *AuditStore) Record(ctx context.Context, e audit.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *AuditStore) List(ctx context.Context, f audit.Filter) (store.Page[audit.Event], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var events []audit.Event
+	for _, e := range s.events {
+		if f.Matches(e) {
+			events = append(events, e)
+		}
+	}
+
+	return paginate(f.ListOptions(), events, audit.Event.Position)
+}
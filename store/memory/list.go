@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"github.com/evanebb/regauth/store"
+	"slices"
+)
+
+// paginate filters, sorts and paginates the given items according to the list options.
+func paginate[T any](o store.ListOptions, items []T, position func(T) store.Position) (store.Page[T], error) {
+	after, ok, err := o.After()
+	if err != nil {
+		return store.Page[T]{}, err
+	}
+
+	var matches []T
+	for _, item := range items {
+		p := position(item)
+		if !o.MatchesName(p.Name) {
+			continue
+		}
+
+		if ok && o.Compare(p, after) <= 0 {
+			continue
+		}
+
+		matches = append(matches, item)
+	}
+
+	slices.SortFunc(matches, func(a, b T) int {
+		return o.Compare(position(a), position(b))
+	})
+
+	return store.NewPage(o, matches[:min(len(matches), o.Limit+1)], position), nil
+}
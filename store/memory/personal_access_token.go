@@ -2,26 +2,51 @@ package memory
 
 import (
 	"context"
+	"github.com/evanebb/regauth/store"
 	"github.com/evanebb/regauth/token"
 	"github.com/google/uuid"
+	"slices"
 	"sync"
+	"time"
 )
 
 type PersonalAccessTokenStore struct {
 	TransactionStore
-	mu            sync.RWMutex
-	tokens        map[string]token.PersonalAccessToken
-	tokenUsageLog map[uuid.UUID][]token.UsageLogEntry
+	mu     sync.RWMutex
+	tokens map[string]token.PersonalAccessToken
+	// previousTokens are the previous plain-text tokens of rotated tokens, which keep working until they expire
+	previousTokens map[string]previousToken
+	// expiryWarnings are the times that the owners of tokens were warned about their expiration, keyed by the token ID
+	expiryWarnings map[uuid.UUID]time.Time
+	// expiryWarningDeliveries are the names of the notifiers that delivered the expiry warning, keyed by the token ID
+	expiryWarningDeliveries map[uuid.UUID][]string
+	tokenUsageLog           map[uuid.UUID][]token.UsageLogEntry
+	// usageRollups are stored per token, keyed by the day and source IP
+	usageRollups map[uuid.UUID]map[usageRollupKey]token.UsageRollup
+}
+
+type previousToken struct {
+	tokenID   uuid.UUID
+	expiresAt time.Time
+}
+
+type usageRollupKey struct {
+	day      string
+	sourceIP string
 }
 
 func NewPersonalAccessTokenStore() *PersonalAccessTokenStore {
 	return &PersonalAccessTokenStore{
-		tokens:        make(map[string]token.PersonalAccessToken),
-		tokenUsageLog: make(map[uuid.UUID][]token.UsageLogEntry),
+		tokens:                  make(map[string]token.PersonalAccessToken),
+		previousTokens:          make(map[string]previousToken),
+		expiryWarnings:          make(map[uuid.UUID]time.Time),
+		expiryWarningDeliveries: make(map[uuid.UUID][]string),
+		tokenUsageLog:           make(map[uuid.UUID][]token.UsageLogEntry),
+		usageRollups:            make(map[uuid.UUID]map[usageRollupKey]token.UsageRollup),
 	}
 }
 
-func (s *PersonalAccessTokenStore) GetAllByUser(ctx context.Context, userID uuid.UUID) ([]token.PersonalAccessToken, error) {
+func (s *PersonalAccessTokenStore) ListByUser(ctx context.Context, userID uuid.UUID, o store.ListOptions) (store.Page[token.PersonalAccessToken], error) {
 	var tokens []token.PersonalAccessToken
 
 	s.mu.RLock()
@@ -33,7 +58,7 @@ func (s *PersonalAccessTokenStore) GetAllByUser(ctx context.Context, userID uuid
 		}
 	}
 
-	return tokens, nil
+	return paginate(o, tokens, token.PersonalAccessToken.Position)
 }
 
 func (s *PersonalAccessTokenStore) GetByID(ctx context.Context, id uuid.UUID) (token.PersonalAccessToken, error) {
@@ -54,11 +79,22 @@ func (s *PersonalAccessTokenStore) GetByPlainTextToken(ctx context.Context, plai
 	defer s.mu.RUnlock()
 
 	t, ok := s.tokens[plainTextToken]
-	if !ok {
+	if ok {
+		return t, t.IsValid()
+	}
+
+	previous, ok := s.previousTokens[plainTextToken]
+	if !ok || !previous.expiresAt.After(time.Now()) {
 		return token.PersonalAccessToken{}, token.ErrNotFound
 	}
 
-	return t, t.IsValid()
+	for _, t := range s.tokens {
+		if t.ID == previous.tokenID {
+			return t, t.IsValid()
+		}
+	}
+
+	return token.PersonalAccessToken{}, token.ErrNotFound
 }
 
 func (s *PersonalAccessTokenStore) Create(ctx context.Context, t token.PersonalAccessToken, plainTextToken string) error {
@@ -80,19 +116,142 @@ func (s *PersonalAccessTokenStore) DeleteByID(ctx context.Context, id uuid.UUID)
 		}
 	}
 
+	for plain, previous := range s.previousTokens {
+		if previous.tokenID == id {
+			delete(s.previousTokens, plain)
+		}
+	}
+
 	return nil
 }
 
-func (s *PersonalAccessTokenStore) GetUsageLog(ctx context.Context, tokenID uuid.UUID) ([]token.UsageLogEntry, error) {
+func (s *PersonalAccessTokenStore) Rotate(ctx context.Context, id uuid.UUID, plainTextToken string, previousExpiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for plain, t := range s.tokens {
+		if t.ID != id {
+			continue
+		}
+
+		// only the previous token of the most recent rotation keeps working
+		for previousPlain, previous := range s.previousTokens {
+			if previous.tokenID == id {
+				delete(s.previousTokens, previousPlain)
+			}
+		}
+
+		s.previousTokens[plain] = previousToken{tokenID: id, expiresAt: previousExpiresAt}
+		delete(s.tokens, plain)
+		s.tokens[plainTextToken] = t
+
+		return nil
+	}
+
+	return token.ErrNotFound
+}
+
+func (s *PersonalAccessTokenStore) Revoke(ctx context.Context, id uuid.UUID, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for plain, t := range s.tokens {
+		if t.ID == id {
+			if !t.IsRevoked() {
+				t.RevokedAt = at
+				s.tokens[plain] = t
+			}
+
+			return nil
+		}
+	}
+
+	return token.ErrNotFound
+}
+
+func (s *PersonalAccessTokenStore) GetExpiring(ctx context.Context, from, until time.Time) ([]token.PersonalAccessToken, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	log, ok := s.tokenUsageLog[tokenID]
-	if !ok {
-		return make([]token.UsageLogEntry, 0), nil
+	var tokens []token.PersonalAccessToken
+	for _, t := range s.tokens {
+		if _, warned := s.expiryWarnings[t.ID]; warned || t.IsRevoked() {
+			continue
+		}
+
+		if t.ExpirationDate.After(from) && !t.ExpirationDate.After(until) {
+			tokens = append(tokens, t)
+		}
+	}
+
+	return tokens, nil
+}
+
+func (s *PersonalAccessTokenStore) SetExpiryWarned(ctx context.Context, id uuid.UUID, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.tokens {
+		if t.ID == id {
+			s.expiryWarnings[id] = at
+			return nil
+		}
+	}
+
+	return token.ErrNotFound
+}
+
+func (s *PersonalAccessTokenStore) GetExpiryWarningDeliveries(ctx context.Context, id uuid.UUID) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return slices.Clone(s.expiryWarningDeliveries[id]), nil
+}
+
+func (s *PersonalAccessTokenStore) SetExpiryWarningDelivered(ctx context.Context, id uuid.UUID, notifier string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.tokens {
+		if t.ID == id {
+			if !slices.Contains(s.expiryWarningDeliveries[id], notifier) {
+				s.expiryWarningDeliveries[id] = append(s.expiryWarningDeliveries[id], notifier)
+			}
+			return nil
+		}
+	}
+
+	return token.ErrNotFound
+}
+
+func (s *PersonalAccessTokenStore) GetUsageLog(ctx context.Context, tokenID uuid.UUID, f token.UsageLogFilter) (store.Page[token.UsageLogEntry], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var entries []token.UsageLogEntry
+	for _, e := range s.tokenUsageLog[tokenID] {
+		if f.Matches(e) {
+			entries = append(entries, e)
+		}
+	}
+
+	return paginate(f.ListOptions(), entries, token.UsageLogEntry.Position)
+}
+
+func (s *PersonalAccessTokenStore) GetLastUsage(ctx context.Context, tokenIDs ...uuid.UUID) (map[uuid.UUID]token.UsageLogEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	lastUsage := make(map[uuid.UUID]token.UsageLogEntry)
+	for _, id := range tokenIDs {
+		for _, e := range s.tokenUsageLog[id] {
+			if last, ok := lastUsage[id]; !ok || e.Timestamp.After(last.Timestamp) {
+				lastUsage[id] = e
+			}
+		}
 	}
 
-	return log, nil
+	return lastUsage, nil
 }
 
 func (s *PersonalAccessTokenStore) AddUsageLogEntry(ctx context.Context, e token.UsageLogEntry) error {
@@ -106,3 +265,64 @@ func (s *PersonalAccessTokenStore) AddUsageLogEntry(ctx context.Context, e token
 	s.tokenUsageLog[e.TokenID] = append(s.tokenUsageLog[e.TokenID], e)
 	return nil
 }
+
+func (s *PersonalAccessTokenStore) PruneUsageLog(ctx context.Context, before time.Time, maxEntriesPerToken int, rollUp bool) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var removed int64
+	for tokenID, log := range s.tokenUsageLog {
+		// sort the entries from newest to oldest, so the newest entries are kept when limiting the number of entries
+		sorted := slices.Clone(log)
+		slices.SortStableFunc(sorted, func(a, b token.UsageLogEntry) int { return b.Timestamp.Compare(a.Timestamp) })
+
+		kept := make([]token.UsageLogEntry, 0, len(sorted))
+		for i, e := range sorted {
+			if (before.IsZero() || !e.Timestamp.Before(before)) && (maxEntriesPerToken <= 0 || i < maxEntriesPerToken) {
+				kept = append(kept, e)
+				continue
+			}
+
+			removed++
+			if rollUp {
+				s.addToUsageRollup(e)
+			}
+		}
+
+		// store the kept entries from oldest to newest again, which is the order they were added in
+		slices.Reverse(kept)
+		s.tokenUsageLog[tokenID] = kept
+	}
+
+	return removed, nil
+}
+
+func (s *PersonalAccessTokenStore) addToUsageRollup(e token.UsageLogEntry) {
+	day := e.Timestamp.UTC().Truncate(24 * time.Hour)
+	key := usageRollupKey{day: day.Format(time.DateOnly), sourceIP: e.SourceIP.String()}
+
+	if _, ok := s.usageRollups[e.TokenID]; !ok {
+		s.usageRollups[e.TokenID] = make(map[usageRollupKey]token.UsageRollup)
+	}
+
+	r, ok := s.usageRollups[e.TokenID][key]
+	if !ok {
+		r = token.UsageRollup{TokenID: e.TokenID, Day: day, SourceIP: e.SourceIP}
+	}
+
+	r.Count++
+	s.usageRollups[e.TokenID][key] = r
+}
+
+func (s *PersonalAccessTokenStore) GetUsageRollups(ctx context.Context, tokenID uuid.UUID) ([]token.UsageRollup, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rollups := make([]token.UsageRollup, 0, len(s.usageRollups[tokenID]))
+	for _, r := range s.usageRollups[tokenID] {
+		rollups = append(rollups, r)
+	}
+
+	slices.SortFunc(rollups, func(a, b token.UsageRollup) int { return b.Day.Compare(a.Day) })
+	return rollups, nil
+}
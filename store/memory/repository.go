@@ -3,7 +3,9 @@ package memory
 import (
 	"context"
 	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/store"
 	"github.com/google/uuid"
+	"maps"
 	"slices"
 	"sync"
 )
@@ -12,27 +14,66 @@ type RepositoryStore struct {
 	TransactionStore
 	mu           sync.RWMutex
 	repositories map[uuid.UUID]repository.Repository
+	tags         map[uuid.UUID]map[string]repository.Tag
+	// collaborators are stored per repository, keyed by the collaborator type and subject ID
+	collaborators map[uuid.UUID]map[collaboratorKey]repository.Collaborator
+	// protectedTags are stored per repository, keyed by their pattern
+	protectedTags map[uuid.UUID]map[string]repository.ProtectedTag
+	// blobs are stored per repository, keyed by their digest
+	blobs map[uuid.UUID]map[string]repository.Blob
+	// namespaces are not tracked separately, so every namespace exists and has the default settings until they are saved
+	namespaceSettings map[string]repository.NamespaceSettings
+	quotaOverrides    map[string]repository.QuotaOverride
+}
+
+type collaboratorKey struct {
+	t         repository.CollaboratorType
+	subjectID uuid.UUID
 }
 
 func NewRepositoryStore() *RepositoryStore {
 	return &RepositoryStore{
-		repositories: make(map[uuid.UUID]repository.Repository),
+		repositories:      make(map[uuid.UUID]repository.Repository),
+		tags:              make(map[uuid.UUID]map[string]repository.Tag),
+		collaborators:     make(map[uuid.UUID]map[collaboratorKey]repository.Collaborator),
+		protectedTags:     make(map[uuid.UUID]map[string]repository.ProtectedTag),
+		blobs:             make(map[uuid.UUID]map[string]repository.Blob),
+		namespaceSettings: make(map[string]repository.NamespaceSettings),
+		quotaOverrides:    make(map[string]repository.QuotaOverride),
 	}
 }
 
-func (s *RepositoryStore) GetAllByNamespace(ctx context.Context, namespaces ...string) ([]repository.Repository, error) {
+func (s *RepositoryStore) ListByNamespace(ctx context.Context, o store.ListOptions, selector repository.LabelSelector, patterns []string, namespaces ...string) (store.Page[repository.Repository], error) {
 	var repositories []repository.Repository
 
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	for _, r := range s.repositories {
-		if slices.Contains(namespaces, r.Namespace) {
+		if slices.Contains(namespaces, r.Namespace) && selector.Matches(r.Labels) && repository.MatchesPatterns(r, patterns) {
+			r.Readme = ""
 			repositories = append(repositories, r)
 		}
 	}
 
-	return repositories, nil
+	return paginate(o, repositories, repository.Repository.Position)
+}
+
+func (s *RepositoryStore) Search(ctx context.Context, f repository.SearchFilter, a repository.SearchAccess) (store.Page[repository.Repository], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matches []repository.Repository
+	for _, r := range s.repositories {
+		if !f.Matches(r) || !a.CanPull(r, slices.Collect(maps.Values(s.collaborators[r.ID]))) {
+			continue
+		}
+
+		r.Readme = ""
+		matches = append(matches, r)
+	}
+
+	return paginate(f.ListOptions(), matches, repository.Repository.Position)
 }
 
 func (s *RepositoryStore) GetByNamespaceAndName(ctx context.Context, namespace string, name string) (repository.Repository, error) {
@@ -69,11 +110,317 @@ func (s *RepositoryStore) Create(ctx context.Context, r repository.Repository) e
 	return nil
 }
 
+func (s *RepositoryStore) Update(ctx context.Context, r repository.Repository) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.repositories[r.ID]; !ok {
+		return repository.ErrNotFound
+	}
+
+	for _, existing := range s.repositories {
+		if existing.ID != r.ID && existing.Namespace == r.Namespace && existing.Name == r.Name {
+			return repository.ErrAlreadyExists
+		}
+	}
+
+	s.repositories[r.ID] = r
+	return nil
+}
+
 func (s *RepositoryStore) DeleteByID(ctx context.Context, id uuid.UUID) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	delete(s.repositories, id)
+	delete(s.tags, id)
+	delete(s.collaborators, id)
+	delete(s.protectedTags, id)
+	delete(s.blobs, id)
+
+	return nil
+}
+
+func (s *RepositoryStore) GetTags(ctx context.Context, repositoryID uuid.UUID) ([]repository.Tag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tags := make([]repository.Tag, 0, len(s.tags[repositoryID]))
+	for _, t := range s.tags[repositoryID] {
+		if err := t.IsValid(); err != nil {
+			return tags, err
+		}
+
+		tags = append(tags, t)
+	}
+
+	return tags, nil
+}
+
+func (s *RepositoryStore) ListTags(ctx context.Context, repositoryID uuid.UUID, o store.ListOptions) (store.Page[repository.Tag], error) {
+	tags, err := s.GetTags(ctx, repositoryID)
+	if err != nil {
+		return store.Page[repository.Tag]{}, err
+	}
+
+	return paginate(o, tags, repository.Tag.Position)
+}
+
+func (s *RepositoryStore) GetTag(ctx context.Context, repositoryID uuid.UUID, name string) (repository.Tag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.tags[repositoryID][name]
+	if !ok {
+		return repository.Tag{}, repository.ErrTagNotFound
+	}
+
+	return t, t.IsValid()
+}
+
+func (s *RepositoryStore) SaveTag(ctx context.Context, t repository.Tag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.repositories[t.RepositoryID]; !ok {
+		return repository.ErrNotFound
+	}
+
+	if _, ok := s.tags[t.RepositoryID]; !ok {
+		s.tags[t.RepositoryID] = make(map[string]repository.Tag)
+	}
+
+	s.tags[t.RepositoryID][string(t.Name)] = t
+
+	return nil
+}
+
+func (s *RepositoryStore) DeleteTag(ctx context.Context, repositoryID uuid.UUID, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tags[repositoryID], name)
+
+	return nil
+}
+
+func (s *RepositoryStore) DeleteTagsByDigest(ctx context.Context, repositoryID uuid.UUID, digest string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, t := range s.tags[repositoryID] {
+		if string(t.Digest) == digest {
+			delete(s.tags[repositoryID], name)
+		}
+	}
+
+	return nil
+}
+
+func (s *RepositoryStore) GetCollaborators(ctx context.Context, repositoryID uuid.UUID) ([]repository.Collaborator, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	collaborators := make([]repository.Collaborator, 0, len(s.collaborators[repositoryID]))
+	for _, c := range s.collaborators[repositoryID] {
+		if err := c.IsValid(); err != nil {
+			return collaborators, err
+		}
+
+		collaborators = append(collaborators, c)
+	}
+
+	return collaborators, nil
+}
+
+func (s *RepositoryStore) GetCollaborator(ctx context.Context, repositoryID uuid.UUID, t repository.CollaboratorType, subjectID uuid.UUID) (repository.Collaborator, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	c, ok := s.collaborators[repositoryID][collaboratorKey{t, subjectID}]
+	if !ok {
+		return repository.Collaborator{}, repository.ErrCollaboratorNotFound
+	}
+
+	return c, c.IsValid()
+}
+
+func (s *RepositoryStore) SaveCollaborator(ctx context.Context, c repository.Collaborator) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.repositories[c.RepositoryID]; !ok {
+		return repository.ErrNotFound
+	}
+
+	if _, ok := s.collaborators[c.RepositoryID]; !ok {
+		s.collaborators[c.RepositoryID] = make(map[collaboratorKey]repository.Collaborator)
+	}
+
+	s.collaborators[c.RepositoryID][collaboratorKey{c.Type, c.SubjectID}] = c
+
+	return nil
+}
+
+func (s *RepositoryStore) RemoveCollaborator(ctx context.Context, repositoryID uuid.UUID, t repository.CollaboratorType, subjectID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.collaborators[repositoryID], collaboratorKey{t, subjectID})
+
+	return nil
+}
+
+func (s *RepositoryStore) GetProtectedTags(ctx context.Context, repositoryID uuid.UUID) ([]repository.ProtectedTag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	protectedTags := make([]repository.ProtectedTag, 0, len(s.protectedTags[repositoryID]))
+	for _, pattern := range slices.Sorted(maps.Keys(s.protectedTags[repositoryID])) {
+		p := s.protectedTags[repositoryID][pattern]
+		if err := p.IsValid(); err != nil {
+			return protectedTags, err
+		}
+
+		protectedTags = append(protectedTags, p)
+	}
+
+	return protectedTags, nil
+}
+
+func (s *RepositoryStore) SaveProtectedTag(ctx context.Context, p repository.ProtectedTag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.repositories[p.RepositoryID]; !ok {
+		return repository.ErrNotFound
+	}
+
+	if _, ok := s.protectedTags[p.RepositoryID]; !ok {
+		s.protectedTags[p.RepositoryID] = make(map[string]repository.ProtectedTag)
+	}
+
+	if _, ok := s.protectedTags[p.RepositoryID][string(p.Pattern)]; !ok {
+		s.protectedTags[p.RepositoryID][string(p.Pattern)] = p
+	}
+
+	return nil
+}
+
+func (s *RepositoryStore) RemoveProtectedTag(ctx context.Context, repositoryID uuid.UUID, pattern string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.protectedTags[repositoryID][pattern]; !ok {
+		return repository.ErrProtectedTagNotFound
+	}
+
+	delete(s.protectedTags[repositoryID], pattern)
+
+	return nil
+}
+
+func (s *RepositoryStore) SaveBlob(ctx context.Context, b repository.Blob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.repositories[b.RepositoryID]; !ok {
+		return repository.ErrNotFound
+	}
+
+	if _, ok := s.blobs[b.RepositoryID]; !ok {
+		s.blobs[b.RepositoryID] = make(map[string]repository.Blob)
+	}
+
+	if _, ok := s.blobs[b.RepositoryID][string(b.Digest)]; !ok {
+		s.blobs[b.RepositoryID][string(b.Digest)] = b
+	}
+
+	return nil
+}
+
+func (s *RepositoryStore) DeleteBlob(ctx context.Context, repositoryID uuid.UUID, digest string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.blobs[repositoryID], digest)
+
+	return nil
+}
+
+func (s *RepositoryStore) GetNamespaceUsage(ctx context.Context, namespace string) (repository.Usage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	usage := repository.Usage{Namespace: namespace}
+	// blobs that are shared between repositories in the namespace are only counted once
+	sizes := make(map[string]int64)
+
+	for _, r := range s.repositories {
+		if r.Namespace != namespace {
+			continue
+		}
+
+		usage.Repositories++
+		for digest, b := range s.blobs[r.ID] {
+			sizes[digest] = b.Size
+		}
+	}
+
+	for _, size := range sizes {
+		usage.Bytes += size
+	}
+
+	return usage, nil
+}
+
+func (s *RepositoryStore) GetNamespaceSettings(ctx context.Context, namespace string) (repository.NamespaceSettings, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	settings, ok := s.namespaceSettings[namespace]
+	if !ok {
+		return repository.DefaultNamespaceSettings(namespace), nil
+	}
+
+	return settings, nil
+}
+
+func (s *RepositoryStore) SaveNamespaceSettings(ctx context.Context, settings repository.NamespaceSettings) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.namespaceSettings[settings.Namespace] = settings
+
+	return nil
+}
+
+func (s *RepositoryStore) GetQuotaOverride(ctx context.Context, namespace string) (repository.QuotaOverride, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	o, ok := s.quotaOverrides[namespace]
+	if !ok {
+		return repository.QuotaOverride{}, repository.ErrQuotaOverrideNotFound
+	}
+
+	return o, nil
+}
+
+func (s *RepositoryStore) SaveQuotaOverride(ctx context.Context, o repository.QuotaOverride) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.quotaOverrides[o.Namespace] = o
+
+	return nil
+}
+
+func (s *RepositoryStore) DeleteQuotaOverride(ctx context.Context, namespace string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.quotaOverrides, namespace)
 
 	return nil
 }
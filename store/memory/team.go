@@ -3,9 +3,11 @@ package memory
 import (
 	"context"
 	"fmt"
+	"github.com/evanebb/regauth/store"
 	"github.com/evanebb/regauth/user"
 	"github.com/google/uuid"
 	"sync"
+	"time"
 )
 
 type TeamStore struct {
@@ -13,12 +15,15 @@ type TeamStore struct {
 	mu          sync.RWMutex
 	teams       map[uuid.UUID]user.Team
 	teamMembers map[uuid.UUID]map[uuid.UUID]user.TeamMember
+	// robots are keyed by the ID of the user belonging to the robot
+	robots map[uuid.UUID]user.Robot
 }
 
 func NewTeamStore() *TeamStore {
 	return &TeamStore{
 		teams:       make(map[uuid.UUID]user.Team),
 		teamMembers: make(map[uuid.UUID]map[uuid.UUID]user.TeamMember),
+		robots:      make(map[uuid.UUID]user.Robot),
 	}
 }
 
@@ -50,6 +55,15 @@ func (s *TeamStore) GetAllByUser(ctx context.Context, userID uuid.UUID) ([]user.
 	return teams, nil
 }
 
+func (s *TeamStore) ListByUser(ctx context.Context, userID uuid.UUID, o store.ListOptions) (store.Page[user.Team], error) {
+	teams, err := s.GetAllByUser(ctx, userID)
+	if err != nil {
+		return store.Page[user.Team]{}, err
+	}
+
+	return paginate(o, teams, user.Team.Position)
+}
+
 func (s *TeamStore) GetByID(ctx context.Context, id uuid.UUID) (user.Team, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -84,6 +98,22 @@ func (s *TeamStore) Create(ctx context.Context, t user.Team) error {
 	}
 
 	s.teams[t.ID] = t
+	s.teamMembers[t.ID] = make(map[uuid.UUID]user.TeamMember)
+
+	return nil
+}
+
+func (s *TeamStore) SetMaxTokenLifetime(ctx context.Context, id uuid.UUID, maxTokenLifetime time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	team, ok := s.teams[id]
+	if !ok {
+		return user.ErrTeamNotFound
+	}
+
+	team.MaxTokenLifetime = maxTokenLifetime
+	s.teams[id] = team
 
 	return nil
 }
@@ -93,6 +123,11 @@ func (s *TeamStore) DeleteByID(ctx context.Context, id uuid.UUID) error {
 	defer s.mu.Unlock()
 
 	delete(s.teams, id)
+	for userID, r := range s.robots {
+		if r.TeamID == id {
+			delete(s.robots, userID)
+		}
+	}
 
 	return nil
 }
@@ -136,6 +171,15 @@ func (s *TeamStore) GetTeamMembers(ctx context.Context, teamID uuid.UUID) ([]use
 	return members, nil
 }
 
+func (s *TeamStore) ListTeamMembers(ctx context.Context, teamID uuid.UUID, o store.ListOptions) (store.Page[user.TeamMember], error) {
+	members, err := s.GetTeamMembers(ctx, teamID)
+	if err != nil {
+		return store.Page[user.TeamMember]{}, err
+	}
+
+	return paginate(o, members, user.TeamMember.Position)
+}
+
 func (s *TeamStore) AddTeamMember(ctx context.Context, m user.TeamMember) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -170,3 +214,72 @@ func (s *TeamStore) RemoveTeamMember(ctx context.Context, teamID uuid.UUID, user
 
 	return nil
 }
+
+func (s *TeamStore) ListRobots(ctx context.Context, teamID uuid.UUID, o store.ListOptions) (store.Page[user.Robot], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var robots []user.Robot
+	for _, r := range s.robots {
+		if r.TeamID != teamID {
+			continue
+		}
+
+		if err := r.IsValid(); err != nil {
+			return store.Page[user.Robot]{}, err
+		}
+
+		robots = append(robots, r)
+	}
+
+	return paginate(o, robots, user.Robot.Position)
+}
+
+func (s *TeamStore) GetRobot(ctx context.Context, teamID uuid.UUID, name string) (user.Robot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, r := range s.robots {
+		if r.TeamID == teamID && string(r.Name) == name {
+			return r, r.IsValid()
+		}
+	}
+
+	return user.Robot{}, user.ErrRobotNotFound
+}
+
+func (s *TeamStore) GetRobotByUserID(ctx context.Context, userID uuid.UUID) (user.Robot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.robots[userID]
+	if !ok {
+		return user.Robot{}, user.ErrRobotNotFound
+	}
+
+	return r, r.IsValid()
+}
+
+func (s *TeamStore) AddRobot(ctx context.Context, r user.Robot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.teams[r.TeamID]; !ok {
+		return user.ErrTeamNotFound
+	}
+
+	s.robots[r.UserID] = r
+
+	return nil
+}
+
+func (s *TeamStore) RemoveRobot(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, ok := s.robots[userID]; ok && r.TeamID == teamID {
+		delete(s.robots, userID)
+	}
+
+	return nil
+}
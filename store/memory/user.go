@@ -2,6 +2,7 @@ package memory
 
 import (
 	"context"
+	"github.com/evanebb/regauth/store"
 	"github.com/evanebb/regauth/user"
 	"github.com/google/uuid"
 	"sync"
@@ -19,16 +20,21 @@ func NewUserStore() *UserStore {
 	}
 }
 
-func (s *UserStore) GetAll(ctx context.Context) ([]user.User, error) {
+func (s *UserStore) List(ctx context.Context, o store.ListOptions) (store.Page[user.User], error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	users := make([]user.User, 0, len(s.users))
 	for _, u := range s.users {
+		if u.Role == user.RoleRobot {
+			// robots are managed through their team, and are not regular users
+			continue
+		}
+
 		users = append(users, u)
 	}
 
-	return users, nil
+	return paginate(o, users, user.User.Position)
 }
 
 func (s *UserStore) GetByID(ctx context.Context, id uuid.UUID) (user.User, error) {
@@ -56,6 +62,19 @@ func (s *UserStore) GetByUsername(ctx context.Context, username string) (user.Us
 	return user.User{}, user.ErrNotFound
 }
 
+func (s *UserStore) GetByIdentity(ctx context.Context, identity user.Identity) (user.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, u := range s.users {
+		if !u.Identity.IsZero() && u.Identity == identity {
+			return u, u.IsValid()
+		}
+	}
+
+	return user.User{}, user.ErrNotFound
+}
+
 func (s *UserStore) Create(ctx context.Context, u user.User) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -65,6 +84,36 @@ func (s *UserStore) Create(ctx context.Context, u user.User) error {
 	return nil
 }
 
+func (s *UserStore) UpdateRole(ctx context.Context, id uuid.UUID, role user.Role) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[id]
+	if !ok {
+		return user.ErrNotFound
+	}
+
+	u.Role = role
+	s.users[id] = u
+
+	return nil
+}
+
+func (s *UserStore) SetDisabled(ctx context.Context, id uuid.UUID, disabled bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[id]
+	if !ok {
+		return user.ErrNotFound
+	}
+
+	u.Disabled = disabled
+	s.users[id] = u
+
+	return nil
+}
+
 func (s *UserStore) DeleteByID(ctx context.Context, id uuid.UUID) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/evanebb/regauth/audit"
+	"github.com/evanebb/regauth/store"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"net"
+	"strings"
+)
+
+type AuditStore struct {
+	TransactionStore
+}
+
+func NewAuditStore(db *pgxpool.Pool) AuditStore {
+	return AuditStore{TransactionStore{db: db}}
+}
+
+func (s AuditStore) Record(ctx context.Context, e audit.Event) error {
+	details := e.Details
+	if details == nil {
+		details = make(map[string]string)
+	}
+
+	query := "INSERT INTO audit_events (id, timestamp, action, actor_id, actor_username, source_ip, target_type, target, details) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)"
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, e.ID, e.Timestamp, e.Action, e.ActorID, e.ActorUsername, e.SourceIP, e.TargetType, e.Target, details)
+	return err
+}
+
+func (s AuditStore) List(ctx context.Context, f audit.Filter) (store.Page[audit.Event], error) {
+	var conditions []string
+	var args []any
+
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if f.ActorUsername != "" {
+		addCondition("actor_username = $%d", f.ActorUsername)
+	}
+	if f.Action != "" {
+		addCondition("action = $%d", f.Action)
+	}
+	if f.TargetType != "" {
+		addCondition("target_type = $%d", f.TargetType)
+	}
+	if f.Target != "" {
+		addCondition("target = $%d", f.Target)
+	}
+	if !f.Since.IsZero() {
+		addCondition("timestamp >= $%d", f.Since)
+	}
+	if !f.Until.IsZero() {
+		addCondition("timestamp <= $%d", f.Until)
+	}
+
+	// pageQuery appends to a WHERE clause, so always start one
+	conditions = append([]string{"TRUE"}, conditions...)
+	query := "SELECT id, timestamp, action, actor_id, actor_username, source_ip, target_type, target, details FROM audit_events WHERE " + strings.Join(conditions, " AND ")
+
+	o := f.ListOptions()
+	query, args, err := pageQuery(query, args, o, listColumns{createdAt: "timestamp", id: "id"})
+	if err != nil {
+		return store.Page[audit.Event]{}, err
+	}
+
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return store.Page[audit.Event]{}, err
+	}
+
+	events, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (audit.Event, error) {
+		var e audit.Event
+		var sourceIP *net.IP
+
+		err := row.Scan(&e.ID, &e.Timestamp, &e.Action, &e.ActorID, &e.ActorUsername, &sourceIP, &e.TargetType, &e.Target, &e.Details)
+		if err != nil {
+			return e, err
+		}
+
+		if sourceIP != nil {
+			e.SourceIP = *sourceIP
+		}
+
+		return e, e.IsValid()
+	})
+	if err != nil {
+		return store.Page[audit.Event]{}, err
+	}
+
+	return store.NewPage(o, events, audit.Event.Position), nil
+}
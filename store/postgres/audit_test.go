@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"github.com/evanebb/regauth/audit"
+	"github.com/google/uuid"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestAuditStore_Record(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewAuditStore(db)
+
+	id, _ := uuid.NewV7()
+	actorID, _ := uuid.Parse("0195cd11-2863-71d4-a3c4-032bc264cf81")
+	timestamp, _ := time.Parse(time.RFC3339, "2025-02-01T00:00:00Z")
+
+	e := audit.Event{
+		ID:            id,
+		Timestamp:     timestamp,
+		Action:        audit.ActionUserCreate,
+		ActorID:       actorID,
+		ActorUsername: "adminuser",
+		SourceIP:      net.ParseIP("10.0.0.1"),
+		TargetType:    audit.TargetTypeUser,
+		Target:        "newuser",
+		Details:       map[string]string{"role": "user"},
+	}
+
+	if err := s.Record(t.Context(), e); err != nil {
+		t.Errorf("expected err to be nil, got %q", err)
+	}
+
+	page, err := s.List(t.Context(), audit.Filter{Target: "newuser", Limit: audit.DefaultLimit})
+	if err != nil {
+		t.Errorf("expected err to be nil, got %q", err)
+	}
+
+	if len(page.Items) != 1 {
+		t.Fatalf("expected one event, got %d", len(page.Items))
+	}
+
+	got := page.Items[0]
+	if got.ID != e.ID || got.Action != e.Action || got.ActorID != e.ActorID || !got.Timestamp.Equal(e.Timestamp) ||
+		!got.SourceIP.Equal(e.SourceIP) || got.Details["role"] != "user" {
+		t.Errorf("expected %+v, got %+v", e, got)
+	}
+}
+
+func TestAuditStore_List(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewAuditStore(db)
+
+	since, _ := time.Parse(time.RFC3339, "2025-01-02T00:00:00Z")
+
+	cases := []struct {
+		desc     string
+		filter   audit.Filter
+		expected []string
+	}{
+		{"all events, newest first", audit.Filter{Limit: audit.DefaultLimit}, []string{"0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e03", "0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e02", "0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e01"}},
+		{"actor", audit.Filter{ActorUsername: "normaluser", Limit: audit.DefaultLimit}, []string{"0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e03"}},
+		{"action", audit.Filter{Action: audit.ActionTeamCreate, Limit: audit.DefaultLimit}, []string{"0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e02"}},
+		{"target", audit.Filter{TargetType: audit.TargetTypeRepository, Target: "adminuser/public-image", Limit: audit.DefaultLimit}, []string{"0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e01"}},
+		{"since", audit.Filter{Since: since, Limit: audit.DefaultLimit}, []string{"0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e03", "0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e02"}},
+		{"until", audit.Filter{Until: since, Limit: audit.DefaultLimit}, []string{"0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e02", "0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e01"}},
+		{"limit", audit.Filter{Limit: 1}, []string{"0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e03"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			page, err := s.List(t.Context(), c.filter)
+			if err != nil {
+				t.Errorf("expected err to be nil, got %q", err)
+			}
+
+			ids := make([]string, len(page.Items))
+			for i, e := range page.Items {
+				ids[i] = e.ID.String()
+			}
+
+			if len(ids) != len(c.expected) {
+				t.Fatalf("expected %v, got %v", c.expected, ids)
+			}
+
+			for i := range ids {
+				if ids[i] != c.expected[i] {
+					t.Errorf("expected %v, got %v", c.expected, ids)
+				}
+			}
+		})
+	}
+}
+
+func TestAuditStore_List_Pagination(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewAuditStore(db)
+
+	f := audit.Filter{Limit: 2}
+	first, err := s.List(t.Context(), f)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(first.Items) != 2 || first.Next == "" {
+		t.Fatalf("expected two events and a next page, got %d events and cursor %q", len(first.Items), first.Next)
+	}
+
+	f.Cursor = first.Next
+	second, err := s.List(t.Context(), f)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(second.Items) != 1 || second.Next != "" {
+		t.Fatalf("expected one event and no next page, got %d events and cursor %q", len(second.Items), second.Next)
+	}
+
+	if id := second.Items[0].ID.String(); id != "0199f1d0-1a2b-7c3d-8e4f-5a6b7c8d9e01" {
+		t.Errorf("expected oldest event on the last page, got %q", id)
+	}
+}
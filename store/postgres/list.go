@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/evanebb/regauth/store"
+	"strings"
+)
+
+// listColumns are the columns that a listing is filtered and sorted on. The namespace column is only required for
+// listings that can be sorted on the namespace. If there is no ID column, the name must be unique within the listing.
+type listColumns struct {
+	namespace string
+	name      string
+	createdAt string
+	id        string
+}
+
+// listQuery appends the filtering, keyset pagination, sorting and limit of the list options to the given query, which
+// must end in a WHERE clause with at least one condition. The columns are used to filter and sort on the name,
+// creation date and ID of the items. One more item than the limit is selected, so that store.NewPage can determine
+// whether there is a next page.
+func listQuery(query string, args []any, o store.ListOptions, nameColumn, createdAtColumn, idColumn string) (string, []any, error) {
+	if o.Name != "" {
+		args = append(args, o.Name)
+		query += fmt.Sprintf(" AND strpos(lower(%s), lower($%d)) > 0", nameColumn, len(args))
+	}
+
+	return pageQuery(query, args, o, listColumns{name: nameColumn, createdAt: createdAtColumn, id: idColumn})
+}
+
+// pageQuery appends the keyset pagination, sorting and limit of the list options to the given query, like listQuery,
+// without filtering on the name.
+func pageQuery(query string, args []any, o store.ListOptions, c listColumns) (string, []any, error) {
+	after, ok, err := o.After()
+	if err != nil {
+		return "", nil, err
+	}
+
+	var columns []string
+	var keys []any
+	switch o.Sort {
+	case store.SortByCreatedAt:
+		columns, keys = []string{c.createdAt}, []any{after.CreatedAt}
+	case store.SortByNamespace:
+		columns, keys = []string{c.namespace, c.name}, []any{after.Namespace, after.Name}
+	default:
+		columns, keys = []string{c.name}, []any{after.Name}
+	}
+
+	if c.id != "" {
+		columns, keys = append(columns, c.id), append(keys, after.ID)
+	} else if o.Sort == store.SortByCreatedAt {
+		columns, keys = append(columns, c.name), append(keys, after.Name)
+	}
+
+	comparison, direction := ">", "ASC"
+	if o.Order == store.SortDescending {
+		comparison, direction = "<", "DESC"
+	}
+
+	if ok {
+		placeholders := make([]string, len(keys))
+		for i, key := range keys {
+			args = append(args, key)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+
+		query += fmt.Sprintf(" AND (%s) %s (%s)", strings.Join(columns, ", "), comparison, strings.Join(placeholders, ", "))
+	}
+
+	order := make([]string, len(columns))
+	for i, column := range columns {
+		order[i] = column + " " + direction
+	}
+
+	args = append(args, o.Limit+1)
+	query += fmt.Sprintf(" ORDER BY %s LIMIT $%d", strings.Join(order, ", "), len(args))
+
+	return query, args, nil
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"regexp"
+	"strings"
+)
+
+// patternsToRegexps converts the given patterns, which use the syntax of path.Match, to anchored regular expressions
+// that can be matched with the ~ operator in a query. It never returns nil, so that it can be passed as an array.
+func patternsToRegexps(patterns []string) []string {
+	regexps := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		regexps = append(regexps, patternToRegexp(p))
+	}
+
+	return regexps
+}
+
+// patternToRegexp converts a valid pattern in the syntax of path.Match to an anchored regular expression. Like in
+// path.Match, wildcards do not match the '/' separator, while character classes are copied as-is.
+func patternToRegexp(pattern string) string {
+	var b strings.Builder
+	b.WriteString("^")
+
+	escaped := false
+	// classLength is -1 outside a character class, and the number of characters in it so far inside one
+	classLength := -1
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		case r == '\\':
+			escaped = true
+		case classLength >= 0:
+			// a ']' directly after the start of a class is part of the class, like in path.Match
+			if r == ']' && classLength > 0 {
+				classLength = -1
+			} else if r != '^' || classLength > 0 {
+				classLength++
+			}
+			b.WriteRune(r)
+		case r == '[':
+			classLength = 0
+			b.WriteRune(r)
+		case r == '*':
+			b.WriteString("[^/]*")
+		case r == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	b.WriteString("$")
+	return b.String()
+}
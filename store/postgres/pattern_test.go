@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"path"
+	"regexp"
+	"testing"
+)
+
+func TestPatternToRegexp(t *testing.T) {
+	patterns := []string{"alice/app", "alice/*", "*/*", "alice/app-?", "alice/[ab]pp", "alice/[^a]pp", "alice/a.p", `alice/\*`, "alice/app/*"}
+	names := []string{"alice/app", "alice/bpp", "alice/cpp", "alice/a.p", "alice/axp", "alice/*", "alice/app-1", "alice/app/worker", "bob/app", "alice/"}
+
+	for _, p := range patterns {
+		re := regexp.MustCompile(patternToRegexp(p))
+		for _, name := range names {
+			expected, _ := path.Match(p, name)
+			if actual := re.MatchString(name); actual != expected {
+				t.Errorf("expected pattern %q to match %q: %t, got %t", p, name, expected, actual)
+			}
+		}
+	}
+}
@@ -3,11 +3,14 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/evanebb/regauth/store"
 	"github.com/evanebb/regauth/token"
 	"github.com/evanebb/regauth/util/auth"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
 )
 
 type PersonalAccessTokenStore struct {
@@ -18,36 +21,52 @@ func NewPersonalAccessTokenStore(db *pgxpool.Pool) PersonalAccessTokenStore {
 	return PersonalAccessTokenStore{TransactionStore{db: db}}
 }
 
-func (s PersonalAccessTokenStore) GetAllByUser(ctx context.Context, userID uuid.UUID) ([]token.PersonalAccessToken, error) {
-	var tokens []token.PersonalAccessToken
+func (s PersonalAccessTokenStore) ListByUser(ctx context.Context, userID uuid.UUID, o store.ListOptions) (store.Page[token.PersonalAccessToken], error) {
+	query := "SELECT id, description, permission, repositories, scopes, allowed_cidrs, expiration_date, revoked_at, user_id, created_at FROM personal_access_tokens WHERE user_id = $1"
+	query, args, err := listQuery(query, []any{userID}, o, "description", "created_at", "id")
+	if err != nil {
+		return store.Page[token.PersonalAccessToken]{}, err
+	}
 
-	query := "SELECT id, description, permission, expiration_date, user_id, created_at FROM personal_access_tokens WHERE user_id = $1"
-	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, userID)
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, args...)
 	if err != nil {
-		return tokens, err
+		return store.Page[token.PersonalAccessToken]{}, err
 	}
 
-	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (token.PersonalAccessToken, error) {
+	tokens, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (token.PersonalAccessToken, error) {
 		var t token.PersonalAccessToken
 		var pt string
+		var repositories, scopes, allowedCIDRs []string
+		var revokedAt *time.Time
 
-		err = rows.Scan(&t.ID, &t.Description, &pt, &t.ExpirationDate, &t.UserID, &t.CreatedAt)
+		err = rows.Scan(&t.ID, &t.Description, &pt, &repositories, &scopes, &allowedCIDRs, &t.ExpirationDate, &revokedAt, &t.UserID, &t.CreatedAt)
 		if err != nil {
 			return t, err
 		}
 
 		t.Permission = permissionFromDatabaseMap[pt]
+		t.Repositories = repositoryPatternsFromDatabase(repositories)
+		t.Scopes = scopesFromDatabase(scopes)
+		t.AllowedCIDRs = cidrsFromDatabase(allowedCIDRs)
+		t.RevokedAt = timeFromDatabase(revokedAt)
 
 		return t, t.IsValid()
 	})
+	if err != nil {
+		return store.Page[token.PersonalAccessToken]{}, err
+	}
+
+	return store.NewPage(o, tokens, token.PersonalAccessToken.Position), nil
 }
 
 func (s PersonalAccessTokenStore) GetByID(ctx context.Context, id uuid.UUID) (token.PersonalAccessToken, error) {
 	var t token.PersonalAccessToken
 	var pt string
+	var repositories, scopes, allowedCIDRs []string
+	var revokedAt *time.Time
 
-	query := "SELECT id, description, permission, expiration_date, user_id, created_at FROM personal_access_tokens WHERE id = $1"
-	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, id).Scan(&t.ID, &t.Description, &pt, &t.ExpirationDate, &t.UserID, &t.CreatedAt)
+	query := "SELECT id, description, permission, repositories, scopes, allowed_cidrs, expiration_date, revoked_at, user_id, created_at FROM personal_access_tokens WHERE id = $1"
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, id).Scan(&t.ID, &t.Description, &pt, &repositories, &scopes, &allowedCIDRs, &t.ExpirationDate, &revokedAt, &t.UserID, &t.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return t, token.ErrNotFound
@@ -57,6 +76,10 @@ func (s PersonalAccessTokenStore) GetByID(ctx context.Context, id uuid.UUID) (to
 	}
 
 	t.Permission = permissionFromDatabaseMap[pt]
+	t.Repositories = repositoryPatternsFromDatabase(repositories)
+	t.Scopes = scopesFromDatabase(scopes)
+	t.AllowedCIDRs = cidrsFromDatabase(allowedCIDRs)
+	t.RevokedAt = timeFromDatabase(revokedAt)
 	return t, t.IsValid()
 }
 
@@ -66,9 +89,17 @@ func (s PersonalAccessTokenStore) GetByPlainTextToken(ctx context.Context, plain
 		return token.PersonalAccessToken{}, ErrTokenTooShort
 	}
 
-	// Select all tokens of which the stored last eight characters match the plain-text token
+	// Select all tokens of which the stored last eight characters match the plain-text token, or the previous
+	// plain-text token of a rotated token that has not expired yet
 	lastEight := plainTextToken[len(plainTextToken)-8:]
-	query := "SELECT id, hash, description, permission, expiration_date, user_id, created_at FROM personal_access_tokens WHERE last_eight = $1"
+	query := `
+		SELECT id, hash, description, permission, repositories, scopes, allowed_cidrs, expiration_date, revoked_at, user_id, created_at
+		FROM personal_access_tokens
+		WHERE last_eight = $1
+		UNION ALL
+		SELECT id, previous_hash, description, permission, repositories, scopes, allowed_cidrs, expiration_date, revoked_at, user_id, created_at
+		FROM personal_access_tokens
+		WHERE previous_last_eight = $1 AND previous_expires_at > now()`
 	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, lastEight)
 	if err != nil {
 		return token.PersonalAccessToken{}, err
@@ -78,14 +109,20 @@ func (s PersonalAccessTokenStore) GetByPlainTextToken(ctx context.Context, plain
 	for rows.Next() {
 		var t token.PersonalAccessToken
 		var pt string
+		var repositories, scopes, allowedCIDRs []string
+		var revokedAt *time.Time
 		var hash []byte
 
-		err = rows.Scan(&t.ID, &hash, &t.Description, &pt, &t.ExpirationDate, &t.UserID, &t.CreatedAt)
+		err = rows.Scan(&t.ID, &hash, &t.Description, &pt, &repositories, &scopes, &allowedCIDRs, &t.ExpirationDate, &revokedAt, &t.UserID, &t.CreatedAt)
 		if err != nil {
 			continue
 		}
 
 		t.Permission = permissionFromDatabaseMap[pt]
+		t.Repositories = repositoryPatternsFromDatabase(repositories)
+		t.Scopes = scopesFromDatabase(scopes)
+		t.AllowedCIDRs = cidrsFromDatabase(allowedCIDRs)
+		t.RevokedAt = timeFromDatabase(revokedAt)
 
 		err = t.IsValid()
 		if err != nil {
@@ -112,8 +149,23 @@ func (s PersonalAccessTokenStore) Create(ctx context.Context, t token.PersonalAc
 	lastEight := plainTextToken[len(plainTextToken)-8:]
 	hash := auth.HashTokenWithRandomSalt(plainTextToken)
 
-	query := "INSERT INTO personal_access_tokens (id, hash, last_eight ,description, permission, expiration_date, user_id, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
-	_, err = s.QuerierFromContext(ctx).Exec(ctx, query, t.ID, hash, lastEight, t.Description, permissionToDatabaseMap[t.Permission], t.ExpirationDate, t.UserID, t.CreatedAt)
+	repositories := make([]string, len(t.Repositories))
+	for i, r := range t.Repositories {
+		repositories[i] = string(r)
+	}
+
+	scopes := make([]string, len(t.Scopes))
+	for i, sc := range t.Scopes {
+		scopes[i] = string(sc)
+	}
+
+	allowedCIDRs := make([]string, len(t.AllowedCIDRs))
+	for i, c := range t.AllowedCIDRs {
+		allowedCIDRs[i] = string(c)
+	}
+
+	query := "INSERT INTO personal_access_tokens (id, hash, last_eight ,description, permission, repositories, scopes, allowed_cidrs, expiration_date, user_id, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)"
+	_, err = s.QuerierFromContext(ctx).Exec(ctx, query, t.ID, hash, lastEight, t.Description, permissionToDatabaseMap[t.Permission], repositories, scopes, allowedCIDRs, t.ExpirationDate, t.UserID, t.CreatedAt)
 	return err
 }
 
@@ -123,16 +175,166 @@ func (s PersonalAccessTokenStore) DeleteByID(ctx context.Context, id uuid.UUID)
 	return err
 }
 
-func (s PersonalAccessTokenStore) GetUsageLog(ctx context.Context, tokenID uuid.UUID) ([]token.UsageLogEntry, error) {
-	var log []token.UsageLogEntry
+func (s PersonalAccessTokenStore) Revoke(ctx context.Context, id uuid.UUID, at time.Time) error {
+	query := "UPDATE personal_access_tokens SET revoked_at = COALESCE(revoked_at, $1) WHERE id = $2"
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, at, id)
+	if err != nil {
+		return err
+	}
 
-	query := "SELECT token_id, source_ip, timestamp FROM personal_access_tokens_usage_log WHERE token_id = $1 ORDER BY timestamp DESC"
-	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, tokenID)
+	if tag.RowsAffected() == 0 {
+		return token.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s PersonalAccessTokenStore) Rotate(ctx context.Context, id uuid.UUID, plainTextToken string, previousExpiresAt time.Time) error {
+	lastEight := plainTextToken[len(plainTextToken)-8:]
+	hash := auth.HashTokenWithRandomSalt(plainTextToken)
+
+	// the right-hand side of each assignment refers to the old row, so the current hash becomes the previous hash
+	query := `
+		UPDATE personal_access_tokens
+		SET previous_hash = hash, previous_last_eight = last_eight, previous_expires_at = $1, hash = $2, last_eight = $3
+		WHERE id = $4`
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, previousExpiresAt, hash, lastEight, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return token.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s PersonalAccessTokenStore) GetExpiring(ctx context.Context, from, until time.Time) ([]token.PersonalAccessToken, error) {
+	query := `
+		SELECT id, description, permission, repositories, scopes, allowed_cidrs, expiration_date, revoked_at, user_id, created_at
+		FROM personal_access_tokens
+		WHERE expiration_date > $1 AND expiration_date <= $2 AND revoked_at IS NULL AND expiry_warned_at IS NULL`
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, from, until)
 	if err != nil {
-		return log, err
+		return nil, err
 	}
 
-	return pgx.CollectRows(rows, pgx.RowToStructByPos[token.UsageLogEntry])
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (token.PersonalAccessToken, error) {
+		var t token.PersonalAccessToken
+		var pt string
+		var repositories, scopes, allowedCIDRs []string
+		var revokedAt *time.Time
+
+		err = rows.Scan(&t.ID, &t.Description, &pt, &repositories, &scopes, &allowedCIDRs, &t.ExpirationDate, &revokedAt, &t.UserID, &t.CreatedAt)
+		if err != nil {
+			return t, err
+		}
+
+		t.Permission = permissionFromDatabaseMap[pt]
+		t.Repositories = repositoryPatternsFromDatabase(repositories)
+		t.Scopes = scopesFromDatabase(scopes)
+		t.AllowedCIDRs = cidrsFromDatabase(allowedCIDRs)
+		t.RevokedAt = timeFromDatabase(revokedAt)
+
+		return t, t.IsValid()
+	})
+}
+
+func (s PersonalAccessTokenStore) SetExpiryWarned(ctx context.Context, id uuid.UUID, at time.Time) error {
+	query := "UPDATE personal_access_tokens SET expiry_warned_at = $1 WHERE id = $2"
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, at, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return token.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s PersonalAccessTokenStore) GetExpiryWarningDeliveries(ctx context.Context, id uuid.UUID) ([]string, error) {
+	query := "SELECT notifier FROM personal_access_tokens_expiry_warning_deliveries WHERE token_id = $1"
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+func (s PersonalAccessTokenStore) SetExpiryWarningDelivered(ctx context.Context, id uuid.UUID, notifier string, at time.Time) error {
+	query := `
+		INSERT INTO personal_access_tokens_expiry_warning_deliveries (token_id, notifier, delivered_at)
+		SELECT id, $2, $3 FROM personal_access_tokens WHERE id = $1
+		ON CONFLICT (token_id, notifier) DO NOTHING`
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, id, notifier, at)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		// the warning may have been delivered before, so only report a missing token
+		if _, err := s.GetByID(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s PersonalAccessTokenStore) GetUsageLog(ctx context.Context, tokenID uuid.UUID, f token.UsageLogFilter) (store.Page[token.UsageLogEntry], error) {
+	args := []any{tokenID}
+	query := "SELECT token_id, source_ip, timestamp FROM personal_access_tokens_usage_log WHERE token_id = $1"
+
+	if !f.Since.IsZero() {
+		args = append(args, f.Since)
+		query += fmt.Sprintf(" AND timestamp >= $%d", len(args))
+	}
+	if !f.Until.IsZero() {
+		args = append(args, f.Until)
+		query += fmt.Sprintf(" AND timestamp <= $%d", len(args))
+	}
+
+	o := f.ListOptions()
+	query, args, err := pageQuery(query, args, o, listColumns{name: "host(source_ip)", createdAt: "timestamp"})
+	if err != nil {
+		return store.Page[token.UsageLogEntry]{}, err
+	}
+
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return store.Page[token.UsageLogEntry]{}, err
+	}
+
+	entries, err := pgx.CollectRows(rows, pgx.RowToStructByPos[token.UsageLogEntry])
+	if err != nil {
+		return store.Page[token.UsageLogEntry]{}, err
+	}
+
+	return store.NewPage(o, entries, token.UsageLogEntry.Position), nil
+}
+
+func (s PersonalAccessTokenStore) GetLastUsage(ctx context.Context, tokenIDs ...uuid.UUID) (map[uuid.UUID]token.UsageLogEntry, error) {
+	query := "SELECT DISTINCT ON (token_id) token_id, source_ip, timestamp FROM personal_access_tokens_usage_log WHERE token_id = ANY($1) ORDER BY token_id, timestamp DESC"
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, tokenIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := pgx.CollectRows(rows, pgx.RowToStructByPos[token.UsageLogEntry])
+	if err != nil {
+		return nil, err
+	}
+
+	lastUsage := make(map[uuid.UUID]token.UsageLogEntry, len(entries))
+	for _, e := range entries {
+		lastUsage[e.TokenID] = e
+	}
+
+	return lastUsage, nil
 }
 
 func (s PersonalAccessTokenStore) AddUsageLogEntry(ctx context.Context, e token.UsageLogEntry) error {
@@ -141,6 +343,101 @@ func (s PersonalAccessTokenStore) AddUsageLogEntry(ctx context.Context, e token.
 	return err
 }
 
+func (s PersonalAccessTokenStore) PruneUsageLog(ctx context.Context, before time.Time, maxEntriesPerToken int, rollUp bool) (int64, error) {
+	var beforeArg *time.Time
+	if !before.IsZero() {
+		beforeArg = &before
+	}
+
+	// the removed entries are rolled up in the same statement, so they can never be removed without being rolled up
+	query := `
+		WITH numbered AS (
+			SELECT id, timestamp, row_number() OVER (PARTITION BY token_id ORDER BY timestamp DESC, id DESC) AS n
+			FROM personal_access_tokens_usage_log
+		), removed AS (
+			DELETE FROM personal_access_tokens_usage_log
+			WHERE id IN (
+				SELECT id FROM numbered
+				WHERE ($1::timestamptz IS NOT NULL AND timestamp < $1) OR ($2::integer > 0 AND n > $2)
+			)
+			RETURNING token_id, source_ip, timestamp
+		), rolled_up AS (
+			INSERT INTO personal_access_tokens_usage_rollups (token_id, day, source_ip, count)
+			SELECT token_id, (timestamp AT TIME ZONE 'UTC')::date, source_ip, count(*) FROM removed WHERE $3::boolean
+			GROUP BY 1, 2, 3
+			ON CONFLICT (token_id, day, source_ip) DO UPDATE SET count = personal_access_tokens_usage_rollups.count + excluded.count
+		)
+		SELECT count(*) FROM removed`
+
+	var removed int64
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, beforeArg, maxEntriesPerToken, rollUp).Scan(&removed)
+	return removed, err
+}
+
+func (s PersonalAccessTokenStore) GetUsageRollups(ctx context.Context, tokenID uuid.UUID) ([]token.UsageRollup, error) {
+	query := "SELECT token_id, day, source_ip, count FROM personal_access_tokens_usage_rollups WHERE token_id = $1 ORDER BY day DESC, source_ip"
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[token.UsageRollup])
+}
+
+// repositoryPatternsFromDatabase converts the stored repository patterns of a token. An empty list is returned as nil,
+// meaning that the token is not restricted to any repositories.
+func repositoryPatternsFromDatabase(repositories []string) []token.RepositoryPattern {
+	if len(repositories) == 0 {
+		return nil
+	}
+
+	patterns := make([]token.RepositoryPattern, len(repositories))
+	for i, r := range repositories {
+		patterns[i] = token.RepositoryPattern(r)
+	}
+
+	return patterns
+}
+
+// scopesFromDatabase converts the stored scopes of a token. An empty list is returned as nil, meaning that the token
+// cannot be used for the API.
+func scopesFromDatabase(scopes []string) []token.Scope {
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	converted := make([]token.Scope, len(scopes))
+	for i, sc := range scopes {
+		converted[i] = token.Scope(sc)
+	}
+
+	return converted
+}
+
+// cidrsFromDatabase converts the stored allowed CIDR ranges of a token. An empty list is returned as nil, meaning that
+// the token can be used from any address.
+func cidrsFromDatabase(cidrs []string) []token.CIDR {
+	if len(cidrs) == 0 {
+		return nil
+	}
+
+	converted := make([]token.CIDR, len(cidrs))
+	for i, c := range cidrs {
+		converted[i] = token.CIDR(c)
+	}
+
+	return converted
+}
+
+// timeFromDatabase converts a nullable timestamp, returning the zero time for NULL.
+func timeFromDatabase(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
+
 var permissionFromDatabaseMap = map[string]token.Permission{
 	"read_only":         token.PermissionReadOnly,
 	"read_write":        token.PermissionReadWrite,
@@ -2,9 +2,11 @@ package postgres
 
 import (
 	"errors"
+	"github.com/evanebb/regauth/store"
 	"github.com/evanebb/regauth/token"
 	"github.com/google/uuid"
 	"net"
+	"slices"
 	"testing"
 	"time"
 )
@@ -15,25 +17,43 @@ func compareTokens(t1 token.PersonalAccessToken, t2 token.PersonalAccessToken) b
 	return t1.ID == t2.ID &&
 		t1.Description == t2.Description &&
 		t1.Permission == t2.Permission &&
+		slices.Equal(t1.Repositories, t2.Repositories) &&
+		slices.Equal(t1.Scopes, t2.Scopes) &&
+		slices.Equal(t1.AllowedCIDRs, t2.AllowedCIDRs) &&
 		t1.ExpirationDate.Equal(t2.ExpirationDate) &&
+		t1.RevokedAt.Equal(t2.RevokedAt) &&
 		t1.UserID == t2.UserID &&
 		t1.CreatedAt.Equal(t2.CreatedAt)
 }
 
-func TestPersonalAccessTokenStore_GetAllByUser(t *testing.T) {
+func TestPersonalAccessTokenStore_ListByUser(t *testing.T) {
 	db := getDatabaseConnection(t)
 	s := NewPersonalAccessTokenStore(db)
 
 	userID, _ := uuid.Parse("0195cd11-2863-71d4-a3c4-032bc264cf81")
 
-	tokens, err := s.GetAllByUser(t.Context(), userID)
+	o := store.DefaultListOptions()
+	o.Limit = 2
+	o.Sort = store.SortByCreatedAt
+
+	page, err := s.ListByUser(t.Context(), userID, o)
 	if err != nil {
-		t.Errorf("expected err to be nil, got %q", err)
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(page.Items) != 2 || page.Next == "" {
+		t.Fatalf("expected two tokens and a next page, got %d tokens and cursor %q", len(page.Items), page.Next)
+	}
+
+	o.Cursor = page.Next
+	page, err = s.ListByUser(t.Context(), userID, o)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
 	}
 
-	// the seeds create three personal access tokens for this user, so we expect there to be three
-	if len(tokens) != 3 {
-		t.Errorf("expected three tokens, got %d", len(tokens))
+	// the seeds create three personal access tokens for this user, so we expect the last one on the second page
+	if len(page.Items) != 1 || page.Next != "" {
+		t.Errorf("expected one token and no next page, got %d tokens and cursor %q", len(page.Items), page.Next)
 	}
 }
 
@@ -51,6 +71,7 @@ func TestPersonalAccessTokenStore_GetByID(t *testing.T) {
 			ID:             tokenID,
 			Description:    "Read-only token",
 			Permission:     token.PermissionReadOnly,
+			Scopes:         []token.Scope{token.ScopeRepositoriesRead, token.ScopeTokensRead},
 			ExpirationDate: expirationDate,
 			UserID:         userID,
 			CreatedAt:      createdAt,
@@ -87,6 +108,7 @@ func TestPersonalAccessTokenStore_GetByPlainTextToken(t *testing.T) {
 			ID:             tokenID,
 			Description:    "Read-only token",
 			Permission:     token.PermissionReadOnly,
+			Scopes:         []token.Scope{token.ScopeRepositoriesRead, token.ScopeTokensRead},
 			ExpirationDate: expirationDate,
 			UserID:         userID,
 			CreatedAt:      createdAt,
@@ -143,6 +165,214 @@ func TestPersonalAccessTokenStore_Create(t *testing.T) {
 			t.Errorf("expected %q, got %q", token.ErrAlreadyExists, err)
 		}
 	})
+
+	t.Run("token restricted to repositories", func(t *testing.T) {
+		restricted := token.PersonalAccessToken{
+			ID:             uuid.New(),
+			Description:    "Restricted token",
+			Permission:     token.PermissionReadWrite,
+			Repositories:   []token.RepositoryPattern{"adminuser/public-image", "team-1/*"},
+			ExpirationDate: expirationDate,
+			UserID:         userID,
+			CreatedAt:      time.Now().Truncate(time.Microsecond),
+		}
+
+		if err := s.Create(t.Context(), restricted, "registry_pat_restricted"); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		saved, err := s.GetByID(t.Context(), restricted.ID)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareTokens(restricted, saved) {
+			t.Errorf("expected %+v, got %+v", restricted, saved)
+		}
+	})
+
+	t.Run("token with scopes", func(t *testing.T) {
+		scoped := token.PersonalAccessToken{
+			ID:             uuid.New(),
+			Description:    "Scoped token",
+			Permission:     token.PermissionReadOnly,
+			Scopes:         []token.Scope{token.ScopeRepositoriesRead, token.ScopeTeamsAdmin},
+			ExpirationDate: expirationDate,
+			UserID:         userID,
+			CreatedAt:      time.Now().Truncate(time.Microsecond),
+		}
+
+		if err := s.Create(t.Context(), scoped, "registry_pat_scoped"); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		saved, err := s.GetByID(t.Context(), scoped.ID)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareTokens(scoped, saved) {
+			t.Errorf("expected %+v, got %+v", scoped, saved)
+		}
+	})
+
+	t.Run("token with allowed CIDRs", func(t *testing.T) {
+		restricted := token.PersonalAccessToken{
+			ID:             uuid.New(),
+			Description:    "Source restricted token",
+			Permission:     token.PermissionReadOnly,
+			AllowedCIDRs:   []token.CIDR{"10.0.0.0/8", "2001:db8::/32"},
+			ExpirationDate: expirationDate,
+			UserID:         userID,
+			CreatedAt:      time.Now().Truncate(time.Microsecond),
+		}
+
+		if err := s.Create(t.Context(), restricted, "registry_pat_cidrs"); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		saved, err := s.GetByID(t.Context(), restricted.ID)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareTokens(restricted, saved) {
+			t.Errorf("expected %+v, got %+v", restricted, saved)
+		}
+	})
+}
+
+func TestPersonalAccessTokenStore_Revoke(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewPersonalAccessTokenStore(db)
+
+	tokenID, _ := uuid.Parse("0195cd16-2142-78e5-8425-a8db7acbc8f8")
+	revokedAt := time.Now().Truncate(time.Microsecond)
+
+	if err := s.Revoke(t.Context(), tokenID, revokedAt); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	// revoking the token again keeps the original time
+	if err := s.Revoke(t.Context(), tokenID, revokedAt.Add(time.Hour)); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	tok, err := s.GetByID(t.Context(), tokenID)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if !tok.RevokedAt.Equal(revokedAt) {
+		t.Errorf("expected token to be revoked at %s, got %s", revokedAt, tok.RevokedAt)
+	}
+
+	// the usage log of the token is kept
+	usageLog, err := s.GetUsageLog(t.Context(), tokenID, token.UsageLogFilter{Limit: token.DefaultUsageLogLimit})
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(usageLog.Items) != 3 {
+		t.Errorf("expected three usage log entries, got %d", len(usageLog.Items))
+	}
+
+	if err := s.Revoke(t.Context(), uuid.Nil, revokedAt); !errors.Is(err, token.ErrNotFound) {
+		t.Errorf("expected %q, got %q", token.ErrNotFound, err)
+	}
+}
+
+func TestPersonalAccessTokenStore_Rotate(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewPersonalAccessTokenStore(db)
+
+	tokenID, _ := uuid.Parse("0195cd16-2142-78e5-8425-a8db7acbc8f8")
+	originalToken := "registry_pat_SVV_otfQNmSjo7viDiCrC0AKe6Qa_iFhxXJBZE1vMOByC9nbUtBPsz3r"
+	rotatedToken := "registry_pat_7mvm1Gx0sNqGAYpvDVKqBKwDd5HCuwpJ8aSkeFkAcxjVMfb-qdBZUfJS"
+	rotatedAgainToken := "registry_pat_kBNq4tdHhCsH1ZCH3M3Mv_aXjFzDaHjqz0BvUaQd60gmcYmS8KJj6Z3P"
+
+	if err := s.Rotate(t.Context(), tokenID, rotatedToken, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	// both the rotated token and the original token work during the grace period
+	for _, plainTextToken := range []string{rotatedToken, originalToken} {
+		tok, err := s.GetByPlainTextToken(t.Context(), plainTextToken)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if tok.ID != tokenID {
+			t.Errorf("expected token %s, got %s", tokenID, tok.ID)
+		}
+	}
+
+	// rotating the token again without a grace period replaces the original token
+	if err := s.Rotate(t.Context(), tokenID, rotatedAgainToken, time.Now()); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if _, err := s.GetByPlainTextToken(t.Context(), rotatedAgainToken); err != nil {
+		t.Errorf("expected err to be nil, got %q", err)
+	}
+
+	for _, plainTextToken := range []string{rotatedToken, originalToken} {
+		if _, err := s.GetByPlainTextToken(t.Context(), plainTextToken); !errors.Is(err, token.ErrNotFound) {
+			t.Errorf("expected %q, got %q", token.ErrNotFound, err)
+		}
+	}
+
+	if err := s.Rotate(t.Context(), uuid.Nil, rotatedToken, time.Now()); !errors.Is(err, token.ErrNotFound) {
+		t.Errorf("expected %q, got %q", token.ErrNotFound, err)
+	}
+}
+
+func TestPersonalAccessTokenStore_GetExpiring(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewPersonalAccessTokenStore(db)
+
+	userID, _ := uuid.Parse("0195cd11-2863-71d4-a3c4-032bc264cf81")
+	now := time.Now()
+
+	expiring := token.PersonalAccessToken{
+		ID:             uuid.New(),
+		Description:    "expiring",
+		Permission:     token.PermissionReadOnly,
+		ExpirationDate: now.Add(time.Hour),
+		UserID:         userID,
+		CreatedAt:      now,
+	}
+
+	if err := s.Create(t.Context(), expiring, "registry_pat_2yq1B6pUzqGfyS0jz6W0Xl3dVvQ2sF1vX8aLh0nNQmYtB3kRrPc5JeWo"); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	tokens, err := s.GetExpiring(t.Context(), now, now.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	// the seeded tokens expire much later, so only the new token is expiring
+	if len(tokens) != 1 || tokens[0].ID != expiring.ID {
+		t.Fatalf("expected only token %s, got %+v", expiring.ID, tokens)
+	}
+
+	if err := s.SetExpiryWarned(t.Context(), expiring.ID, now); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	tokens, err = s.GetExpiring(t.Context(), now, now.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(tokens) != 0 {
+		t.Errorf("expected no tokens after warning, got %d", len(tokens))
+	}
+
+	if err := s.SetExpiryWarned(t.Context(), uuid.Nil, now); !errors.Is(err, token.ErrNotFound) {
+		t.Errorf("expected %q, got %q", token.ErrNotFound, err)
+	}
 }
 
 func TestPersonalAccessTokenStore_DeleteByID(t *testing.T) {
@@ -169,14 +399,79 @@ func TestPersonalAccessTokenStore_GetUsageLog(t *testing.T) {
 	s := NewPersonalAccessTokenStore(db)
 
 	tokenID, _ := uuid.Parse("0195cd16-2142-78e5-8425-a8db7acbc8f8")
+	since, _ := time.Parse(time.RFC3339, "2045-03-27T12:16:33.110405Z")
+
+	cases := []struct {
+		desc     string
+		filter   token.UsageLogFilter
+		expected []string
+	}{
+		{"all entries, newest first", token.UsageLogFilter{Limit: token.DefaultUsageLogLimit}, []string{"192.168.1.12", "192.168.1.11", "192.168.1.10"}},
+		{"since", token.UsageLogFilter{Since: since, Limit: token.DefaultUsageLogLimit}, []string{"192.168.1.12", "192.168.1.11"}},
+		{"until", token.UsageLogFilter{Until: since, Limit: token.DefaultUsageLogLimit}, []string{"192.168.1.11", "192.168.1.10"}},
+		{"limit", token.UsageLogFilter{Limit: 1}, []string{"192.168.1.12"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			page, err := s.GetUsageLog(t.Context(), tokenID, c.filter)
+			if err != nil {
+				t.Errorf("expected nil, got %q", err)
+			}
+
+			if len(page.Items) != len(c.expected) {
+				t.Fatalf("expected %d log entries, got %d", len(c.expected), len(page.Items))
+			}
+
+			for i, e := range page.Items {
+				if e.SourceIP.String() != c.expected[i] {
+					t.Errorf("expected %q, got %q", c.expected[i], e.SourceIP)
+				}
+			}
+		})
+	}
+
+	t.Run("next page", func(t *testing.T) {
+		f := token.UsageLogFilter{Limit: 2}
+		first, err := s.GetUsageLog(t.Context(), tokenID, f)
+		if err != nil {
+			t.Fatalf("expected nil, got %q", err)
+		}
+
+		if first.Next == "" {
+			t.Fatal("expected a next page")
+		}
+
+		f.Cursor = first.Next
+		second, err := s.GetUsageLog(t.Context(), tokenID, f)
+		if err != nil {
+			t.Fatalf("expected nil, got %q", err)
+		}
+
+		if len(second.Items) != 1 || second.Items[0].SourceIP.String() != "192.168.1.10" || second.Next != "" {
+			t.Errorf("expected only the oldest entry on the last page, got %+v", second)
+		}
+	})
+}
+
+func TestPersonalAccessTokenStore_GetLastUsage(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewPersonalAccessTokenStore(db)
 
-	entries, err := s.GetUsageLog(t.Context(), tokenID)
+	usedTokenID, _ := uuid.Parse("0195cd16-2142-78e5-8425-a8db7acbc8f8")
+	unusedTokenID, _ := uuid.Parse("0195cd16-2142-790c-8835-6d3430abb642")
+
+	lastUsage, err := s.GetLastUsage(t.Context(), usedTokenID, unusedTokenID)
 	if err != nil {
 		t.Errorf("expected nil, got %q", err)
 	}
 
-	if len(entries) != 3 {
-		t.Errorf("expected three log entries, got %d", len(entries))
+	if len(lastUsage) != 1 {
+		t.Errorf("expected one entry, got %d", len(lastUsage))
+	}
+
+	if e := lastUsage[usedTokenID]; e.SourceIP.String() != "192.168.1.12" {
+		t.Errorf("expected last usage from %q, got %q", "192.168.1.12", e.SourceIP)
 	}
 }
 
@@ -195,12 +490,131 @@ func TestPersonalAccessTokenStore_AddUsageLogEntry(t *testing.T) {
 		t.Errorf("expected nil, got %q", err)
 	}
 
-	entries, err := s.GetUsageLog(t.Context(), tokenID)
+	page, err := s.GetUsageLog(t.Context(), tokenID, token.UsageLogFilter{Limit: token.DefaultUsageLogLimit})
 	if err != nil {
 		t.Errorf("expected nil, got %q", err)
 	}
 
-	if len(entries) != 4 {
-		t.Errorf("expected four log entries, got %d", len(entries))
+	if len(page.Items) != 4 {
+		t.Errorf("expected four log entries, got %d", len(page.Items))
+	}
+}
+
+func TestPersonalAccessTokenStore_PruneUsageLog(t *testing.T) {
+	tokenID, _ := uuid.Parse("0195cd16-2142-78e5-8425-a8db7acbc8f8")
+	before, _ := time.Parse(time.RFC3339, "2045-03-27T12:16:33.110405Z")
+
+	t.Run("max age", func(t *testing.T) {
+		db := getDatabaseConnection(t)
+		s := NewPersonalAccessTokenStore(db)
+
+		removed, err := s.PruneUsageLog(t.Context(), before, 0, false)
+		if err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		if removed != 1 {
+			t.Errorf("expected one removed entry, got %d", removed)
+		}
+
+		rollups, err := s.GetUsageRollups(t.Context(), tokenID)
+		if err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		if len(rollups) != 0 {
+			t.Errorf("expected no rollups, got %d", len(rollups))
+		}
+	})
+
+	t.Run("max entries per token with roll up", func(t *testing.T) {
+		db := getDatabaseConnection(t)
+		s := NewPersonalAccessTokenStore(db)
+
+		removed, err := s.PruneUsageLog(t.Context(), time.Time{}, 1, true)
+		if err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		if removed != 2 {
+			t.Errorf("expected two removed entries, got %d", removed)
+		}
+
+		page, err := s.GetUsageLog(t.Context(), tokenID, token.UsageLogFilter{Limit: token.DefaultUsageLogLimit})
+		if err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		if len(page.Items) != 1 || page.Items[0].SourceIP.String() != "192.168.1.12" {
+			t.Errorf("expected only the newest entry to be kept, got %+v", page.Items)
+		}
+
+		rollups, err := s.GetUsageRollups(t.Context(), tokenID)
+		if err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		if len(rollups) != 2 {
+			t.Errorf("expected two rollups, got %d", len(rollups))
+		}
+
+		for _, r := range rollups {
+			if r.Count != 1 {
+				t.Errorf("expected rollup count to be 1, got %d", r.Count)
+			}
+		}
+	})
+}
+
+func TestPersonalAccessTokenStore_ExpiryWarningDeliveries(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewPersonalAccessTokenStore(db)
+
+	userID, _ := uuid.Parse("0195cd11-2863-71d4-a3c4-032bc264cf81")
+	now := time.Now()
+
+	pat := token.PersonalAccessToken{
+		ID:             uuid.New(),
+		Description:    "warned",
+		Permission:     token.PermissionReadOnly,
+		ExpirationDate: now.Add(time.Hour),
+		UserID:         userID,
+		CreatedAt:      now,
+	}
+
+	if err := s.Create(t.Context(), pat, "registry_pat_Vq8Jr2uK0sLmN4bXcT7yHw1eZp3aG6dFiO9kQ5nRvB2xMjLh8sYtCw4E"); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	tokenID := pat.ID
+
+	delivered, err := s.GetExpiryWarningDeliveries(t.Context(), tokenID)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(delivered) != 0 {
+		t.Fatalf("expected no deliveries, got %v", delivered)
+	}
+
+	// recording the same delivery twice is allowed
+	for _, notifier := range []string{"smtp", "webhook", "smtp"} {
+		if err := s.SetExpiryWarningDelivered(t.Context(), tokenID, notifier, now); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+	}
+
+	delivered, err = s.GetExpiryWarningDeliveries(t.Context(), tokenID)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	slices.Sort(delivered)
+	if !slices.Equal(delivered, []string{"smtp", "webhook"}) {
+		t.Errorf("expected deliveries %v, got %v", []string{"smtp", "webhook"}, delivered)
+	}
+
+	if err := s.SetExpiryWarningDelivered(t.Context(), uuid.Nil, "smtp", now); !errors.Is(err, token.ErrNotFound) {
+		t.Errorf("expected %q, got %q", token.ErrNotFound, err)
 	}
 }
@@ -3,10 +3,13 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/store"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"strings"
 )
 
 type RepositoryStore struct {
@@ -17,8 +20,10 @@ func NewRepositoryStore(db *pgxpool.Pool) RepositoryStore {
 	return RepositoryStore{TransactionStore{db: db}}
 }
 
-func (s RepositoryStore) GetAllByNamespace(ctx context.Context, namespaces ...string) ([]repository.Repository, error) {
-	var repositories []repository.Repository
+func (s RepositoryStore) ListByNamespace(ctx context.Context, o store.ListOptions, selector repository.LabelSelector, patterns []string, namespaces ...string) (store.Page[repository.Repository], error) {
+	if selector == nil {
+		selector = make(repository.LabelSelector)
+	}
 
 	query := `
 		SELECT
@@ -26,26 +31,135 @@ func (s RepositoryStore) GetAllByNamespace(ctx context.Context, namespaces ...st
 			namespaces.name as namespace,
 			repositories.name,
 			repositories.visibility,
+			repositories.description,
+			repositories.labels,
+			COALESCE(repositories.created_by, '00000000-0000-0000-0000-000000000000'),
 			repositories.created_at
 		FROM repositories
 		JOIN namespaces ON repositories.namespace_id = namespaces.id
-		WHERE namespaces.name = ANY($1)
+		WHERE namespaces.name = ANY($1) AND repositories.labels @> $2
+		AND (cardinality($3::text[]) = 0 OR (namespaces.name || '/' || repositories.name) ~ ANY($3))
 		`
-	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, namespaces)
+	query, args, err := listQuery(query, []any{namespaces, selector, patternsToRegexps(patterns)}, o, "repositories.name", "repositories.created_at", "repositories.id")
+	if err != nil {
+		return store.Page[repository.Repository]{}, err
+	}
+
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, args...)
 	if err != nil {
-		return repositories, err
+		return store.Page[repository.Repository]{}, err
 	}
 
-	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (repository.Repository, error) {
+	repositories, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (repository.Repository, error) {
 		var r repository.Repository
 
-		err = rows.Scan(&r.ID, &r.Namespace, &r.Name, &r.Visibility, &r.CreatedAt)
+		err = rows.Scan(&r.ID, &r.Namespace, &r.Name, &r.Visibility, &r.Description, &r.Labels, &r.CreatedBy, &r.CreatedAt)
 		if err != nil {
 			return r, err
 		}
 
 		return r, r.IsValid()
 	})
+	if err != nil {
+		return store.Page[repository.Repository]{}, err
+	}
+
+	return store.NewPage(o, repositories, repository.Repository.Position), nil
+}
+
+func (s RepositoryStore) Search(ctx context.Context, f repository.SearchFilter, a repository.SearchAccess) (store.Page[repository.Repository], error) {
+	teamIDs := a.TeamIDs
+	if teamIDs == nil {
+		teamIDs = []uuid.UUID{}
+	}
+
+	namespaces := a.Namespaces
+	if namespaces == nil {
+		namespaces = []string{}
+	}
+
+	// every collaborator permission includes pull access, so any collaborator entry for the caller or their teams counts
+	args := []any{namespaces, a.UserID, teamIDs, patternsToRegexps(a.Repositories)}
+	conditions := []string{`(
+			repositories.visibility = 'public'
+			OR (
+				(
+					namespaces.name = ANY($1)
+					OR EXISTS (
+						SELECT 1 FROM repository_collaborators
+						WHERE repository_collaborators.repository_id = repositories.id
+						AND (repository_collaborators.user_id = $2 OR repository_collaborators.team_id = ANY($3))
+					)
+				)
+				AND (cardinality($4::text[]) = 0 OR (namespaces.name || '/' || repositories.name) ~ ANY($4))
+			)
+		)`}
+
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if f.Query != "" {
+		addCondition("strpos(lower(repositories.name), lower($%d)) > 0", f.Query)
+	}
+	if f.Namespace != "" {
+		addCondition("namespaces.name = $%d", f.Namespace)
+	}
+	if len(f.Labels) > 0 {
+		addCondition("repositories.labels @> $%d", f.Labels)
+	}
+	if f.After != "" {
+		namespace, name, _ := strings.Cut(f.After, "/")
+		args = append(args, namespace, name)
+		conditions = append(conditions, fmt.Sprintf("(namespaces.name, repositories.name) > ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	query := `
+		SELECT
+			repositories.id,
+			namespaces.name as namespace,
+			repositories.name,
+			repositories.visibility,
+			repositories.description,
+			repositories.labels,
+			COALESCE(repositories.created_by, '00000000-0000-0000-0000-000000000000'),
+			repositories.created_at
+		FROM repositories
+		JOIN namespaces ON repositories.namespace_id = namespaces.id
+		WHERE ` + strings.Join(conditions, " AND ")
+
+	o := f.ListOptions()
+	query, args, err := pageQuery(query, args, o, listColumns{
+		namespace: "namespaces.name",
+		name:      "repositories.name",
+		createdAt: "repositories.created_at",
+		id:        "repositories.id",
+	})
+	if err != nil {
+		return store.Page[repository.Repository]{}, err
+	}
+
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return store.Page[repository.Repository]{}, err
+	}
+
+	repositories, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (repository.Repository, error) {
+		var r repository.Repository
+
+		err := row.Scan(&r.ID, &r.Namespace, &r.Name, &r.Visibility, &r.Description, &r.Labels, &r.CreatedBy, &r.CreatedAt)
+		if err != nil {
+			return r, err
+		}
+
+		return r, r.IsValid()
+	})
+	if err != nil {
+		return store.Page[repository.Repository]{}, err
+	}
+
+	return store.NewPage(o, repositories, repository.Repository.Position), nil
 }
 
 func (s RepositoryStore) GetByNamespaceAndName(ctx context.Context, namespace string, name string) (repository.Repository, error) {
@@ -57,12 +171,16 @@ func (s RepositoryStore) GetByNamespaceAndName(ctx context.Context, namespace st
 			namespaces.name as namespace,
 			repositories.name,
 			repositories.visibility,
+			repositories.description,
+			repositories.labels,
+			repositories.readme,
+			COALESCE(repositories.created_by, '00000000-0000-0000-0000-000000000000'),
 			repositories.created_at
 		FROM repositories
 		JOIN namespaces ON repositories.namespace_id = namespaces.id
 		WHERE namespaces.name = $1 AND repositories.name = $2
 		`
-	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, namespace, name).Scan(&r.ID, &r.Namespace, &r.Name, &r.Visibility, &r.CreatedAt)
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, namespace, name).Scan(&r.ID, &r.Namespace, &r.Name, &r.Visibility, &r.Description, &r.Labels, &r.Readme, &r.CreatedBy, &r.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return r, repository.ErrNotFound
@@ -83,12 +201,16 @@ func (s RepositoryStore) GetByID(ctx context.Context, id uuid.UUID) (repository.
 			namespaces.name as namespace,
 			repositories.name,
 			repositories.visibility,
+			repositories.description,
+			repositories.labels,
+			repositories.readme,
+			COALESCE(repositories.created_by, '00000000-0000-0000-0000-000000000000'),
 			repositories.created_at
 		FROM repositories
 		JOIN namespaces ON repositories.namespace_id = namespaces.id
 		WHERE repositories.id = $1
 		`
-	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, id).Scan(&r.ID, &r.Namespace, &r.Name, &r.Visibility, &r.CreatedAt)
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, id).Scan(&r.ID, &r.Namespace, &r.Name, &r.Visibility, &r.Description, &r.Labels, &r.Readme, &r.CreatedBy, &r.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return r, repository.ErrNotFound
@@ -110,18 +232,421 @@ func (s RepositoryStore) Create(ctx context.Context, r repository.Repository) er
 	}
 
 	query := `
-		INSERT INTO repositories (id, namespace_id, name, visibility, created_at)
-		SELECT $1, id, $2, $3, $4
+		INSERT INTO repositories (id, namespace_id, name, visibility, description, labels, readme, created_by, created_at)
+		SELECT $1, id, $2, $3, $4, $5, $6, NULLIF($7, '00000000-0000-0000-0000-000000000000'::uuid), $8
 		FROM namespaces
-		WHERE name = $5
+		WHERE name = $9
 		`
 
-	_, err = s.QuerierFromContext(ctx).Exec(ctx, query, r.ID, r.Name, r.Visibility, r.CreatedAt, r.Namespace)
+	_, err = s.QuerierFromContext(ctx).Exec(ctx, query, r.ID, r.Name, r.Visibility, r.Description, labelsOrEmpty(r.Labels), r.Readme, r.CreatedBy, r.CreatedAt, r.Namespace)
 	return err
 }
 
+func (s RepositoryStore) Update(ctx context.Context, r repository.Repository) error {
+	existing, err := s.GetByNamespaceAndName(ctx, r.Namespace, string(r.Name))
+	if err == nil && existing.ID != r.ID {
+		return repository.ErrAlreadyExists
+	}
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+
+	query := `
+		UPDATE repositories
+		SET namespace_id = namespaces.id, name = $2, visibility = $3, description = $4, labels = $5, readme = $6
+		FROM namespaces
+		WHERE repositories.id = $1 AND namespaces.name = $7
+		`
+
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, r.ID, r.Name, r.Visibility, r.Description, labelsOrEmpty(r.Labels), r.Readme, r.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// labelsOrEmpty returns an empty set of labels instead of nil, so they are stored as an empty JSON object.
+func labelsOrEmpty(l repository.Labels) repository.Labels {
+	if l == nil {
+		return make(repository.Labels)
+	}
+
+	return l
+}
+
 func (s RepositoryStore) DeleteByID(ctx context.Context, id uuid.UUID) error {
 	query := "DELETE FROM repositories WHERE id = $1"
 	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, id)
 	return err
 }
+
+func (s RepositoryStore) GetTags(ctx context.Context, repositoryID uuid.UUID) ([]repository.Tag, error) {
+	var tags []repository.Tag
+
+	query := `
+		SELECT repository_id, name, digest, media_type, size, pushed_at
+		FROM repository_tags
+		WHERE repository_id = $1
+		ORDER BY name
+		`
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, repositoryID)
+	if err != nil {
+		return tags, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (repository.Tag, error) {
+		var t repository.Tag
+
+		err = rows.Scan(&t.RepositoryID, &t.Name, &t.Digest, &t.MediaType, &t.Size, &t.PushedAt)
+		if err != nil {
+			return t, err
+		}
+
+		return t, t.IsValid()
+	})
+}
+
+func (s RepositoryStore) ListTags(ctx context.Context, repositoryID uuid.UUID, o store.ListOptions) (store.Page[repository.Tag], error) {
+	query := "SELECT repository_id, name, digest, media_type, size, pushed_at FROM repository_tags WHERE repository_id = $1"
+	query, args, err := listQuery(query, []any{repositoryID}, o, "name", "pushed_at", "")
+	if err != nil {
+		return store.Page[repository.Tag]{}, err
+	}
+
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return store.Page[repository.Tag]{}, err
+	}
+
+	tags, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (repository.Tag, error) {
+		var t repository.Tag
+
+		err := row.Scan(&t.RepositoryID, &t.Name, &t.Digest, &t.MediaType, &t.Size, &t.PushedAt)
+		if err != nil {
+			return t, err
+		}
+
+		return t, t.IsValid()
+	})
+	if err != nil {
+		return store.Page[repository.Tag]{}, err
+	}
+
+	return store.NewPage(o, tags, repository.Tag.Position), nil
+}
+
+func (s RepositoryStore) GetTag(ctx context.Context, repositoryID uuid.UUID, name string) (repository.Tag, error) {
+	var t repository.Tag
+
+	query := `
+		SELECT repository_id, name, digest, media_type, size, pushed_at
+		FROM repository_tags
+		WHERE repository_id = $1 AND name = $2
+		`
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, repositoryID, name).Scan(&t.RepositoryID, &t.Name, &t.Digest, &t.MediaType, &t.Size, &t.PushedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return t, repository.ErrTagNotFound
+		}
+
+		return t, err
+	}
+
+	return t, t.IsValid()
+}
+
+func (s RepositoryStore) SaveTag(ctx context.Context, t repository.Tag) error {
+	query := `
+		INSERT INTO repository_tags (repository_id, name, digest, media_type, size, pushed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (repository_id, name) DO UPDATE
+		SET digest = excluded.digest, media_type = excluded.media_type, size = excluded.size, pushed_at = excluded.pushed_at
+		`
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, t.RepositoryID, t.Name, t.Digest, t.MediaType, t.Size, t.PushedAt)
+	return err
+}
+
+func (s RepositoryStore) DeleteTag(ctx context.Context, repositoryID uuid.UUID, name string) error {
+	query := "DELETE FROM repository_tags WHERE repository_id = $1 AND name = $2"
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, repositoryID, name)
+	return err
+}
+
+func (s RepositoryStore) DeleteTagsByDigest(ctx context.Context, repositoryID uuid.UUID, digest string) error {
+	query := "DELETE FROM repository_tags WHERE repository_id = $1 AND digest = $2"
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, repositoryID, digest)
+	return err
+}
+
+// collaboratorSelectQuery selects the collaborators of repositories, resolving the user or team that each collaborator
+// refers to.
+const collaboratorSelectQuery = `
+	SELECT
+		repository_collaborators.repository_id,
+		CASE WHEN repository_collaborators.user_id IS NOT NULL THEN 'user' ELSE 'team' END AS type,
+		COALESCE(repository_collaborators.user_id, repository_collaborators.team_id) AS subject_id,
+		COALESCE(users.username, teams.name) AS subject_name,
+		repository_collaborators.permission,
+		repository_collaborators.created_at
+	FROM repository_collaborators
+	LEFT JOIN users ON repository_collaborators.user_id = users.id
+	LEFT JOIN teams ON repository_collaborators.team_id = teams.id
+	`
+
+func (s RepositoryStore) GetCollaborators(ctx context.Context, repositoryID uuid.UUID) ([]repository.Collaborator, error) {
+	var collaborators []repository.Collaborator
+
+	query := collaboratorSelectQuery + "WHERE repository_collaborators.repository_id = $1 ORDER BY subject_name"
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, repositoryID)
+	if err != nil {
+		return collaborators, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (repository.Collaborator, error) {
+		var c repository.Collaborator
+
+		err = rows.Scan(&c.RepositoryID, &c.Type, &c.SubjectID, &c.SubjectName, &c.Permission, &c.CreatedAt)
+		if err != nil {
+			return c, err
+		}
+
+		return c, c.IsValid()
+	})
+}
+
+func (s RepositoryStore) GetCollaborator(ctx context.Context, repositoryID uuid.UUID, t repository.CollaboratorType, subjectID uuid.UUID) (repository.Collaborator, error) {
+	var c repository.Collaborator
+
+	query := collaboratorSelectQuery + `
+		WHERE repository_collaborators.repository_id = $1
+		AND COALESCE(repository_collaborators.user_id, repository_collaborators.team_id) = $2
+		AND CASE WHEN repository_collaborators.user_id IS NOT NULL THEN 'user' ELSE 'team' END = $3
+		`
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, repositoryID, subjectID, t).Scan(&c.RepositoryID, &c.Type, &c.SubjectID, &c.SubjectName, &c.Permission, &c.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return c, repository.ErrCollaboratorNotFound
+		}
+
+		return c, err
+	}
+
+	return c, c.IsValid()
+}
+
+func (s RepositoryStore) SaveCollaborator(ctx context.Context, c repository.Collaborator) error {
+	var query string
+	switch c.Type {
+	case repository.CollaboratorTypeUser:
+		query = `
+			INSERT INTO repository_collaborators (repository_id, user_id, permission, created_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (repository_id, user_id) DO UPDATE SET permission = excluded.permission
+			`
+	case repository.CollaboratorTypeTeam:
+		query = `
+			INSERT INTO repository_collaborators (repository_id, team_id, permission, created_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (repository_id, team_id) DO UPDATE SET permission = excluded.permission
+			`
+	default:
+		return repository.ErrInvalidCollaboratorType
+	}
+
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, c.RepositoryID, c.SubjectID, c.Permission, c.CreatedAt)
+	return err
+}
+
+func (s RepositoryStore) RemoveCollaborator(ctx context.Context, repositoryID uuid.UUID, t repository.CollaboratorType, subjectID uuid.UUID) error {
+	var query string
+	switch t {
+	case repository.CollaboratorTypeUser:
+		query = "DELETE FROM repository_collaborators WHERE repository_id = $1 AND user_id = $2"
+	case repository.CollaboratorTypeTeam:
+		query = "DELETE FROM repository_collaborators WHERE repository_id = $1 AND team_id = $2"
+	default:
+		return repository.ErrInvalidCollaboratorType
+	}
+
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, repositoryID, subjectID)
+	return err
+}
+
+func (s RepositoryStore) GetProtectedTags(ctx context.Context, repositoryID uuid.UUID) ([]repository.ProtectedTag, error) {
+	var protectedTags []repository.ProtectedTag
+
+	query := "SELECT repository_id, pattern, created_at FROM repository_protected_tags WHERE repository_id = $1 ORDER BY pattern"
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, repositoryID)
+	if err != nil {
+		return protectedTags, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (repository.ProtectedTag, error) {
+		var p repository.ProtectedTag
+
+		err = rows.Scan(&p.RepositoryID, &p.Pattern, &p.CreatedAt)
+		if err != nil {
+			return p, err
+		}
+
+		return p, p.IsValid()
+	})
+}
+
+func (s RepositoryStore) SaveProtectedTag(ctx context.Context, p repository.ProtectedTag) error {
+	query := `
+		INSERT INTO repository_protected_tags (repository_id, pattern, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (repository_id, pattern) DO NOTHING
+		`
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, p.RepositoryID, p.Pattern, p.CreatedAt)
+	return err
+}
+
+func (s RepositoryStore) RemoveProtectedTag(ctx context.Context, repositoryID uuid.UUID, pattern string) error {
+	query := "DELETE FROM repository_protected_tags WHERE repository_id = $1 AND pattern = $2"
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, repositoryID, pattern)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repository.ErrProtectedTagNotFound
+	}
+
+	return nil
+}
+
+func (s RepositoryStore) SaveBlob(ctx context.Context, b repository.Blob) error {
+	query := `
+		INSERT INTO repository_blobs (repository_id, digest, size, pushed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (repository_id, digest) DO NOTHING
+		`
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, b.RepositoryID, b.Digest, b.Size, b.PushedAt)
+	return err
+}
+
+func (s RepositoryStore) DeleteBlob(ctx context.Context, repositoryID uuid.UUID, digest string) error {
+	query := "DELETE FROM repository_blobs WHERE repository_id = $1 AND digest = $2"
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, repositoryID, digest)
+	return err
+}
+
+func (s RepositoryStore) GetNamespaceUsage(ctx context.Context, namespace string) (repository.Usage, error) {
+	usage := repository.Usage{Namespace: namespace}
+
+	// blobs that are shared between repositories in the namespace are only counted once
+	query := `
+		SELECT
+			(SELECT count(*) FROM repositories WHERE repositories.namespace_id = namespaces.id),
+			(SELECT COALESCE(sum(blobs.size), 0)::bigint FROM (
+				SELECT DISTINCT repository_blobs.digest, repository_blobs.size
+				FROM repository_blobs
+				JOIN repositories ON repository_blobs.repository_id = repositories.id
+				WHERE repositories.namespace_id = namespaces.id
+			) AS blobs)
+		FROM namespaces
+		WHERE namespaces.name = $1
+		`
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, namespace).Scan(&usage.Repositories, &usage.Bytes)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return usage, repository.ErrNamespaceNotFound
+		}
+
+		return usage, err
+	}
+
+	return usage, nil
+}
+
+func (s RepositoryStore) GetNamespaceSettings(ctx context.Context, namespace string) (repository.NamespaceSettings, error) {
+	settings := repository.NamespaceSettings{Namespace: namespace}
+
+	query := "SELECT auto_create_repositories, auto_create_visibility FROM namespaces WHERE name = $1"
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, namespace).Scan(&settings.AutoCreateRepositories, &settings.AutoCreateVisibility)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return settings, repository.ErrNamespaceNotFound
+		}
+
+		return settings, err
+	}
+
+	return settings, settings.IsValid()
+}
+
+func (s RepositoryStore) SaveNamespaceSettings(ctx context.Context, settings repository.NamespaceSettings) error {
+	query := "UPDATE namespaces SET auto_create_repositories = $2, auto_create_visibility = $3 WHERE name = $1"
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, settings.Namespace, settings.AutoCreateRepositories, settings.AutoCreateVisibility)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNamespaceNotFound
+	}
+
+	return nil
+}
+
+func (s RepositoryStore) GetQuotaOverride(ctx context.Context, namespace string) (repository.QuotaOverride, error) {
+	o := repository.QuotaOverride{Namespace: namespace}
+
+	// both limits are set together, so a namespace without a repository limit uses the default quota
+	var repositories *int
+	var bytes *int64
+
+	query := "SELECT quota_repositories, quota_bytes FROM namespaces WHERE name = $1"
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, namespace).Scan(&repositories, &bytes)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return o, repository.ErrNamespaceNotFound
+		}
+
+		return o, err
+	}
+
+	if repositories == nil || bytes == nil {
+		return o, repository.ErrQuotaOverrideNotFound
+	}
+
+	o.Repositories = *repositories
+	o.Bytes = *bytes
+
+	return o, o.IsValid()
+}
+
+func (s RepositoryStore) SaveQuotaOverride(ctx context.Context, o repository.QuotaOverride) error {
+	query := "UPDATE namespaces SET quota_repositories = $2, quota_bytes = $3 WHERE name = $1"
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, o.Namespace, o.Repositories, o.Bytes)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNamespaceNotFound
+	}
+
+	return nil
+}
+
+func (s RepositoryStore) DeleteQuotaOverride(ctx context.Context, namespace string) error {
+	query := "UPDATE namespaces SET quota_repositories = NULL, quota_bytes = NULL WHERE name = $1"
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, namespace)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return repository.ErrNamespaceNotFound
+	}
+
+	return nil
+}
@@ -3,7 +3,10 @@ package postgres
 import (
 	"errors"
 	"github.com/evanebb/regauth/repository"
+	"github.com/evanebb/regauth/store"
 	"github.com/google/uuid"
+	"maps"
+	"slices"
 	"testing"
 	"time"
 )
@@ -15,20 +18,206 @@ func compareRepositories(r1 repository.Repository, r2 repository.Repository) boo
 		r1.Namespace == r2.Namespace &&
 		r1.Name == r2.Name &&
 		r1.Visibility == r2.Visibility &&
+		r1.Description == r2.Description &&
+		maps.Equal(r1.Labels, r2.Labels) &&
+		r1.Readme == r2.Readme &&
+		r1.CreatedBy == r2.CreatedBy &&
 		r1.CreatedAt.Equal(r2.CreatedAt)
 }
 
-func TestRepositoryStore_GetAllByNamespace(t *testing.T) {
+// compareTags will check two repository.Tag objects for equality.
+// Mostly exists for proper timestamp comparison.
+func compareTags(t1 repository.Tag, t2 repository.Tag) bool {
+	return t1.RepositoryID == t2.RepositoryID &&
+		t1.Name == t2.Name &&
+		t1.Digest == t2.Digest &&
+		t1.MediaType == t2.MediaType &&
+		t1.Size == t2.Size &&
+		t1.PushedAt.Equal(t2.PushedAt)
+}
+
+// compareCollaborators will check two repository.Collaborator objects for equality.
+// Mostly exists for proper timestamp comparison.
+func compareCollaborators(c1 repository.Collaborator, c2 repository.Collaborator) bool {
+	return c1.RepositoryID == c2.RepositoryID &&
+		c1.Type == c2.Type &&
+		c1.SubjectID == c2.SubjectID &&
+		c1.SubjectName == c2.SubjectName &&
+		c1.Permission == c2.Permission &&
+		c1.CreatedAt.Equal(c2.CreatedAt)
+}
+
+func TestRepositoryStore_ListByNamespace(t *testing.T) {
 	db := getDatabaseConnection(t)
 	s := NewRepositoryStore(db)
 
-	repositories, err := s.GetAllByNamespace(t.Context(), "adminuser")
-	if err != nil {
-		t.Errorf("expected err to be nil, got %q", err)
+	testCases := []struct {
+		desc     string
+		selector repository.LabelSelector
+		patterns []string
+		expected int
+	}{
+		{"no selector", nil, nil, 2},
+		{"label on all repositories", repository.LabelSelector{"team": "platform"}, nil, 2},
+		{"label on single repository", repository.LabelSelector{"team": "platform", "tier": "base"}, nil, 1},
+		{"no matching repositories", repository.LabelSelector{"team": "security"}, nil, 0},
+		{"namespace pattern", nil, []string{"adminuser/*"}, 2},
+		{"repository pattern", nil, []string{"adminuser/public-*"}, 1},
+		{"pattern of other namespace", nil, []string{"normaluser/*"}, 0},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			page, err := s.ListByNamespace(t.Context(), store.DefaultListOptions(), c.selector, c.patterns, "adminuser")
+			if err != nil {
+				t.Errorf("expected err to be nil, got %q", err)
+			}
+
+			if len(page.Items) != c.expected {
+				t.Errorf("expected %d repositories, got %d", c.expected, len(page.Items))
+			}
+		})
+	}
+}
+
+func TestRepositoryStore_Search(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	normalUserID, _ := uuid.Parse("0195cd11-2863-721e-a75c-86522539d0ee")
+	team2ID, _ := uuid.Parse("0195d46f-fde4-7b27-b542-e41ed0917ace")
+
+	defaultFilter := repository.SearchFilter{
+		Sort:  repository.SearchSortNamespace,
+		Order: repository.SearchOrderAscending,
+		Limit: repository.DefaultSearchLimit,
+	}
+
+	withFilter := func(modify func(f *repository.SearchFilter)) repository.SearchFilter {
+		f := defaultFilter
+		modify(&f)
+		return f
+	}
+
+	testCases := []struct {
+		desc     string
+		filter   repository.SearchFilter
+		access   repository.SearchAccess
+		expected []string
+	}{
+		{
+			desc:     "anonymous",
+			filter:   defaultFilter,
+			expected: []string{"adminuser/public-image", "normaluser/public-image"},
+		},
+		{
+			desc:     "own namespace",
+			filter:   defaultFilter,
+			access:   repository.SearchAccess{Namespaces: []string{"normaluser"}},
+			expected: []string{"adminuser/public-image", "normaluser/private-image", "normaluser/public-image"},
+		},
+		{
+			desc:     "user collaborator",
+			filter:   defaultFilter,
+			access:   repository.SearchAccess{UserID: normalUserID},
+			expected: []string{"adminuser/private-image", "adminuser/public-image", "normaluser/public-image"},
+		},
+		{
+			desc:     "team collaborator",
+			filter:   defaultFilter,
+			access:   repository.SearchAccess{TeamIDs: []uuid.UUID{team2ID}},
+			expected: []string{"adminuser/public-image", "normaluser/private-image", "normaluser/public-image"},
+		},
+		{
+			desc:     "restricted to repositories",
+			filter:   defaultFilter,
+			access:   repository.SearchAccess{Namespaces: []string{"adminuser", "normaluser"}, Repositories: []string{"normaluser/*"}},
+			expected: []string{"adminuser/public-image", "normaluser/private-image", "normaluser/public-image"},
+		},
+		{
+			desc:     "name query",
+			filter:   withFilter(func(f *repository.SearchFilter) { f.Query = "PRIVATE" }),
+			access:   repository.SearchAccess{Namespaces: []string{"adminuser"}},
+			expected: []string{"adminuser/private-image"},
+		},
+		{
+			desc:     "namespace",
+			filter:   withFilter(func(f *repository.SearchFilter) { f.Namespace = "normaluser" }),
+			expected: []string{"normaluser/public-image"},
+		},
+		{
+			desc:     "labels",
+			filter:   withFilter(func(f *repository.SearchFilter) { f.Labels = repository.LabelSelector{"team": "platform"} }),
+			expected: []string{"adminuser/public-image"},
+		},
+		{
+			desc: "descending order with limit",
+			filter: withFilter(func(f *repository.SearchFilter) {
+				f.Order = repository.SearchOrderDescending
+				f.Limit = 2
+			}),
+			access:   repository.SearchAccess{Namespaces: []string{"adminuser", "normaluser"}},
+			expected: []string{"normaluser/public-image", "normaluser/private-image"},
+		},
+		{
+			desc:     "after repository",
+			filter:   withFilter(func(f *repository.SearchFilter) { f.After = "adminuser/public-image" }),
+			access:   repository.SearchAccess{Namespaces: []string{"adminuser", "normaluser"}},
+			expected: []string{"normaluser/private-image", "normaluser/public-image"},
+		},
 	}
 
-	if len(repositories) != 2 {
-		t.Errorf("expected two repositories, got %d", len(repositories))
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			page, err := s.Search(t.Context(), c.filter, c.access)
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			var actual []string
+			for _, r := range page.Items {
+				actual = append(actual, r.Namespace+"/"+string(r.Name))
+			}
+
+			if !slices.Equal(c.expected, actual) {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRepositoryStore_Search_Pagination(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	f := repository.SearchFilter{
+		Sort:  repository.SearchSortNamespace,
+		Order: repository.SearchOrderDescending,
+		Limit: 2,
+	}
+	access := repository.SearchAccess{Namespaces: []string{"adminuser", "normaluser"}}
+
+	var actual []string
+	for range 3 {
+		page, err := s.Search(t.Context(), f, access)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		for _, r := range page.Items {
+			actual = append(actual, r.FullName())
+		}
+
+		if page.Next == "" {
+			break
+		}
+
+		f.Cursor = page.Next
+	}
+
+	expected := []string{"normaluser/public-image", "normaluser/private-image", "adminuser/public-image", "adminuser/private-image"}
+	if !slices.Equal(expected, actual) {
+		t.Errorf("expected %v, got %v", expected, actual)
 	}
 }
 
@@ -45,6 +234,7 @@ func TestRepositoryStore_GetByNamespaceAndName(t *testing.T) {
 			Namespace:  "adminuser",
 			Name:       "public-image",
 			Visibility: repository.VisibilityPublic,
+			Labels:     repository.Labels{"team": "platform", "tier": "base"},
 			CreatedAt:  createdAt,
 		}
 
@@ -78,6 +268,7 @@ func TestRepositoryStore_GetByID(t *testing.T) {
 			Namespace:  "adminuser",
 			Name:       "public-image",
 			Visibility: repository.VisibilityPublic,
+			Labels:     repository.Labels{"team": "platform", "tier": "base"},
 			CreatedAt:  createdAt,
 		}
 
@@ -120,6 +311,102 @@ func TestRepositoryStore_Create(t *testing.T) {
 			t.Errorf("expected %q, got %q", repository.ErrAlreadyExists, err)
 		}
 	})
+
+	t.Run("nested repository", func(t *testing.T) {
+		nested := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "adminuser",
+			Name:       "new-image/worker",
+			Visibility: repository.VisibilityPublic,
+		}
+
+		if err := s.Create(t.Context(), nested); err != nil {
+			t.Fatalf("expected nil, got %q", err)
+		}
+
+		actual, err := s.GetByNamespaceAndName(t.Context(), "adminuser", "new-image/worker")
+		if err != nil {
+			t.Fatalf("expected nil, got %q", err)
+		}
+
+		if actual.ID != nested.ID {
+			t.Errorf("expected repository %s, got %s", nested.ID, actual.ID)
+		}
+	})
+
+	t.Run("name not matching the registry grammar", func(t *testing.T) {
+		invalid := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "adminuser",
+			Name:       "Invalid-Image",
+			Visibility: repository.VisibilityPublic,
+		}
+
+		if err := s.Create(t.Context(), invalid); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestRepositoryStore_Update(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+
+	t.Run("existing repository", func(t *testing.T) {
+		repo, err := s.GetByID(t.Context(), repoID)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		repo.Namespace = "team-1"
+		repo.Name = "renamed-image"
+		repo.Visibility = repository.VisibilityPrivate
+		repo.Description = "Moved to the team namespace"
+		repo.Labels = repository.Labels{"team": "team-1"}
+		repo.Readme = "# Renamed image"
+
+		if err := s.Update(t.Context(), repo); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		updated, err := s.GetByNamespaceAndName(t.Context(), "team-1", "renamed-image")
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareRepositories(repo, updated) {
+			t.Errorf("expected %+v, got %+v", repo, updated)
+		}
+	})
+
+	t.Run("name already taken", func(t *testing.T) {
+		repo, err := s.GetByID(t.Context(), repoID)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		repo.Namespace = "adminuser"
+		repo.Name = "private-image"
+
+		if err := s.Update(t.Context(), repo); !errors.Is(err, repository.ErrAlreadyExists) {
+			t.Errorf("expected %q, got %q", repository.ErrAlreadyExists, err)
+		}
+	})
+
+	t.Run("repository does not exist", func(t *testing.T) {
+		repo := repository.Repository{
+			ID:         uuid.New(),
+			Namespace:  "adminuser",
+			Name:       "does-not-exist",
+			Visibility: repository.VisibilityPublic,
+		}
+
+		if err := s.Update(t.Context(), repo); !errors.Is(err, repository.ErrNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrNotFound, err)
+		}
+	})
 }
 
 func TestRepositoryStore_DeleteByID(t *testing.T) {
@@ -140,3 +427,605 @@ func TestRepositoryStore_DeleteByID(t *testing.T) {
 		t.Errorf("expected repository to be deleted, got %q", err)
 	}
 }
+
+func TestRepositoryStore_GetTags(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+
+	tags, err := s.GetTags(t.Context(), repoID)
+	if err != nil {
+		t.Errorf("expected err to be nil, got %q", err)
+	}
+
+	if len(tags) != 2 {
+		t.Errorf("expected two tags, got %d", len(tags))
+	}
+}
+
+func TestRepositoryStore_ListTags(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+
+	o := store.DefaultListOptions()
+	o.Limit = 1
+
+	first, err := s.ListTags(t.Context(), repoID, o)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(first.Items) != 1 || first.Next == "" {
+		t.Fatalf("expected one tag and a next page, got %d tags and cursor %q", len(first.Items), first.Next)
+	}
+
+	o.Cursor = first.Next
+	second, err := s.ListTags(t.Context(), repoID, o)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(second.Items) != 1 || second.Next != "" {
+		t.Fatalf("expected one tag and no next page, got %d tags and cursor %q", len(second.Items), second.Next)
+	}
+
+	if first.Items[0].Name >= second.Items[0].Name {
+		t.Errorf("expected tags to be sorted by name, got %q before %q", first.Items[0].Name, second.Items[0].Name)
+	}
+}
+
+func TestRepositoryStore_GetTag(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+
+	t.Run("existing tag", func(t *testing.T) {
+		pushedAt, _ := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+
+		expectedTag := repository.Tag{
+			RepositoryID: repoID,
+			Name:         "latest",
+			Digest:       "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b",
+			MediaType:    "application/vnd.oci.image.index.v1+json",
+			Size:         10240,
+			PushedAt:     pushedAt,
+		}
+
+		tag, err := s.GetTag(t.Context(), repoID, "latest")
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareTags(expectedTag, tag) {
+			t.Errorf("expected %+v, got %+v", expectedTag, tag)
+		}
+	})
+
+	t.Run("tag does not exist", func(t *testing.T) {
+		if _, err := s.GetTag(t.Context(), repoID, "foo"); !errors.Is(err, repository.ErrTagNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrTagNotFound, err)
+		}
+	})
+}
+
+func TestRepositoryStore_SaveTag(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+
+	tag := repository.Tag{
+		RepositoryID: repoID,
+		Name:         "v2.0.0",
+		Digest:       "sha256:0f6e6ad35a5d8a0d4bd1ed0e8f7b3f0d0f3dcd2d5f2b2e36d36c1a73c7b2a1b1",
+		MediaType:    "application/vnd.oci.image.manifest.v1+json",
+		Size:         2048,
+		PushedAt:     time.Now().Truncate(time.Microsecond),
+	}
+
+	t.Run("new tag", func(t *testing.T) {
+		if err := s.SaveTag(t.Context(), tag); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		saved, err := s.GetTag(t.Context(), repoID, "v2.0.0")
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareTags(tag, saved) {
+			t.Errorf("expected %+v, got %+v", tag, saved)
+		}
+	})
+
+	t.Run("overwrite existing tag", func(t *testing.T) {
+		tag.Digest = "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b"
+		if err := s.SaveTag(t.Context(), tag); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		saved, err := s.GetTag(t.Context(), repoID, "v2.0.0")
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareTags(tag, saved) {
+			t.Errorf("expected %+v, got %+v", tag, saved)
+		}
+	})
+}
+
+func TestRepositoryStore_DeleteTag(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+
+	if err := s.DeleteTag(t.Context(), repoID, "latest"); err != nil {
+		t.Errorf("expected nil, got %q", err)
+	}
+
+	if _, err := s.GetTag(t.Context(), repoID, "latest"); !errors.Is(err, repository.ErrTagNotFound) {
+		t.Errorf("expected tag to be deleted, got %q", err)
+	}
+
+	if _, err := s.GetTag(t.Context(), repoID, "v1.0.0"); err != nil {
+		t.Errorf("expected other tag to still exist, got %q", err)
+	}
+}
+
+func TestRepositoryStore_DeleteTagsByDigest(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+
+	err := s.DeleteTagsByDigest(t.Context(), repoID, "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b")
+	if err != nil {
+		t.Errorf("expected nil, got %q", err)
+	}
+
+	tags, err := s.GetTags(t.Context(), repoID)
+	if err != nil {
+		t.Errorf("expected err to be nil, got %q", err)
+	}
+
+	if len(tags) != 0 {
+		t.Errorf("expected all tags to be deleted, got %d", len(tags))
+	}
+}
+
+func TestRepositoryStore_GetCollaborators(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-7728-9e48-d51b8578ea53")
+
+	collaborators, err := s.GetCollaborators(t.Context(), repoID)
+	if err != nil {
+		t.Errorf("expected err to be nil, got %q", err)
+	}
+
+	if len(collaborators) != 1 {
+		t.Errorf("expected one collaborator, got %d", len(collaborators))
+	}
+}
+
+func TestRepositoryStore_GetCollaborator(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	createdAt, _ := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+
+	t.Run("user collaborator", func(t *testing.T) {
+		repoID, _ := uuid.Parse("0195cd13-ba14-7728-9e48-d51b8578ea53")
+		userID, _ := uuid.Parse("0195cd11-2863-721e-a75c-86522539d0ee")
+
+		expected := repository.Collaborator{
+			RepositoryID: repoID,
+			Type:         repository.CollaboratorTypeUser,
+			SubjectID:    userID,
+			SubjectName:  "normaluser",
+			Permission:   repository.CollaboratorPermissionPush,
+			CreatedAt:    createdAt,
+		}
+
+		c, err := s.GetCollaborator(t.Context(), repoID, repository.CollaboratorTypeUser, userID)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareCollaborators(expected, c) {
+			t.Errorf("expected %+v, got %+v", expected, c)
+		}
+	})
+
+	t.Run("team collaborator", func(t *testing.T) {
+		repoID, _ := uuid.Parse("0195cd13-ba14-77a5-bec6-46a26a17ad2d")
+		teamID, _ := uuid.Parse("0195d46f-fde4-7b27-b542-e41ed0917ace")
+
+		expected := repository.Collaborator{
+			RepositoryID: repoID,
+			Type:         repository.CollaboratorTypeTeam,
+			SubjectID:    teamID,
+			SubjectName:  "team-2",
+			Permission:   repository.CollaboratorPermissionPull,
+			CreatedAt:    createdAt,
+		}
+
+		c, err := s.GetCollaborator(t.Context(), repoID, repository.CollaboratorTypeTeam, teamID)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareCollaborators(expected, c) {
+			t.Errorf("expected %+v, got %+v", expected, c)
+		}
+	})
+
+	t.Run("collaborator does not exist", func(t *testing.T) {
+		repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+		userID, _ := uuid.Parse("0195cd11-2863-721e-a75c-86522539d0ee")
+
+		_, err := s.GetCollaborator(t.Context(), repoID, repository.CollaboratorTypeUser, userID)
+		if !errors.Is(err, repository.ErrCollaboratorNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrCollaboratorNotFound, err)
+		}
+	})
+}
+
+func TestRepositoryStore_SaveCollaborator(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+	teamID, _ := uuid.Parse("0195d46e-cfbf-7324-b9aa-4c9c78d3b722")
+
+	c := repository.Collaborator{
+		RepositoryID: repoID,
+		Type:         repository.CollaboratorTypeTeam,
+		SubjectID:    teamID,
+		SubjectName:  "team-1",
+		Permission:   repository.CollaboratorPermissionPull,
+		CreatedAt:    time.Now().Truncate(time.Microsecond),
+	}
+
+	t.Run("new collaborator", func(t *testing.T) {
+		if err := s.SaveCollaborator(t.Context(), c); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		saved, err := s.GetCollaborator(t.Context(), repoID, c.Type, c.SubjectID)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareCollaborators(c, saved) {
+			t.Errorf("expected %+v, got %+v", c, saved)
+		}
+	})
+
+	t.Run("update existing collaborator", func(t *testing.T) {
+		c.Permission = repository.CollaboratorPermissionDelete
+		if err := s.SaveCollaborator(t.Context(), c); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		saved, err := s.GetCollaborator(t.Context(), repoID, c.Type, c.SubjectID)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareCollaborators(c, saved) {
+			t.Errorf("expected %+v, got %+v", c, saved)
+		}
+	})
+}
+
+func TestRepositoryStore_RemoveCollaborator(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-7728-9e48-d51b8578ea53")
+	userID, _ := uuid.Parse("0195cd11-2863-721e-a75c-86522539d0ee")
+
+	if err := s.RemoveCollaborator(t.Context(), repoID, repository.CollaboratorTypeUser, userID); err != nil {
+		t.Errorf("expected nil, got %q", err)
+	}
+
+	_, err := s.GetCollaborator(t.Context(), repoID, repository.CollaboratorTypeUser, userID)
+	if !errors.Is(err, repository.ErrCollaboratorNotFound) {
+		t.Errorf("expected collaborator to be removed, got %q", err)
+	}
+}
+
+func TestRepositoryStore_GetProtectedTags(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+
+	protectedTags, err := s.GetProtectedTags(t.Context(), repoID)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	patterns := make([]repository.TagPattern, 0, len(protectedTags))
+	for _, p := range protectedTags {
+		patterns = append(patterns, p.Pattern)
+	}
+
+	expected := []repository.TagPattern{"latest", "v*"}
+	if !slices.Equal(patterns, expected) {
+		t.Errorf("expected %v, got %v", expected, patterns)
+	}
+}
+
+func TestRepositoryStore_SaveProtectedTag(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-7728-9e48-d51b8578ea53")
+	p := repository.ProtectedTag{
+		RepositoryID: repoID,
+		Pattern:      "release-*",
+		CreatedAt:    time.Now(),
+	}
+
+	t.Run("new protected tag", func(t *testing.T) {
+		if err := s.SaveProtectedTag(t.Context(), p); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+	})
+
+	t.Run("existing protected tag", func(t *testing.T) {
+		if err := s.SaveProtectedTag(t.Context(), p); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		protectedTags, err := s.GetProtectedTags(t.Context(), repoID)
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if len(protectedTags) != 1 {
+			t.Errorf("expected one protected tag, got %d", len(protectedTags))
+		}
+	})
+}
+
+func TestRepositoryStore_RemoveProtectedTag(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+
+	t.Run("existing protected tag", func(t *testing.T) {
+		if err := s.RemoveProtectedTag(t.Context(), repoID, "latest"); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+	})
+
+	t.Run("protected tag does not exist", func(t *testing.T) {
+		if err := s.RemoveProtectedTag(t.Context(), repoID, "latest"); !errors.Is(err, repository.ErrProtectedTagNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrProtectedTagNotFound, err)
+		}
+	})
+}
+
+func TestRepositoryStore_SaveBlob(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-779d-8960-bc20595f515e")
+	b := repository.Blob{
+		RepositoryID: repoID,
+		Digest:       "sha256:a3ed95caeb02ffe68cdd9fd84406680ae93d633cb16422d00e8a7c22955b46d4",
+		Size:         1000,
+		PushedAt:     time.Now(),
+	}
+
+	t.Run("new blob", func(t *testing.T) {
+		if err := s.SaveBlob(t.Context(), b); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+	})
+
+	t.Run("existing blob", func(t *testing.T) {
+		if err := s.SaveBlob(t.Context(), b); err != nil {
+			t.Errorf("expected nil, got %q", err)
+		}
+
+		usage, err := s.GetNamespaceUsage(t.Context(), "normaluser")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if usage.Bytes != b.Size {
+			t.Errorf("expected %d bytes, got %d", b.Size, usage.Bytes)
+		}
+	})
+}
+
+func TestRepositoryStore_DeleteBlob(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	repoID, _ := uuid.Parse("0195cd13-ba14-76fd-b43e-55f190e566bd")
+
+	if err := s.DeleteBlob(t.Context(), repoID, "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b"); err != nil {
+		t.Errorf("expected nil, got %q", err)
+	}
+
+	usage, err := s.GetNamespaceUsage(t.Context(), "adminuser")
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if usage.Bytes != 1500 {
+		t.Errorf("expected 1500 bytes, got %d", usage.Bytes)
+	}
+}
+
+func TestRepositoryStore_GetNamespaceUsage(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	testCases := []struct {
+		desc      string
+		namespace string
+		expected  repository.Usage
+	}{
+		{"namespace with shared blobs", "adminuser", repository.Usage{Namespace: "adminuser", Repositories: 2, Bytes: 11740}},
+		{"namespace without blobs", "normaluser", repository.Usage{Namespace: "normaluser", Repositories: 2}},
+		{"namespace without repositories", "team-1", repository.Usage{Namespace: "team-1"}},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			usage, err := s.GetNamespaceUsage(t.Context(), c.namespace)
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			if usage != c.expected {
+				t.Errorf("expected %+v, got %+v", c.expected, usage)
+			}
+		})
+	}
+
+	t.Run("namespace does not exist", func(t *testing.T) {
+		_, err := s.GetNamespaceUsage(t.Context(), "nonexistent")
+		if !errors.Is(err, repository.ErrNamespaceNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrNamespaceNotFound, err)
+		}
+	})
+}
+
+func TestRepositoryStore_GetNamespaceSettings(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	t.Run("existing namespace", func(t *testing.T) {
+		settings, err := s.GetNamespaceSettings(t.Context(), "adminuser")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		expected := repository.DefaultNamespaceSettings("adminuser")
+		if settings != expected {
+			t.Errorf("expected %+v, got %+v", expected, settings)
+		}
+	})
+
+	t.Run("namespace does not exist", func(t *testing.T) {
+		_, err := s.GetNamespaceSettings(t.Context(), "nonexistent")
+		if !errors.Is(err, repository.ErrNamespaceNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrNamespaceNotFound, err)
+		}
+	})
+}
+
+func TestRepositoryStore_SaveNamespaceSettings(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	t.Run("existing namespace", func(t *testing.T) {
+		settings := repository.NamespaceSettings{
+			Namespace:              "team-1",
+			AutoCreateRepositories: true,
+			AutoCreateVisibility:   repository.VisibilityPublic,
+		}
+
+		if err := s.SaveNamespaceSettings(t.Context(), settings); err != nil {
+			t.Fatalf("expected nil, got %q", err)
+		}
+
+		saved, err := s.GetNamespaceSettings(t.Context(), "team-1")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if saved != settings {
+			t.Errorf("expected %+v, got %+v", settings, saved)
+		}
+	})
+
+	t.Run("namespace does not exist", func(t *testing.T) {
+		settings := repository.DefaultNamespaceSettings("nonexistent")
+		if err := s.SaveNamespaceSettings(t.Context(), settings); !errors.Is(err, repository.ErrNamespaceNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrNamespaceNotFound, err)
+		}
+	})
+}
+
+func TestRepositoryStore_QuotaOverride(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewRepositoryStore(db)
+
+	t.Run("namespace without quota override", func(t *testing.T) {
+		_, err := s.GetQuotaOverride(t.Context(), "adminuser")
+		if !errors.Is(err, repository.ErrQuotaOverrideNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrQuotaOverrideNotFound, err)
+		}
+	})
+
+	t.Run("save and delete quota override", func(t *testing.T) {
+		o := repository.QuotaOverride{Namespace: "normaluser", Quota: repository.Quota{Repositories: 3, Bytes: 1000}}
+		if err := s.SaveQuotaOverride(t.Context(), o); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		saved, err := s.GetQuotaOverride(t.Context(), "normaluser")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if saved != o {
+			t.Errorf("expected %+v, got %+v", o, saved)
+		}
+
+		if err := s.DeleteQuotaOverride(t.Context(), "normaluser"); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if _, err := s.GetQuotaOverride(t.Context(), "normaluser"); !errors.Is(err, repository.ErrQuotaOverrideNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrQuotaOverrideNotFound, err)
+		}
+	})
+
+	t.Run("unlimited quota override", func(t *testing.T) {
+		o := repository.QuotaOverride{Namespace: "team-1"}
+		if err := s.SaveQuotaOverride(t.Context(), o); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		saved, err := s.GetQuotaOverride(t.Context(), "team-1")
+		if err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if saved != o {
+			t.Errorf("expected %+v, got %+v", o, saved)
+		}
+	})
+
+	t.Run("namespace does not exist", func(t *testing.T) {
+		if _, err := s.GetQuotaOverride(t.Context(), "nonexistent"); !errors.Is(err, repository.ErrNamespaceNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrNamespaceNotFound, err)
+		}
+
+		o := repository.QuotaOverride{Namespace: "nonexistent"}
+		if err := s.SaveQuotaOverride(t.Context(), o); !errors.Is(err, repository.ErrNamespaceNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrNamespaceNotFound, err)
+		}
+
+		if err := s.DeleteQuotaOverride(t.Context(), "nonexistent"); !errors.Is(err, repository.ErrNamespaceNotFound) {
+			t.Errorf("expected %q, got %q", repository.ErrNamespaceNotFound, err)
+		}
+	})
+}
@@ -3,10 +3,12 @@ package postgres
 import (
 	"context"
 	"errors"
+	"github.com/evanebb/regauth/store"
 	"github.com/evanebb/regauth/user"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
 )
 
 type TeamStore struct {
@@ -24,6 +26,7 @@ func (s TeamStore) GetAllByUser(ctx context.Context, userID uuid.UUID) ([]user.T
 		SELECT
 			teams.id,
 			teams.name,
+			teams.max_token_lifetime_seconds,
 			teams.created_at
 		FROM teams
 		JOIN team_members ON teams.id = team_members.team_id
@@ -37,20 +40,62 @@ func (s TeamStore) GetAllByUser(ctx context.Context, userID uuid.UUID) ([]user.T
 
 	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (user.Team, error) {
 		var t user.Team
+		var maxTokenLifetime int64
 
-		if err := rows.Scan(&t.ID, &t.Name, &t.CreatedAt); err != nil {
+		if err := rows.Scan(&t.ID, &t.Name, &maxTokenLifetime, &t.CreatedAt); err != nil {
 			return t, err
 		}
 
+		t.MaxTokenLifetime = time.Duration(maxTokenLifetime) * time.Second
 		return t, t.IsValid()
 	})
 }
 
+func (s TeamStore) ListByUser(ctx context.Context, userID uuid.UUID, o store.ListOptions) (store.Page[user.Team], error) {
+	query := `
+		SELECT
+			teams.id,
+			teams.name,
+			teams.max_token_lifetime_seconds,
+			teams.created_at
+		FROM teams
+		JOIN team_members ON teams.id = team_members.team_id
+		WHERE team_members.user_id = $1
+		`
+	query, args, err := listQuery(query, []any{userID}, o, "teams.name", "teams.created_at", "teams.id")
+	if err != nil {
+		return store.Page[user.Team]{}, err
+	}
+
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return store.Page[user.Team]{}, err
+	}
+
+	teams, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (user.Team, error) {
+		var t user.Team
+		var maxTokenLifetime int64
+
+		if err := rows.Scan(&t.ID, &t.Name, &maxTokenLifetime, &t.CreatedAt); err != nil {
+			return t, err
+		}
+
+		t.MaxTokenLifetime = time.Duration(maxTokenLifetime) * time.Second
+		return t, t.IsValid()
+	})
+	if err != nil {
+		return store.Page[user.Team]{}, err
+	}
+
+	return store.NewPage(o, teams, user.Team.Position), nil
+}
+
 func (s TeamStore) GetByID(ctx context.Context, id uuid.UUID) (user.Team, error) {
 	var t user.Team
+	var maxTokenLifetime int64
 
-	query := "SELECT id, name, created_at FROM teams WHERE id = $1"
-	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, id).Scan(&t.ID, &t.Name, &t.CreatedAt)
+	query := "SELECT id, name, max_token_lifetime_seconds, created_at FROM teams WHERE id = $1"
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, id).Scan(&t.ID, &t.Name, &maxTokenLifetime, &t.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return t, user.ErrTeamNotFound
@@ -59,14 +104,16 @@ func (s TeamStore) GetByID(ctx context.Context, id uuid.UUID) (user.Team, error)
 		return t, err
 	}
 
+	t.MaxTokenLifetime = time.Duration(maxTokenLifetime) * time.Second
 	return t, nil
 }
 
 func (s TeamStore) GetByName(ctx context.Context, name string) (user.Team, error) {
 	var t user.Team
+	var maxTokenLifetime int64
 
-	query := "SELECT id, name, created_at FROM teams WHERE name = $1"
-	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, name).Scan(&t.ID, &t.Name, &t.CreatedAt)
+	query := "SELECT id, name, max_token_lifetime_seconds, created_at FROM teams WHERE name = $1"
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, name).Scan(&t.ID, &t.Name, &maxTokenLifetime, &t.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return t, user.ErrTeamNotFound
@@ -75,6 +122,7 @@ func (s TeamStore) GetByName(ctx context.Context, name string) (user.Team, error
 		return t, err
 	}
 
+	t.MaxTokenLifetime = time.Duration(maxTokenLifetime) * time.Second
 	return t, nil
 }
 
@@ -92,8 +140,8 @@ func (s TeamStore) Create(ctx context.Context, t user.Team) error {
 		return err
 	}
 
-	query := "INSERT INTO teams (id, name, created_at) VALUES ($1, $2, $3)"
-	if _, err := tx.Exec(ctx, query, t.ID, t.Name, t.CreatedAt); err != nil {
+	query := "INSERT INTO teams (id, name, max_token_lifetime_seconds, created_at) VALUES ($1, $2, $3, $4)"
+	if _, err := tx.Exec(ctx, query, t.ID, t.Name, int64(t.MaxTokenLifetime/time.Second), t.CreatedAt); err != nil {
 		_ = tx.Rollback(ctx)
 		return err
 	}
@@ -108,10 +156,40 @@ func (s TeamStore) Create(ctx context.Context, t user.Team) error {
 	return nil
 }
 
+func (s TeamStore) SetMaxTokenLifetime(ctx context.Context, id uuid.UUID, maxTokenLifetime time.Duration) error {
+	query := "UPDATE teams SET max_token_lifetime_seconds = $1 WHERE id = $2"
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, int64(maxTokenLifetime/time.Second), id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return user.ErrTeamNotFound
+	}
+
+	return nil
+}
+
 func (s TeamStore) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	tx, err := s.QuerierFromContext(ctx).Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the users belonging to the robots of the team would otherwise be left behind without a team
+	robotQuery := "DELETE FROM users WHERE id IN (SELECT user_id FROM team_robots WHERE team_id = $1)"
+	if _, err := tx.Exec(ctx, robotQuery, id); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
 	query := "DELETE FROM teams WHERE id = $1"
-	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, id)
-	return err
+	if _, err := tx.Exec(ctx, query, id); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 func (s TeamStore) GetTeamMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (user.TeamMember, error) {
@@ -172,6 +250,44 @@ func (s TeamStore) GetTeamMembers(ctx context.Context, teamID uuid.UUID) ([]user
 	})
 }
 
+func (s TeamStore) ListTeamMembers(ctx context.Context, teamID uuid.UUID, o store.ListOptions) (store.Page[user.TeamMember], error) {
+	query := `
+		SELECT
+			team_members.user_id,
+			team_members.team_id,
+			users.username,
+			team_members.role,
+			team_members.created_at
+		FROM team_members
+		JOIN users ON team_members.user_id = users.id
+		WHERE team_members.team_id = $1
+		`
+	query, args, err := listQuery(query, []any{teamID}, o, "users.username", "team_members.created_at", "team_members.user_id")
+	if err != nil {
+		return store.Page[user.TeamMember]{}, err
+	}
+
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return store.Page[user.TeamMember]{}, err
+	}
+
+	members, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (user.TeamMember, error) {
+		var tm user.TeamMember
+
+		if err := rows.Scan(&tm.UserID, &tm.TeamID, &tm.Username, &tm.Role, &tm.CreatedAt); err != nil {
+			return tm, err
+		}
+
+		return tm, tm.IsValid()
+	})
+	if err != nil {
+		return store.Page[user.TeamMember]{}, err
+	}
+
+	return store.NewPage(o, members, user.TeamMember.Position), nil
+}
+
 func (s TeamStore) AddTeamMember(ctx context.Context, m user.TeamMember) error {
 	_, err := s.GetTeamMember(ctx, m.TeamID, m.UserID)
 	if err == nil {
@@ -191,3 +307,106 @@ func (s TeamStore) RemoveTeamMember(ctx context.Context, teamID uuid.UUID, userI
 	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, teamID, userID)
 	return err
 }
+
+func (s TeamStore) ListRobots(ctx context.Context, teamID uuid.UUID, o store.ListOptions) (store.Page[user.Robot], error) {
+	query := `
+		SELECT
+			team_robots.user_id,
+			team_robots.team_id,
+			team_robots.name,
+			users.username,
+			team_robots.created_at
+		FROM team_robots
+		JOIN users ON team_robots.user_id = users.id
+		WHERE team_robots.team_id = $1
+		`
+	query, args, err := listQuery(query, []any{teamID}, o, "team_robots.name", "team_robots.created_at", "team_robots.user_id")
+	if err != nil {
+		return store.Page[user.Robot]{}, err
+	}
+
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return store.Page[user.Robot]{}, err
+	}
+
+	robots, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (user.Robot, error) {
+		var r user.Robot
+
+		if err := rows.Scan(&r.UserID, &r.TeamID, &r.Name, &r.Username, &r.CreatedAt); err != nil {
+			return r, err
+		}
+
+		return r, r.IsValid()
+	})
+	if err != nil {
+		return store.Page[user.Robot]{}, err
+	}
+
+	return store.NewPage(o, robots, user.Robot.Position), nil
+}
+
+func (s TeamStore) GetRobot(ctx context.Context, teamID uuid.UUID, name string) (user.Robot, error) {
+	var r user.Robot
+
+	query := `
+		SELECT
+			team_robots.user_id,
+			team_robots.team_id,
+			team_robots.name,
+			users.username,
+			team_robots.created_at
+		FROM team_robots
+		JOIN users ON team_robots.user_id = users.id
+		WHERE team_robots.team_id = $1
+		AND team_robots.name = $2
+		`
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, teamID, name).Scan(&r.UserID, &r.TeamID, &r.Name, &r.Username, &r.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return r, user.ErrRobotNotFound
+		}
+
+		return r, err
+	}
+
+	return r, r.IsValid()
+}
+
+func (s TeamStore) GetRobotByUserID(ctx context.Context, userID uuid.UUID) (user.Robot, error) {
+	var r user.Robot
+
+	query := `
+		SELECT
+			team_robots.user_id,
+			team_robots.team_id,
+			team_robots.name,
+			users.username,
+			team_robots.created_at
+		FROM team_robots
+		JOIN users ON team_robots.user_id = users.id
+		WHERE team_robots.user_id = $1
+		`
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, userID).Scan(&r.UserID, &r.TeamID, &r.Name, &r.Username, &r.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return r, user.ErrRobotNotFound
+		}
+
+		return r, err
+	}
+
+	return r, r.IsValid()
+}
+
+func (s TeamStore) AddRobot(ctx context.Context, r user.Robot) error {
+	query := "INSERT INTO team_robots (user_id, team_id, name, created_at) VALUES ($1, $2, $3, $4)"
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, r.UserID, r.TeamID, r.Name, r.CreatedAt)
+	return err
+}
+
+func (s TeamStore) RemoveRobot(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error {
+	query := "DELETE FROM team_robots WHERE team_id = $1 AND user_id = $2"
+	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, teamID, userID)
+	return err
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"errors"
+	"github.com/evanebb/regauth/store"
 	"github.com/evanebb/regauth/user"
 	"github.com/google/uuid"
 	"testing"
@@ -13,9 +14,20 @@ import (
 func compareTeams(t1 user.Team, t2 user.Team) bool {
 	return t1.ID == t2.ID &&
 		t1.Name == t2.Name &&
+		t1.MaxTokenLifetime == t2.MaxTokenLifetime &&
 		t1.CreatedAt.Equal(t2.CreatedAt)
 }
 
+// compareRobots will check two user.Robot objects for equality.
+// Mostly exists for proper timestamp comparison.
+func compareRobots(r1 user.Robot, r2 user.Robot) bool {
+	return r1.UserID == r2.UserID &&
+		r1.TeamID == r2.TeamID &&
+		r1.Name == r2.Name &&
+		r1.Username == r2.Username &&
+		r1.CreatedAt.Equal(r2.CreatedAt)
+}
+
 func TestTeamStore_GetAllByUser(t *testing.T) {
 	db := getDatabaseConnection(t)
 	s := NewTeamStore(db)
@@ -32,6 +44,39 @@ func TestTeamStore_GetAllByUser(t *testing.T) {
 	}
 }
 
+func TestTeamStore_ListByUser(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewTeamStore(db)
+
+	userID, _ := uuid.Parse("0195cd11-2863-71d4-a3c4-032bc264cf81")
+
+	o := store.DefaultListOptions()
+	o.Limit = 1
+
+	page, err := s.ListByUser(t.Context(), userID, o)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(page.Items) != 1 || page.Items[0].Name != "team-1" {
+		t.Errorf("expected only team-1 on the first page, got %+v", page.Items)
+	}
+
+	o.Cursor = page.Next
+	page, err = s.ListByUser(t.Context(), userID, o)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(page.Items) != 1 || page.Items[0].Name != "team-2" {
+		t.Errorf("expected only team-2 on the second page, got %+v", page.Items)
+	}
+
+	if page.Next != "" {
+		t.Errorf("expected no next page, got cursor %q", page.Next)
+	}
+}
+
 func TestTeamStore_GetByID(t *testing.T) {
 	db := getDatabaseConnection(t)
 	s := NewTeamStore(db)
@@ -116,6 +161,30 @@ func TestTeamStore_Create(t *testing.T) {
 	})
 }
 
+func TestTeamStore_SetMaxTokenLifetime(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewTeamStore(db)
+
+	teamID, _ := uuid.Parse("0195d46e-cfbf-7324-b9aa-4c9c78d3b722")
+
+	if err := s.SetMaxTokenLifetime(t.Context(), teamID, 30*24*time.Hour); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	team, err := s.GetByID(t.Context(), teamID)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if team.MaxTokenLifetime != 30*24*time.Hour {
+		t.Errorf("expected maximum token lifetime to be %s, got %s", 30*24*time.Hour, team.MaxTokenLifetime)
+	}
+
+	if err := s.SetMaxTokenLifetime(t.Context(), uuid.Nil, time.Hour); !errors.Is(err, user.ErrTeamNotFound) {
+		t.Errorf("expected %q, got %q", user.ErrTeamNotFound, err)
+	}
+}
+
 func TestTeamStore_DeleteByID(t *testing.T) {
 	db := getDatabaseConnection(t)
 	s := NewTeamStore(db)
@@ -133,6 +202,12 @@ func TestTeamStore_DeleteByID(t *testing.T) {
 	if _, err := s.GetByID(t.Context(), teamID); !errors.Is(err, user.ErrTeamNotFound) {
 		t.Errorf("expected team to be deleted, got %q", err)
 	}
+
+	// the users belonging to the robots of the team should be deleted as well
+	robotUserID, _ := uuid.Parse("0199f1c2-4a10-7b3e-9d5c-1f2e3a4b5c6d")
+	if _, err := NewUserStore(db).GetByID(t.Context(), robotUserID); !errors.Is(err, user.ErrNotFound) {
+		t.Errorf("expected robot user to be deleted, got %q", err)
+	}
 }
 
 // compareTeamMembers will check two user.TeamMember objects for equality.
@@ -195,6 +270,25 @@ func TestTeamStore_GetTeamMembers(t *testing.T) {
 	}
 }
 
+func TestTeamStore_ListTeamMembers(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewTeamStore(db)
+
+	teamID, _ := uuid.Parse("0195d46e-cfbf-7324-b9aa-4c9c78d3b722")
+
+	o := store.DefaultListOptions()
+	o.Name = "admin"
+
+	page, err := s.ListTeamMembers(t.Context(), teamID, o)
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if len(page.Items) != 1 || page.Items[0].Username != "adminuser" {
+		t.Errorf("expected only adminuser, got %+v", page.Items)
+	}
+}
+
 func TestTeamStore_AddTeamMember(t *testing.T) {
 	db := getDatabaseConnection(t)
 	s := NewTeamStore(db)
@@ -241,3 +335,122 @@ func TestTeamStore_RemoveTeamMember(t *testing.T) {
 		t.Errorf("expected team member to be removed, got %q", err)
 	}
 }
+
+func TestTeamStore_ListRobots(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewTeamStore(db)
+
+	teamID, _ := uuid.Parse("0195d46e-cfbf-7324-b9aa-4c9c78d3b722")
+
+	page, err := s.ListRobots(t.Context(), teamID, store.DefaultListOptions())
+	if err != nil {
+		t.Errorf("expected err to be nil, got %q", err)
+	}
+
+	if len(page.Items) != 1 {
+		t.Errorf("expected one robot, got %d", len(page.Items))
+	}
+}
+
+func TestTeamStore_GetRobot(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewTeamStore(db)
+
+	teamID, _ := uuid.Parse("0195d46e-cfbf-7324-b9aa-4c9c78d3b722")
+
+	t.Run("existing robot", func(t *testing.T) {
+		userID, _ := uuid.Parse("0199f1c2-4a10-7b3e-9d5c-1f2e3a4b5c6d")
+		createdAt, _ := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+
+		expectedRobot := user.Robot{
+			UserID:    userID,
+			TeamID:    teamID,
+			Name:      "ci",
+			Username:  "team-1+ci",
+			CreatedAt: createdAt,
+		}
+
+		robot, err := s.GetRobot(t.Context(), teamID, "ci")
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareRobots(expectedRobot, robot) {
+			t.Errorf("expected %+v, got %+v", expectedRobot, robot)
+		}
+
+		robot, err = s.GetRobotByUserID(t.Context(), userID)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareRobots(expectedRobot, robot) {
+			t.Errorf("expected %+v, got %+v", expectedRobot, robot)
+		}
+	})
+
+	t.Run("robot does not exist", func(t *testing.T) {
+		if _, err := s.GetRobot(t.Context(), teamID, "foo"); !errors.Is(err, user.ErrRobotNotFound) {
+			t.Errorf("expected %q, got %q", user.ErrRobotNotFound, err)
+		}
+
+		if _, err := s.GetRobotByUserID(t.Context(), uuid.Nil); !errors.Is(err, user.ErrRobotNotFound) {
+			t.Errorf("expected %q, got %q", user.ErrRobotNotFound, err)
+		}
+	})
+}
+
+func TestTeamStore_AddRobot(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewTeamStore(db)
+	userStore := NewUserStore(db)
+
+	teamID, _ := uuid.Parse("0195d46f-fde4-7b27-b542-e41ed0917ace")
+
+	robot := user.Robot{
+		UserID:    uuid.New(),
+		TeamID:    teamID,
+		Name:      "deploy",
+		Username:  "team-2+deploy",
+		CreatedAt: time.Now().Truncate(time.Microsecond),
+	}
+
+	u := user.User{
+		ID:        robot.UserID,
+		Username:  robot.Username,
+		Role:      user.RoleRobot,
+		CreatedAt: robot.CreatedAt,
+	}
+	if err := userStore.Create(t.Context(), u); err != nil {
+		t.Fatalf("could not create robot user: %q", err)
+	}
+
+	if err := s.AddRobot(t.Context(), robot); err != nil {
+		t.Errorf("expected nil, got %q", err)
+	}
+
+	saved, err := s.GetRobot(t.Context(), teamID, "deploy")
+	if err != nil {
+		t.Errorf("expected err to be nil, got %q", err)
+	}
+
+	if !compareRobots(robot, saved) {
+		t.Errorf("expected %+v, got %+v", robot, saved)
+	}
+}
+
+func TestTeamStore_RemoveRobot(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewTeamStore(db)
+
+	teamID, _ := uuid.Parse("0195d46e-cfbf-7324-b9aa-4c9c78d3b722")
+	userID, _ := uuid.Parse("0199f1c2-4a10-7b3e-9d5c-1f2e3a4b5c6d")
+
+	if err := s.RemoveRobot(t.Context(), teamID, userID); err != nil {
+		t.Errorf("expected nil, got %q", err)
+	}
+
+	if _, err := s.GetRobot(t.Context(), teamID, "ci"); !errors.Is(err, user.ErrRobotNotFound) {
+		t.Errorf("expected robot to be removed, got %q", err)
+	}
+}
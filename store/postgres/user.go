@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"github.com/evanebb/regauth/store"
 	"github.com/evanebb/regauth/user"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -17,32 +18,43 @@ func NewUserStore(db *pgxpool.Pool) UserStore {
 	return UserStore{TransactionStore{db: db}}
 }
 
-func (s UserStore) GetAll(ctx context.Context) ([]user.User, error) {
-	var users []user.User
+func (s UserStore) List(ctx context.Context, o store.ListOptions) (store.Page[user.User], error) {
+	// robots are managed through their team, and are not regular users
+	query, args, err := listQuery("SELECT id, username, role, disabled, identity_source, identity_subject, created_at FROM users WHERE role <> 'robot'", nil, o, "username", "created_at", "id")
+	if err != nil {
+		return store.Page[user.User]{}, err
+	}
 
-	query := "SELECT id, username, role, created_at FROM users"
-	rows, err := s.QuerierFromContext(ctx).Query(ctx, query)
+	rows, err := s.QuerierFromContext(ctx).Query(ctx, query, args...)
 	if err != nil {
-		return users, err
+		return store.Page[user.User]{}, err
 	}
 
-	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (user.User, error) {
+	users, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (user.User, error) {
 		var u user.User
+		var identitySource, identitySubject *string
 
-		err = rows.Scan(&u.ID, &u.Username, &u.Role, &u.CreatedAt)
+		err = rows.Scan(&u.ID, &u.Username, &u.Role, &u.Disabled, &identitySource, &identitySubject, &u.CreatedAt)
 		if err != nil {
 			return u, err
 		}
 
+		u.Identity = identityFromDatabase(identitySource, identitySubject)
 		return u, u.IsValid()
 	})
+	if err != nil {
+		return store.Page[user.User]{}, err
+	}
+
+	return store.NewPage(o, users, user.User.Position), nil
 }
 
 func (s UserStore) GetByID(ctx context.Context, id uuid.UUID) (user.User, error) {
 	var u user.User
+	var identitySource, identitySubject *string
 
-	query := "SELECT id, username, role, created_at FROM users WHERE id = $1"
-	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, id).Scan(&u.ID, &u.Username, &u.Role, &u.CreatedAt)
+	query := "SELECT id, username, role, disabled, identity_source, identity_subject, created_at FROM users WHERE id = $1"
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, id).Scan(&u.ID, &u.Username, &u.Role, &u.Disabled, &identitySource, &identitySubject, &u.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return u, user.ErrNotFound
@@ -51,14 +63,16 @@ func (s UserStore) GetByID(ctx context.Context, id uuid.UUID) (user.User, error)
 		return u, err
 	}
 
+	u.Identity = identityFromDatabase(identitySource, identitySubject)
 	return u, u.IsValid()
 }
 
 func (s UserStore) GetByUsername(ctx context.Context, username string) (user.User, error) {
 	var u user.User
+	var identitySource, identitySubject *string
 
-	query := "SELECT id, username, role, created_at FROM users WHERE username = $1"
-	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, username).Scan(&u.ID, &u.Username, &u.Role, &u.CreatedAt)
+	query := "SELECT id, username, role, disabled, identity_source, identity_subject, created_at FROM users WHERE username = $1"
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, username).Scan(&u.ID, &u.Username, &u.Role, &u.Disabled, &identitySource, &identitySubject, &u.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return u, user.ErrNotFound
@@ -67,6 +81,25 @@ func (s UserStore) GetByUsername(ctx context.Context, username string) (user.Use
 		return u, err
 	}
 
+	u.Identity = identityFromDatabase(identitySource, identitySubject)
+	return u, u.IsValid()
+}
+
+func (s UserStore) GetByIdentity(ctx context.Context, identity user.Identity) (user.User, error) {
+	var u user.User
+	var identitySource, identitySubject *string
+
+	query := "SELECT id, username, role, disabled, identity_source, identity_subject, created_at FROM users WHERE identity_source = $1 AND identity_subject = $2"
+	err := s.QuerierFromContext(ctx).QueryRow(ctx, query, identity.Source, identity.Subject).Scan(&u.ID, &u.Username, &u.Role, &u.Disabled, &identitySource, &identitySubject, &u.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return u, user.ErrNotFound
+		}
+
+		return u, err
+	}
+
+	u.Identity = identityFromDatabase(identitySource, identitySubject)
 	return u, u.IsValid()
 }
 
@@ -84,24 +117,69 @@ func (s UserStore) Create(ctx context.Context, u user.User) error {
 		return err
 	}
 
-	query := "INSERT INTO users (id, username, role, created_at) VALUES ($1, $2, $3, $4)"
-	if _, err = tx.Exec(ctx, query, u.ID, u.Username, u.Role, u.CreatedAt); err != nil {
-		_ = tx.Rollback(ctx)
-		return err
+	var identitySource, identitySubject *string
+	if !u.Identity.IsZero() {
+		identitySource, identitySubject = &u.Identity.Source, &u.Identity.Subject
 	}
 
-	nsQuery := "INSERT INTO namespaces (name, user_id) VALUES ($1, $2)"
-	if _, err := tx.Exec(ctx, nsQuery, u.Username, u.ID); err != nil {
+	query := "INSERT INTO users (id, username, role, disabled, identity_source, identity_subject, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)"
+	if _, err = tx.Exec(ctx, query, u.ID, u.Username, u.Role, u.Disabled, identitySource, identitySubject, u.CreatedAt); err != nil {
 		_ = tx.Rollback(ctx)
 		return err
 	}
 
+	if u.Role != user.RoleRobot {
+		// robots use the namespace of their team, so they do not get their own namespace
+		nsQuery := "INSERT INTO namespaces (name, user_id) VALUES ($1, $2)"
+		if _, err := tx.Exec(ctx, nsQuery, u.Username, u.ID); err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+	}
+
 	_ = tx.Commit(ctx)
 	return nil
 }
 
+func (s UserStore) UpdateRole(ctx context.Context, id uuid.UUID, role user.Role) error {
+	query := "UPDATE users SET role = $1 WHERE id = $2"
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, role, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return user.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s UserStore) SetDisabled(ctx context.Context, id uuid.UUID, disabled bool) error {
+	query := "UPDATE users SET disabled = $1 WHERE id = $2"
+	tag, err := s.QuerierFromContext(ctx).Exec(ctx, query, disabled, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return user.ErrNotFound
+	}
+
+	return nil
+}
+
 func (s UserStore) DeleteByID(ctx context.Context, id uuid.UUID) error {
 	query := "DELETE FROM users WHERE id = $1"
 	_, err := s.QuerierFromContext(ctx).Exec(ctx, query, id)
 	return err
 }
+
+// identityFromDatabase converts the nullable identity columns of a user, returning an empty identity for local users.
+func identityFromDatabase(source, subject *string) user.Identity {
+	if source == nil || subject == nil {
+		return user.Identity{}
+	}
+
+	return user.Identity{Source: *source, Subject: *subject}
+}
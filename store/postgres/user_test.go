@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"errors"
+	"github.com/evanebb/regauth/store"
 	"github.com/evanebb/regauth/user"
 	"github.com/google/uuid"
+	"slices"
 	"testing"
 	"time"
 )
@@ -14,22 +16,81 @@ func compareUsers(u1 user.User, u2 user.User) bool {
 	return u1.ID == u2.ID &&
 		u1.Username == u2.Username &&
 		u1.Role == u2.Role &&
+		u1.Identity == u2.Identity &&
 		u1.CreatedAt.Equal(u2.CreatedAt)
 }
 
-func TestUserStore_GetAll(t *testing.T) {
+func TestUserStore_List(t *testing.T) {
 	db := getDatabaseConnection(t)
 	s := NewUserStore(db)
 
-	users, err := s.GetAll(t.Context())
-	if err != nil {
-		t.Errorf("expected err to be nil, got %q", err)
-	}
+	t.Run("all users", func(t *testing.T) {
+		page, err := s.List(t.Context(), store.DefaultListOptions())
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
 
-	// the seeds create two users, so we expect there to be two
-	if len(users) != 2 {
-		t.Errorf("expected two users, got %d", len(users))
-	}
+		// the seeds create two users and a robot, which is not a regular user, so we expect there to be two
+		if len(page.Items) != 2 {
+			t.Errorf("expected two users, got %d", len(page.Items))
+		}
+
+		if page.Next != "" {
+			t.Errorf("expected no next page, got cursor %q", page.Next)
+		}
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		o := store.DefaultListOptions()
+		o.Limit = 1
+		o.Order = store.SortDescending
+
+		var usernames []user.Username
+		for {
+			page, err := s.List(t.Context(), o)
+			if err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			for _, u := range page.Items {
+				usernames = append(usernames, u.Username)
+			}
+
+			if page.Next == "" {
+				break
+			}
+
+			o.Cursor = page.Next
+		}
+
+		expected := []user.Username{"normaluser", "adminuser"}
+		if !slices.Equal(expected, usernames) {
+			t.Errorf("expected %v, got %v", expected, usernames)
+		}
+	})
+
+	t.Run("name filter", func(t *testing.T) {
+		o := store.DefaultListOptions()
+		o.Name = "NORMAL"
+
+		page, err := s.List(t.Context(), o)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if len(page.Items) != 1 || page.Items[0].Username != "normaluser" {
+			t.Errorf("expected only normaluser, got %+v", page.Items)
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		o := store.DefaultListOptions()
+		o.Cursor = "invalid"
+
+		if _, err := s.List(t.Context(), o); !errors.Is(err, store.ErrInvalidCursor) {
+			t.Errorf("expected %q, got %q", store.ErrInvalidCursor, err)
+		}
+	})
 }
 
 func TestUserStore_GetByID(t *testing.T) {
@@ -97,6 +158,48 @@ func TestUserStore_GetByUsername(t *testing.T) {
 	})
 }
 
+func TestUserStore_GetByIdentity(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewUserStore(db)
+
+	identity := user.Identity{Source: "https://idp.example.com", Subject: "1234"}
+	provisioned := user.User{
+		ID:        uuid.New(),
+		Username:  "provisioned",
+		Role:      user.RoleUser,
+		Identity:  identity,
+		CreatedAt: time.Now().Truncate(time.Microsecond),
+	}
+
+	if err := s.Create(t.Context(), provisioned); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	t.Run("linked user", func(t *testing.T) {
+		u, err := s.GetByIdentity(t.Context(), identity)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if !compareUsers(provisioned, u) {
+			t.Errorf("expected %+v, got %+v", provisioned, u)
+		}
+	})
+
+	t.Run("same subject in other identity source", func(t *testing.T) {
+		other := user.Identity{Source: "https://other.example.com", Subject: identity.Subject}
+		if _, err := s.GetByIdentity(t.Context(), other); !errors.Is(err, user.ErrNotFound) {
+			t.Errorf("expected %q, got %q", user.ErrNotFound, err)
+		}
+	})
+
+	t.Run("local users are not returned", func(t *testing.T) {
+		if _, err := s.GetByIdentity(t.Context(), user.Identity{}); !errors.Is(err, user.ErrNotFound) {
+			t.Errorf("expected %q, got %q", user.ErrNotFound, err)
+		}
+	})
+}
+
 func TestUserStore_Create(t *testing.T) {
 	db := getDatabaseConnection(t)
 	s := NewUserStore(db)
@@ -116,6 +219,67 @@ func TestUserStore_Create(t *testing.T) {
 	})
 }
 
+func TestUserStore_UpdateRole(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewUserStore(db)
+
+	userID, _ := uuid.Parse("0195cd11-2863-721e-a75c-86522539d0ee")
+
+	if err := s.UpdateRole(t.Context(), userID, user.RoleAdmin); err != nil {
+		t.Errorf("expected nil, got %q", err)
+	}
+
+	u, err := s.GetByID(t.Context(), userID)
+	if err != nil {
+		t.Errorf("expected user to exist, got %q", err)
+	}
+
+	if u.Role != user.RoleAdmin {
+		t.Errorf("expected role %q, got %q", user.RoleAdmin, u.Role)
+	}
+
+	if err := s.UpdateRole(t.Context(), uuid.New(), user.RoleAdmin); !errors.Is(err, user.ErrNotFound) {
+		t.Errorf("expected %q, got %q", user.ErrNotFound, err)
+	}
+}
+
+func TestUserStore_SetDisabled(t *testing.T) {
+	db := getDatabaseConnection(t)
+	s := NewUserStore(db)
+
+	userID, _ := uuid.Parse("0195cd11-2863-721e-a75c-86522539d0ee")
+
+	if err := s.SetDisabled(t.Context(), userID, true); err != nil {
+		t.Errorf("expected nil, got %q", err)
+	}
+
+	u, err := s.GetByID(t.Context(), userID)
+	if err != nil {
+		t.Errorf("expected user to exist, got %q", err)
+	}
+
+	if !u.Disabled {
+		t.Error("expected user to be disabled")
+	}
+
+	if err := s.SetDisabled(t.Context(), userID, false); err != nil {
+		t.Errorf("expected nil, got %q", err)
+	}
+
+	u, err = s.GetByID(t.Context(), userID)
+	if err != nil {
+		t.Errorf("expected user to exist, got %q", err)
+	}
+
+	if u.Disabled {
+		t.Error("expected user to be enabled")
+	}
+
+	if err := s.SetDisabled(t.Context(), uuid.New(), true); !errors.Is(err, user.ErrNotFound) {
+		t.Errorf("expected %q, got %q", user.ErrNotFound, err)
+	}
+}
+
 func TestUserStore_DeleteByID(t *testing.T) {
 	db := getDatabaseConnection(t)
 	s := NewUserStore(db)
@@ -6,6 +6,9 @@ var (
 	ErrNotFound          = errors.New("personal access token not found")
 	ErrAlreadyExists     = errors.New("personal access token already exists, cannot create it again")
 	ErrInvalidPermission = errors.New("permission is not valid, must be one of 'readOnly', 'readWrite', 'readWriteDelete'")
+
+	ErrInvalidUsageLogLimit     = errors.New("limit is not valid, must be between 1 and 1000")
+	ErrInvalidUsageLogTimeRange = errors.New("time range is not valid, start cannot be after end")
 )
 
 type InvalidDescriptionError string
@@ -13,3 +16,33 @@ type InvalidDescriptionError string
 func (e InvalidDescriptionError) Error() string {
 	return "invalid personal access token description: " + string(e)
 }
+
+type InvalidRepositoryPatternError string
+
+func (e InvalidRepositoryPatternError) Error() string {
+	return "invalid personal access token repository pattern: " + string(e)
+}
+
+type InvalidScopeError string
+
+func (e InvalidScopeError) Error() string {
+	return "invalid personal access token scope: " + string(e)
+}
+
+type InvalidCIDRError string
+
+func (e InvalidCIDRError) Error() string {
+	return "invalid personal access token CIDR range: " + string(e)
+}
+
+type InvalidGracePeriodError string
+
+func (e InvalidGracePeriodError) Error() string {
+	return "invalid personal access token rotation grace period: " + string(e)
+}
+
+type InvalidExpirationDateError string
+
+func (e InvalidExpirationDateError) Error() string {
+	return "invalid personal access token expiration date: " + string(e)
+}
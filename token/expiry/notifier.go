@@ -0,0 +1,20 @@
+package expiry
+
+import (
+	"context"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+)
+
+// Warning warns about a personal access token that is about to expire.
+type Warning struct {
+	Token token.PersonalAccessToken
+	Owner user.User
+}
+
+// Notifier sends warnings about personal access tokens that are about to expire.
+type Notifier interface {
+	// Name identifies the notifier, so that its deliveries are tracked separately from those of the other notifiers.
+	Name() string
+	Notify(ctx context.Context, w Warning) error
+}
@@ -0,0 +1,58 @@
+package expiry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTPNotifier sends warnings by email through an SMTP server. Since users do not have an email address, every warning
+// is sent to the same recipients.
+type SMTPNotifier struct {
+	addr string
+	auth smtp.Auth
+	from string
+	to   []string
+}
+
+// NewSMTPNotifier creates a new SMTPNotifier. If a username is given, it authenticates to the SMTP server using the
+// PLAIN mechanism, which is only allowed over TLS or to localhost.
+func NewSMTPNotifier(host string, port int, username, password, from string, to []string) SMTPNotifier {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return SMTPNotifier{
+		addr: net.JoinHostPort(host, strconv.Itoa(port)),
+		auth: auth,
+		from: from,
+		to:   to,
+	}
+}
+
+func (n SMTPNotifier) Name() string {
+	return "smtp"
+}
+
+func (n SMTPNotifier) Notify(ctx context.Context, w Warning) error {
+	var msg bytes.Buffer
+	_, _ = fmt.Fprintf(&msg, "From: %s\r\n", n.from)
+	_, _ = fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(n.to, ", "))
+	_, _ = fmt.Fprintf(&msg, "Subject: Personal access token %q of %s expires soon\r\n", w.Token.Description, w.Owner.Username)
+	_, _ = fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	_, _ = fmt.Fprint(&msg, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	_, _ = fmt.Fprintf(&msg, "The personal access token %q (%s) of %s expires at %s.\r\n", w.Token.Description, w.Token.ID, w.Owner.Username, w.Token.ExpirationDate.Format(time.RFC3339))
+	_, _ = fmt.Fprint(&msg, "Rotate the token or create a new one, and replace it everywhere it is used before then.\r\n")
+
+	if err := smtp.SendMail(n.addr, n.auth, n.from, n.to, msg.Bytes()); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
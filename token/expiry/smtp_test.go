@@ -0,0 +1,111 @@
+package expiry
+
+import (
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smtpMessage is a message received by the local SMTP server.
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+// startSMTPServer starts a minimal local SMTP server, which accepts a single message and sends it on the returned
+// channel.
+func startSMTPServer(t *testing.T) (string, int, <-chan smtpMessage) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	messages := make(chan smtpMessage, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		c := textproto.NewConn(conn)
+		_ = c.PrintfLine("220 localhost ready")
+
+		var msg smtpMessage
+		for {
+			line, err := c.ReadLine()
+			if err != nil {
+				return
+			}
+
+			command, arg, _ := strings.Cut(line, " ")
+			switch strings.ToUpper(command) {
+			case "EHLO", "HELO":
+				_ = c.PrintfLine("250 localhost")
+			case "MAIL":
+				msg.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+				_ = c.PrintfLine("250 OK")
+			case "RCPT":
+				msg.to = append(msg.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+				_ = c.PrintfLine("250 OK")
+			case "DATA":
+				_ = c.PrintfLine("354 end data with <CR><LF>.<CR><LF>")
+				data, err := c.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				msg.data = string(data)
+				_ = c.PrintfLine("250 OK")
+				messages <- msg
+			case "QUIT":
+				_ = c.PrintfLine("221 bye")
+				return
+			default:
+				_ = c.PrintfLine("502 command not implemented")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return host, p, messages
+}
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	host, port, messages := startSMTPServer(t)
+
+	warning := Warning{
+		Token: token.PersonalAccessToken{ID: uuid.New(), Description: "ci", ExpirationDate: time.Now().Add(time.Hour)},
+		Owner: user.User{Username: "owner"},
+	}
+
+	n := NewSMTPNotifier(host, port, "", "", "regauth@example.com", []string{"platform@example.com"})
+	if err := n.Notify(t.Context(), warning); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	msg := <-messages
+	if msg.from != "regauth@example.com" {
+		t.Errorf("expected message from regauth@example.com, got %q", msg.from)
+	}
+
+	if len(msg.to) != 1 || msg.to[0] != "platform@example.com" {
+		t.Errorf("expected message to platform@example.com, got %q", msg.to)
+	}
+
+	if !strings.Contains(msg.data, warning.Token.ID.String()) || !strings.Contains(msg.data, "owner") {
+		t.Errorf("expected message to mention the token and its owner, got %q", msg.data)
+	}
+}
@@ -0,0 +1,113 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// Warner warns about personal access tokens that are about to expire through one or more notifiers.
+type Warner struct {
+	logger     *slog.Logger
+	tokenStore token.Store
+	userStore  user.Store
+	before     time.Duration
+	notifiers  []Notifier
+}
+
+// NewWarner creates a new Warner, which warns about tokens the given time before they expire.
+func NewWarner(logger *slog.Logger, tokenStore token.Store, userStore user.Store, before time.Duration, notifiers ...Notifier) Warner {
+	return Warner{logger: logger, tokenStore: tokenStore, userStore: userStore, before: before, notifiers: notifiers}
+}
+
+// Run warns about expiring tokens immediately, and then on every interval until the context is cancelled.
+func (w Warner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Warn(ctx); err != nil {
+			w.logger.ErrorContext(ctx, "failed to warn about expiring tokens", slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Warn sends a warning through every notifier for each token that is about to expire, once. The delivery is recorded
+// per notifier, so if any notifier fails, only that notifier warns about the token again the next time.
+func (w Warner) Warn(ctx context.Context) error {
+	now := time.Now()
+	tokens, err := w.tokenStore.GetExpiring(ctx, now, now.Add(w.before))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	warned := 0
+	for _, t := range tokens {
+		owner, err := w.userStore.GetByID(ctx, t.UserID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get owner of token %s: %w", t.ID, err))
+			continue
+		}
+
+		// the tokens of disabled users cannot be used anyway
+		if owner.Disabled {
+			continue
+		}
+
+		if err := w.notify(ctx, Warning{Token: t, Owner: owner}, now); err != nil {
+			errs = append(errs, fmt.Errorf("failed to warn about token %s: %w", t.ID, err))
+			continue
+		}
+
+		if err := w.tokenStore.SetExpiryWarned(ctx, t.ID, now); err != nil {
+			errs = append(errs, fmt.Errorf("failed to record warning about token %s: %w", t.ID, err))
+			continue
+		}
+
+		warned++
+	}
+
+	if warned > 0 {
+		w.logger.InfoContext(ctx, "warned about expiring tokens", slog.Int("tokens", warned))
+	}
+
+	return errors.Join(errs...)
+}
+
+// notify sends the warning through the notifiers that have not delivered it yet, and records each delivery.
+func (w Warner) notify(ctx context.Context, warning Warning, now time.Time) error {
+	delivered, err := w.tokenStore.GetExpiryWarningDeliveries(ctx, warning.Token.ID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, n := range w.notifiers {
+		if slices.Contains(delivered, n.Name()) {
+			continue
+		}
+
+		if err := n.Notify(ctx, warning); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Name(), err))
+			continue
+		}
+
+		if err := w.tokenStore.SetExpiryWarningDelivered(ctx, warning.Token.ID, n.Name(), now); err != nil {
+			errs = append(errs, fmt.Errorf("failed to record delivery through %s: %w", n.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,156 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingNotifier records the warnings that it receives, and optionally fails to send them.
+type recordingNotifier struct {
+	name     string
+	mu       sync.Mutex
+	warnings []Warning
+	err      error
+}
+
+func (n *recordingNotifier) Name() string {
+	return n.name
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, w Warning) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.err != nil {
+		return n.err
+	}
+
+	n.warnings = append(n.warnings, w)
+	return nil
+}
+
+// newStoresWithTokens creates stores containing an enabled and a disabled user, which both have a token expiring in
+// an hour, and the enabled user also has a token expiring in a month and a revoked token expiring in an hour.
+// It returns the ID of the only token that should be warned about within a day.
+func newStoresWithTokens(t *testing.T) (*memory.PersonalAccessTokenStore, *memory.UserStore, uuid.UUID) {
+	t.Helper()
+
+	tokenStore := memory.NewPersonalAccessTokenStore()
+	userStore := memory.NewUserStore()
+	now := time.Now()
+
+	owner := user.User{ID: uuid.New(), Username: "owner", Role: user.RoleUser}
+	disabled := user.User{ID: uuid.New(), Username: "disabled", Role: user.RoleUser, Disabled: true}
+	for _, u := range []user.User{owner, disabled} {
+		if err := userStore.Create(t.Context(), u); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+	}
+
+	expiring := token.PersonalAccessToken{ID: uuid.New(), Description: "expiring", Permission: token.PermissionReadOnly, ExpirationDate: now.Add(time.Hour), UserID: owner.ID}
+	tokens := map[string]token.PersonalAccessToken{
+		"registry_pat_expiring": expiring,
+		"registry_pat_later":    {ID: uuid.New(), Description: "later", Permission: token.PermissionReadOnly, ExpirationDate: now.AddDate(0, 1, 0), UserID: owner.ID},
+		"registry_pat_revoked":  {ID: uuid.New(), Description: "revoked", Permission: token.PermissionReadOnly, ExpirationDate: now.Add(time.Hour), RevokedAt: now, UserID: owner.ID},
+		"registry_pat_disabled": {ID: uuid.New(), Description: "disabled", Permission: token.PermissionReadOnly, ExpirationDate: now.Add(time.Hour), UserID: disabled.ID},
+	}
+
+	for plainTextToken, pat := range tokens {
+		if err := tokenStore.Create(t.Context(), pat, plainTextToken); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+	}
+
+	return tokenStore, userStore, expiring.ID
+}
+
+func TestWarner_Warn(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("warns once", func(t *testing.T) {
+		t.Parallel()
+
+		tokenStore, userStore, tokenID := newStoresWithTokens(t)
+		n := &recordingNotifier{name: "recording"}
+		w := NewWarner(logger, tokenStore, userStore, 24*time.Hour, n)
+
+		for range 2 {
+			if err := w.Warn(t.Context()); err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+		}
+
+		if len(n.warnings) != 1 {
+			t.Fatalf("expected one warning, got %d", len(n.warnings))
+		}
+
+		if n.warnings[0].Token.ID != tokenID {
+			t.Errorf("expected warning about token %s, got %s", tokenID, n.warnings[0].Token.ID)
+		}
+
+		if n.warnings[0].Owner.Username != "owner" {
+			t.Errorf("expected warning for owner, got %s", n.warnings[0].Owner.Username)
+		}
+	})
+
+	t.Run("failing notifier", func(t *testing.T) {
+		t.Parallel()
+
+		tokenStore, userStore, _ := newStoresWithTokens(t)
+		failing := &recordingNotifier{name: "failing", err: errors.New("unavailable")}
+		w := NewWarner(logger, tokenStore, userStore, 24*time.Hour, failing)
+
+		if err := w.Warn(t.Context()); err == nil {
+			t.Fatal("expected err to not be nil")
+		}
+
+		// the token is warned about again once the notifier works
+		failing.err = nil
+		if err := w.Warn(t.Context()); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+
+		if len(failing.warnings) != 1 {
+			t.Errorf("expected one warning, got %d", len(failing.warnings))
+		}
+	})
+
+	t.Run("failing notifier does not cause duplicates from other notifiers", func(t *testing.T) {
+		t.Parallel()
+
+		tokenStore, userStore, _ := newStoresWithTokens(t)
+		working := &recordingNotifier{name: "working"}
+		failing := &recordingNotifier{name: "failing", err: errors.New("unavailable")}
+		w := NewWarner(logger, tokenStore, userStore, 24*time.Hour, working, failing)
+
+		if err := w.Warn(t.Context()); err == nil {
+			t.Fatal("expected err to not be nil")
+		}
+
+		failing.err = nil
+		for range 2 {
+			if err := w.Warn(t.Context()); err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+		}
+
+		if len(working.warnings) != 1 {
+			t.Errorf("expected one warning from working notifier, got %d", len(working.warnings))
+		}
+
+		if len(failing.warnings) != 1 {
+			t.Errorf("expected one warning from failing notifier, got %d", len(failing.warnings))
+		}
+	})
+}
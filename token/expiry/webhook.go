@@ -0,0 +1,73 @@
+package expiry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+// WebhookNotifier sends warnings as JSON to a webhook.
+type WebhookNotifier struct {
+	client *http.Client
+	url    string
+	secret string
+}
+
+// NewWebhookNotifier creates a new WebhookNotifier. If a secret is given, it is sent as a bearer token in the
+// Authorization header of each request.
+func NewWebhookNotifier(url, secret string) WebhookNotifier {
+	return WebhookNotifier{
+		client: &http.Client{Timeout: 10 * time.Second},
+		url:    url,
+		secret: secret,
+	}
+}
+
+type webhookPayload struct {
+	TokenID        uuid.UUID `json:"tokenId"`
+	Description    string    `json:"description"`
+	Owner          string    `json:"owner"`
+	ExpirationDate time.Time `json:"expirationDate"`
+}
+
+func (n WebhookNotifier) Name() string {
+	return "webhook"
+}
+
+func (n WebhookNotifier) Notify(ctx context.Context, w Warning) error {
+	body, err := json.Marshal(webhookPayload{
+		TokenID:        w.Token.ID,
+		Description:    string(w.Token.Description),
+		Owner:          string(w.Owner.Username),
+		ExpirationDate: w.Token.ExpirationDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if n.secret != "" {
+		req.Header.Set("Authorization", "Bearer "+n.secret)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package expiry
+
+import (
+	"encoding/json"
+	"github.com/evanebb/regauth/token"
+	"github.com/evanebb/regauth/user"
+	"github.com/google/uuid"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	var (
+		authorization string
+		payload       webhookPayload
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	warning := Warning{
+		Token: token.PersonalAccessToken{ID: uuid.New(), Description: "ci", ExpirationDate: time.Now().Add(time.Hour).Truncate(time.Second)},
+		Owner: user.User{Username: "owner"},
+	}
+
+	n := NewWebhookNotifier(server.URL, "secret")
+	if err := n.Notify(t.Context(), warning); err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	if authorization != "Bearer secret" {
+		t.Errorf("expected secret to be sent as bearer token, got %q", authorization)
+	}
+
+	if payload.TokenID != warning.Token.ID || payload.Description != "ci" || payload.Owner != "owner" || !payload.ExpirationDate.Equal(warning.Token.ExpirationDate) {
+		t.Errorf("unexpected payload %+v", payload)
+	}
+
+	t.Run("unexpected status code", func(t *testing.T) {
+		t.Parallel()
+
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		t.Cleanup(failing.Close)
+
+		if err := NewWebhookNotifier(failing.URL, "").Notify(t.Context(), warning); err == nil {
+			t.Error("expected err to not be nil")
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package token
+
+import "time"
+
+// LifetimePolicy limits how long personal access tokens can be valid for. Every token has to expire, so the expiration
+// date of a new token always has to be in the future.
+type LifetimePolicy struct {
+	// MaxLifetime is the maximum time between creating a token and its expiration date. Zero means that there is no
+	// maximum lifetime.
+	MaxLifetime time.Duration
+}
+
+// Restrict returns a policy that does not allow tokens to be valid for longer than the given maximum lifetime, in
+// addition to the policy itself. A maximum lifetime of zero does not restrict the policy.
+func (p LifetimePolicy) Restrict(maxLifetime time.Duration) LifetimePolicy {
+	if maxLifetime > 0 && (p.MaxLifetime == 0 || maxLifetime < p.MaxLifetime) {
+		p.MaxLifetime = maxLifetime
+	}
+
+	return p
+}
+
+// Allows checks whether the given token may be created with its expiration date.
+func (p LifetimePolicy) Allows(t PersonalAccessToken) error {
+	if !t.ExpirationDate.After(t.CreatedAt) {
+		return InvalidExpirationDateError("expiration date must be in the future")
+	}
+
+	if p.MaxLifetime > 0 && t.ExpirationDate.After(t.CreatedAt.Add(p.MaxLifetime)) {
+		return InvalidExpirationDateError("expiration date cannot be more than " + p.MaxLifetime.String() + " in the future")
+	}
+
+	return nil
+}
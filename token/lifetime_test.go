@@ -0,0 +1,66 @@
+package token
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLifetimePolicy_Restrict(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc        string
+		policy      LifetimePolicy
+		maxLifetime time.Duration
+		expected    time.Duration
+	}{
+		{"shorter lifetime", LifetimePolicy{MaxLifetime: 90 * 24 * time.Hour}, 30 * 24 * time.Hour, 30 * 24 * time.Hour},
+		{"longer lifetime", LifetimePolicy{MaxLifetime: 30 * 24 * time.Hour}, 90 * 24 * time.Hour, 30 * 24 * time.Hour},
+		{"no maximum lifetime", LifetimePolicy{}, 30 * 24 * time.Hour, 30 * 24 * time.Hour},
+		{"no restriction", LifetimePolicy{MaxLifetime: 30 * 24 * time.Hour}, 0, 30 * 24 * time.Hour},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			if actual := c.policy.Restrict(c.maxLifetime).MaxLifetime; actual != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLifetimePolicy_Allows(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	p := LifetimePolicy{MaxLifetime: 30 * 24 * time.Hour}
+
+	testCases := []struct {
+		desc           string
+		policy         LifetimePolicy
+		expirationDate time.Time
+		valid          bool
+	}{
+		{"within maximum lifetime", p, now.Add(24 * time.Hour), true},
+		{"exactly maximum lifetime", p, now.Add(30 * 24 * time.Hour), true},
+		{"exceeds maximum lifetime", p, now.Add(31 * 24 * time.Hour), false},
+		{"no maximum lifetime", LifetimePolicy{}, now.AddDate(30, 0, 0), true},
+		{"expiration date in the past", LifetimePolicy{}, now.Add(-time.Hour), false},
+		{"no expiration date", LifetimePolicy{}, time.Time{}, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.policy.Allows(PersonalAccessToken{ExpirationDate: c.expirationDate, CreatedAt: now})
+			if c.valid && err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			var expirationDateErr InvalidExpirationDateError
+			if !c.valid && !errors.As(err, &expirationDateErr) {
+				t.Errorf("expected InvalidExpirationDateError, got %q", err)
+			}
+		})
+	}
+}
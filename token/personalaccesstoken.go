@@ -1,19 +1,35 @@
 package token
 
 import (
+	"github.com/evanebb/regauth/store"
 	"github.com/google/uuid"
 	"net"
+	"path"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 )
 
 type PersonalAccessToken struct {
-	ID             uuid.UUID
-	Description    Description
-	Permission     Permission
+	ID          uuid.UUID
+	Description Description
+	Permission  Permission
+	// Repositories optionally restricts the token to the repositories matching one of the given patterns.
+	// If it is empty, the token is not restricted to any repositories.
+	Repositories []RepositoryPattern
+	// Scopes determine which operations of the API the token may be used for. A token without any scopes can only be
+	// used to log in to the registry.
+	Scopes []Scope
+	// AllowedCIDRs optionally restricts the source IP addresses that the token can be used from.
+	// If it is empty, the token can be used from any address.
+	AllowedCIDRs   []CIDR
 	ExpirationDate time.Time
-	UserID         uuid.UUID
-	CreatedAt      time.Time
+	// RevokedAt is the time that the token was revoked, or the zero time if it has not been revoked. Revoked tokens
+	// cannot be used anymore, but are kept along with their usage log.
+	RevokedAt time.Time
+	UserID    uuid.UUID
+	CreatedAt time.Time
 }
 
 func (t PersonalAccessToken) IsValid() error {
@@ -25,9 +41,126 @@ func (t PersonalAccessToken) IsValid() error {
 		return err
 	}
 
+	for _, r := range t.Repositories {
+		if err := r.IsValid(); err != nil {
+			return err
+		}
+	}
+
+	for _, sc := range t.Scopes {
+		if err := sc.IsValid(); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range t.AllowedCIDRs {
+		if err := c.IsValid(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// Position returns the position of the token in a listing, which is sorted on the description.
+func (t PersonalAccessToken) Position() store.Position {
+	return store.Position{Name: string(t.Description), CreatedAt: t.CreatedAt, ID: t.ID}
+}
+
+// AllowsRepository checks whether the token may be used to access the given repository, formatted as 'namespace/name'.
+func (t PersonalAccessToken) AllowsRepository(repository string) bool {
+	if len(t.Repositories) == 0 {
+		return true
+	}
+
+	for _, r := range t.Repositories {
+		if r.Matches(repository) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowsNamespace checks whether the token may be used to access every repository in the given namespace, which
+// requires a pattern matching any name in it, like 'namespace/*'.
+func (t PersonalAccessToken) AllowsNamespace(namespace string) bool {
+	if len(t.Repositories) == 0 {
+		return true
+	}
+
+	for _, r := range t.Repositories {
+		namespacePattern, name, _ := strings.Cut(string(r), "/")
+		if matched, err := path.Match(namespacePattern, namespace); err == nil && matched && strings.Trim(name, "*") == "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CoversRepositoryPattern checks whether every repository matching the given pattern may be accessed with the token.
+// Since glob patterns cannot be compared in general, a pattern containing wildcards is only covered by the same pattern.
+func (t PersonalAccessToken) CoversRepositoryPattern(p RepositoryPattern) bool {
+	if len(t.Repositories) == 0 {
+		return true
+	}
+
+	for _, r := range t.Repositories {
+		if r == p || (!p.hasWildcards() && r.Matches(string(p))) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowsSourceIP checks whether the token may be used from the given IP address. A token that is restricted to certain
+// CIDR ranges cannot be used if the address is unknown.
+func (t PersonalAccessToken) AllowsSourceIP(ip net.IP) bool {
+	if len(t.AllowedCIDRs) == 0 {
+		return true
+	}
+
+	for _, c := range t.AllowedCIDRs {
+		if c.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CoversCIDR checks whether the token may be used from every address in the given range.
+func (t PersonalAccessToken) CoversCIDR(c CIDR) bool {
+	if len(t.AllowedCIDRs) == 0 {
+		return true
+	}
+
+	for _, a := range t.AllowedCIDRs {
+		if a.ContainsRange(c) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsExpired checks whether the token has expired at the given time.
+func (t PersonalAccessToken) IsExpired(now time.Time) bool {
+	return t.ExpirationDate.Before(now)
+}
+
+// IsRevoked checks whether the token has been revoked.
+func (t PersonalAccessToken) IsRevoked() bool {
+	return !t.RevokedAt.IsZero()
+}
+
+// HasScope checks whether the token may be used for API operations requiring the given scope.
+func (t PersonalAccessToken) HasScope(s Scope) bool {
+	return slices.Contains(t.Scopes, s)
+}
+
 type Description string
 
 var validDescription = regexp.MustCompile(`^[a-zA-Z0-9-_ ]+$`)
@@ -49,6 +182,68 @@ func (d Description) IsValid() error {
 	return nil
 }
 
+// RepositoryPattern is a repository name formatted as 'namespace/name', in which both parts may contain glob patterns
+// as supported by path.Match, for example 'myteam/*'. The name may consist of multiple path components, and since a
+// wildcard does not match slashes, nested repositories have to be matched per level, for example 'myteam/payments/*'.
+type RepositoryPattern string
+
+func (r RepositoryPattern) IsValid() error {
+	namespace, name, ok := strings.Cut(string(r), "/")
+	if !ok || namespace == "" || name == "" {
+		return InvalidRepositoryPatternError(`repository pattern must be formatted as "namespace/name"`)
+	}
+
+	if _, err := path.Match(string(r), ""); err != nil {
+		return InvalidRepositoryPatternError("repository pattern is malformed")
+	}
+
+	return nil
+}
+
+// Matches checks whether the given repository, formatted as 'namespace/name', matches the pattern.
+func (r RepositoryPattern) Matches(repository string) bool {
+	matched, err := path.Match(string(r), repository)
+	return err == nil && matched
+}
+
+func (r RepositoryPattern) hasWildcards() bool {
+	return strings.ContainsAny(string(r), `*?[\`)
+}
+
+// CIDR is a range of IP addresses in CIDR notation, for example '10.0.0.0/8' or '2001:db8::/32'.
+type CIDR string
+
+func (c CIDR) IsValid() error {
+	if _, _, err := net.ParseCIDR(string(c)); err != nil {
+		return InvalidCIDRError(c)
+	}
+
+	return nil
+}
+
+// Contains checks whether the given IP address is part of the range.
+func (c CIDR) Contains(ip net.IP) bool {
+	_, n, err := net.ParseCIDR(string(c))
+	return err == nil && ip != nil && n.Contains(ip)
+}
+
+// ContainsRange checks whether every IP address in the given range is part of the range.
+func (c CIDR) ContainsRange(o CIDR) bool {
+	_, n, err := net.ParseCIDR(string(c))
+	if err != nil {
+		return false
+	}
+
+	_, other, err := net.ParseCIDR(string(o))
+	if err != nil {
+		return false
+	}
+
+	ones, bits := n.Mask.Size()
+	otherOnes, otherBits := other.Mask.Size()
+	return bits == otherBits && ones <= otherOnes && n.Contains(other.IP)
+}
+
 type Permission string
 
 const (
@@ -79,9 +274,63 @@ func (p Permission) GetAllowedActions() []string {
 	return a
 }
 
+// Includes checks whether the permission allows every action that is allowed by the other permission.
+func (p Permission) Includes(o Permission) bool {
+	allowed := p.GetAllowedActions()
+	for _, a := range o.GetAllowedActions() {
+		if !slices.Contains(allowed, a) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Scope grants access to a group of operations of the API.
+type Scope string
+
+const (
+	ScopeRepositoriesRead  = Scope("repositories:read")
+	ScopeRepositoriesWrite = Scope("repositories:write")
+	ScopeTokensRead        = Scope("tokens:read")
+	ScopeTokensWrite       = Scope("tokens:write")
+	ScopeTeamsRead         = Scope("teams:read")
+	ScopeTeamsAdmin        = Scope("teams:admin")
+	ScopeUsersRead         = Scope("users:read")
+	ScopeUsersAdmin        = Scope("users:admin")
+	ScopeAuditRead         = Scope("audit:read")
+)
+
+// Scopes contains all valid scopes.
+var Scopes = []Scope{
+	ScopeRepositoriesRead,
+	ScopeRepositoriesWrite,
+	ScopeTokensRead,
+	ScopeTokensWrite,
+	ScopeTeamsRead,
+	ScopeTeamsAdmin,
+	ScopeUsersRead,
+	ScopeUsersAdmin,
+	ScopeAuditRead,
+}
+
+func (s Scope) IsValid() error {
+	if !slices.Contains(Scopes, s) {
+		return InvalidScopeError(s)
+	}
+
+	return nil
+}
+
 // UsageLogEntry is a single entry in the usage log of a PersonalAccessToken.
 type UsageLogEntry struct {
 	TokenID   uuid.UUID
 	SourceIP  net.IP
 	Timestamp time.Time
 }
+
+// Position returns the position of the entry in the usage log, which is sorted on the timestamp of the entries.
+// Entries do not have an ID, so entries with the same timestamp are ordered on their source IP address instead.
+func (e UsageLogEntry) Position() store.Position {
+	return store.Position{Name: e.SourceIP.String(), CreatedAt: e.Timestamp}
+}
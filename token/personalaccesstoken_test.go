@@ -2,6 +2,7 @@ package token
 
 import (
 	"errors"
+	"net"
 	"slices"
 	"strings"
 	"testing"
@@ -18,6 +19,11 @@ func TestPersonalAccessToken_IsValid(t *testing.T) {
 		{"valid token", PersonalAccessToken{Description: Description("description"), Permission: PermissionReadOnly}, nil},
 		{"invalid description", PersonalAccessToken{Description: Description("a"), Permission: PermissionReadOnly}, InvalidDescriptionError("description cannot be shorter than 2 characters")},
 		{"invalid permission", PersonalAccessToken{Description: Description("description"), Permission: Permission("invalid")}, ErrInvalidPermission},
+		{"invalid repository pattern", PersonalAccessToken{Description: Description("description"), Permission: PermissionReadOnly, Repositories: []RepositoryPattern{"invalid"}}, InvalidRepositoryPatternError(`repository pattern must be formatted as "namespace/name"`)},
+		{"valid scopes", PersonalAccessToken{Description: Description("description"), Permission: PermissionReadOnly, Scopes: []Scope{ScopeRepositoriesRead, ScopeTokensWrite}}, nil},
+		{"invalid scope", PersonalAccessToken{Description: Description("description"), Permission: PermissionReadOnly, Scopes: []Scope{"repositories:delete"}}, InvalidScopeError("repositories:delete")},
+		{"valid allowed CIDRs", PersonalAccessToken{Description: Description("description"), Permission: PermissionReadOnly, AllowedCIDRs: []CIDR{"10.0.0.0/8", "2001:db8::/32"}}, nil},
+		{"invalid allowed CIDR", PersonalAccessToken{Description: Description("description"), Permission: PermissionReadOnly, AllowedCIDRs: []CIDR{"10.0.0.1"}}, InvalidCIDRError("10.0.0.1")},
 	}
 
 	for _, c := range testCases {
@@ -108,3 +114,250 @@ func TestPermission_GetAllowedActions(t *testing.T) {
 		})
 	}
 }
+
+func TestPermission_Includes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		permission Permission
+		other      Permission
+		expected   bool
+	}{
+		{PermissionReadOnly, PermissionReadOnly, true},
+		{PermissionReadOnly, PermissionReadWrite, false},
+		{PermissionReadWrite, PermissionReadOnly, true},
+		{PermissionReadWrite, PermissionReadWriteDelete, false},
+		{PermissionReadWriteDelete, PermissionReadWrite, true},
+	}
+
+	for _, c := range testCases {
+		t.Run(string(c.permission)+" "+string(c.other), func(t *testing.T) {
+			if actual := c.permission.Includes(c.other); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPersonalAccessToken_AllowsRepository(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc         string
+		repositories []RepositoryPattern
+		repository   string
+		expected     bool
+	}{
+		{"unrestricted token", nil, "alice/app", true},
+		{"exact match", []RepositoryPattern{"alice/app"}, "alice/app", true},
+		{"no match", []RepositoryPattern{"alice/app"}, "alice/other", false},
+		{"namespace glob match", []RepositoryPattern{"alice/*"}, "alice/other", true},
+		{"namespace glob does not match other namespace", []RepositoryPattern{"alice/*"}, "bob/app", false},
+		{"second pattern matches", []RepositoryPattern{"alice/app", "ci-*/build"}, "ci-team/build", true},
+		{"namespace glob does not match nested repository", []RepositoryPattern{"alice/*"}, "alice/app/worker", false},
+		{"nested glob match", []RepositoryPattern{"alice/app/*"}, "alice/app/worker", true},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual := PersonalAccessToken{Repositories: c.repositories}.AllowsRepository(c.repository)
+			if actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPersonalAccessToken_AllowsNamespace(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc         string
+		repositories []RepositoryPattern
+		namespace    string
+		expected     bool
+	}{
+		{"unrestricted token", nil, "alice", true},
+		{"namespace glob", []RepositoryPattern{"alice/*"}, "alice", true},
+		{"glob on every namespace", []RepositoryPattern{"*/*"}, "alice", true},
+		{"namespace glob of other namespace", []RepositoryPattern{"bob/*"}, "alice", false},
+		{"single repository", []RepositoryPattern{"alice/app"}, "alice", false},
+		{"partial name glob", []RepositoryPattern{"alice/app-*"}, "alice", false},
+		{"single character glob", []RepositoryPattern{"alice/?"}, "alice", false},
+		{"second pattern matches", []RepositoryPattern{"alice/app", "alice/*"}, "alice", true},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual := PersonalAccessToken{Repositories: c.repositories}.AllowsNamespace(c.namespace)
+			if actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRepositoryPattern_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc    string
+		pattern string
+		err     error
+	}{
+		{"valid repository", "alice/app", nil},
+		{"valid glob pattern", "alice/*", nil},
+		{"missing name", "alice", InvalidRepositoryPatternError(`repository pattern must be formatted as "namespace/name"`)},
+		{"empty name", "alice/", InvalidRepositoryPatternError(`repository pattern must be formatted as "namespace/name"`)},
+		{"nested name", "alice/app/*", nil},
+		{"malformed pattern", "alice/[app", InvalidRepositoryPatternError("repository pattern is malformed")},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := RepositoryPattern(c.pattern).IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestPersonalAccessToken_HasScope(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		scopes   []Scope
+		scope    Scope
+		expected bool
+	}{
+		{"token without scopes", nil, ScopeRepositoriesRead, false},
+		{"token with scope", []Scope{ScopeRepositoriesRead}, ScopeRepositoriesRead, true},
+		{"token with other scopes", []Scope{ScopeRepositoriesRead, ScopeTokensRead}, ScopeRepositoriesWrite, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual := PersonalAccessToken{Scopes: c.scopes}.HasScope(c.scope)
+			if actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPersonalAccessToken_CoversRepositoryPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc         string
+		repositories []RepositoryPattern
+		pattern      RepositoryPattern
+		expected     bool
+	}{
+		{"unrestricted token", nil, "*/*", true},
+		{"same repository", []RepositoryPattern{"alice/app"}, "alice/app", true},
+		{"other repository", []RepositoryPattern{"alice/app"}, "alice/other", false},
+		{"repository matching glob", []RepositoryPattern{"alice/*"}, "alice/app", true},
+		{"same glob", []RepositoryPattern{"alice/*"}, "alice/*", true},
+		{"glob in other namespace", []RepositoryPattern{"alice/*"}, "bob/*", false},
+		{"broader glob", []RepositoryPattern{"alice/app"}, "alice/*", false},
+		{"narrower glob is not compared", []RepositoryPattern{"alice/*"}, "alice/app-*", false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual := PersonalAccessToken{Repositories: c.repositories}.CoversRepositoryPattern(c.pattern)
+			if actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPersonalAccessToken_CoversCIDR(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		cidrs    []CIDR
+		cidr     CIDR
+		expected bool
+	}{
+		{"unrestricted token", nil, "0.0.0.0/0", true},
+		{"same range", []CIDR{"10.0.0.0/8"}, "10.0.0.0/8", true},
+		{"narrower range", []CIDR{"10.0.0.0/8"}, "10.1.0.0/16", true},
+		{"single address", []CIDR{"10.0.0.0/8"}, "10.1.2.3/32", true},
+		{"broader range", []CIDR{"10.1.0.0/16"}, "10.0.0.0/8", false},
+		{"other range", []CIDR{"10.0.0.0/8"}, "192.168.0.0/16", false},
+		{"range in second allowed range", []CIDR{"10.0.0.0/8", "192.168.0.0/16"}, "192.168.1.0/24", true},
+		{"IPv6 range", []CIDR{"2001:db8::/32"}, "2001:db8:1::/48", true},
+		{"IPv6 range is not part of IPv4 range", []CIDR{"0.0.0.0/0"}, "::/0", false},
+		{"invalid range", []CIDR{"10.0.0.0/8"}, "10.0.0.0", false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual := PersonalAccessToken{AllowedCIDRs: c.cidrs}.CoversCIDR(c.cidr)
+			if actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPersonalAccessToken_AllowsSourceIP(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		cidrs    []CIDR
+		ip       net.IP
+		expected bool
+	}{
+		{"unrestricted token", nil, net.ParseIP("192.168.1.10"), true},
+		{"unrestricted token with unknown address", nil, nil, true},
+		{"address in range", []CIDR{"192.168.1.0/24"}, net.ParseIP("192.168.1.10"), true},
+		{"address not in range", []CIDR{"192.168.1.0/24"}, net.ParseIP("192.168.2.10"), false},
+		{"address in second range", []CIDR{"192.168.1.0/24", "10.0.0.0/8"}, net.ParseIP("10.1.2.3"), true},
+		{"single address", []CIDR{"10.0.0.1/32"}, net.ParseIP("10.0.0.1"), true},
+		{"IPv6 address in range", []CIDR{"2001:db8::/32"}, net.ParseIP("2001:db8::1"), true},
+		{"IPv4-mapped IPv6 address in range", []CIDR{"192.168.1.0/24"}, net.ParseIP("::ffff:192.168.1.10"), true},
+		{"restricted token with unknown address", []CIDR{"0.0.0.0/0"}, nil, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual := PersonalAccessToken{AllowedCIDRs: c.cidrs}.AllowsSourceIP(c.ip)
+			if actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCIDR_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc string
+		cidr string
+		err  error
+	}{
+		{"valid IPv4 range", "10.0.0.0/8", nil},
+		{"valid IPv6 range", "2001:db8::/32", nil},
+		{"missing prefix length", "10.0.0.1", InvalidCIDRError("10.0.0.1")},
+		{"invalid prefix length", "10.0.0.0/33", InvalidCIDRError("10.0.0.0/33")},
+		{"not an address", "localhost/8", InvalidCIDRError("localhost/8")},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := CIDR(c.cidr).IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
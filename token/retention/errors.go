@@ -0,0 +1,8 @@
+package retention
+
+import "errors"
+
+var (
+	ErrInvalidMaxAge             = errors.New("maximum age cannot be negative")
+	ErrInvalidMaxEntriesPerToken = errors.New("maximum number of entries per token cannot be negative")
+)
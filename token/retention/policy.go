@@ -0,0 +1,32 @@
+package retention
+
+import "time"
+
+// Policy determines how long entries are kept in the usage log of personal access tokens.
+type Policy struct {
+	// MaxAge is the maximum age of usage log entries. Zero means entries are not removed based on their age.
+	MaxAge time.Duration
+	// MaxEntriesPerToken is the maximum number of usage log entries that are kept for each token, keeping the newest
+	// entries. Zero means entries are not removed based on their number.
+	MaxEntriesPerToken int
+	// RollUp aggregates the removed entries into daily usage per token and source IP, so that the usage history of a
+	// token is not lost entirely.
+	RollUp bool
+}
+
+func (p Policy) IsValid() error {
+	if p.MaxAge < 0 {
+		return ErrInvalidMaxAge
+	}
+
+	if p.MaxEntriesPerToken < 0 {
+		return ErrInvalidMaxEntriesPerToken
+	}
+
+	return nil
+}
+
+// Enabled returns whether the policy removes any entries from the usage log.
+func (p Policy) Enabled() bool {
+	return p.MaxAge > 0 || p.MaxEntriesPerToken > 0
+}
@@ -0,0 +1,54 @@
+package retention
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPolicy_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc   string
+		policy Policy
+		err    error
+	}{
+		{"valid policy", Policy{MaxAge: 24 * time.Hour, MaxEntriesPerToken: 100, RollUp: true}, nil},
+		{"disabled policy", Policy{}, nil},
+		{"negative max age", Policy{MaxAge: -time.Hour}, ErrInvalidMaxAge},
+		{"negative max entries", Policy{MaxEntriesPerToken: -1}, ErrInvalidMaxEntriesPerToken},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.policy.IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestPolicy_Enabled(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc     string
+		policy   Policy
+		expected bool
+	}{
+		{"max age", Policy{MaxAge: time.Hour}, true},
+		{"max entries", Policy{MaxEntriesPerToken: 10}, true},
+		{"only roll up", Policy{RollUp: true}, false},
+		{"empty policy", Policy{}, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			if actual := c.policy.Enabled(); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
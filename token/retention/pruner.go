@@ -0,0 +1,56 @@
+package retention
+
+import (
+	"context"
+	"github.com/evanebb/regauth/token"
+	"log/slog"
+	"time"
+)
+
+// Pruner enforces a Policy on the usage log of personal access tokens.
+type Pruner struct {
+	logger     *slog.Logger
+	policy     Policy
+	tokenStore token.Store
+}
+
+func NewPruner(logger *slog.Logger, policy Policy, tokenStore token.Store) Pruner {
+	return Pruner{logger: logger, policy: policy, tokenStore: tokenStore}
+}
+
+// Run prunes the usage log immediately, and then on every interval until the context is cancelled.
+func (p Pruner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Prune(ctx); err != nil {
+			p.logger.ErrorContext(ctx, "failed to prune token usage log", slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune removes all entries from the usage log that are not allowed by the policy once.
+func (p Pruner) Prune(ctx context.Context) error {
+	var before time.Time
+	if p.policy.MaxAge > 0 {
+		before = time.Now().Add(-p.policy.MaxAge)
+	}
+
+	removed, err := p.tokenStore.PruneUsageLog(ctx, before, p.policy.MaxEntriesPerToken, p.policy.RollUp)
+	if err != nil {
+		return err
+	}
+
+	if removed > 0 {
+		p.logger.InfoContext(ctx, "pruned token usage log", slog.Int64("removed", removed))
+	}
+
+	return nil
+}
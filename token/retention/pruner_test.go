@@ -0,0 +1,132 @@
+package retention
+
+import (
+	"github.com/evanebb/regauth/store/memory"
+	"github.com/evanebb/regauth/token"
+	"github.com/google/uuid"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+)
+
+// newTokenStoreWithUsage creates a token store containing a single token, which was used from the given IP address
+// once every hour, for the given number of hours until now.
+func newTokenStoreWithUsage(t *testing.T, sourceIP string, hours int) (*memory.PersonalAccessTokenStore, uuid.UUID) {
+	t.Helper()
+
+	s := memory.NewPersonalAccessTokenStore()
+	tokenID, _ := uuid.NewV7()
+	now := time.Now()
+
+	for i := hours - 1; i >= 0; i-- {
+		e := token.UsageLogEntry{TokenID: tokenID, SourceIP: net.ParseIP(sourceIP), Timestamp: now.Add(-time.Duration(i) * time.Hour)}
+		if err := s.AddUsageLogEntry(t.Context(), e); err != nil {
+			t.Fatalf("expected err to be nil, got %q", err)
+		}
+	}
+
+	return s, tokenID
+}
+
+func getUsageLog(t *testing.T, s token.Store, tokenID uuid.UUID) []token.UsageLogEntry {
+	t.Helper()
+
+	page, err := s.GetUsageLog(t.Context(), tokenID, token.UsageLogFilter{Limit: token.MaxUsageLogLimit})
+	if err != nil {
+		t.Fatalf("expected err to be nil, got %q", err)
+	}
+
+	return page.Items
+}
+
+func TestPruner_Prune(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("max age", func(t *testing.T) {
+		t.Parallel()
+
+		s, tokenID := newTokenStoreWithUsage(t, "10.0.0.1", 48)
+		p := NewPruner(logger, Policy{MaxAge: 24*time.Hour - time.Minute}, s)
+
+		if err := p.Prune(t.Context()); err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		entries := getUsageLog(t, s, tokenID)
+		if len(entries) != 24 {
+			t.Errorf("expected 24 entries to be kept, got %d", len(entries))
+		}
+
+		rollups, _ := s.GetUsageRollups(t.Context(), tokenID)
+		if len(rollups) != 0 {
+			t.Errorf("expected no rollups, got %d", len(rollups))
+		}
+	})
+
+	t.Run("max entries per token keeps newest entries", func(t *testing.T) {
+		t.Parallel()
+
+		s, tokenID := newTokenStoreWithUsage(t, "10.0.0.1", 10)
+		p := NewPruner(logger, Policy{MaxEntriesPerToken: 3}, s)
+
+		if err := p.Prune(t.Context()); err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		entries := getUsageLog(t, s, tokenID)
+		if len(entries) != 3 {
+			t.Fatalf("expected 3 entries to be kept, got %d", len(entries))
+		}
+
+		if time.Since(entries[2].Timestamp) > 3*time.Hour {
+			t.Errorf("expected the newest entries to be kept, oldest kept entry is from %s", entries[2].Timestamp)
+		}
+	})
+
+	t.Run("roll up removed entries", func(t *testing.T) {
+		t.Parallel()
+
+		s, tokenID := newTokenStoreWithUsage(t, "10.0.0.1", 72)
+		p := NewPruner(logger, Policy{MaxEntriesPerToken: 2, RollUp: true}, s)
+
+		if err := p.Prune(t.Context()); err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		rollups, err := s.GetUsageRollups(t.Context(), tokenID)
+		if err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		var total int64
+		for i, r := range rollups {
+			total += r.Count
+			if i > 0 && !r.Day.Before(rollups[i-1].Day) {
+				t.Errorf("expected rollups to be sorted from newest to oldest")
+			}
+		}
+
+		if total != 70 {
+			t.Errorf("expected 70 entries to be rolled up, got %d", total)
+		}
+	})
+
+	t.Run("disabled parts of the policy", func(t *testing.T) {
+		t.Parallel()
+
+		s, tokenID := newTokenStoreWithUsage(t, "10.0.0.1", 5)
+		p := NewPruner(logger, Policy{}, s)
+
+		if err := p.Prune(t.Context()); err != nil {
+			t.Errorf("expected err to be nil, got %q", err)
+		}
+
+		if entries := getUsageLog(t, s, tokenID); len(entries) != 5 {
+			t.Errorf("expected all entries to be kept, got %d", len(entries))
+		}
+	})
+}
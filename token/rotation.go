@@ -0,0 +1,28 @@
+package token
+
+import "time"
+
+// RotationPolicy configures the rotation of personal access tokens.
+type RotationPolicy struct {
+	// GracePeriod is the time that the previous plain-text token of a rotated token keeps working by default, which is
+	// also the maximum grace period that can be requested.
+	GracePeriod time.Duration
+}
+
+// GracePeriodFor checks the requested grace period of a rotation, and returns the configured grace period if none
+// was requested.
+func (p RotationPolicy) GracePeriodFor(requested time.Duration, ok bool) (time.Duration, error) {
+	if !ok {
+		return p.GracePeriod, nil
+	}
+
+	if requested < 0 {
+		return 0, InvalidGracePeriodError("grace period cannot be negative")
+	}
+
+	if requested > p.GracePeriod {
+		return 0, InvalidGracePeriodError("grace period cannot be longer than " + p.GracePeriod.String())
+	}
+
+	return requested, nil
+}
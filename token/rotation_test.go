@@ -0,0 +1,46 @@
+package token
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRotationPolicy_GracePeriodFor(t *testing.T) {
+	t.Parallel()
+
+	p := RotationPolicy{GracePeriod: 24 * time.Hour}
+
+	testCases := []struct {
+		desc      string
+		requested time.Duration
+		ok        bool
+		expected  time.Duration
+		valid     bool
+	}{
+		{"nothing requested", 0, false, 24 * time.Hour, true},
+		{"shorter grace period", time.Hour, true, time.Hour, true},
+		{"no grace period", 0, true, 0, true},
+		{"maximum grace period", 24 * time.Hour, true, 24 * time.Hour, true},
+		{"longer grace period", 25 * time.Hour, true, 0, false},
+		{"negative grace period", -time.Hour, true, 0, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			actual, err := p.GracePeriodFor(c.requested, c.ok)
+			if c.valid && err != nil {
+				t.Fatalf("expected err to be nil, got %q", err)
+			}
+
+			var gracePeriodErr InvalidGracePeriodError
+			if !c.valid && !errors.As(err, &gracePeriodErr) {
+				t.Fatalf("expected InvalidGracePeriodError, got %q", err)
+			}
+
+			if actual != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, actual)
+			}
+		})
+	}
+}
@@ -4,17 +4,48 @@ import (
 	"context"
 	"github.com/evanebb/regauth/store"
 	"github.com/google/uuid"
+	"time"
 )
 
 type Store interface {
 	store.TransactionStore
-	GetAllByUser(ctx context.Context, userID uuid.UUID) ([]PersonalAccessToken, error)
+	// ListByUser returns a page of the tokens of the given user, sorted and filtered on their description.
+	ListByUser(ctx context.Context, userID uuid.UUID, o store.ListOptions) (store.Page[PersonalAccessToken], error)
 	GetByID(ctx context.Context, id uuid.UUID) (PersonalAccessToken, error)
 	GetByPlainTextToken(ctx context.Context, plainTextToken string) (PersonalAccessToken, error)
 	// Create will create the given token in the underlying store. Note that the plain-text token is a password, and
 	// must be hashed by the implementor before storing it.
 	Create(ctx context.Context, t PersonalAccessToken, plainTextToken string) error
 	DeleteByID(ctx context.Context, id uuid.UUID) error
-	GetUsageLog(ctx context.Context, tokenID uuid.UUID) ([]UsageLogEntry, error)
+	// Revoke marks the given token as revoked at the given time, keeping the token and its usage log. If the token has
+	// already been revoked, the original time is kept.
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time) error
+	// Rotate replaces the plain-text token of the given token, which must be hashed by the implementor like in Create.
+	// The previous plain-text token keeps working until the given time, replacing the previous plain-text token of an
+	// earlier rotation.
+	Rotate(ctx context.Context, id uuid.UUID, plainTextToken string, previousExpiresAt time.Time) error
+	// GetExpiring returns the tokens that expire in the given time range and have not been revoked, of which the owner
+	// has not been warned about the expiration yet.
+	GetExpiring(ctx context.Context, from, until time.Time) ([]PersonalAccessToken, error)
+	// SetExpiryWarned records that the owner of the given token has been warned about its expiration at the given time.
+	SetExpiryWarned(ctx context.Context, id uuid.UUID, at time.Time) error
+	// GetExpiryWarningDeliveries returns the names of the notifiers that have already delivered the warning about the
+	// expiration of the given token.
+	GetExpiryWarningDeliveries(ctx context.Context, id uuid.UUID) ([]string, error)
+	// SetExpiryWarningDelivered records that the given notifier has delivered the warning about the expiration of the
+	// given token at the given time.
+	SetExpiryWarningDelivered(ctx context.Context, id uuid.UUID, notifier string, at time.Time) error
+	// GetUsageLog returns the entries in the usage log of the given token matching the filter, from newest to oldest.
+	GetUsageLog(ctx context.Context, tokenID uuid.UUID, f UsageLogFilter) (store.Page[UsageLogEntry], error)
+	// GetLastUsage returns the most recent usage log entry of each of the given tokens, keyed by the token ID. Tokens
+	// that have never been used are not included.
+	GetLastUsage(ctx context.Context, tokenIDs ...uuid.UUID) (map[uuid.UUID]UsageLogEntry, error)
 	AddUsageLogEntry(ctx context.Context, e UsageLogEntry) error
+	// PruneUsageLog removes the usage log entries that are older than the given time, and the entries exceeding the
+	// given maximum number of entries per token, keeping the newest ones. A zero time or maximum disables that part.
+	// If rollUp is set, the removed entries are first aggregated into the daily usage rollups of each token.
+	// It returns the number of removed entries.
+	PruneUsageLog(ctx context.Context, before time.Time, maxEntriesPerToken int, rollUp bool) (int64, error)
+	// GetUsageRollups returns the daily usage rollups of the given token, from newest to oldest.
+	GetUsageRollups(ctx context.Context, tokenID uuid.UUID) ([]UsageRollup, error)
 }
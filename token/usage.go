@@ -0,0 +1,70 @@
+package token
+
+import (
+	"github.com/evanebb/regauth/store"
+	"github.com/google/uuid"
+	"net"
+	"time"
+)
+
+const (
+	DefaultUsageLogLimit = 100
+	MaxUsageLogLimit     = 1000
+)
+
+// UsageLogFilter selects the entries that are returned when querying the usage log of a PersonalAccessToken.
+// Entries are always returned from newest to oldest.
+type UsageLogFilter struct {
+	// Since and Until restrict the entries to the ones in the given time range. Both are inclusive, and zero values are
+	// not filtered on.
+	Since time.Time
+	Until time.Time
+	Limit int
+	// Cursor continues a previous query after the last entry that it returned. It is empty for the first page.
+	Cursor store.Cursor
+}
+
+func (f UsageLogFilter) IsValid() error {
+	if !f.Since.IsZero() && !f.Until.IsZero() && f.Since.After(f.Until) {
+		return ErrInvalidUsageLogTimeRange
+	}
+
+	if f.Limit < 1 || f.Limit > MaxUsageLogLimit {
+		return ErrInvalidUsageLogLimit
+	}
+
+	_, _, err := f.ListOptions().After()
+	return err
+}
+
+// ListOptions returns the pagination of the filter as store.ListOptions, sorted from newest to oldest.
+func (f UsageLogFilter) ListOptions() store.ListOptions {
+	return store.ListOptions{
+		Limit:  f.Limit,
+		Cursor: f.Cursor,
+		Sort:   store.SortByCreatedAt,
+		Order:  store.SortDescending,
+	}
+}
+
+// Matches checks whether the given entry is in the time range of the filter.
+func (f UsageLogFilter) Matches(e UsageLogEntry) bool {
+	if !f.Since.IsZero() && e.Timestamp.Before(f.Since) {
+		return false
+	}
+
+	if !f.Until.IsZero() && e.Timestamp.After(f.Until) {
+		return false
+	}
+
+	return true
+}
+
+// UsageRollup is the aggregated usage of a PersonalAccessToken from a single IP address on a single day, which is kept
+// after the individual entries have been removed from the usage log.
+type UsageRollup struct {
+	TokenID  uuid.UUID
+	Day      time.Time
+	SourceIP net.IP
+	Count    int64
+}
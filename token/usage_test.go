@@ -0,0 +1,61 @@
+package token
+
+import (
+	"errors"
+	"github.com/evanebb/regauth/store"
+	"testing"
+	"time"
+)
+
+func TestUsageLogFilter_IsValid(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := []struct {
+		desc   string
+		filter UsageLogFilter
+		err    error
+	}{
+		{"valid filter", UsageLogFilter{Since: now.Add(-time.Hour), Until: now, Limit: DefaultUsageLogLimit}, nil},
+		{"since after until", UsageLogFilter{Since: now, Until: now.Add(-time.Hour), Limit: DefaultUsageLogLimit}, ErrInvalidUsageLogTimeRange},
+		{"limit too low", UsageLogFilter{Limit: 0}, ErrInvalidUsageLogLimit},
+		{"limit too high", UsageLogFilter{Limit: MaxUsageLogLimit + 1}, ErrInvalidUsageLogLimit},
+		{"malformed cursor", UsageLogFilter{Limit: DefaultUsageLogLimit, Cursor: "not-a-cursor"}, store.ErrInvalidCursor},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.filter.IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestUsageLogFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	e := UsageLogEntry{Timestamp: timestamp}
+
+	testCases := []struct {
+		desc     string
+		filter   UsageLogFilter
+		expected bool
+	}{
+		{"empty filter", UsageLogFilter{}, true},
+		{"inclusive time range", UsageLogFilter{Since: timestamp, Until: timestamp}, true},
+		{"before time range", UsageLogFilter{Since: timestamp.Add(time.Second)}, false},
+		{"after time range", UsageLogFilter{Until: timestamp.Add(-time.Second)}, false},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			if actual := c.filter.Matches(e); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
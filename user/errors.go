@@ -11,6 +11,8 @@ var (
 	ErrTeamMemberNotFound      = errors.New("team member not found")
 	ErrTeamMemberAlreadyExists = errors.New("team member already exists in team")
 	ErrInvalidTeamMemberRole   = errors.New("team member role is not valid, must be one of 'admin', 'user'")
+	ErrRobotNotFound           = errors.New("robot not found")
+	ErrInvalidMaxTokenLifetime = errors.New("maximum token lifetime cannot be negative")
 )
 
 type InvalidUsernameError string
@@ -24,3 +26,9 @@ type InvalidTeamNameError string
 func (e InvalidTeamNameError) Error() string {
 	return "invalid team name: " + string(e)
 }
+
+type InvalidRobotNameError string
+
+func (e InvalidRobotNameError) Error() string {
+	return "invalid robot name: " + string(e)
+}
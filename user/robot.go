@@ -0,0 +1,79 @@
+package user
+
+import (
+	"github.com/evanebb/regauth/store"
+	"github.com/google/uuid"
+	"regexp"
+	"time"
+)
+
+// Robot is a non-interactive account that is owned by a team, meant to be used by automated processes like pipelines.
+// Each robot has its own User with the RoleRobot role, which is used to authenticate it using personal access tokens.
+// Robots cannot log in using a password, and cannot use the API.
+type Robot struct {
+	// UserID is the ID of the User belonging to the robot.
+	UserID uuid.UUID
+	TeamID uuid.UUID
+	Name   RobotName
+	// Username is the username of the User belonging to the robot, see NewRobotUsername.
+	Username  Username
+	CreatedAt time.Time
+}
+
+func (r Robot) IsValid() error {
+	if err := r.Name.IsValid(); err != nil {
+		return err
+	}
+
+	if err := r.Username.isValidRobotUsername(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Position returns the position of the robot in a listing, which is sorted on the robot name.
+func (r Robot) Position() store.Position {
+	return store.Position{Name: string(r.Name), CreatedAt: r.CreatedAt, ID: r.UserID}
+}
+
+type RobotName string
+
+var validRobotName = regexp.MustCompile(`^[a-zA-Z0-9-_]+$`)
+
+func (n RobotName) IsValid() error {
+	l := len([]rune(n))
+	if l < 2 {
+		return InvalidRobotNameError("robot name cannot be shorter than 2 characters")
+	}
+
+	if l > 64 {
+		return InvalidRobotNameError("robot name cannot be longer than 64 characters")
+	}
+
+	if !validRobotName.MatchString(string(n)) {
+		return InvalidRobotNameError(`robot name can only contain alphanumeric characters, "-" and "_"`)
+	}
+
+	return nil
+}
+
+// NewRobotUsername returns the username for the robot with the given name in the given team, formatted as
+// 'team+name'. Since regular usernames cannot contain a '+', these never conflict with the usernames of other users.
+func NewRobotUsername(team TeamName, name RobotName) Username {
+	return Username(string(team) + "+" + string(name))
+}
+
+var validRobotUsername = regexp.MustCompile(`^[a-zA-Z0-9-_]+\+[a-zA-Z0-9-_]+$`)
+
+func (u Username) isValidRobotUsername() error {
+	if len([]rune(u)) > 255 {
+		return InvalidUsernameError("username cannot be longer than 255 characters")
+	}
+
+	if !validRobotUsername.MatchString(string(u)) {
+		return InvalidUsernameError(`robot username must be formatted as "team+name"`)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRobot_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc  string
+		robot Robot
+		err   error
+	}{
+		{"valid robot", Robot{Name: RobotName("ci"), Username: NewRobotUsername("team", "ci")}, nil},
+		{"invalid name", Robot{Name: RobotName("a"), Username: NewRobotUsername("team", "a")}, InvalidRobotNameError("robot name cannot be shorter than 2 characters")},
+		{"invalid username", Robot{Name: RobotName("ci"), Username: Username("ci")}, InvalidUsernameError(`robot username must be formatted as "team+name"`)},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := c.robot.IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestRobotName_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		desc string
+		name string
+		err  error
+	}{
+		{"valid robot name", "ci-pipeline", nil},
+		{"robot name too short", "a", InvalidRobotNameError("robot name cannot be shorter than 2 characters")},
+		{"robot name too long", strings.Repeat("a", 65), InvalidRobotNameError("robot name cannot be longer than 64 characters")},
+		{"robot name contains disallowed characters", "ci+robot", InvalidRobotNameError(`robot name can only contain alphanumeric characters, "-" and "_"`)},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := RobotName(c.name).IsValid()
+			if !errors.Is(err, c.err) {
+				t.Errorf("expected %q, got %q", c.err, err)
+			}
+		})
+	}
+}
+
+func TestNewRobotUsername(t *testing.T) {
+	t.Parallel()
+
+	expected := Username("myteam+ci")
+	if actual := NewRobotUsername("myteam", "ci"); actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
@@ -4,26 +4,44 @@ import (
 	"context"
 	"github.com/evanebb/regauth/store"
 	"github.com/google/uuid"
+	"time"
 )
 
 type Store interface {
 	store.TransactionStore
-	GetAll(ctx context.Context) ([]User, error)
+	// List returns a page of all users, excluding robots.
+	List(ctx context.Context, o store.ListOptions) (store.Page[User], error)
 	GetByID(ctx context.Context, id uuid.UUID) (User, error)
 	GetByUsername(ctx context.Context, username string) (User, error)
+	// GetByIdentity returns the user that was provisioned from the given account in an external identity source.
+	GetByIdentity(ctx context.Context, identity Identity) (User, error)
 	Create(ctx context.Context, u User) error
+	UpdateRole(ctx context.Context, id uuid.UUID, role Role) error
+	SetDisabled(ctx context.Context, id uuid.UUID, disabled bool) error
 	DeleteByID(ctx context.Context, id uuid.UUID) error
 }
 
 type TeamStore interface {
 	store.TransactionStore
 	GetAllByUser(ctx context.Context, userID uuid.UUID) ([]Team, error)
+	// ListByUser returns a page of the teams that the given user is a member of.
+	ListByUser(ctx context.Context, userID uuid.UUID, o store.ListOptions) (store.Page[Team], error)
 	GetByID(ctx context.Context, id uuid.UUID) (Team, error)
 	GetByName(ctx context.Context, name string) (Team, error)
 	Create(ctx context.Context, t Team) error
+	SetMaxTokenLifetime(ctx context.Context, id uuid.UUID, maxTokenLifetime time.Duration) error
 	DeleteByID(ctx context.Context, id uuid.UUID) error
 	GetTeamMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (TeamMember, error)
 	GetTeamMembers(ctx context.Context, teamID uuid.UUID) ([]TeamMember, error)
+	// ListTeamMembers returns a page of the members of the given team, sorted and filtered on their username.
+	ListTeamMembers(ctx context.Context, teamID uuid.UUID, o store.ListOptions) (store.Page[TeamMember], error)
 	AddTeamMember(ctx context.Context, m TeamMember) error
 	RemoveTeamMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error
+	// ListRobots returns a page of the robots of the given team, sorted and filtered on their name.
+	ListRobots(ctx context.Context, teamID uuid.UUID, o store.ListOptions) (store.Page[Robot], error)
+	GetRobot(ctx context.Context, teamID uuid.UUID, name string) (Robot, error)
+	GetRobotByUserID(ctx context.Context, userID uuid.UUID) (Robot, error)
+	// AddRobot will add the given robot to its team. The User belonging to the robot must already exist.
+	AddRobot(ctx context.Context, r Robot) error
+	RemoveRobot(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) error
 }
@@ -1,15 +1,19 @@
 package user
 
 import (
+	"github.com/evanebb/regauth/store"
 	"github.com/google/uuid"
 	"regexp"
 	"time"
 )
 
 type Team struct {
-	ID        uuid.UUID
-	Name      TeamName
-	CreatedAt time.Time
+	ID   uuid.UUID
+	Name TeamName
+	// MaxTokenLifetime is the maximum lifetime of the personal access tokens of the members and robots of the team, in
+	// addition to the server-wide maximum. Zero means that the team does not limit the lifetime of tokens.
+	MaxTokenLifetime time.Duration
+	CreatedAt        time.Time
 }
 
 func (t Team) IsValid() error {
@@ -17,9 +21,18 @@ func (t Team) IsValid() error {
 		return err
 	}
 
+	if t.MaxTokenLifetime < 0 {
+		return ErrInvalidMaxTokenLifetime
+	}
+
 	return nil
 }
 
+// Position returns the position of the team in a listing, which is sorted on the team name.
+func (t Team) Position() store.Position {
+	return store.Position{Name: string(t.Name), CreatedAt: t.CreatedAt, ID: t.ID}
+}
+
 type TeamName string
 
 var validTeamName = regexp.MustCompile(`^[a-zA-Z0-9-_]+$`)
@@ -61,6 +74,11 @@ func (m TeamMember) IsValid() error {
 	return nil
 }
 
+// Position returns the position of the member in a listing, which is sorted on the username.
+func (m TeamMember) Position() store.Position {
+	return store.Position{Name: string(m.Username), CreatedAt: m.CreatedAt, ID: m.UserID}
+}
+
 type TeamMemberRole string
 
 const (
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestTeam_IsValid(t *testing.T) {
@@ -16,6 +17,8 @@ func TestTeam_IsValid(t *testing.T) {
 	}{
 		{"valid team", Team{Name: TeamName("team")}, nil},
 		{"invalid name", Team{Name: TeamName("a")}, InvalidTeamNameError("team name cannot be shorter than 2 characters")},
+		{"maximum token lifetime", Team{Name: TeamName("team"), MaxTokenLifetime: 30 * 24 * time.Hour}, nil},
+		{"negative maximum token lifetime", Team{Name: TeamName("team"), MaxTokenLifetime: -time.Hour}, ErrInvalidMaxTokenLifetime},
 	}
 
 	for _, c := range testCases {
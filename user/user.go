@@ -1,20 +1,30 @@
 package user
 
 import (
+	"github.com/evanebb/regauth/store"
 	"github.com/google/uuid"
 	"regexp"
 	"time"
 )
 
 type User struct {
-	ID        uuid.UUID
-	Username  Username
-	Role      Role
+	ID       uuid.UUID
+	Username Username
+	Role     Role
+	// Disabled users cannot authenticate, but are kept along with their namespace, repositories and tokens.
+	Disabled bool
+	// Identity links the user to the account in the external identity source that it was provisioned from. It is empty
+	// for local users and robots.
+	Identity  Identity
 	CreatedAt time.Time
 }
 
 func (u User) IsValid() error {
-	if err := u.Username.IsValid(); err != nil {
+	if u.Role == RoleRobot {
+		if err := u.Username.isValidRobotUsername(); err != nil {
+			return err
+		}
+	} else if err := u.Username.IsValid(); err != nil {
 		return err
 	}
 
@@ -25,6 +35,26 @@ func (u User) IsValid() error {
 	return nil
 }
 
+// Position returns the position of the user in a listing, which is sorted on the username.
+func (u User) Position() store.Position {
+	return store.Position{Name: string(u.Username), CreatedAt: u.CreatedAt, ID: u.ID}
+}
+
+// Identity identifies an account in an external identity source, such as an OpenID Connect identity provider or LDAP
+// directory. Users are linked to their account on its identifier within the source, instead of its username, since the
+// username can often be changed by the user.
+type Identity struct {
+	// Source identifies the identity source, for example the issuer of an OpenID Connect identity provider.
+	Source string
+	// Subject is the identifier of the account within the source, for example the 'sub' claim of an ID token.
+	Subject string
+}
+
+// IsZero checks whether the identity is empty, meaning that the user was not provisioned from an identity source.
+func (i Identity) IsZero() bool {
+	return i == Identity{}
+}
+
 type Username string
 
 var validUsername = regexp.MustCompile(`^[a-zA-Z0-9-_]+$`)
@@ -51,10 +81,12 @@ type Role string
 const (
 	RoleAdmin Role = "admin"
 	RoleUser  Role = "user"
+	// RoleRobot is used for the users belonging to a Robot, and cannot be assigned to other users.
+	RoleRobot Role = "robot"
 )
 
 func (r Role) IsValid() error {
-	if r != RoleAdmin && r != RoleUser {
+	if r != RoleAdmin && r != RoleUser && r != RoleRobot {
 		return ErrInvalidRole
 	}
 
@@ -17,6 +17,9 @@ func TestUser_IsValid(t *testing.T) {
 		{"valid user", User{Username: Username("username"), Role: RoleAdmin}, nil},
 		{"invalid username", User{Username: Username("a"), Role: RoleAdmin}, InvalidUsernameError("username cannot be shorter than 2 characters")},
 		{"invalid role", User{Username: Username("username"), Role: Role("invalid")}, ErrInvalidRole},
+		{"valid robot user", User{Username: Username("team+robot"), Role: RoleRobot}, nil},
+		{"robot user without team", User{Username: Username("robot"), Role: RoleRobot}, InvalidUsernameError(`robot username must be formatted as "team+name"`)},
+		{"regular user with robot username", User{Username: Username("team+robot"), Role: RoleUser}, InvalidUsernameError(`username can only contain alphanumeric characters, "-" and "_"`)},
 	}
 
 	for _, c := range testCases {